@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+// binding is the generated code for one prompt.
+type binding struct {
+	typeName string // prefix of the generated identifiers, e.g. "SupportBot"
+	prompt   string
+	chat     bool
+	fields   []field
+}
+
+// field is one template variable of a binding.
+type field struct {
+	name        string // Go field name
+	variable    string
+	goType      string // "string", "int64", "float64", or "bool"
+	optional    bool   // declared optional in the InputSchema, so a pointer
+	description string
+}
+
+// methodNames are the methods of a generated Vars type, which fields must
+// not shadow.
+var methodNames = map[string]bool{"Vars": true, "Format": true}
+
+// initialisms are the name segments written in upper case, as Go names do.
+var initialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "id": true, "json": true,
+	"llm": true, "sql": true, "uri": true, "url": true, "xml": true,
+}
+
+func newBinding(pv *promptregistry.PromptVersion) (binding, error) {
+	b := binding{
+		typeName: goName(pv.Name, "Prompt"),
+		prompt:   pv.Name,
+		chat:     pv.IsChat(),
+	}
+	if b.typeName == "" {
+		return binding{}, fmt.Errorf("prompt %q has no letters or digits to name its type", pv.Name)
+	}
+
+	variables := pv.Variables()
+	for name := range pv.InputSchema {
+		if !slices.Contains(variables, name) {
+			variables = append(variables, name)
+		}
+	}
+	slices.Sort(variables)
+
+	byName := make(map[string]string, len(variables))
+	for _, v := range variables {
+		f := field{name: goName(v, "Var"), variable: v, goType: "string"}
+		if methodNames[f.name] {
+			f.name += "Var"
+		}
+		if other, ok := byName[f.name]; ok {
+			return binding{}, fmt.Errorf("prompt %q: variables %q and %q both map to field %s", pv.Name, other, v, f.name)
+		}
+		byName[f.name] = v
+
+		if spec, ok := pv.InputSchema[v]; ok {
+			switch spec.Type {
+			case promptregistry.VarTypeInteger:
+				f.goType = "int64"
+			case promptregistry.VarTypeNumber:
+				f.goType = "float64"
+			case promptregistry.VarTypeBoolean:
+				f.goType = "bool"
+			}
+			f.optional = !spec.Required
+			f.description = spec.Description
+		}
+		b.fields = append(b.fields, f)
+	}
+	return b, nil
+}
+
+// goName turns a prompt or variable name such as "support-bot" or
+// "user_id" into an exported Go identifier ("SupportBot", "UserID"). Names
+// starting with a digit get prefix.
+func goName(name, prefix string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, part := range parts {
+		if initialisms[strings.ToLower(part)] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	s := b.String()
+	if s != "" && unicode.IsDigit(rune(s[0])) {
+		s = prefix + s
+	}
+	return s
+}
+
+// generate returns the Go source of package pkg with bindings for
+// versions, which come from source.
+func generate(pkg, source string, versions []*promptregistry.PromptVersion) ([]byte, error) {
+	bindings := make([]binding, 0, len(versions))
+	types := make(map[string]string, len(versions))
+	for _, pv := range versions {
+		b, err := newBinding(pv)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := types[b.typeName]; ok {
+			return nil, fmt.Errorf("prompts %q and %q both map to type %sVars", other, pv.Name, b.typeName)
+		}
+		types[b.typeName] = pv.Name
+		bindings = append(bindings, b)
+	}
+	slices.SortFunc(bindings, func(a, b binding) int { return strings.Compare(a.typeName, b.typeName) })
+
+	needStrconv := false
+	for _, b := range bindings {
+		for _, f := range b.fields {
+			needStrconv = needStrconv || f.goType != "string"
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/promptgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import (\n")
+	if needStrconv {
+		buf.WriteString("\t\"strconv\"\n\n")
+	}
+	buf.WriteString("\t\"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry\"\n)\n")
+
+	for _, b := range bindings {
+		b.write(&buf)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.Bytes())
+	}
+	return code, nil
+}
+
+func (b binding) write(buf *bytes.Buffer) {
+	vars := b.typeName + "Vars"
+
+	fmt.Fprintf(buf, "\n// %sPromptName is the registry name of the %q prompt.\n", b.typeName, b.prompt)
+	fmt.Fprintf(buf, "const %sPromptName = %q\n\n", b.typeName, b.prompt)
+
+	fmt.Fprintf(buf, "// %s holds the variables of the %q prompt.\n", vars, b.prompt)
+	fmt.Fprintf(buf, "type %s struct {\n", vars)
+	for i, f := range b.fields {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "\t// %s fills {{%s}}.", f.name, f.variable)
+		if f.description != "" {
+			fmt.Fprintf(buf, " %s", strings.Join(strings.Fields(f.description), " "))
+		}
+		if f.optional {
+			buf.WriteString(" Optional: nil uses the schema default.")
+		}
+		typ := f.goType
+		if f.optional {
+			typ = "*" + typ
+		}
+		fmt.Fprintf(buf, "\n\t%s %s\n", f.name, typ)
+	}
+	buf.WriteString("}\n\n")
+
+	fmt.Fprintf(buf, "// Vars returns v as the map PromptVersion.Format takes.\n")
+	fmt.Fprintf(buf, "func (v %s) Vars() map[string]string {\n", vars)
+	buf.WriteString("\tvars := map[string]string{\n")
+	for _, f := range b.fields {
+		if !f.optional {
+			fmt.Fprintf(buf, "\t\t%q: %s,\n", f.variable, f.format("v."+f.name))
+		}
+	}
+	buf.WriteString("\t}\n")
+	for _, f := range b.fields {
+		if f.optional {
+			fmt.Fprintf(buf, "\tif v.%s != nil {\n", f.name)
+			fmt.Fprintf(buf, "\t\tvars[%q] = %s\n", f.variable, f.format("*v."+f.name))
+			buf.WriteString("\t}\n")
+		}
+	}
+	buf.WriteString("\treturn vars\n}\n\n")
+
+	if b.chat {
+		fmt.Fprintf(buf, "// Format formats pv, a version of the %q chat prompt, with v.\n", b.prompt)
+		fmt.Fprintf(buf, "func (v %s) Format(pv *promptregistry.PromptVersion) ([]promptregistry.ChatMessage, error) {\n", vars)
+		buf.WriteString("\treturn pv.FormatAsMessages(v.Vars())\n}\n")
+		return
+	}
+	fmt.Fprintf(buf, "// Format formats pv, a version of the %q prompt, with v.\n", b.prompt)
+	fmt.Fprintf(buf, "func (v %s) Format(pv *promptregistry.PromptVersion) (string, error) {\n", vars)
+	buf.WriteString("\treturn pv.FormatAsText(v.Vars())\n}\n")
+}
+
+// format returns the expression converting expr, of the field's type, to
+// the string Format substitutes.
+func (f field) format(expr string) string {
+	switch f.goType {
+	case "int64":
+		return fmt.Sprintf("strconv.FormatInt(%s, 10)", expr)
+	case "float64":
+		return fmt.Sprintf("strconv.FormatFloat(%s, 'g', -1, 64)", expr)
+	case "bool":
+		return fmt.Sprintf("strconv.FormatBool(%s)", expr)
+	}
+	return expr
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+func TestGenerate(t *testing.T) {
+	versions := []*promptregistry.PromptVersion{
+		{
+			Name:     "support-bot",
+			Template: "Hi {{user_id}}, you have {{count}} items. {{note}}",
+			InputSchema: promptregistry.InputSchema{
+				"count":  {Type: promptregistry.VarTypeInteger, Required: true, Description: "Items in the cart."},
+				"note":   {Default: ""},
+				"urgent": {Type: promptregistry.VarTypeBoolean, Default: "false"},
+			},
+		},
+		{
+			Name:     "qa.chat",
+			Messages: []promptregistry.ChatMessage{{Role: "user", Content: "{{question}}"}},
+		},
+	}
+
+	code, err := generate("prompts", "prompts/", versions)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	got := string(code)
+	for _, want := range []string{
+		"// Code generated by cmd/promptgen from prompts/. DO NOT EDIT.",
+		"package prompts",
+		`const SupportBotPromptName = "support-bot"`,
+		"// Count fills {{count}}. Items in the cart.\n\tCount int64",
+		"UserID string",
+		"Note *string",
+		"Urgent *bool",
+		`"count":   strconv.FormatInt(v.Count, 10),`,
+		"if v.Urgent != nil {\n\t\tvars[\"urgent\"] = strconv.FormatBool(*v.Urgent)",
+		"func (v SupportBotVars) Format(pv *promptregistry.PromptVersion) (string, error) {",
+		"func (v QaChatVars) Format(pv *promptregistry.PromptVersion) ([]promptregistry.ChatMessage, error) {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "QaChatVars") > strings.Index(got, "SupportBotVars") {
+		t.Error("bindings are not sorted by type name")
+	}
+}
+
+func TestGenerate_Collisions(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []*promptregistry.PromptVersion
+		wantErr  string
+	}{
+		{
+			name:     "fields",
+			versions: []*promptregistry.PromptVersion{{Name: "qa", Template: "{{user_id}} {{User_ID}}"}},
+			wantErr:  "both map to field UserID",
+		},
+		{
+			name: "types",
+			versions: []*promptregistry.PromptVersion{
+				{Name: "support-bot", Template: "hi"},
+				{Name: "support_bot", Template: "hi"},
+			},
+			wantErr: "both map to type SupportBotVars",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generate("prompts", "test", tt.versions)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("generate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePromptSpec(t *testing.T) {
+	var cfg promptregistry.LoadConfig
+
+	name, opts, err := parsePromptSpec("qa@production")
+	cfg.Apply(opts...)
+	if err != nil || name != "qa" || cfg.Alias != "production" {
+		t.Errorf(`parsePromptSpec("qa@production") = %q, %+v, %v`, name, cfg, err)
+	}
+
+	cfg = promptregistry.LoadConfig{}
+	name, opts, err = parsePromptSpec("qa/3")
+	cfg.Apply(opts...)
+	if err != nil || name != "qa" || cfg.Version != 3 {
+		t.Errorf(`parsePromptSpec("qa/3") = %q, %+v, %v`, name, cfg, err)
+	}
+
+	cfg = promptregistry.LoadConfig{}
+	name, opts, err = parsePromptSpec("team/qa")
+	cfg.Apply(opts...)
+	if err != nil || name != "team/qa" || cfg.Version != 0 {
+		t.Errorf(`parsePromptSpec("team/qa") = %q, %+v, %v`, name, cfg, err)
+	}
+
+	for _, bad := range []string{"", "qa@", "qa/0", "/3"} {
+		if _, _, err := parsePromptSpec(bad); err == nil {
+			t.Errorf("parsePromptSpec(%q) error = nil", bad)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Command promptgen generates typed Go bindings for prompts, so services
+// that format them get compile-time checking of prompt inputs.
+//
+// For each prompt it writes a <Name>Vars struct with one field per template
+// variable and a Format method that formats a loaded version with it:
+//
+//	go run github.com/opendatahub-io/mlflow-go/cmd/promptgen \
+//		-dir prompts -pkg prompts -out prompts/prompts_gen.go
+//
+// Prompts are read from local prompt files (-dir, in the format
+// promptregistry.SyncPlan uses) or loaded from the registry (-prompt,
+// repeatable, as "name", "name@alias", or "name/version"). Loading from the
+// registry uses the MLFLOW_* environment variables, and declared input
+// schemas give variables their Go types: integer as int64, number as
+// float64, boolean as bool, and optional variables as pointers.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow"
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+// promptFlags collects repeated -prompt flags.
+type promptFlags []string
+
+func (p *promptFlags) String() string { return strings.Join(*p, ",") }
+
+func (p *promptFlags) Set(s string) error {
+	*p = append(*p, s)
+	return nil
+}
+
+func main() {
+	var (
+		prompts promptFlags
+		dir     = flag.String("dir", "", "directory of prompt files to read")
+		pkg     = flag.String("pkg", "prompts", "package name of the generated file")
+		out     = flag.String("out", "", "output file (default stdout)")
+		timeout = flag.Duration("timeout", time.Minute, "timeout for loading prompts from the registry")
+	)
+	flag.Var(&prompts, "prompt", `prompt to load from the registry: "name", "name@alias", or "name/version" (repeatable)`)
+	flag.Parse()
+
+	if *dir == "" && len(prompts) == 0 {
+		fmt.Fprintln(os.Stderr, "promptgen: -dir or -prompt is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	versions, source, err := load(ctx, *dir, prompts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "promptgen: %v\n", err)
+		os.Exit(1)
+	}
+	code, err := generate(*pkg, source, versions)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "promptgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		_, _ = os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil { //nolint:gosec // generated source is meant to be readable
+		fmt.Fprintf(os.Stderr, "promptgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// load reads the prompts in dir and loads the prompts named by specs from
+// the registry, returning them with a description of where they came from.
+func load(ctx context.Context, dir string, specs []string) ([]*promptregistry.PromptVersion, string, error) {
+	var (
+		versions []*promptregistry.PromptVersion
+		sources  []string
+	)
+
+	if dir != "" {
+		files, err := promptregistry.ReadPromptFiles(dir)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, f := range files {
+			versions = append(versions, &promptregistry.PromptVersion{
+				Name:     f.Name,
+				Template: f.Template,
+				Messages: f.Messages,
+			})
+		}
+		sources = append(sources, dir)
+	}
+
+	if len(specs) > 0 {
+		client, err := mlflow.NewClient()
+		if err != nil {
+			return nil, "", err
+		}
+		for _, spec := range specs {
+			name, opts, err := parsePromptSpec(spec)
+			if err != nil {
+				return nil, "", err
+			}
+			pv, err := client.PromptRegistry().LoadPrompt(ctx, name, opts...)
+			if err != nil {
+				return nil, "", err
+			}
+			versions = append(versions, pv)
+		}
+		sources = append(sources, "the MLflow prompt registry")
+	}
+
+	return versions, strings.Join(sources, " and "), nil
+}
+
+// parsePromptSpec parses "name", "name@alias", or "name/version", the
+// forms of MLflow's prompts:/ URIs.
+func parsePromptSpec(spec string) (string, []promptregistry.LoadOption, error) {
+	if name, alias, ok := strings.Cut(spec, "@"); ok {
+		if name == "" || alias == "" {
+			return "", nil, fmt.Errorf("invalid prompt %q: want name@alias", spec)
+		}
+		return name, []promptregistry.LoadOption{promptregistry.WithAlias(alias)}, nil
+	}
+	// Namespaced names contain "/" too, so only a numeric last segment is
+	// a version
+	if i := strings.LastIndex(spec, "/"); i >= 0 {
+		if v, err := strconv.Atoi(spec[i+1:]); err == nil {
+			if i == 0 || v <= 0 {
+				return "", nil, fmt.Errorf("invalid prompt %q: want name/version with a positive version", spec)
+			}
+			return spec[:i], []promptregistry.LoadOption{promptregistry.WithVersion(v)}, nil
+		}
+	}
+	if spec == "" {
+		return "", nil, fmt.Errorf("prompt name is required")
+	}
+	return spec, nil, nil
+}
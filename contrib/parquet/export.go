@@ -0,0 +1,117 @@
+// Package mlflowparquet exports MLflow runs as Parquet files.
+//
+// It is a separate module so that the core SDK does not depend on a
+// Parquet library.
+package mlflowparquet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// timeColumns are the FlattenRuns columns written as timestamps.
+var timeColumns = map[string]bool{"start_time": true, "end_time": true}
+
+// WriteRuns writes runs to w as a Parquet file with one row per run and
+// the columns of tracking.FlattenRuns. Metric columns are doubles,
+// start_time and end_time are UTC timestamps in milliseconds, and all other
+// columns are strings. Every column is optional: cells FlattenRuns leaves
+// empty, such as keys a run did not log, are null.
+//
+// Parquet orders the columns of a row by name, so they are not in the
+// FlattenRuns order; select them by name when reading the file.
+func WriteRuns(w io.Writer, runs []tracking.Run) error {
+	if w == nil {
+		return fmt.Errorf("mlflow: writer is required")
+	}
+
+	header, rows := tracking.FlattenRuns(runs)
+
+	group := make(parquet.Group, len(header))
+	for _, col := range header {
+		switch {
+		case strings.HasPrefix(col, "metrics."):
+			group[col] = parquet.Optional(parquet.Leaf(parquet.DoubleType))
+		case timeColumns[col]:
+			group[col] = parquet.Optional(parquet.Timestamp(parquet.Millisecond))
+		default:
+			group[col] = parquet.Optional(parquet.String())
+		}
+	}
+	schema := parquet.NewSchema("runs", group)
+
+	pw := parquet.NewWriter(w, schema)
+	for _, row := range rows {
+		record, err := recordOf(header, row)
+		if err != nil {
+			return err
+		}
+		if err := pw.Write(record); err != nil {
+			return fmt.Errorf("failed to write Parquet: %w", err)
+		}
+	}
+	if err := pw.Close(); err != nil {
+		return fmt.Errorf("failed to write Parquet: %w", err)
+	}
+	return nil
+}
+
+// ExportRuns searches runs in the given experiments and writes them to w
+// with WriteRuns. An empty filter exports every active run. All matching
+// runs are fetched before anything is written, since the columns depend on
+// the keys every run logged.
+func ExportRuns(ctx context.Context, c *tracking.Client, experimentIDs []string, filter string, w io.Writer) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if w == nil {
+		return fmt.Errorf("mlflow: writer is required")
+	}
+
+	var opts []tracking.SearchRunsOption
+	if filter != "" {
+		opts = append(opts, tracking.WithRunsFilter(filter))
+	}
+	runs, err := c.SearchRunsCursor(experimentIDs, opts...).All(ctx)
+	if err != nil {
+		return err
+	}
+	return WriteRuns(w, runs)
+}
+
+// recordOf converts a FlattenRuns row to a value for the schema WriteRuns
+// builds, leaving empty cells out so they are written as nulls.
+func recordOf(header, row []string) (map[string]any, error) {
+	record := make(map[string]any, len(header))
+	for i, col := range header {
+		cell := row[i]
+		if cell == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(col, "metrics."):
+			v, err := strconv.ParseFloat(cell, 64)
+			if err != nil {
+				return nil, fmt.Errorf("mlflow: invalid value %q for %s: %w", cell, col, err)
+			}
+			record[col] = v
+		case timeColumns[col]:
+			t, err := time.Parse(time.RFC3339Nano, cell)
+			if err != nil {
+				return nil, fmt.Errorf("mlflow: invalid time %q for %s: %w", cell, col, err)
+			}
+			record[col] = t
+		default:
+			record[col] = cell
+		}
+	}
+	return record, nil
+}
@@ -0,0 +1,141 @@
+package mlflowparquet
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// runRow reads back a subset of the columns WriteRuns writes.
+type runRow struct {
+	RunID     *string  `parquet:"run_id,optional"`
+	StartTime *int64   `parquet:"start_time,optional"`
+	EndTime   *int64   `parquet:"end_time,optional"`
+	Loss      *float64 `parquet:"metrics.loss,optional"`
+	LR        *string  `parquet:"params.lr,optional"`
+}
+
+func readRuns(t *testing.T, data []byte) (columns []string, rows []runRow) {
+	t.Helper()
+	f, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	for _, path := range f.Schema().Columns() {
+		columns = append(columns, strings.Join(path, "."))
+	}
+	rows, err = parquet.Read[runRow](bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	return columns, rows
+}
+
+func TestWriteRuns(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	runs := []tracking.Run{
+		{
+			Info: tracking.RunInfo{RunID: "a", StartTime: start, EndTime: start.Add(time.Minute)},
+			Data: tracking.RunData{
+				Metrics: []tracking.Metric{{Key: "loss", Value: 0.25}},
+				Params:  []tracking.Param{{Key: "lr", Value: "0.01"}},
+			},
+		},
+		{Info: tracking.RunInfo{RunID: "b", StartTime: start}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteRuns(&buf, runs); err != nil {
+		t.Fatalf("WriteRuns() error = %v", err)
+	}
+	columns, rows := readRuns(t, buf.Bytes())
+
+	header, _ := tracking.FlattenRuns(runs)
+	slices.Sort(header)
+	if !slices.Equal(columns, header) {
+		t.Errorf("columns = %q, want %q", columns, header)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	for _, col := range []string{"start_time", "end_time"} {
+		leaf, ok := f.Schema().Lookup(col)
+		if !ok || leaf.Node.Type().LogicalType().Timestamp == nil {
+			t.Errorf("%s is not a timestamp column", col)
+		}
+	}
+
+	a, b := rows[0], rows[1]
+	if *a.RunID != "a" || *a.StartTime != start.UnixMilli() || *a.EndTime != start.Add(time.Minute).UnixMilli() {
+		t.Errorf("row a = %s %v %v", *a.RunID, *a.StartTime, *a.EndTime)
+	}
+	if a.Loss == nil || *a.Loss != 0.25 || a.LR == nil || *a.LR != "0.01" {
+		t.Errorf("row a loss = %v, lr = %v", a.Loss, a.LR)
+	}
+	// Values a run did not log are null
+	if *b.RunID != "b" || b.EndTime != nil || b.Loss != nil || b.LR != nil {
+		t.Errorf("row b = %+v, want nulls for end_time, loss, and lr", b)
+	}
+}
+
+func TestWriteRuns_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRuns(&buf, nil); err != nil {
+		t.Fatalf("WriteRuns() error = %v", err)
+	}
+	if _, rows := readRuns(t, buf.Bytes()); len(rows) != 0 {
+		t.Errorf("got %d rows, want 0", len(rows))
+	}
+}
+
+func TestExportRuns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/runs/search" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runs": [{
+			"info": {"run_id": "a", "experiment_id": "1", "status": "FINISHED", "start_time": 1772366400000},
+			"data": {"metrics": [{"key": "loss", "value": 0.5, "timestamp": 1772366400000, "step": 0}]}
+		}]}`))
+	}))
+	defer server.Close()
+
+	client, err := mlflow.NewClient(mlflow.WithTrackingURI(server.URL), mlflow.WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportRuns(context.Background(), client.Tracking(), []string{"1"}, "", &buf); err != nil {
+		t.Fatalf("ExportRuns() error = %v", err)
+	}
+	_, rows := readRuns(t, buf.Bytes())
+	if len(rows) != 1 || *rows[0].RunID != "a" || rows[0].Loss == nil || *rows[0].Loss != 0.5 {
+		t.Errorf("rows = %+v", rows)
+	}
+}
+
+func TestExportRuns_Validation(t *testing.T) {
+	if err := ExportRuns(context.Background(), nil, nil, "", &bytes.Buffer{}); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if err := WriteRuns(nil, nil); err == nil {
+		t.Error("expected error for nil writer")
+	}
+}
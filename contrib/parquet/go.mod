@@ -0,0 +1,21 @@
+module github.com/opendatahub-io/mlflow-go/contrib/parquet
+
+go 1.24.0
+
+toolchain go1.24.3
+
+require (
+	github.com/opendatahub-io/mlflow-go v0.0.0
+	github.com/parquet-go/parquet-go v0.25.1
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/pierrec/lz4/v4 v4.1.21 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
+
+replace github.com/opendatahub-io/mlflow-go => ../..
@@ -0,0 +1,144 @@
+// Package mlflowprom exports MLflow client activity as Prometheus metrics.
+//
+// It is a separate module so that the core SDK does not depend on the
+// Prometheus client library.
+package mlflowprom
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow"
+)
+
+// Collector counts and times the mutating MLflow API calls reported through
+// the SDK's audit hook. Register it with a prometheus.Registerer and pass
+// Hook to mlflow.WithAuditHook:
+//
+//	collector := mlflowprom.NewCollector()
+//	prometheus.MustRegister(collector)
+//	client, err := mlflow.NewClient(mlflow.WithAuditHook(collector.Hook()))
+//
+// Read calls (gets and searches) are not audited, so they are not counted.
+type Collector struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewCollector creates a Collector with the metrics
+// mlflow_client_requests_total{method, endpoint, code} and
+// mlflow_client_request_duration_seconds{method, endpoint}.
+//
+// endpoint is the route template of the call, such as
+// "/api/2.0/mlflow/logged-models/{id}", so IDs and artifact paths do not
+// become label values. code is the HTTP status, "error" if no response was received, or
+// "dry_run" for requests skipped in dry-run mode.
+func NewCollector() *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mlflow",
+			Subsystem: "client",
+			Name:      "requests_total",
+			Help:      "Mutating MLflow API calls by method, endpoint, and response code.",
+		}, []string{"method", "endpoint", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "mlflow",
+			Subsystem: "client",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of mutating MLflow API calls.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+	}
+}
+
+// Hook returns the function to pass to mlflow.WithAuditHook.
+func (c *Collector) Hook() func(mlflow.AuditEvent) {
+	return c.observe
+}
+
+func (c *Collector) observe(e mlflow.AuditEvent) {
+	endpoint := route(e.Method, e.Endpoint)
+	c.requests.WithLabelValues(e.Method, endpoint, code(e)).Inc()
+	if !e.DryRun {
+		c.duration.WithLabelValues(e.Method, endpoint).Observe(e.Duration.Seconds())
+	}
+}
+
+// routes are the templates of the mutating endpoints that name their
+// resource in the path. A segment in braces matches any one segment.
+// Templates are matched by method too, so that fixed endpoints such as
+// POST logged-models/search are not taken for an ID.
+var routes = []struct {
+	method   string
+	template string
+}{
+	{http.MethodPost, "/api/3.0/mlflow/traces/{id}/assessments"},
+	{http.MethodPatch, "/api/2.0/mlflow/logged-models/{id}"},
+	{http.MethodDelete, "/api/2.0/mlflow/logged-models/{id}"},
+	{http.MethodPatch, "/api/2.0/mlflow/logged-models/{id}/tags"},
+	{http.MethodDelete, "/api/2.0/mlflow/logged-models/{id}/tags/{key}"},
+	{http.MethodPost, "/api/2.0/mlflow/logged-models/{id}/params"},
+}
+
+// artifactsPrefix is the prefix of the artifact API, whose paths name the
+// artifact in any number of segments.
+const artifactsPrefix = "/api/2.0/mlflow-artifacts/artifacts/"
+
+// route returns the route template of endpoint, or endpoint itself if it
+// has no resource in its path.
+func route(method, endpoint string) string {
+	if strings.HasPrefix(endpoint, artifactsPrefix) {
+		return artifactsPrefix + "{path}"
+	}
+	segments := strings.Split(endpoint, "/")
+	for _, r := range routes {
+		if r.method == method && matchRoute(strings.Split(r.template, "/"), segments) {
+			return r.template
+		}
+	}
+	return endpoint
+}
+
+func matchRoute(template, segments []string) bool {
+	if len(template) != len(segments) {
+		return false
+	}
+	for i, t := range template {
+		if strings.HasPrefix(t, "{") {
+			if segments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if t != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func code(e mlflow.AuditEvent) string {
+	switch {
+	case e.DryRun:
+		return "dry_run"
+	case e.StatusCode == 0:
+		return "error"
+	default:
+		return strconv.Itoa(e.StatusCode)
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+}
@@ -0,0 +1,104 @@
+package mlflowprom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow"
+)
+
+func TestCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/runs/delete") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no run"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	collector := NewCollector()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collector)
+
+	client, err := mlflow.NewClient(
+		mlflow.WithTrackingURI(server.URL),
+		mlflow.WithInsecure(),
+		mlflow.WithAuditHook(collector.Hook()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	_ = client.Tracking().SetTag(ctx, "run-1", "a", "b")
+	_ = client.Tracking().SetTag(ctx, "run-1", "c", "d")
+	_ = client.Tracking().DeleteRun(ctx, "run-1")
+	_ = client.Tracking().DeleteRun(mlflow.ContextWithDryRun(ctx), "run-2")
+	// Reads are not audited
+	_, _ = client.Tracking().GetRun(ctx, "run-1")
+
+	requests := collector.requests
+	if got := testutil.ToFloat64(requests.WithLabelValues("POST", "/api/2.0/mlflow/runs/set-tag", "200")); got != 2 {
+		t.Errorf("set-tag 200 = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(requests.WithLabelValues("POST", "/api/2.0/mlflow/runs/delete", "404")); got != 1 {
+		t.Errorf("delete 404 = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(requests.WithLabelValues("POST", "/api/2.0/mlflow/runs/delete", "dry_run")); got != 1 {
+		t.Errorf("delete dry_run = %v, want 1", got)
+	}
+	if n := testutil.CollectAndCount(collector, "mlflow_client_requests_total"); n != 3 {
+		t.Errorf("requests_total series = %d, want 3", n)
+	}
+	if n := testutil.CollectAndCount(collector, "mlflow_client_request_duration_seconds"); n != 2 {
+		t.Errorf("duration series = %d, want 2 (dry runs are not timed)", n)
+	}
+}
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		event mlflow.AuditEvent
+		want  string
+	}{
+		{mlflow.AuditEvent{StatusCode: 201}, "201"},
+		{mlflow.AuditEvent{}, "error"},
+		{mlflow.AuditEvent{DryRun: true}, "dry_run"},
+	}
+	for _, tt := range tests {
+		if got := code(tt.event); got != tt.want {
+			t.Errorf("code(%+v) = %q, want %q", tt.event, got, tt.want)
+		}
+	}
+}
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		method, endpoint, want string
+	}{
+		{"POST", "/api/2.0/mlflow/runs/set-tag", "/api/2.0/mlflow/runs/set-tag"},
+		{"POST", "/api/3.0/mlflow/traces/tr-1/assessments", "/api/3.0/mlflow/traces/{id}/assessments"},
+		{"POST", "/api/3.0/mlflow/traces/tr%2F1/assessments", "/api/3.0/mlflow/traces/{id}/assessments"},
+		{"DELETE", "/api/2.0/mlflow/logged-models/m-1", "/api/2.0/mlflow/logged-models/{id}"},
+		{"PATCH", "/api/2.0/mlflow/logged-models/m-1", "/api/2.0/mlflow/logged-models/{id}"},
+		{"PATCH", "/api/2.0/mlflow/logged-models/m-1/tags", "/api/2.0/mlflow/logged-models/{id}/tags"},
+		{"DELETE", "/api/2.0/mlflow/logged-models/m-1/tags/team", "/api/2.0/mlflow/logged-models/{id}/tags/{key}"},
+		{"POST", "/api/2.0/mlflow/logged-models/m-1/params", "/api/2.0/mlflow/logged-models/{id}/params"},
+		{"POST", "/api/2.0/mlflow/logged-models", "/api/2.0/mlflow/logged-models"},
+		{"POST", "/api/2.0/mlflow/logged-models/search", "/api/2.0/mlflow/logged-models/search"},
+		{"PUT", "/api/2.0/mlflow-artifacts/artifacts/1/run-1/artifacts/model.pkl", "/api/2.0/mlflow-artifacts/artifacts/{path}"},
+	}
+	for _, tt := range tests {
+		if got := route(tt.method, tt.endpoint); got != tt.want {
+			t.Errorf("route(%q, %q) = %q, want %q", tt.method, tt.endpoint, got, tt.want)
+		}
+	}
+}
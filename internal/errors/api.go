@@ -6,6 +6,17 @@ import (
 	"net/http"
 )
 
+// ErrUnsupportedByServer is returned when the connected MLflow server has no
+// API for the requested operation.
+var ErrUnsupportedByServer = errors.New("mlflow: operation not supported by server")
+
+// ErrDeleteNotConfirmed is returned when a client with delete protection
+// refuses a destructive call whose target was not confirmed.
+var ErrDeleteNotConfirmed = errors.New("mlflow: delete not confirmed")
+
+// ErrClientClosed is returned for requests made after the client was closed.
+var ErrClientClosed = errors.New("mlflow: client closed")
+
 // APIError represents an error response from the MLflow API.
 type APIError struct {
 	StatusCode int
@@ -0,0 +1,157 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Hinter is implemented by errors that can suggest how to fix their cause.
+type Hinter interface {
+	Hint() string
+}
+
+// Hint returns a suggested fix for err, or "" if none is known. It looks
+// through wrapped errors for a Hinter.
+func Hint(err error) string {
+	var h Hinter
+	if errors.As(err, &h) {
+		return h.Hint()
+	}
+	return ""
+}
+
+// Hint suggests a fix for common failures identified by status code and
+// error code. It returns "" when there is nothing more useful to say than
+// the server's message.
+func (e *APIError) Hint() string {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return "check the Authorization header set with WithHeaders; the token may be missing or expired"
+	case e.StatusCode == http.StatusForbidden:
+		return "the credentials are valid but lack permission; check the workspace and the role bound to the caller"
+	case e.Code == "RESOURCE_ALREADY_EXISTS":
+		return "load the existing resource instead, or choose a different name"
+	case e.StatusCode == http.StatusNotFound:
+		return "check the name or ID, and that the client targets the workspace the resource lives in"
+	case strings.Contains(e.Message, "exceeded length limit") || strings.Contains(e.Message, "exceeds the maximum length"):
+		return "shorten the value or log it as an artifact; MLflow limits the length of params, tags, and names"
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "the server is rate limiting; retry with backoff or reduce concurrency"
+	case e.StatusCode >= 500:
+		return "the server failed; retry later, and check the server logs if the error persists"
+	}
+	return ""
+}
+
+// ParamAlreadyLoggedError is returned when a run already has a param with
+// the same key and a different value. MLflow params are immutable.
+type ParamAlreadyLoggedError struct {
+	*APIError
+
+	// Key is the param key, if the server named it.
+	Key string
+
+	// OldValue and NewValue are the logged and rejected values, if the
+	// server reported them.
+	OldValue string
+	NewValue string
+}
+
+// Unwrap returns the underlying APIError.
+func (e *ParamAlreadyLoggedError) Unwrap() error {
+	return e.APIError
+}
+
+// Hint implements Hinter.
+func (e *ParamAlreadyLoggedError) Hint() string {
+	key := "the param"
+	if e.Key != "" {
+		key = "param " + e.Key
+	}
+	return key + " is already logged for this run and params cannot change; " +
+		"log a metric or tag instead, or start a new run for the new value"
+}
+
+// AliasConflictError is returned when an operation is refused because
+// aliases point to the resource, such as deleting an aliased model version
+// on Databricks.
+type AliasConflictError struct {
+	*APIError
+}
+
+// Unwrap returns the underlying APIError.
+func (e *AliasConflictError) Unwrap() error {
+	return e.APIError
+}
+
+// Hint implements Hinter.
+func (e *AliasConflictError) Hint() string {
+	return "delete or reassign the aliases that point to this version, then retry"
+}
+
+// InvalidFilterError is returned when the server cannot parse a search
+// filter or order_by clause.
+type InvalidFilterError struct {
+	*APIError
+
+	// Filter is the rejected expression, if the server quoted it.
+	Filter string
+}
+
+// Unwrap returns the underlying APIError.
+func (e *InvalidFilterError) Unwrap() error {
+	return e.APIError
+}
+
+// Hint implements Hinter.
+func (e *InvalidFilterError) Hint() string {
+	return "quote string values with single quotes (params.model = 'cnn'), wrap keys with " +
+		"special characters in backticks (tags.`team-name` = 'x'), and join clauses with AND; " +
+		"OR is not supported"
+}
+
+var (
+	paramKeyPattern      = regexp.MustCompile(`[Pp]aram with key='([^']*)'`)
+	paramOldValuePattern = regexp.MustCompile(`already logged with value='([^']*)'`)
+	paramNewValuePattern = regexp.MustCompile(`new value '([^']*)'`)
+	filterPattern        = regexp.MustCompile(`filter (?:string )?'([^']*)'`)
+)
+
+// Classify returns a typed error for well-known failures, such as
+// ParamAlreadyLoggedError, wrapping e. Other errors are returned as is.
+func Classify(e *APIError) error {
+	switch {
+	case e.StatusCode == http.StatusBadRequest && strings.Contains(e.Message, "already logged"):
+		err := &ParamAlreadyLoggedError{APIError: e}
+		err.Key = submatch(paramKeyPattern, e.Message)
+		err.OldValue = submatch(paramOldValuePattern, e.Message)
+		err.NewValue = submatch(paramNewValuePattern, e.Message)
+		return err
+	case IsAliasConflict(e):
+		return &AliasConflictError{APIError: e}
+	case e.StatusCode == http.StatusBadRequest && isFilterMessage(e.Message):
+		return &InvalidFilterError{APIError: e, Filter: submatch(filterPattern, e.Message)}
+	}
+	return e
+}
+
+// isFilterMessage reports whether msg is one of the server's filter or
+// order_by parse errors.
+func isFilterMessage(msg string) bool {
+	lower := strings.ToLower(msg)
+	for _, s := range []string{"filter string", "parsing filter", "invalid clause", "invalid attribute key", "order_by"} {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func submatch(re *regexp.Regexp, s string) string {
+	if m := re.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClassify_ParamAlreadyLogged(t *testing.T) {
+	apiErr := &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_PARAMETER_VALUE",
+		Message: "Changing param values is not allowed. Param with key='lr' was already logged " +
+			"with value='0.01' for run ID='abc'. Attempted logging new value '0.02'.",
+	}
+
+	err := Classify(apiErr)
+	var param *ParamAlreadyLoggedError
+	if !errors.As(err, &param) {
+		t.Fatalf("Classify() = %T, want *ParamAlreadyLoggedError", err)
+	}
+	if param.Key != "lr" || param.OldValue != "0.01" || param.NewValue != "0.02" {
+		t.Errorf("Key, OldValue, NewValue = %q, %q, %q", param.Key, param.OldValue, param.NewValue)
+	}
+	if err.Error() != apiErr.Error() {
+		t.Errorf("Error() = %q, want the APIError message", err.Error())
+	}
+	if !IsInvalidArgument(err) {
+		t.Error("expected the typed error to still match IsInvalidArgument")
+	}
+	if hint := Hint(err); !strings.Contains(hint, "param lr") {
+		t.Errorf("Hint() = %q, want it to name the param", hint)
+	}
+}
+
+func TestClassify_ParamAlreadyLogged_OldFormat(t *testing.T) {
+	err := Classify(&APIError{
+		StatusCode: http.StatusBadRequest,
+		Message:    "Changing param values is not allowed. Params were already logged='[...]' for run ID='abc'.",
+	})
+	var param *ParamAlreadyLoggedError
+	if !errors.As(err, &param) {
+		t.Fatalf("Classify() = %T, want *ParamAlreadyLoggedError", err)
+	}
+	if param.Key != "" {
+		t.Errorf("Key = %q, want empty", param.Key)
+	}
+	if hint := param.Hint(); !strings.HasPrefix(hint, "the param") {
+		t.Errorf("Hint() = %q", hint)
+	}
+}
+
+func TestClassify_AliasConflict(t *testing.T) {
+	err := Classify(&APIError{StatusCode: http.StatusConflict, Message: "version has aliases"})
+	var conflict *AliasConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Classify() = %T, want *AliasConflictError", err)
+	}
+	if !IsAliasConflict(err) || Hint(err) == "" {
+		t.Error("expected IsAliasConflict and a hint")
+	}
+
+	// Already exists is a 409 too, but not an alias conflict
+	err = Classify(&APIError{StatusCode: http.StatusConflict, Code: "RESOURCE_ALREADY_EXISTS"})
+	if errors.As(err, &conflict) {
+		t.Error("RESOURCE_ALREADY_EXISTS classified as an alias conflict")
+	}
+}
+
+func TestClassify_InvalidFilter(t *testing.T) {
+	err := Classify(&APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_PARAMETER_VALUE",
+		Message:    "Error on parsing filter 'params.lr = 0.1 OR x'",
+	})
+	var filter *InvalidFilterError
+	if !errors.As(err, &filter) {
+		t.Fatalf("Classify() = %T, want *InvalidFilterError", err)
+	}
+	if filter.Filter != "params.lr = 0.1 OR x" {
+		t.Errorf("Filter = %q", filter.Filter)
+	}
+	if !strings.Contains(filter.Hint(), "single quotes") {
+		t.Errorf("Hint() = %q", filter.Hint())
+	}
+}
+
+func TestClassify_Unrecognized(t *testing.T) {
+	apiErr := &APIError{StatusCode: http.StatusBadRequest, Message: "Invalid name"}
+	if err := Classify(apiErr); err != error(apiErr) {
+		t.Errorf("Classify() = %#v, want the APIError unchanged", err)
+	}
+}
+
+func TestAPIError_Hint(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *APIError
+		want string // substring; "" means no hint
+	}{
+		{"unauthorized", &APIError{StatusCode: 401}, "Authorization"},
+		{"forbidden", &APIError{StatusCode: 403}, "permission"},
+		{"not found", &APIError{StatusCode: 404}, "workspace"},
+		{"already exists", &APIError{StatusCode: 400, Code: "RESOURCE_ALREADY_EXISTS"}, "different name"},
+		{"length limit", &APIError{StatusCode: 400, Message: "Param value 'x' had length 7000, which exceeded length limit of 6000"}, "artifact"},
+		{"rate limited", &APIError{StatusCode: 429}, "backoff"},
+		{"server error", &APIError{StatusCode: 503}, "retry"},
+		{"plain bad request", &APIError{StatusCode: 400, Message: "Invalid name"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.err.Hint()
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("Hint() = %q, want none", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("Hint() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHint_Wrapped(t *testing.T) {
+	err := fmt.Errorf("failed to get run: %w", &APIError{StatusCode: 404})
+	if Hint(err) == "" {
+		t.Error("expected Hint to find the wrapped APIError")
+	}
+	if Hint(errors.New("plain")) != "" {
+		t.Error("expected no hint for a plain error")
+	}
+}
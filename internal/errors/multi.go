@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ItemError is the failure of one item in a bulk operation.
+type ItemError struct {
+	// Index is the item's position in the caller's input, or -1 when the
+	// operation found the items itself (such as the versions of a prompt).
+	Index int
+
+	// Resource identifies the item, such as "version 3" or "experiments 1,2".
+	Resource string
+
+	// Err is the item's error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ItemError) Error() string {
+	switch {
+	case e.Resource != "":
+		return fmt.Sprintf("%s: %v", e.Resource, e.Err)
+	case e.Index >= 0:
+		return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+	default:
+		return e.Err.Error()
+	}
+}
+
+// Unwrap returns the item's error.
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError collects the item failures of a bulk operation. Like the error
+// returned by errors.Join, errors.Is and errors.As match it against every
+// item's error; range over Errors to report which items failed.
+type MultiError struct {
+	Errors []*ItemError
+}
+
+// Add records the failure of one item. A nil err is ignored.
+func (e *MultiError) Add(index int, resource string, err error) {
+	if err == nil {
+		return
+	}
+	e.Errors = append(e.Errors, &ItemError{Index: index, Resource: resource, Err: err})
+}
+
+// Err returns e, or nil if no failures were recorded. Return it rather than
+// e itself so callers comparing against nil see no error.
+func (e *MultiError) Err() error {
+	if e == nil || len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error implements the error interface, listing one failure per line.
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, item := range e.Errors {
+		msgs[i] = item.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the item errors, for errors.Is and errors.As.
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, item := range e.Errors {
+		errs[i] = item
+	}
+	return errs
+}
@@ -0,0 +1,51 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestMultiError(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound, Message: "gone"}
+	sentinel := errors.New("sentinel")
+
+	var multi MultiError
+	multi.Add(0, "", sentinel)
+	multi.Add(-1, "version 3", notFound)
+	multi.Add(2, "ignored", nil)
+	multi.Add(-1, "", errors.New("plain"))
+
+	err := multi.Err()
+	if err == nil {
+		t.Fatal("Err() = nil, want error")
+	}
+	if len(multi.Errors) != 3 {
+		t.Fatalf("got %d item errors, want 3 (nil is ignored)", len(multi.Errors))
+	}
+
+	want := "item 0: sentinel\nversion 3: mlflow: gone (status 404)\nplain"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	if !errors.Is(err, sentinel) || !IsNotFound(err) {
+		t.Error("errors.Is and errors.As should match every item's error")
+	}
+	var item *ItemError
+	if !errors.As(err, &item) || item.Index != 0 {
+		t.Errorf("errors.As(*ItemError) = %+v, want the first item", item)
+	}
+}
+
+func TestMultiError_ErrEmpty(t *testing.T) {
+	var multi MultiError
+	if err := multi.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	var nilMulti *MultiError
+	if err := nilMulti.Err(); err != nil {
+		t.Errorf("nil Err() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,132 @@
+// Package pagination provides a generic cursor over MLflow's token-based paging.
+package pagination
+
+import (
+	"context"
+	"sync"
+)
+
+// Page is one page of results.
+type Page[T any] struct {
+	// Items are the results in this page.
+	Items []T
+
+	// NextPageToken is the token for the following page.
+	// Empty if this is the last page.
+	NextPageToken string
+}
+
+// FetchFunc fetches the page that starts at pageToken.
+// An empty pageToken requests the first page.
+type FetchFunc[T any] func(ctx context.Context, pageToken string) (Page[T], error)
+
+// Cursor iterates over pages of results. It is safe for concurrent use:
+// each page is handed to exactly one caller of Next.
+//
+//	for {
+//		page, ok := cur.Next(ctx)
+//		if !ok {
+//			break
+//		}
+//		// use page.Items
+//	}
+//	if err := cur.Err(); err != nil {
+//		// handle error
+//	}
+type Cursor[T any] struct {
+	mu    sync.Mutex
+	fetch FetchFunc[T]
+	token string
+	done  bool
+	err   error
+}
+
+// New returns a cursor that starts at the first page.
+func New[T any](fetch FetchFunc[T]) *Cursor[T] {
+	return &Cursor[T]{fetch: fetch}
+}
+
+// NewAt returns a cursor that starts at the page identified by pageToken,
+// for resuming an iteration.
+func NewAt[T any](fetch FetchFunc[T], pageToken string) *Cursor[T] {
+	return &Cursor[T]{fetch: fetch, token: pageToken}
+}
+
+// Next fetches the next page. It returns false when there are no more pages
+// or an error occurred; check Err to tell the two apart.
+func (c *Cursor[T]) Next(ctx context.Context) (Page[T], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.done {
+		return Page[T]{}, false
+	}
+
+	page, err := c.fetch(ctx, c.token)
+	if err != nil {
+		c.err = err
+		c.done = true
+		return Page[T]{}, false
+	}
+
+	c.token = page.NextPageToken
+	if c.token == "" {
+		c.done = true
+	}
+	return page, true
+}
+
+// All fetches every remaining page and returns the combined items.
+func (c *Cursor[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for {
+		page, ok := c.Next(ctx)
+		if !ok {
+			break
+		}
+		all = append(all, page.Items...)
+	}
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+	if all == nil {
+		all = []T{}
+	}
+	return all, nil
+}
+
+// Err returns the error that stopped iteration, if any.
+func (c *Cursor[T]) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// PageToken returns the token of the next page to be fetched.
+// Empty once iteration has finished or before the first page.
+func (c *Cursor[T]) PageToken() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token
+}
+
+// Count counts the items in first, the first page of results, and in the
+// pages after it, fetching pages until more than limit items have been
+// seen. It returns the count, at most limit, and whether more than limit
+// items exist.
+func Count[T any](ctx context.Context, fetch FetchFunc[T], first Page[T], limit int) (n int, capped bool, err error) {
+	n = len(first.Items)
+	token := first.NextPageToken
+	for n <= limit && token != "" {
+		page, err := fetch(ctx, token)
+		if err != nil {
+			return 0, false, err
+		}
+		n += len(page.Items)
+		token = page.NextPageToken
+	}
+	if n > limit {
+		return limit, true, nil
+	}
+	return n, false, nil
+}
@@ -0,0 +1,166 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func pagedFetch(pages [][]int) FetchFunc[int] {
+	return func(_ context.Context, token string) (Page[int], error) {
+		i := 0
+		if token != "" {
+			i = int(token[0] - '0')
+		}
+		p := Page[int]{Items: pages[i]}
+		if i+1 < len(pages) {
+			p.NextPageToken = string(rune('0' + i + 1))
+		}
+		return p, nil
+	}
+}
+
+func TestCursor_All(t *testing.T) {
+	cur := New(pagedFetch([][]int{{1, 2}, {3}, {4, 5}}))
+
+	all, err := cur.All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(all) != 5 || all[0] != 1 || all[4] != 5 {
+		t.Errorf("All() = %v, want [1 2 3 4 5]", all)
+	}
+
+	// Exhausted cursors return no more pages
+	if _, ok := cur.Next(context.Background()); ok {
+		t.Error("Next() after exhaustion should return false")
+	}
+}
+
+func TestCursor_Empty(t *testing.T) {
+	cur := New(pagedFetch([][]int{{}}))
+
+	all, err := cur.All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if all == nil || len(all) != 0 {
+		t.Errorf("All() = %v, want empty non-nil slice", all)
+	}
+}
+
+func TestCursor_Error(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	cur := New(func(_ context.Context, token string) (Page[int], error) {
+		calls++
+		if token == "" {
+			return Page[int]{Items: []int{1}, NextPageToken: "next"}, nil
+		}
+		return Page[int]{}, wantErr
+	})
+
+	if _, ok := cur.Next(context.Background()); !ok {
+		t.Fatal("first Next() should succeed")
+	}
+	if _, ok := cur.Next(context.Background()); ok {
+		t.Fatal("second Next() should fail")
+	}
+	if !errors.Is(cur.Err(), wantErr) {
+		t.Errorf("Err() = %v, want %v", cur.Err(), wantErr)
+	}
+	if _, ok := cur.Next(context.Background()); ok || calls != 2 {
+		t.Errorf("Next() after error should not fetch again (calls = %d)", calls)
+	}
+	if _, err := New(func(context.Context, string) (Page[int], error) { return Page[int]{}, wantErr }).All(context.Background()); !errors.Is(err, wantErr) {
+		t.Errorf("All() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestCursor_NewAt(t *testing.T) {
+	cur := NewAt(pagedFetch([][]int{{1}, {2}, {3}}), "1")
+
+	all, err := cur.All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(all) != 2 || all[0] != 2 {
+		t.Errorf("All() = %v, want [2 3]", all)
+	}
+}
+
+func TestCursor_ConcurrentNext(t *testing.T) {
+	pages := make([][]int, 10)
+	for i := range pages {
+		pages[i] = []int{i}
+	}
+	cur := New(pagedFetch(pages))
+
+	var mu sync.Mutex
+	seen := make(map[int]int)
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				page, ok := cur.Next(context.Background())
+				if !ok {
+					return
+				}
+				mu.Lock()
+				for _, v := range page.Items {
+					seen[v]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 10 {
+		t.Errorf("saw %d distinct pages, want 10", len(seen))
+	}
+	for v, n := range seen {
+		if n != 1 {
+			t.Errorf("page %d delivered %d times, want 1", v, n)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	fetch := pagedFetch([][]int{{1, 2}, {3, 4}, {5}})
+	ctx := context.Background()
+	first, _ := fetch(ctx, "")
+
+	tests := []struct {
+		limit      int
+		want       int
+		wantCapped bool
+	}{
+		{limit: 10, want: 5},
+		{limit: 5, want: 5},
+		{limit: 4, want: 4, wantCapped: true},
+		{limit: 1, want: 1, wantCapped: true},
+	}
+	for _, tt := range tests {
+		n, capped, err := Count(ctx, fetch, first, tt.limit)
+		if err != nil {
+			t.Fatalf("Count(%d) error = %v", tt.limit, err)
+		}
+		if n != tt.want || capped != tt.wantCapped {
+			t.Errorf("Count(%d) = %d, %v, want %d, %v", tt.limit, n, capped, tt.want, tt.wantCapped)
+		}
+	}
+}
+
+func TestCount_Error(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(context.Context, string) (Page[int], error) { return Page[int]{}, wantErr }
+
+	_, _, err := Count(context.Background(), fetch, Page[int]{Items: []int{1}, NextPageToken: "1"}, 10)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Count() error = %v, want %v", err, wantErr)
+	}
+}
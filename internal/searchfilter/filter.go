@@ -0,0 +1,27 @@
+// Package searchfilter builds MLflow search filter expressions.
+package searchfilter
+
+import "strings"
+
+// Key escapes backticks in a backtick-quoted filter key to prevent injection.
+func Key(s string) string {
+	return strings.ReplaceAll(s, "`", "``")
+}
+
+// Value escapes single quotes in a quoted filter value to prevent injection.
+func Value(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// And joins the non-empty conditions with AND. MLflow filter syntax has no
+// grouping or OR, so appending a condition to a caller's filter with plain
+// AND cannot change what the caller's part matches.
+func And(conds ...string) string {
+	nonEmpty := make([]string, 0, len(conds))
+	for _, c := range conds {
+		if c != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+	return strings.Join(nonEmpty, " AND ")
+}
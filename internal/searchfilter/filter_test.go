@@ -0,0 +1,30 @@
+package searchfilter
+
+import "testing"
+
+func TestAnd(t *testing.T) {
+	tests := []struct {
+		conds []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"", ""}, ""},
+		{[]string{"a = 1"}, "a = 1"},
+		{[]string{"", "b = 2"}, "b = 2"},
+		{[]string{"a = 1", "", "b = 2"}, "a = 1 AND b = 2"},
+	}
+	for _, tt := range tests {
+		if got := And(tt.conds...); got != tt.want {
+			t.Errorf("And(%q) = %q, want %q", tt.conds, got, tt.want)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	if got := Value("it's"); got != "it''s" {
+		t.Errorf("Value() = %q, want %q", got, "it''s")
+	}
+	if got := Key("a`b"); got != "a``b" {
+		t.Errorf("Key() = %q, want %q", got, "a``b")
+	}
+}
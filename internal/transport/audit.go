@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// AuditEvent describes a completed mutating API call.
+type AuditEvent struct {
+	// Time is when the call started.
+	Time time.Time
+
+	// Actor identifies who made the call. Set via Config.AuditActor,
+	// defaulting to the operating system user.
+	Actor string
+
+	// OnBehalfOf is the user the call was made for when the client
+	// impersonates users (see Config.ImpersonateUser), read from the
+	// impersonation header of the request. Empty otherwise.
+	OnBehalfOf string
+
+	// Method and Endpoint identify the API call (e.g., "POST", "/api/2.0/mlflow/runs/create").
+	Method   string
+	Endpoint string
+
+	// Resource holds identifiers from the request body, such as "name",
+	// "version", "run_id", or "experiment_id". Values such as prompt
+	// templates or metric values are never included.
+	Resource map[string]string
+
+	// StatusCode is the HTTP status, or 0 if no response was received.
+	StatusCode int
+
+	// DryRun is true if the request was not sent because of dry-run mode.
+	DryRun bool
+
+	// Err is the error returned to the caller, or nil on success.
+	Err error
+
+	// Duration is how long the call took.
+	Duration time.Duration
+}
+
+// auditResourceKeys are the request body fields copied into AuditEvent.Resource.
+var auditResourceKeys = []string{
+	"name", "new_name", "version", "alias", "key",
+	"experiment_id", "run_id", "run_uuid", "trace_id", "model_id",
+}
+
+// auditResource extracts resource identifiers from an encoded request body.
+func auditResource(body []byte) map[string]string {
+	if len(body) == 0 {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+	resource := make(map[string]string)
+	for _, k := range auditResourceKeys {
+		raw, ok := fields[k]
+		if !ok {
+			continue
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err == nil {
+			resource[k] = v
+		}
+	}
+	return resource
+}
+
+// defaultAuditActor returns the operating system user, checking the same
+// environment variables as Python's getpass.getuser (used by MLflow for run user IDs).
+func defaultAuditActor() string {
+	for _, k := range []string{"LOGNAME", "USER", "LNAME", "USERNAME"} {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
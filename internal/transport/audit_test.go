@@ -0,0 +1,94 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestClient_AuditHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/2.0/mlflow/registered-models/delete" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "nope"})
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var events []AuditEvent
+	client, err := New(Config{
+		BaseURL:    server.URL,
+		AuditHook:  func(e AuditEvent) { events = append(events, e) },
+		AuditActor: "alice",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	body := map[string]any{"name": "greeting", "version": "2", "value": "secret template"}
+	if err := client.Post(ctx, "/api/2.0/mlflow/model-versions/set-tag", body, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	_ = client.Delete(ctx, "/api/2.0/mlflow/registered-models/delete", map[string]string{"name": "gone"}, nil)
+	_ = client.Get(ctx, "/api/2.0/mlflow/registered-models/get", nil, nil)
+	_ = client.Post(ctx, "/api/2.0/mlflow/runs/search", map[string]string{}, nil)
+
+	if len(events) != 2 {
+		t.Fatalf("audit events = %d, want 2 (reads are not audited)", len(events))
+	}
+
+	ok := events[0]
+	if ok.Actor != "alice" {
+		t.Errorf("Actor = %q, want %q", ok.Actor, "alice")
+	}
+	if ok.Method != http.MethodPost || ok.Endpoint != "/api/2.0/mlflow/model-versions/set-tag" {
+		t.Errorf("call = %s %s", ok.Method, ok.Endpoint)
+	}
+	if ok.Resource["name"] != "greeting" || ok.Resource["version"] != "2" {
+		t.Errorf("Resource = %v, want name and version", ok.Resource)
+	}
+	if _, leaked := ok.Resource["value"]; leaked {
+		t.Error("Resource must not include non-identifier fields")
+	}
+	if ok.StatusCode != http.StatusOK || ok.Err != nil {
+		t.Errorf("outcome = %d, %v; want 200, nil", ok.StatusCode, ok.Err)
+	}
+
+	failed := events[1]
+	if failed.StatusCode != http.StatusNotFound || !errors.IsNotFound(failed.Err) {
+		t.Errorf("outcome = %d, %v; want 404 not found", failed.StatusCode, failed.Err)
+	}
+}
+
+func TestClient_AuditHook_DryRun(t *testing.T) {
+	var events []AuditEvent
+	client, err := New(Config{
+		BaseURL:   "http://127.0.0.1:1",
+		DryRun:    true,
+		AuditHook: func(e AuditEvent) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Post(context.Background(), "/api/2.0/mlflow/runs/delete", map[string]string{"run_id": "r1"}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("audit events = %d, want 1", len(events))
+	}
+	if !events[0].DryRun {
+		t.Error("DryRun = false, want true")
+	}
+	if events[0].Resource["run_id"] != "r1" {
+		t.Errorf("Resource[run_id] = %q, want %q", events[0].Resource["run_id"], "r1")
+	}
+}
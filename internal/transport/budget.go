@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBudgetWindow is the window a RetryBudget is measured over when
+	// it sets none.
+	defaultBudgetWindow = 10 * time.Second
+
+	// defaultBudgetMinRetries is the number of retries a RetryBudget allows
+	// per window regardless of its ratio, when it sets none.
+	defaultBudgetMinRetries = 10
+
+	// budgetBuckets is the number of slots a budget window is divided into.
+	// Counts expire one slot at a time as the window slides.
+	budgetBuckets = 10
+)
+
+// RetryBudget caps the retries a client sends relative to its requests, so
+// that when a server is failing, every client retrying every request does
+// not multiply the load on it. A retry is refused once the retries sent in
+// the last Window exceed MinRetries plus Ratio times the requests sent in
+// it; the request then fails with the error of its last attempt.
+type RetryBudget struct {
+	// Ratio is the fraction of requests that may be retried, such as 0.1
+	// for one retry per ten requests.
+	Ratio float64
+
+	// Window is the sliding window requests and retries are counted over.
+	// Default: 10 seconds.
+	Window time.Duration
+
+	// MinRetries is the number of retries allowed per Window regardless of
+	// Ratio, so a client that sends few requests can still retry.
+	// Default: 10. Use a negative value for none.
+	MinRetries int
+
+	// OnExhausted, if set, is called with the method and path of each
+	// request whose retry the budget refused. It runs synchronously on the
+	// goroutine sending the request.
+	OnExhausted func(method, path string)
+}
+
+// retryBudget tracks the requests and retries of a client against a
+// RetryBudget.
+type retryBudget struct {
+	ratio       float64
+	minRetries  int
+	bucket      time.Duration
+	onExhausted func(method, path string)
+	now         func() time.Time
+
+	mu       sync.Mutex
+	requests [budgetBuckets]int
+	retries  [budgetBuckets]int
+	current  int       // index of the bucket being filled
+	start    time.Time // when the current bucket began
+}
+
+func newRetryBudget(cfg RetryBudget) *retryBudget {
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultBudgetWindow
+	}
+	minRetries := cfg.MinRetries
+	switch {
+	case minRetries == 0:
+		minRetries = defaultBudgetMinRetries
+	case minRetries < 0:
+		minRetries = 0
+	}
+	return &retryBudget{
+		ratio:       cfg.Ratio,
+		minRetries:  minRetries,
+		bucket:      window / budgetBuckets,
+		onExhausted: cfg.OnExhausted,
+		now:         time.Now,
+	}
+}
+
+// advance expires the buckets that have fallen out of the window. It must
+// be called with b.mu held.
+func (b *retryBudget) advance() {
+	now := b.now()
+	if b.start.IsZero() {
+		b.start = now
+		return
+	}
+	elapsed := int(now.Sub(b.start) / b.bucket)
+	if elapsed <= 0 {
+		return
+	}
+	for i := range min(elapsed, budgetBuckets) {
+		idx := (b.current + 1 + i) % budgetBuckets
+		b.requests[idx] = 0
+		b.retries[idx] = 0
+	}
+	b.current = (b.current + elapsed) % budgetBuckets
+	b.start = b.start.Add(time.Duration(elapsed) * b.bucket)
+}
+
+// deposit records a request sent for the first time.
+func (b *retryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	b.requests[b.current]++
+}
+
+// withdraw reports whether a retry may be sent, and records it if so.
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	var requests, retries int
+	for i := range budgetBuckets {
+		requests += b.requests[i]
+		retries += b.retries[i]
+	}
+	if float64(retries) >= float64(b.minRetries)+b.ratio*float64(requests) {
+		return false
+	}
+	b.retries[b.current]++
+	return true
+}
@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryBudget(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newRetryBudget(RetryBudget{Ratio: 0.5, Window: 10 * time.Second, MinRetries: -1})
+	b.now = func() time.Time { return now }
+
+	for range 4 {
+		b.deposit()
+	}
+	if !b.withdraw() || !b.withdraw() {
+		t.Fatal("withdraw() = false, want two retries allowed for four requests")
+	}
+	if b.withdraw() {
+		t.Error("withdraw() = true, want budget exhausted")
+	}
+
+	// The requests and retries expire once the window has passed
+	now = now.Add(11 * time.Second)
+	if b.withdraw() {
+		t.Error("withdraw() = true with no requests in the window")
+	}
+	b.deposit()
+	b.deposit()
+	if !b.withdraw() {
+		t.Error("withdraw() = false, want a retry allowed after the window slid")
+	}
+}
+
+func TestRetryBudget_MinRetries(t *testing.T) {
+	b := newRetryBudget(RetryBudget{MinRetries: 2})
+	if !b.withdraw() || !b.withdraw() {
+		t.Fatal("withdraw() = false, want MinRetries allowed without requests")
+	}
+	if b.withdraw() {
+		t.Error("withdraw() = true, want budget exhausted")
+	}
+}
+
+func TestFailover_RetryBudgetExhausted(t *testing.T) {
+	var primaryStatus, secondaryStatus atomic.Int32
+	var primaryHits, secondaryHits atomic.Int32
+	primaryStatus.Store(http.StatusInternalServerError)
+	secondaryStatus.Store(http.StatusInternalServerError)
+	primary := countingServer(t, &primaryStatus, &primaryHits)
+	secondary := countingServer(t, &secondaryStatus, &secondaryHits)
+
+	var exhausted []string
+	client, err := New(Config{
+		BaseURL:      primary.URL,
+		FailoverURLs: []string{secondary.URL},
+		RetryBudget: &RetryBudget{
+			MinRetries:  1,
+			OnExhausted: func(method, path string) { exhausted = append(exhausted, method+" "+path) },
+		},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	for range 3 {
+		if err := client.Get(ctx, "/api/test", nil, nil); err == nil {
+			t.Fatal("Get() error = nil, want server error")
+		}
+	}
+
+	// The first request fails over and sticks to the secondary; the others
+	// are refused a retry on the primary
+	if primaryHits.Load() != 1 || secondaryHits.Load() != 3 {
+		t.Errorf("primary, secondary hits = %d, %d, want 1, 3", primaryHits.Load(), secondaryHits.Load())
+	}
+	if len(exhausted) != 2 || exhausted[0] != "GET /api/test" {
+		t.Errorf("OnExhausted calls = %v, want 2 for GET /api/test", exhausted)
+	}
+	if s := client.Stats(); s.Retries != 1 || s.RetriesDenied != 2 {
+		t.Errorf("Retries, RetriesDenied = %d, %d, want 1, 2", s.Retries, s.RetriesDenied)
+	}
+}
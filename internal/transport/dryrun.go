@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+)
+
+// dryRunKey is the context key for per-call dry-run mode.
+type dryRunKey struct{}
+
+// WithDryRun returns a context that puts every mutating request made with it
+// into dry-run mode, regardless of the client configuration.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx was marked with WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	v, _ := ctx.Value(dryRunKey{}).(bool)
+	return v
+}
+
+// readOnlyPostPaths lists endpoints that use POST but never modify server state.
+// MLflow uses POST for searches whose filters do not fit comfortably in a query string.
+var readOnlyPostPaths = map[string]bool{
+	"/api/2.0/mlflow/experiments/search":   true,
+	"/api/2.0/mlflow/runs/search":          true,
+	"/api/2.0/mlflow/logged-models/search": true,
+	"/api/3.0/mlflow/traces/search":        true,
+}
+
+// isMutating reports whether a request may modify server state.
+func isMutating(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	case http.MethodPost:
+		return !readOnlyPostPaths[path]
+	default:
+		return true
+	}
+}
@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_DryRun_SkipsMutatingRequests(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	handler := &testLogHandler{}
+	client, err := New(Config{BaseURL: server.URL, DryRun: true, Logger: slog.New(handler)})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	var result map[string]string
+
+	if err := client.Post(ctx, "/api/2.0/mlflow/runs/create", map[string]string{"a": "b"}, &result); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if result != nil {
+		t.Errorf("result = %v, want untouched", result)
+	}
+	if err := client.Delete(ctx, "/api/2.0/mlflow/registered-models/delete", nil, nil); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	// Reads are still sent, including POST-based searches
+	if err := client.Get(ctx, "/api/2.0/mlflow/runs/get", nil, &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := client.Post(ctx, "/api/2.0/mlflow/runs/search", map[string]string{}, &result); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	want := []string{"GET /api/2.0/mlflow/runs/get", "POST /api/2.0/mlflow/runs/search"}
+	if len(paths) != len(want) {
+		t.Fatalf("sent requests = %v, want %v", paths, want)
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("request[%d] = %q, want %q", i, paths[i], want[i])
+		}
+	}
+
+	var dryRunLogs int
+	for _, r := range handler.records {
+		if r.Message == "dry-run: request not sent" {
+			dryRunLogs++
+		}
+	}
+	if dryRunLogs != 2 {
+		t.Errorf("dry-run log records = %d, want 2", dryRunLogs)
+	}
+}
+
+func TestClient_DryRun_PerCallContext(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Post(WithDryRun(context.Background()), "/api/2.0/mlflow/runs/delete", nil, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if called {
+		t.Error("request should not have been sent in dry-run mode")
+	}
+}
+
+func TestClient_DryRun_StillValidatesBody(t *testing.T) {
+	client, err := New(Config{BaseURL: "http://127.0.0.1:1", DryRun: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Post(context.Background(), "/api/2.0/mlflow/runs/create", map[string]any{"bad": make(chan int)}, nil)
+	if err == nil {
+		t.Error("expected encode error for unserializable body")
+	}
+}
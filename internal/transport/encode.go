@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// maxPooledBufferSize caps the buffers kept for reuse, so one unusually
+// large request does not pin its memory for the life of the process.
+const maxPooledBufferSize = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// EncodedJSON is a JSON document held in a pooled buffer.
+type EncodedJSON struct {
+	buf *bytes.Buffer
+}
+
+// EncodeJSON encodes v like json.Marshal, but into a buffer reused across
+// calls. Call Release once the bytes are no longer needed.
+func EncodeJSON(v any) (*EncodedJSON, error) {
+	buf, _ := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		bufferPool.Put(buf)
+		return nil, err
+	}
+	// Encode terminates the document with a newline; Marshal does not.
+	buf.Truncate(buf.Len() - 1)
+	return &EncodedJSON{buf: buf}, nil
+}
+
+// Bytes returns the encoded document. It is valid until Release.
+func (e *EncodedJSON) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// Release returns the buffer to the pool. e must not be used afterwards.
+func (e *EncodedJSON) Release() {
+	if e.buf.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(e.buf)
+	}
+	e.buf = nil
+}
+
+// requestBody is a pooled JSON document used as an HTTP request body.
+// net/http may finish writing and close a request body after Client.Do
+// returns, so the buffer is released only once both the sender (via
+// release) and the HTTP transport (via Close) are done with it.
+type requestBody struct {
+	*bytes.Reader
+	encoded *EncodedJSON
+	refs    atomic.Int32
+	closed  sync.Once
+}
+
+func newRequestBody(encoded *EncodedJSON) *requestBody {
+	b := &requestBody{Reader: bytes.NewReader(encoded.Bytes()), encoded: encoded}
+	b.refs.Store(2)
+	return b
+}
+
+// Close is called by the HTTP transport when it is done with the body.
+func (b *requestBody) Close() error {
+	b.closed.Do(b.release)
+	return nil
+}
+
+// reopen returns a fresh reader over the body for Request.GetBody. Each
+// reader holds its own reference until closed, since net/http and the
+// failover transport may still be sending a replayed body after the first
+// one is closed. It is only called while the sender's reference is held.
+func (b *requestBody) reopen() (io.ReadCloser, error) {
+	b.refs.Add(1)
+	return &replayedBody{Reader: bytes.NewReader(b.encoded.Bytes()), body: b}, nil
+}
+
+// replayedBody is a reader returned by requestBody.reopen.
+type replayedBody struct {
+	*bytes.Reader
+	body   *requestBody
+	closed sync.Once
+}
+
+func (r *replayedBody) Close() error {
+	r.closed.Do(r.body.release)
+	return nil
+}
+
+// release drops one reference and frees the buffer when none remain.
+func (b *requestBody) release() {
+	if b.refs.Add(-1) == 0 {
+		b.encoded.Release()
+	}
+}
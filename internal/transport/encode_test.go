@@ -0,0 +1,85 @@
+package transport
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+)
+
+func TestEncodeJSON_MatchesMarshal(t *testing.T) {
+	v := map[string]any{"name": "<b>&", "values": []int{1, 2, 3}}
+
+	want, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := EncodeJSON(v)
+	if err != nil {
+		t.Fatalf("EncodeJSON() error = %v", err)
+	}
+	defer encoded.Release()
+
+	if string(encoded.Bytes()) != string(want) {
+		t.Errorf("EncodeJSON() = %s, want %s", encoded.Bytes(), want)
+	}
+}
+
+func TestEncodeJSON_Error(t *testing.T) {
+	if _, err := EncodeJSON(make(chan int)); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+}
+
+func TestRequestBody_ReleasedAfterBothReferences(t *testing.T) {
+	encoded, err := EncodeJSON(map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := newRequestBody(encoded)
+
+	data, err := io.ReadAll(body)
+	if err != nil || string(data) != `{"a":"b"}` {
+		t.Fatalf("ReadAll() = %q, %v", data, err)
+	}
+
+	body.release()
+	if encoded.buf == nil {
+		t.Fatal("buffer released while the transport still holds the body")
+	}
+
+	// Close is idempotent and drops only the transport's reference
+	_ = body.Close()
+	_ = body.Close()
+	if encoded.buf != nil {
+		t.Error("buffer not released after both references were dropped")
+	}
+}
+
+func TestRequestBody_ReplayHoldsReference(t *testing.T) {
+	encoded, err := EncodeJSON(map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := newRequestBody(encoded)
+	replay, err := body.reopen()
+	if err != nil {
+		t.Fatalf("reopen() error = %v", err)
+	}
+
+	// The sender and the first body are done, but a replay is still sending
+	_ = body.Close()
+	body.release()
+	if encoded.buf == nil {
+		t.Fatal("buffer released while a replayed body is still open")
+	}
+	data, err := io.ReadAll(replay)
+	if err != nil || string(data) != `{"a":"b"}` {
+		t.Fatalf("ReadAll(replay) = %q, %v", data, err)
+	}
+
+	_ = replay.Close()
+	_ = replay.Close()
+	if encoded.buf != nil {
+		t.Error("buffer not released after the replayed body was closed")
+	}
+}
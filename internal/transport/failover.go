@@ -0,0 +1,258 @@
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRecoveryInterval is how often the primary is probed while
+	// requests are being sent to a secondary.
+	defaultRecoveryInterval = 30 * time.Second
+
+	// healthProbeTimeout bounds a probe of the primary's /health endpoint.
+	healthProbeTimeout = 5 * time.Second
+)
+
+// failoverTransport sends requests to the active endpoint and fails over to
+// the next one on a connection error or a server error. It stays on the
+// endpoint it failed over to, probing the primary in the background, and
+// returns to the primary once its health check passes.
+type failoverTransport struct {
+	next      http.RoundTripper
+	endpoints []*url.URL // endpoints[0] is the primary
+	logger    *slog.Logger
+	stats     *statsRecorder
+
+	// budget caps the retries sent to secondaries; nil if unlimited.
+	budget *retryBudget
+
+	recoveryInterval time.Duration
+
+	mu        sync.Mutex
+	active    int
+	lastProbe time.Time
+	probing   bool
+}
+
+func newFailoverTransport(next http.RoundTripper, primary *url.URL, secondaries []string, logger *slog.Logger, stats *statsRecorder) (*failoverTransport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &failoverTransport{
+		next:             next,
+		endpoints:        []*url.URL{primary},
+		logger:           logger,
+		stats:            stats,
+		recoveryInterval: defaultRecoveryInterval,
+	}
+	for _, s := range secondaries {
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid failover URL %q: %w", s, err)
+		}
+		t.endpoints = append(t.endpoints, u)
+	}
+	return t, nil
+}
+
+// RoundTrip implements http.RoundTripper. Requests addressed to the primary
+// fail over; others, such as reads sent to a read replica, pass through.
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.endpoints[0].Host {
+		return t.next.RoundTrip(req)
+	}
+	rel := strings.TrimPrefix(req.URL.Path, strings.TrimRight(t.endpoints[0].Path, "/"))
+	start := t.activeEndpoint()
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	for i := range t.endpoints {
+		idx := (start + i) % len(t.endpoints)
+		attempt, buildErr := t.rewrite(req, idx, rel, i > 0)
+		if buildErr != nil {
+			// The body cannot be replayed; report the previous failure
+			break
+		}
+		if i > 0 && t.stats != nil {
+			t.stats.retry(req.Method, rel)
+		}
+
+		resp, err = t.next.RoundTrip(attempt)
+		if !shouldFailover(req, resp, err) || i == len(t.endpoints)-1 {
+			if err == nil && idx != start {
+				t.switchTo(idx)
+			}
+			return resp, err
+		}
+		if t.budget != nil && !t.budget.withdraw() {
+			t.exhausted(req.Method, rel)
+			return resp, err
+		}
+
+		if t.logger != nil {
+			t.logger.Warn("failing over to next tracking server",
+				"from", t.endpoints[idx].Host,
+				"to", t.endpoints[(idx+1)%len(t.endpoints)].Host,
+				"reason", failoverReason(resp, err),
+			)
+		}
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+	}
+	if resp != nil && err == nil {
+		// Closed above; a replay was impossible, so fail with the status
+		return nil, fmt.Errorf("request failed with status %d and cannot be replayed on another server", resp.StatusCode)
+	}
+	return nil, err
+}
+
+// exhausted records that the retry budget refused to retry a request.
+func (t *failoverTransport) exhausted(method, rel string) {
+	if t.stats != nil {
+		t.stats.retryDenied()
+	}
+	if t.logger != nil {
+		t.logger.Warn("retry budget exhausted; not failing over",
+			"method", method,
+			"path", rel,
+		)
+	}
+	if t.budget.onExhausted != nil {
+		t.budget.onExhausted(method, rel)
+	}
+}
+
+// rewrite returns a copy of req addressed to endpoint idx. A retry needs a
+// fresh body from GetBody.
+func (t *failoverTransport) rewrite(req *http.Request, idx int, rel string, retry bool) (*http.Request, error) {
+	if idx == 0 && !retry {
+		return req, nil
+	}
+	out := req.Clone(req.Context())
+	ep := t.endpoints[idx]
+	out.URL.Scheme = ep.Scheme
+	out.URL.Host = ep.Host
+	out.URL.Path = strings.TrimRight(ep.Path, "/") + rel
+	out.URL.RawPath = ""
+	out.Host = ""
+
+	if retry && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("request body cannot be replayed")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		out.Body = body
+	}
+	return out, nil
+}
+
+// shouldFailover reports whether a request should be tried on the next
+// server. Reads fail over on any connection error or 5xx. Writes, which the
+// failed server may already have applied, fail over only when they cannot
+// have reached it: a failed dial, or a 502 or 503 from a gateway in front of
+// it. A 504 may come after the server applied the write, so it is treated
+// like any other server error.
+func shouldFailover(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	read := req.Method == http.MethodGet || req.Method == http.MethodHead
+	if err != nil {
+		var opErr *net.OpError
+		if stderrors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		return read && !stderrors.Is(err, context.Canceled) && !stderrors.Is(err, context.DeadlineExceeded)
+	}
+	switch {
+	case resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode >= 500:
+		return read
+	}
+	return false
+}
+
+func failoverReason(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return resp.Status
+}
+
+// activeEndpoint returns the endpoint to try first, and starts a probe of
+// the primary if one is due.
+func (t *failoverTransport) activeEndpoint() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active != 0 && !t.probing && time.Since(t.lastProbe) >= t.recoveryInterval {
+		t.probing = true
+		t.lastProbe = time.Now()
+		go t.probePrimary()
+	}
+	return t.active
+}
+
+func (t *failoverTransport) switchTo(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active == idx {
+		return
+	}
+	t.active = idx
+	if idx != 0 {
+		// Give the primary a full interval before the first probe
+		t.lastProbe = time.Now()
+	}
+}
+
+// probePrimary checks the primary's /health endpoint and makes it active
+// again if it responds with 200 OK.
+func (t *failoverTransport) probePrimary() {
+	healthy := t.checkHealth()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.probing = false
+	if healthy && t.active != 0 {
+		t.active = 0
+		if t.logger != nil {
+			t.logger.Info("primary tracking server recovered", "host", t.endpoints[0].Host)
+		}
+	}
+}
+
+func (t *failoverTransport) checkHealth() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), healthProbeTimeout)
+	defer cancel()
+
+	primary := t.endpoints[0]
+	u := *primary
+	u.Path = strings.TrimRight(primary.Path, "/") + "/health"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return false
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
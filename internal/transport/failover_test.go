@@ -0,0 +1,247 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// countingServer serves status with an empty JSON body and counts requests.
+func countingServer(t *testing.T, status *atomic.Int32, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(int(status.Load()))
+			return
+		}
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(int(status.Load()))
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func failoverOf(t *testing.T, c *Client) *failoverTransport {
+	t.Helper()
+	ft, ok := c.httpClient.Transport.(*failoverTransport)
+	if !ok {
+		t.Fatalf("transport = %T, want *failoverTransport", c.httpClient.Transport)
+	}
+	return ft
+}
+
+func TestFailover_ReadOnServerError(t *testing.T) {
+	var primaryStatus, secondaryStatus atomic.Int32
+	var primaryHits, secondaryHits atomic.Int32
+	primaryStatus.Store(http.StatusInternalServerError)
+	secondaryStatus.Store(http.StatusOK)
+	primary := countingServer(t, &primaryStatus, &primaryHits)
+	secondary := countingServer(t, &secondaryStatus, &secondaryHits)
+
+	client, err := New(Config{BaseURL: primary.URL, FailoverURLs: []string{secondary.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v, want failover to succeed", err)
+	}
+	// Sticky: the next request goes straight to the secondary
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if primaryHits.Load() != 1 || secondaryHits.Load() != 2 {
+		t.Errorf("primary, secondary hits = %d, %d, want 1, 2", primaryHits.Load(), secondaryHits.Load())
+	}
+}
+
+func TestFailover_WriteNotRetriedOnServerError(t *testing.T) {
+	var primaryStatus, secondaryStatus atomic.Int32
+	var primaryHits, secondaryHits atomic.Int32
+	primaryStatus.Store(http.StatusInternalServerError)
+	secondaryStatus.Store(http.StatusOK)
+	primary := countingServer(t, &primaryStatus, &primaryHits)
+	secondary := countingServer(t, &secondaryStatus, &secondaryHits)
+
+	client, err := New(Config{BaseURL: primary.URL, FailoverURLs: []string{secondary.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Post(context.Background(), "/api/test", map[string]string{"a": "b"}, nil)
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Post() error = %v, want the primary's 500", err)
+	}
+	if secondaryHits.Load() != 0 {
+		t.Error("a write that may have been applied was replayed on the secondary")
+	}
+
+	// A 503 from a gateway means the write never reached the server
+	primaryStatus.Store(http.StatusServiceUnavailable)
+	if err := client.Post(context.Background(), "/api/test", map[string]string{"a": "b"}, nil); err != nil {
+		t.Fatalf("Post() error = %v, want failover on 503", err)
+	}
+	if secondaryHits.Load() != 1 {
+		t.Errorf("secondary hits = %d, want 1", secondaryHits.Load())
+	}
+}
+
+func TestFailover_WriteOnDialErrorReplaysBody(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	deadURL := dead.URL
+	dead.Close()
+
+	var got map[string]string
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer secondary.Close()
+
+	client, err := New(Config{BaseURL: deadURL, FailoverURLs: []string{secondary.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Post(context.Background(), "/api/test", map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if got["name"] != "x" {
+		t.Errorf("secondary body = %v, want the original body", got)
+	}
+}
+
+func TestFailover_RewritesPathPrefix(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	deadURL := dead.URL
+	dead.Close()
+
+	var gotPath string
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{}`))
+	}))
+	defer secondary.Close()
+
+	client, err := New(Config{BaseURL: deadURL + "/mlflow", FailoverURLs: []string{secondary.URL + "/replica/"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := client.Get(context.Background(), "/api/2.0/mlflow/runs/get", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if gotPath != "/replica/api/2.0/mlflow/runs/get" {
+		t.Errorf("path = %q, want /replica/api/2.0/mlflow/runs/get", gotPath)
+	}
+}
+
+func TestFailover_AllServersFail(t *testing.T) {
+	var status atomic.Int32
+	var hitsA, hitsB atomic.Int32
+	status.Store(http.StatusBadGateway)
+	a := countingServer(t, &status, &hitsA)
+	b := countingServer(t, &status, &hitsB)
+
+	client, err := New(Config{BaseURL: a.URL, FailoverURLs: []string{b.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Get(context.Background(), "/api/test", nil, nil)
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Get() error = %v, want the last server's 502", err)
+	}
+	if hitsA.Load() != 1 || hitsB.Load() != 1 {
+		t.Errorf("hits = %d, %d, want each server tried once", hitsA.Load(), hitsB.Load())
+	}
+}
+
+func TestFailover_RecoversToPrimary(t *testing.T) {
+	var primaryStatus, secondaryStatus atomic.Int32
+	var primaryHits, secondaryHits atomic.Int32
+	primaryStatus.Store(http.StatusServiceUnavailable)
+	secondaryStatus.Store(http.StatusOK)
+	primary := countingServer(t, &primaryStatus, &primaryHits)
+	secondary := countingServer(t, &secondaryStatus, &secondaryHits)
+
+	client, err := New(Config{BaseURL: primary.URL, FailoverURLs: []string{secondary.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ft := failoverOf(t, client)
+	ft.recoveryInterval = 0
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	// The primary is back; the next request triggers a probe
+	primaryStatus.Store(http.StatusOK)
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		ft.mu.Lock()
+		active, probing := ft.active, ft.probing
+		ft.mu.Unlock()
+		if active == 0 && !probing {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("primary not restored after a healthy probe")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	before := primaryHits.Load()
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if primaryHits.Load() != before+1 {
+		t.Error("expected requests to return to the primary")
+	}
+}
+
+func TestFailover_ContextCanceledNotRetried(t *testing.T) {
+	var hits atomic.Int32
+	slow := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		io.WriteString(w, `{}`)
+	}))
+	defer secondary.Close()
+
+	client, err := New(Config{BaseURL: slow.URL, FailoverURLs: []string{secondary.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Get(ctx, "/api/test", nil, nil); err == nil {
+		t.Fatal("expected deadline error")
+	}
+	if hits.Load() != 0 {
+		t.Error("request failed over after the caller's context ended")
+	}
+}
@@ -0,0 +1,89 @@
+package transport
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// DefaultImpersonationHeader is the header that carries the impersonated
+// user unless Config.ImpersonationHeader names another. It is the header
+// set by common authenticating proxies, such as oauth2-proxy.
+const DefaultImpersonationHeader = "X-Forwarded-User"
+
+// headersKey is the context key for per-request header overrides.
+type headersKey struct{}
+
+// impersonationKey is the context key for the per-request impersonated user.
+type impersonationKey struct{}
+
+// WithImpersonation returns a context whose requests are made on behalf of
+// user, sent in the client's impersonation header.
+func WithImpersonation(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, impersonationKey{}, user)
+}
+
+// ImpersonationFromContext returns the user set with WithImpersonation.
+func ImpersonationFromContext(ctx context.Context) (string, bool) {
+	user, ok := ctx.Value(impersonationKey{}).(string)
+	return user, ok
+}
+
+// impersonatedUser returns the user requests made with ctx are made on
+// behalf of: the user in ctx, else the client's.
+func (c *Client) impersonatedUser(ctx context.Context) string {
+	if user, ok := ImpersonationFromContext(ctx); ok {
+		return user
+	}
+	return c.impersonateUser
+}
+
+// WithHeader returns a context whose requests carry the header name with
+// value, overriding a client header of the same name.
+func WithHeader(ctx context.Context, name, value string) context.Context {
+	headers := maps.Clone(contextHeaders(ctx))
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	headers[http.CanonicalHeaderKey(name)] = value
+	return context.WithValue(ctx, headersKey{}, headers)
+}
+
+// HeaderFromContext returns the value WithHeader set for name in ctx.
+func HeaderFromContext(ctx context.Context, name string) (string, bool) {
+	v, ok := contextHeaders(ctx)[http.CanonicalHeaderKey(name)]
+	return v, ok
+}
+
+// contextHeaders returns the header overrides of ctx, keyed by canonical
+// name. The map must not be modified.
+func contextHeaders(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey{}).(map[string]string)
+	return headers
+}
+
+// flightKey returns the key under which identical concurrent reads share a
+// response: the URL, plus the header overrides and impersonated user of
+// ctx, so reads made for different workspaces or users never share one.
+func flightKey(ctx context.Context, url string) string {
+	headers := contextHeaders(ctx)
+	user, impersonating := ImpersonationFromContext(ctx)
+	if len(headers) == 0 && !impersonating {
+		return url
+	}
+	var b strings.Builder
+	b.WriteString(url)
+	if impersonating {
+		b.WriteString("\non-behalf-of: ")
+		b.WriteString(user)
+	}
+	for _, name := range slices.Sorted(maps.Keys(headers)) {
+		b.WriteString("\n")
+		b.WriteString(name)
+		b.WriteString(": ")
+		b.WriteString(headers[name])
+	}
+	return b.String()
+}
@@ -0,0 +1,189 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_ContextHeaderOverridesClientHeader(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Values("X-Mlflow-Workspace")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, Headers: map[string]string{"X-MLFLOW-WORKSPACE": "default"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := WithHeader(context.Background(), "x-mlflow-workspace", "team-a")
+	if err := client.Get(ctx, "/a", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "team-a" {
+		t.Errorf("X-MLFLOW-WORKSPACE = %q, want [team-a]", got)
+	}
+
+	if err := client.Get(context.Background(), "/a", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "default" {
+		t.Errorf("X-MLFLOW-WORKSPACE = %q, want [default]", got)
+	}
+}
+
+func TestWithHeader_DoesNotModifyParent(t *testing.T) {
+	parent := WithHeader(context.Background(), "X-A", "1")
+	child := WithHeader(parent, "X-B", "2")
+
+	if _, ok := HeaderFromContext(parent, "X-B"); ok {
+		t.Error("parent context has header X-B")
+	}
+	if v, _ := HeaderFromContext(child, "x-a"); v != "1" {
+		t.Errorf("child X-A = %q, want 1", v)
+	}
+}
+
+func TestClient_Singleflight_SeparatesContextHeaders(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "` + r.Header.Get("X-Mlflow-Workspace") + `"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, Singleflight: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	workspaces := []string{"team-a", "team-b", "team-a", "team-b"}
+	var wg sync.WaitGroup
+	results := make([]string, len(workspaces))
+	for i, ws := range workspaces {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct {
+				Name string `json:"name"`
+			}
+			ctx := WithHeader(context.Background(), "X-MLFLOW-WORKSPACE", ws)
+			_ = client.Get(ctx, "/api/2.0/mlflow/registered-models/get", nil, &out)
+			results[i] = out.Name
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("server received %d requests, want 2", n)
+	}
+	for i, ws := range workspaces {
+		if results[i] != ws {
+			t.Errorf("caller %d got %q, want %q", i, results[i], ws)
+		}
+	}
+}
+
+func TestClient_Impersonation(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Remote-User")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var events []AuditEvent
+	client, err := New(Config{
+		BaseURL:             server.URL,
+		AuditHook:           func(e AuditEvent) { events = append(events, e) },
+		AuditActor:          "svc-gateway",
+		ImpersonateUser:     "alice",
+		ImpersonationHeader: "X-Remote-User",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Post(context.Background(), "/api/2.0/mlflow/runs/create", map[string]string{}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if got != "alice" {
+		t.Errorf("X-Remote-User = %q, want alice", got)
+	}
+
+	ctx := WithImpersonation(context.Background(), "bob")
+	if err := client.Post(ctx, "/api/2.0/mlflow/runs/create", map[string]string{}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if got != "bob" {
+		t.Errorf("X-Remote-User = %q, want bob", got)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d audit events, want 2", len(events))
+	}
+	for i, want := range []string{"alice", "bob"} {
+		if events[i].Actor != "svc-gateway" || events[i].OnBehalfOf != want {
+			t.Errorf("event %d: Actor = %q, OnBehalfOf = %q, want svc-gateway, %q", i, events[i].Actor, events[i].OnBehalfOf, want)
+		}
+	}
+}
+
+func TestClient_Impersonation_DefaultHeader(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(DefaultImpersonationHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Get(context.Background(), "/a", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != "" {
+		t.Errorf("%s = %q without impersonation, want empty", DefaultImpersonationHeader, got)
+	}
+
+	if err := client.Get(WithImpersonation(context.Background(), "carol"), "/a", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != "carol" {
+		t.Errorf("%s = %q, want carol", DefaultImpersonationHeader, got)
+	}
+}
+
+func TestFlightKey(t *testing.T) {
+	ctx := context.Background()
+	if got := flightKey(ctx, "u"); got != "u" {
+		t.Errorf("flightKey() = %q, want u", got)
+	}
+
+	alice := flightKey(WithImpersonation(ctx, "alice"), "u")
+	bob := flightKey(WithImpersonation(ctx, "bob"), "u")
+	teamA := flightKey(WithHeader(ctx, "X-MLFLOW-WORKSPACE", "team-a"), "u")
+	if alice == bob || alice == "u" || teamA == "u" || teamA == alice {
+		t.Errorf("flightKey() does not separate contexts: %q, %q, %q", alice, bob, teamA)
+	}
+}
@@ -0,0 +1,116 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// hedgingTransport sends a second attempt of a read that has not responded
+// within delay, and returns whichever attempt responds first, canceling the
+// other. Other requests pass through.
+type hedgingTransport struct {
+	next   http.RoundTripper
+	delay  time.Duration
+	stats  *statsRecorder
+	budget *retryBudget // caps hedges with failover retries; nil if unlimited
+}
+
+// attempt is the outcome of one attempt of a hedged request.
+type attempt struct {
+	idx    int
+	resp   *http.Response
+	err    error
+	cancel context.CancelFunc
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *hedgingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	results := make(chan attempt, 2)
+	var cancels []context.CancelFunc
+	send := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		idx := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := t.next.RoundTrip(req.Clone(ctx))
+			results <- attempt{idx: idx, resp: resp, err: err, cancel: cancel}
+		}()
+	}
+	send()
+	pending := 1
+
+	timer := time.NewTimer(t.delay)
+	defer timer.Stop()
+	hedge := timer.C
+
+	for {
+		select {
+		case <-hedge:
+			hedge = nil
+			if t.budget != nil && !t.budget.withdraw() {
+				t.stats.retryDenied()
+				if t.budget.onExhausted != nil {
+					t.budget.onExhausted(req.Method, req.URL.Path)
+				}
+				continue
+			}
+			t.stats.hedge()
+			send()
+			pending++
+
+		case r := <-results:
+			pending--
+			if r.err != nil && pending > 0 {
+				// The other attempt may still succeed
+				r.cancel()
+				continue
+			}
+			if pending > 0 {
+				// Cancel the slower attempt and discard its response
+				for i, cancel := range cancels {
+					if i != r.idx {
+						cancel()
+					}
+				}
+				go drain(results, pending)
+			}
+			if r.err != nil {
+				r.cancel()
+				return nil, r.err
+			}
+			r.resp.Body = &cancelOnClose{ReadCloser: r.resp.Body, cancel: r.cancel}
+			return r.resp, nil
+		}
+	}
+}
+
+// drain waits for the n canceled attempts still to arrive on results and
+// closes their responses.
+func drain(results <-chan attempt, n int) {
+	for range n {
+		r := <-results
+		if r.resp != nil {
+			_ = r.resp.Body.Close()
+		}
+		r.cancel()
+	}
+}
+
+// cancelOnClose releases the context of a winning attempt once its body is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
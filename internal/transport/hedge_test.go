@@ -0,0 +1,107 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedging_SlowFirstAttempt(t *testing.T) {
+	var hits atomic.Int32
+	canceled := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			// The first attempt stalls until it is canceled
+			select {
+			case <-r.Context().Done():
+				canceled <- struct{}{}
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "hedge"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, HedgeDelay: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var out struct {
+		Name string `json:"name"`
+	}
+	start := time.Now()
+	if err := client.Get(context.Background(), "/api/test", nil, &out); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if out.Name != "hedge" || time.Since(start) > 2*time.Second {
+		t.Errorf("Get() = %q after %v, want the hedged response", out.Name, time.Since(start))
+	}
+	select {
+	case <-canceled:
+	case <-time.After(2 * time.Second):
+		t.Error("slow attempt was not canceled")
+	}
+	if s := client.Stats(); s.Hedges != 1 || s.Requests != 1 {
+		t.Errorf("Hedges, Requests = %d, %d, want 1, 1", s.Hedges, s.Requests)
+	}
+}
+
+func TestHedging_FastResponseNotHedged(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, HedgeDelay: time.Second})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	// Writes are never hedged
+	if err := client.Post(ctx, "/api/test", map[string]string{}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if hits.Load() != 2 || client.Stats().Hedges != 0 {
+		t.Errorf("hits = %d, Hedges = %d, want 2, 0", hits.Load(), client.Stats().Hedges)
+	}
+}
+
+func TestHedging_RetryBudget(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(30 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{
+		BaseURL:     server.URL,
+		HedgeDelay:  5 * time.Millisecond,
+		RetryBudget: &RetryBudget{MinRetries: -1},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Get(context.Background(), "/api/test", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if s := client.Stats(); s.Hedges != 0 || s.RetriesDenied != 1 || hits.Load() != 1 {
+		t.Errorf("Hedges, RetriesDenied, hits = %d, %d, %d, want 0, 1, 1", s.Hedges, s.RetriesDenied, hits.Load())
+	}
+}
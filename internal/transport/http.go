@@ -17,11 +17,42 @@ import (
 )
 
 // Client handles HTTP communication with the MLflow API.
+//
+// Request paths are escaped URL paths: build IDs and other values into them
+// with PathSegment, and artifact paths with (&url.URL{Path: p}).EscapedPath().
 type Client struct {
 	baseURL    *url.URL
 	headers    map[string]string
+	userAgent  string
 	httpClient *http.Client
 	logger     *slog.Logger
+	dryRun     bool
+	auditHook  func(AuditEvent)
+	auditActor string
+
+	// flights deduplicates concurrent identical GET requests; nil if disabled.
+	flights *flightGroup
+
+	// timeouts sets per-request timeouts by class; nil if disabled.
+	timeouts *TimeoutProfile
+
+	signer func(*http.Request) error
+
+	// readURL receives read requests; nil if reads go to baseURL.
+	readURL *url.URL
+
+	stats *statsRecorder
+
+	// retryBudget caps failover retries; nil if unlimited.
+	retryBudget *retryBudget
+
+	limiters []*limiter
+
+	impersonateUser     string
+	impersonationHeader string
+	deleteProtection    bool
+
+	gate gate
 }
 
 // Config holds configuration for creating a transport Client.
@@ -32,6 +63,66 @@ type Config struct {
 	Logger     *slog.Logger
 	Timeout    time.Duration
 	Insecure   bool
+
+	// UserAgent is sent as the User-Agent header unless Headers sets one.
+	UserAgent string
+
+	// DryRun validates and logs mutating requests without sending them.
+	// Read requests are still sent.
+	DryRun bool
+
+	// AuditHook, if set, is called synchronously after every mutating request.
+	AuditHook func(AuditEvent)
+
+	// AuditActor is reported as AuditEvent.Actor. Defaults to the OS user.
+	AuditActor string
+
+	// Singleflight makes concurrent identical GET requests share a single
+	// HTTP round trip.
+	Singleflight bool
+
+	// TimeoutProfile, if set, replaces the single client-wide Timeout with a
+	// timeout per class of request. Timeout is then ignored.
+	TimeoutProfile *TimeoutProfile
+
+	// RequestSigner, if set, is called on each request after its headers are
+	// set and just before it is sent.
+	RequestSigner func(*http.Request) error
+
+	// FailoverURLs are secondary servers, in order of preference, that
+	// requests fail over to when BaseURL is unreachable or failing.
+	FailoverURLs []string
+
+	// RetryBudget, if set, caps the requests sent again to a failover
+	// server relative to the requests sent.
+	RetryBudget *RetryBudget
+
+	// HedgeDelay, if positive, sends a second attempt of a GET request that
+	// has not responded within it, and uses whichever responds first. Hedges
+	// are also capped by RetryBudget.
+	HedgeDelay time.Duration
+
+	// ConcurrencyLimits bound the requests in flight to matching endpoints.
+	// A request waits for a free slot, or until its context ends.
+	ConcurrencyLimits []ConcurrencyLimit
+
+	// ReadURL, if set, is a read replica that receives every request that
+	// does not modify server state. Writes go to BaseURL.
+	ReadURL string
+
+	// ImpersonateUser, if set, is sent in ImpersonationHeader on every
+	// request, unless the context names another user (see WithImpersonation).
+	ImpersonateUser string
+
+	// DeleteProtection refuses destructive calls, such as deleting a prompt,
+	// experiment, or run, unless the context confirms their target (see
+	// WithConfirm).
+	DeleteProtection bool
+
+	// ImpersonationHeader is the header that carries the user requests are
+	// made on behalf of, and that AuditEvent.OnBehalfOf is read from.
+	// Default: DefaultImpersonationHeader.
+	ImpersonationHeader string
 }
 
 // errorResponse represents the MLflow API error format.
@@ -53,6 +144,10 @@ func New(cfg Config) (*Client, error) {
 		if timeout == 0 {
 			timeout = 30 * time.Second
 		}
+		if cfg.TimeoutProfile != nil {
+			// Deadlines are set per request from the profile instead
+			timeout = 0
+		}
 		httpClient = &http.Client{Timeout: timeout}
 		if cfg.Insecure {
 			if dt, ok := http.DefaultTransport.(*http.Transport); ok {
@@ -68,11 +163,84 @@ func New(cfg Config) (*Client, error) {
 		}
 	}
 
+	var readURL *url.URL
+	if cfg.ReadURL != "" {
+		if readURL, err = url.Parse(cfg.ReadURL); err != nil {
+			return nil, fmt.Errorf("invalid read URL: %w", err)
+		}
+	}
+
+	stats := &statsRecorder{}
+	var budget *retryBudget
+	if cfg.RetryBudget != nil {
+		budget = newRetryBudget(*cfg.RetryBudget)
+	}
+	if len(cfg.FailoverURLs) > 0 {
+		ft, err := newFailoverTransport(httpClient.Transport, baseURL, cfg.FailoverURLs, cfg.Logger, stats)
+		if err != nil {
+			return nil, err
+		}
+		ft.budget = budget
+		// Copy so a caller's client is not modified
+		hc := *httpClient
+		hc.Transport = ft
+		httpClient = &hc
+	}
+	if cfg.HedgeDelay > 0 {
+		next := httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		hc := *httpClient
+		hc.Transport = &hedgingTransport{next: next, delay: cfg.HedgeDelay, stats: stats, budget: budget}
+		httpClient = &hc
+	}
+
+	auditActor := cfg.AuditActor
+	if auditActor == "" && cfg.AuditHook != nil {
+		auditActor = defaultAuditActor()
+	}
+
+	impersonationHeader := cfg.ImpersonationHeader
+	if impersonationHeader == "" {
+		impersonationHeader = DefaultImpersonationHeader
+	}
+
+	limiters := make([]*limiter, 0, len(cfg.ConcurrencyLimits))
+	for _, l := range cfg.ConcurrencyLimits {
+		lim, err := newLimiter(l)
+		if err != nil {
+			return nil, err
+		}
+		limiters = append(limiters, lim)
+	}
+
+	var flights *flightGroup
+	if cfg.Singleflight {
+		flights = &flightGroup{}
+	}
+
 	return &Client{
 		baseURL:    baseURL,
 		headers:    cfg.Headers,
+		userAgent:  cfg.UserAgent,
 		httpClient: httpClient,
 		logger:     cfg.Logger,
+		dryRun:     cfg.DryRun,
+		auditHook:  cfg.AuditHook,
+		auditActor: auditActor,
+		flights:    flights,
+		timeouts:   cfg.TimeoutProfile,
+		signer:     cfg.RequestSigner,
+		readURL:    readURL,
+		stats:      stats,
+
+		retryBudget: budget,
+		limiters:    limiters,
+
+		impersonateUser:     cfg.ImpersonateUser,
+		impersonationHeader: impersonationHeader,
+		deleteProtection:    cfg.DeleteProtection,
 	}, nil
 }
 
@@ -86,53 +254,282 @@ func (c *Client) Post(ctx context.Context, path string, body, result any) error
 	return c.do(ctx, http.MethodPost, path, nil, body, result)
 }
 
+// Patch performs a PATCH request to the specified path with a JSON body.
+func (c *Client) Patch(ctx context.Context, path string, body, result any) error {
+	return c.do(ctx, http.MethodPatch, path, nil, body, result)
+}
+
 // Delete performs a DELETE request to the specified path with a JSON body.
 func (c *Client) Delete(ctx context.Context, path string, body, result any) error {
 	return c.do(ctx, http.MethodDelete, path, nil, body, result)
 }
 
-func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
-	// Build request URL, preserving any path prefix from the base URL
-	// (e.g., base "https://host/mlflow" + path "/api/2.0/mlflow/..." → "/mlflow/api/2.0/mlflow/...")
-	fullPath := strings.TrimRight(c.baseURL.Path, "/") + path
-	reqURL := c.baseURL.ResolveReference(&url.URL{Path: fullPath, RawQuery: query.Encode()})
+// Upload performs a PUT request that streams body to the specified path as
+// application/octet-stream. size is sent as Content-Length; pass -1 if unknown.
+func (c *Client) Upload(ctx context.Context, path string, body io.Reader, size int64) error {
+	return c.send(ctx, http.MethodPut, path, nil, payload{
+		reader:      body,
+		size:        size,
+		contentType: contentTypeOctetStream,
+	}, nil)
+}
 
+// Download performs a GET request to the specified path and returns the raw
+// response body, for endpoints that serve files rather than JSON.
+func (c *Client) Download(ctx context.Context, path string) ([]byte, error) {
+	var data rawBody
+	if err := c.send(ctx, http.MethodGet, path, nil, payload{}, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+const contentTypeOctetStream = "application/octet-stream"
+
+// rawBody is a result that receives the undecoded response body.
+type rawBody []byte
+
+// payload is a request body ready to send.
+type payload struct {
+	reader      io.Reader
+	size        int64
+	contentType string
+
+	// data holds the encoded JSON body, if any, for audit events.
+	data []byte
+
+	// getBody, if set, returns a fresh copy of the body for replays.
+	getBody func() (io.ReadCloser, error)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
 	// Encode body if present
-	var bodyReader io.Reader
+	p := payload{contentType: "application/json"}
 	if body != nil {
-		data, err := json.Marshal(body)
+		encoded, err := EncodeJSON(body)
 		if err != nil {
 			return fmt.Errorf("failed to encode request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(data)
+		rb := newRequestBody(encoded)
+		// send has finished with p.data, including audit, when it returns;
+		// the HTTP transport releases its reference by closing the body.
+		defer rb.release()
+
+		p.reader = rb
+		p.size = int64(len(encoded.Bytes()))
+		p.data = encoded.Bytes()
+		p.getBody = rb.reopen
+	}
+
+	return c.send(ctx, method, path, query, p, result)
+}
+
+// PathSegment escapes s for use as a single segment of a request path, so
+// that "/", "?", and "%" in it are sent literally. It returns an error if s
+// is empty, ".", or "..", which would address a different endpoint.
+func PathSegment(s string) (string, error) {
+	if s == "" || s == "." || s == ".." {
+		return "", fmt.Errorf("%q is not a valid path segment", s)
+	}
+	return url.PathEscape(s), nil
+}
+
+// requestURL resolves path, an escaped URL path, against baseURL. Setting
+// RawPath keeps escaped slashes in a segment such as a trace ID from
+// splitting it, and keeps dot segments inside it from being resolved.
+func requestURL(baseURL *url.URL, path string, query url.Values) (*url.URL, error) {
+	fullPath := strings.TrimRight(baseURL.EscapedPath(), "/") + path
+	unescaped, err := url.PathUnescape(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: invalid request path %q: %w", path, err)
+	}
+	return baseURL.ResolveReference(&url.URL{Path: unescaped, RawPath: fullPath, RawQuery: query.Encode()}), nil
+}
+
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body payload, result any) (err error) {
+	// Build request URL, preserving any path prefix from the base URL
+	// (e.g., base "https://host/mlflow" + path "/api/2.0/mlflow/..." → "/mlflow/api/2.0/mlflow/...")
+	baseURL := c.baseURLFor(ctx, method, path)
+	reqURL, err := requestURL(baseURL, path, query)
+	if err != nil {
+		return err
+	}
+
+	dryRun := (c.dryRun || IsDryRun(ctx)) && isMutating(method, path)
+
+	if c.timeouts != nil {
+		if d := c.timeouts.timeoutFor(body, result); d > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+	}
+
+	// Report the outcome of mutating calls once they complete
+	var (
+		statusCode int
+		onBehalfOf string
+	)
+	if c.auditHook != nil && isMutating(method, path) {
+		auditStart := time.Now()
+		defer func() {
+			c.auditHook(AuditEvent{
+				Time:       auditStart,
+				Actor:      c.auditActor,
+				OnBehalfOf: onBehalfOf,
+				Method:     method,
+				Endpoint:   path,
+				Resource:   auditResource(body.data),
+				StatusCode: statusCode,
+				DryRun:     dryRun,
+				Err:        err,
+				Duration:   time.Since(auditStart),
+			})
+		}()
+	}
+
+	if c.deleteProtection {
+		if err = checkConfirmed(ctx, method, path, body.data); err != nil {
+			return err
+		}
 	}
 
 	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), body.reader)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
+	if body.reader != nil && body.size >= 0 {
+		req.ContentLength = body.size
+	}
+	if body.getBody != nil {
+		// Allow net/http to replay the body on redirects and retries
+		req.GetBody = body.getBody
+	}
 
 	// Set headers
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", body.contentType)
 	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 	for k, v := range c.headers {
 		req.Header.Set(k, v)
 	}
+	for k, v := range contextHeaders(ctx) {
+		req.Header.Set(k, v)
+	}
+	if user := c.impersonatedUser(ctx); user != "" {
+		req.Header.Set(c.impersonationHeader, user)
+	}
+	onBehalfOf = req.Header.Get(c.impersonationHeader)
+
+	// In dry-run mode, stop after the request is fully built.
+	// The result is left untouched so callers see zero-valued responses.
+	if dryRun {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		if c.logger != nil {
+			c.logger.Info("dry-run: request not sent",
+				"method", method,
+				"url", reqURL.String(),
+			)
+		}
+		return nil
+	}
+
+	if c.signer != nil {
+		if err = c.signer(req); err != nil {
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			return fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+
+	if err = c.gate.enter(); err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return err
+	}
+	defer c.gate.leave()
+
+	done := c.stats.start(method, path)
+	defer func() { done(err) }()
+	if c.retryBudget != nil {
+		c.retryBudget.deposit()
+	}
 
+	// Execute request within the concurrency limits, sharing the response
+	// with identical concurrent reads
+	execute := func(s *stream) (int, []byte, error) {
+		release, err := c.limit(ctx, method, path)
+		if err != nil {
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			return 0, nil, err
+		}
+		defer release()
+		return c.execute(req, s)
+	}
+	var respBody []byte
+	s, streaming := result.(*stream)
+	switch {
+	case streaming:
+		statusCode, respBody, err = execute(s)
+	case c.flights != nil && method == http.MethodGet:
+		statusCode, respBody, err = c.flights.do(ctx, flightKey(ctx, reqURL.String()), func() (int, []byte, error) {
+			return execute(nil)
+		})
+	default:
+		statusCode, respBody, err = execute(nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Handle error responses
+	if statusCode >= 400 {
+		return c.parseError(statusCode, respBody)
+	}
+	if streaming {
+		return nil
+	}
+
+	// Decode successful response
+	if raw, ok := result.(*rawBody); ok {
+		// Copy, since the body may be shared with other callers
+		*raw = bytes.Clone(respBody)
+		return nil
+	}
+	if result != nil && len(respBody) > 0 {
+		if err = json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// execute sends req and returns the response status code and body.
+// If s is set, a successful response is decoded by s as it is read and the
+// returned body is nil.
+func (c *Client) execute(req *http.Request, s *stream) (int, []byte, error) {
 	// Log request
 	start := time.Now()
 	if c.logger != nil {
 		c.logger.Debug("request",
-			"method", method,
-			"url", reqURL.String(),
+			"method", req.Method,
+			"url", req.URL.String(),
 		)
 	}
 
-	// Execute request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return 0, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -145,25 +542,17 @@ func (c *Client) do(ctx context.Context, method, path string, query url.Values,
 		)
 	}
 
+	if s != nil && resp.StatusCode < 400 {
+		return resp.StatusCode, nil, s.decode(resp.Body)
+	}
+
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Handle error responses
-	if resp.StatusCode >= 400 {
-		return c.parseError(resp.StatusCode, respBody)
+		return 0, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Decode successful response
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-	}
-
-	return nil
+	return resp.StatusCode, respBody, nil
 }
 
 func (c *Client) parseError(statusCode int, body []byte) error {
@@ -176,9 +565,9 @@ func (c *Client) parseError(statusCode int, body []byte) error {
 		}
 	}
 
-	return &errors.APIError{
+	return errors.Classify(&errors.APIError{
 		StatusCode: statusCode,
 		Code:       errResp.ErrorCode,
 		Message:    errResp.Message,
-	}
+	})
 }
@@ -3,10 +3,12 @@ package transport
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -87,6 +89,99 @@ func TestClient_Post_Success(t *testing.T) {
 	}
 }
 
+func TestClient_Patch_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["status"] != "READY" {
+			t.Errorf("expected body.status=READY, got %s", body["status"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"id": "m-1"})
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var result map[string]string
+	err = client.Patch(context.Background(), "/api/update", map[string]string{"status": "READY"}, &result)
+	if err != nil {
+		t.Fatalf("Patch() error = %v", err)
+	}
+
+	if result["id"] != "m-1" {
+		t.Errorf("result = %v, want id=m-1", result)
+	}
+}
+
+func TestClient_Upload_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.Header.Get("Content-Type") != "application/octet-stream" {
+			t.Errorf("expected Content-Type application/octet-stream, got %s", r.Header.Get("Content-Type"))
+		}
+		if r.ContentLength != 5 {
+			t.Errorf("ContentLength = %d, want 5", r.ContentLength)
+		}
+		if r.URL.Path != "/api/upload/dir/file name.txt" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		if string(data) != "hello" {
+			t.Errorf("body = %q, want %q", data, "hello")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Upload(context.Background(), "/api/upload/dir/file name.txt", strings.NewReader("hello"), 5)
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+}
+
+func TestClient_Download_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/download/file.txt" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(`{"not": "decoded"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	data, err := client.Download(context.Background(), "/api/download/file.txt")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if string(data) != `{"not": "decoded"}` {
+		t.Errorf("body = %q", data)
+	}
+}
+
 func TestClient_Error_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -165,6 +260,35 @@ func TestClient_Error_Conflict(t *testing.T) {
 	}
 }
 
+func TestClient_Error_Classified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error_code": "INVALID_PARAMETER_VALUE",
+			"message":    "Changing param values is not allowed. Param with key='lr' was already logged with value='0.1' for run ID='r1'. Attempted logging new value '0.2'.",
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Post(context.Background(), "/api/test", nil, nil)
+	paramErr, ok := err.(*errors.ParamAlreadyLoggedError)
+	if !ok {
+		t.Fatalf("expected *ParamAlreadyLoggedError, got %T", err)
+	}
+	if paramErr.Key != "lr" {
+		t.Errorf("Key = %q, want lr", paramErr.Key)
+	}
+	if !errors.IsInvalidArgument(err) {
+		t.Error("expected IsInvalidArgument to match the wrapped APIError")
+	}
+}
+
 func TestClient_ContextCancellation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		time.Sleep(100 * time.Millisecond)
@@ -493,3 +617,96 @@ func TestCustomHeadersWithToken(t *testing.T) {
 		t.Errorf("X-MLFLOW-WORKSPACE = %q, want %q", got, "team-dora")
 	}
 }
+
+func TestClient_RequestSigner(t *testing.T) {
+	var gotSig, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get("X-Signature")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{
+		BaseURL: server.URL,
+		Headers: map[string]string{"X-Workspace": "team-a"},
+		RequestSigner: func(req *http.Request) error {
+			if req.Header.Get("X-Workspace") != "team-a" {
+				t.Error("expected headers to be set before signing")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			data, _ := io.ReadAll(body)
+			req.Header.Set("X-Signature", req.Method+" "+req.URL.Path+" "+string(data))
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := client.Post(context.Background(), "/api/test", map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if want := `POST /api/test {"name":"x"}`; gotSig != want {
+		t.Errorf("X-Signature = %q, want %q", gotSig, want)
+	}
+	if gotBody != `{"name":"x"}` {
+		t.Errorf("body = %q, want it intact after signing", gotBody)
+	}
+}
+
+func TestClient_RequestSigner_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("request sent despite signer error")
+	}))
+	defer server.Close()
+
+	client, err := New(Config{
+		BaseURL: server.URL,
+		RequestSigner: func(_ *http.Request) error {
+			return context.DeadlineExceeded
+		},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Get(context.Background(), "/api/test", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to sign request") {
+		t.Fatalf("Get() error = %v, want sign error", err)
+	}
+}
+
+func TestRequestURL(t *testing.T) {
+	base, _ := url.Parse("https://mlflow.example.com/prefix%20dir/")
+	segment, err := PathSegment("../x/..")
+	if err != nil {
+		t.Fatalf("PathSegment() error = %v", err)
+	}
+
+	got, err := requestURL(base, "/api/3.0/mlflow/traces/"+segment+"/assessments", url.Values{"a": {"1"}})
+	if err != nil {
+		t.Fatalf("requestURL() error = %v", err)
+	}
+	want := "https://mlflow.example.com/prefix%20dir/api/3.0/mlflow/traces/..%2Fx%2F../assessments?a=1"
+	if got.String() != want {
+		t.Errorf("requestURL() = %q, want %q", got, want)
+	}
+
+	if _, err := requestURL(base, "/api/100%", nil); err == nil {
+		t.Error("requestURL() with an invalid escape error = nil")
+	}
+}
+
+func TestPathSegment_Invalid(t *testing.T) {
+	for _, s := range []string{"", ".", ".."} {
+		if _, err := PathSegment(s); err == nil {
+			t.Errorf("PathSegment(%q) error = nil", s)
+		}
+	}
+}
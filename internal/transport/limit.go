@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ConcurrencyLimit bounds the number of requests to matching endpoints that
+// a client has in flight at once.
+type ConcurrencyLimit struct {
+	// Pattern selects the endpoints: a request path, optionally preceded by
+	// a method and a space. A trailing "*" matches any rest of the path.
+	// For example, "PUT /api/2.0/mlflow-artifacts/artifacts/*" matches
+	// every artifact upload.
+	Pattern string
+
+	// N is the number of matching requests allowed in flight.
+	N int
+}
+
+// limiter enforces one ConcurrencyLimit.
+type limiter struct {
+	method string // empty matches any method
+	path   string
+	prefix bool
+	slots  chan struct{}
+}
+
+func newLimiter(l ConcurrencyLimit) (*limiter, error) {
+	if l.N < 1 {
+		return nil, fmt.Errorf("concurrency limit for %q must be positive, got %d", l.Pattern, l.N)
+	}
+	pattern := strings.TrimSpace(l.Pattern)
+	lim := &limiter{slots: make(chan struct{}, l.N)}
+	if method, path, ok := strings.Cut(pattern, " "); ok {
+		lim.method = method
+		pattern = strings.TrimSpace(path)
+	}
+	if !strings.HasPrefix(pattern, "/") {
+		return nil, fmt.Errorf("invalid concurrency limit pattern %q: path must start with /", l.Pattern)
+	}
+	lim.path, lim.prefix = strings.CutSuffix(pattern, "*")
+	return lim, nil
+}
+
+func (l *limiter) matches(method, path string) bool {
+	if l.method != "" && l.method != method {
+		return false
+	}
+	if l.prefix {
+		return strings.HasPrefix(path, l.path)
+	}
+	return path == l.path
+}
+
+// limit waits until every limit matching the request has a free slot and
+// takes it. The returned function gives the slots back. Slots are taken in
+// the order the limits were configured, so requests matching several
+// limits cannot deadlock.
+func (c *Client) limit(ctx context.Context, method, path string) (func(), error) {
+	var held []*limiter
+	release := func() {
+		for _, l := range held {
+			<-l.slots
+		}
+	}
+	for _, l := range c.limiters {
+		if !l.matches(method, path) {
+			continue
+		}
+		select {
+		case l.slots <- struct{}{}:
+			held = append(held, l)
+		case <-ctx.Done():
+			release()
+			return nil, fmt.Errorf("waiting for concurrency limit: %w", ctx.Err())
+		}
+	}
+	return release, nil
+}
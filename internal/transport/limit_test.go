@@ -0,0 +1,119 @@
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_ConcurrencyLimit(t *testing.T) {
+	var inFlight, maxInFlight, other atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			other.Add(1)
+		} else {
+			n := inFlight.Add(1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{
+		BaseURL:           server.URL,
+		ConcurrencyLimits: []ConcurrencyLimit{{Pattern: "PUT /api/2.0/mlflow-artifacts/artifacts/*", N: 2}},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.Upload(ctx, "/api/2.0/mlflow-artifacts/artifacts/run/a.txt", strings.NewReader("a"), 1); err != nil {
+				t.Errorf("Upload() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if m := maxInFlight.Load(); m != 2 {
+		t.Errorf("max uploads in flight = %d, want 2", m)
+	}
+	// Other requests are not limited
+	if err := client.Get(ctx, "/api/2.0/mlflow-artifacts/artifacts/run/a.txt", nil, nil); err != nil || other.Load() != 1 {
+		t.Errorf("Get() error = %v, requests = %d", err, other.Load())
+	}
+}
+
+func TestClient_ConcurrencyLimit_ContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := New(Config{
+		BaseURL:           server.URL,
+		ConcurrencyLimits: []ConcurrencyLimit{{Pattern: "/api/test", N: 1}},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	go func() { _ = client.Get(context.Background(), "/api/test", nil, nil) }()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Get(ctx, "/api/test", nil, nil); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want DeadlineExceeded while waiting for a slot", err)
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	tests := []struct {
+		limit   ConcurrencyLimit
+		method  string
+		path    string
+		want    bool
+		wantErr bool
+	}{
+		{limit: ConcurrencyLimit{Pattern: "/api/a", N: 1}, method: http.MethodGet, path: "/api/a", want: true},
+		{limit: ConcurrencyLimit{Pattern: "/api/a", N: 1}, method: http.MethodGet, path: "/api/a/b", want: false},
+		{limit: ConcurrencyLimit{Pattern: "/api/*", N: 1}, method: http.MethodPost, path: "/api/a/b", want: true},
+		{limit: ConcurrencyLimit{Pattern: "PUT /api/*", N: 1}, method: http.MethodGet, path: "/api/a", want: false},
+		{limit: ConcurrencyLimit{Pattern: "/api/a", N: 0}, wantErr: true},
+		{limit: ConcurrencyLimit{Pattern: "api/a", N: 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		l, err := newLimiter(tt.limit)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("newLimiter(%+v) error = %v, wantErr %v", tt.limit, err, tt.wantErr)
+			continue
+		}
+		if err == nil && l.matches(tt.method, tt.path) != tt.want {
+			t.Errorf("%q matches %s %s = %v, want %v", tt.limit.Pattern, tt.method, tt.path, !tt.want, tt.want)
+		}
+	}
+}
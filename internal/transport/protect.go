@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// confirmKey is the context key for the targets confirmed for deletion.
+type confirmKey struct{}
+
+// protectedDeletes maps the endpoints refused under delete protection to the
+// request body field that names their target.
+var protectedDeletes = map[string]string{
+	"/api/2.0/mlflow/registered-models/delete": "name",
+	"/api/2.0/mlflow/model-versions/delete":    "name",
+	"/api/2.0/mlflow/experiments/delete":       "experiment_id",
+	"/api/2.0/mlflow/experiments/hard-delete":  "experiment_id",
+	"/api/2.0/mlflow/runs/delete":              "run_id",
+}
+
+// loggedModelsPath is the prefix of logged model endpoints; DELETE on a
+// logged model names it in the path.
+const loggedModelsPath = "/api/2.0/mlflow/logged-models/"
+
+// WithConfirm returns a context that confirms the deletion of targets, such
+// as qualified prompt names or experiment and run IDs, on a client with
+// delete protection. The SDK packages scope it to a single call made with a
+// per-call confirm option, so it is never handed to callers.
+func WithConfirm(ctx context.Context, targets ...string) context.Context {
+	confirmed := slices.Concat(confirmedTargets(ctx), targets)
+	return context.WithValue(ctx, confirmKey{}, confirmed)
+}
+
+func confirmedTargets(ctx context.Context) []string {
+	targets, _ := ctx.Value(confirmKey{}).([]string)
+	return targets
+}
+
+// protectedTarget returns the target of a destructive request, and whether
+// the request is one delete protection guards. The path is cleaned first,
+// as resolving the request URL would, so dot segments cannot route around
+// the checks.
+//
+// Any DELETE under the logged models endpoint other than deleting a model
+// tag is guarded; those that do not name a single model are refused
+// outright, since their target cannot be confirmed.
+func protectedTarget(method, p string, body []byte) (string, bool) {
+	p = path.Clean(p)
+	if field, ok := protectedDeletes[p]; ok {
+		return auditResource(body)[field], true
+	}
+	if method != http.MethodDelete || !strings.HasPrefix(p+"/", loggedModelsPath) {
+		return "", false
+	}
+
+	rest := strings.TrimPrefix(p+"/", loggedModelsPath)
+	segments := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	switch {
+	case len(segments) == 1 && segments[0] != "":
+		if id, err := url.PathUnescape(segments[0]); err == nil {
+			return id, true
+		}
+	case len(segments) == 3 && segments[0] != "" && segments[1] == "tags" && segments[2] != "":
+		// Deleting a model tag leaves the model in place
+		return "", false
+	}
+	// No confirmation matches an empty target
+	return "", true
+}
+
+// checkConfirmed returns an error wrapping errors.ErrDeleteNotConfirmed if
+// the request is destructive and ctx does not confirm exactly its target.
+func checkConfirmed(ctx context.Context, method, path string, body []byte) error {
+	target, ok := protectedTarget(method, path, body)
+	if !ok {
+		return nil
+	}
+	for _, confirmed := range confirmedTargets(ctx) {
+		if confirmed != "" && confirmed == target {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s %s targets %q; confirm it with WithConfirm", errors.ErrDeleteNotConfirmed, method, path, target)
+}
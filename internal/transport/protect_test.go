@@ -0,0 +1,109 @@
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestProtectedTarget(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantTarget string
+		wantOK     bool
+	}{
+		{"delete prompt", http.MethodDelete, "/api/2.0/mlflow/registered-models/delete", `{"name":"qa"}`, "qa", true},
+		{"delete version", http.MethodDelete, "/api/2.0/mlflow/model-versions/delete", `{"name":"qa","version":"2"}`, "qa", true},
+		{"delete experiment", http.MethodPost, "/api/2.0/mlflow/experiments/delete", `{"experiment_id":"7"}`, "7", true},
+		{"hard delete experiment", http.MethodPost, "/api/2.0/mlflow/experiments/hard-delete", `{"experiment_id":"7"}`, "7", true},
+		{"delete run", http.MethodPost, "/api/2.0/mlflow/runs/delete", `{"run_id":"r1"}`, "r1", true},
+		{"delete logged model", http.MethodDelete, "/api/2.0/mlflow/logged-models/m-1", "", "m-1", true},
+		{"delete logged model tag", http.MethodDelete, "/api/2.0/mlflow/logged-models/m-1/tags/k", "", "", false},
+		{"delete escaped logged model", http.MethodDelete, "/api/2.0/mlflow/logged-models/x%2F..%2Fm-2", "", "x/../m-2", true},
+		{"delete through dot segments", http.MethodDelete, "/api/2.0/mlflow/logged-models/x/../m-2", "", "m-2", true},
+		{"delete run through dot segments", http.MethodPost, "/api/2.0/mlflow/logged-models/../runs/delete", `{"run_id":"r1"}`, "r1", true},
+		{"unrecognized logged model delete", http.MethodDelete, "/api/2.0/mlflow/logged-models/m-1/params", "", "", true},
+		{"delete all logged models", http.MethodDelete, "/api/2.0/mlflow/logged-models/", "", "", true},
+		{"delete alias", http.MethodDelete, "/api/2.0/mlflow/registered-models/alias", `{"name":"qa"}`, "", false},
+		{"create run", http.MethodPost, "/api/2.0/mlflow/runs/create", `{}`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, ok := protectedTarget(tt.method, tt.path, []byte(tt.body))
+			if target != tt.wantTarget || ok != tt.wantOK {
+				t.Errorf("protectedTarget() = %q, %v, want %q, %v", target, ok, tt.wantTarget, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCheckConfirmed(t *testing.T) {
+	const path = "/api/2.0/mlflow/registered-models/delete"
+	body := []byte(`{"name":"team-a/qa"}`)
+	ctx := context.Background()
+
+	if err := checkConfirmed(ctx, http.MethodDelete, path, body); !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Errorf("unconfirmed: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if err := checkConfirmed(WithConfirm(ctx, "other"), http.MethodDelete, path, body); !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Errorf("other target: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if err := checkConfirmed(WithConfirm(ctx, "team-a/qa"), http.MethodDelete, path, body); err != nil {
+		t.Errorf("qualified name: error = %v", err)
+	}
+	// A bare name does not confirm the prompt in any namespace
+	if err := checkConfirmed(WithConfirm(WithConfirm(ctx, "qa"), "x"), http.MethodDelete, path, body); !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Errorf("unqualified name: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if err := checkConfirmed(ctx, http.MethodPost, "/api/2.0/mlflow/runs/create", nil); err != nil {
+		t.Errorf("unprotected call: error = %v", err)
+	}
+}
+
+func TestClient_DeleteProtection(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var events []AuditEvent
+	client, err := New(Config{
+		BaseURL:          server.URL,
+		DeleteProtection: true,
+		AuditHook:        func(e AuditEvent) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	body := map[string]string{"experiment_id": "7"}
+	err = client.Post(context.Background(), "/api/2.0/mlflow/experiments/delete", body, nil)
+	if !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Fatalf("Post() error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, want 0", n)
+	}
+	if len(events) != 1 || events[0].Err == nil {
+		t.Errorf("audit events = %+v, want one refused call", events)
+	}
+
+	if err = client.Post(WithConfirm(context.Background(), "7"), "/api/2.0/mlflow/experiments/delete", body, nil); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,30 @@
+package transport
+
+import (
+	"context"
+	"net/url"
+)
+
+// primaryKey is the context key for forcing reads to the primary server.
+type primaryKey struct{}
+
+// WithPrimary returns a context whose reads are sent to the primary server
+// even when a read replica is configured.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// isPrimaryOnly reports whether ctx was marked with WithPrimary.
+func isPrimaryOnly(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
+
+// baseURLFor returns the server a request is sent to: the read replica for
+// reads when one is configured, and the primary otherwise.
+func (c *Client) baseURLFor(ctx context.Context, method, path string) *url.URL {
+	if c.readURL == nil || isMutating(method, path) || isPrimaryOnly(ctx) {
+		return c.baseURL
+	}
+	return c.readURL
+}
@@ -0,0 +1,78 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_ReadURL(t *testing.T) {
+	var primaryHits, replicaHits atomic.Int32
+	handler := func(hits *atomic.Int32) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		})
+	}
+	primary := httptest.NewServer(handler(&primaryHits))
+	defer primary.Close()
+	replica := httptest.NewServer(handler(&replicaHits))
+	defer replica.Close()
+
+	client, err := New(Config{BaseURL: primary.URL, ReadURL: replica.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		call        func() error
+		wantReplica bool
+	}{
+		{"get", func() error { return client.Get(ctx, "/api/2.0/mlflow/runs/get", nil, nil) }, true},
+		{"search", func() error { return client.Post(ctx, "/api/2.0/mlflow/runs/search", map[string]any{}, nil) }, true},
+		{"write", func() error { return client.Post(ctx, "/api/2.0/mlflow/runs/create", map[string]any{}, nil) }, false},
+		{"delete", func() error { return client.Delete(ctx, "/api/2.0/mlflow/runs/delete", map[string]any{}, nil) }, false},
+		{"primary read", func() error { return client.Get(WithPrimary(ctx), "/api/2.0/mlflow/runs/get", nil, nil) }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primaryBefore, replicaBefore := primaryHits.Load(), replicaHits.Load()
+			if err := tt.call(); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+			gotReplica := replicaHits.Load() == replicaBefore+1 && primaryHits.Load() == primaryBefore
+			gotPrimary := primaryHits.Load() == primaryBefore+1 && replicaHits.Load() == replicaBefore
+			if tt.wantReplica && !gotReplica {
+				t.Error("expected the request to go to the replica")
+			}
+			if !tt.wantReplica && !gotPrimary {
+				t.Error("expected the request to go to the primary")
+			}
+		})
+	}
+}
+
+func TestClient_ReadURL_PathPrefix(t *testing.T) {
+	var gotPath string
+	replica := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{}`))
+	}))
+	defer replica.Close()
+
+	client, err := New(Config{BaseURL: "https://primary.invalid/mlflow", ReadURL: replica.URL + "/cache/"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := client.Get(context.Background(), "/api/2.0/mlflow/runs/get", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if gotPath != "/cache/api/2.0/mlflow/runs/get" {
+		t.Errorf("path = %q, want /cache/api/2.0/mlflow/runs/get", gotPath)
+	}
+}
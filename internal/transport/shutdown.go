@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// gate tracks the requests in flight so Shutdown can wait for them, and
+// refuses new ones once shutdown has begun.
+type gate struct {
+	mu      sync.Mutex
+	active  int
+	closed  bool
+	drained chan struct{} // closed once closed and active reaches 0
+}
+
+// enter admits a request, or returns ErrClientClosed after shutdown began.
+// Every successful enter must be followed by leave.
+func (g *gate) enter() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return errors.ErrClientClosed
+	}
+	g.active++
+	return nil
+}
+
+func (g *gate) leave() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.active--
+	if g.closed && g.active == 0 {
+		close(g.drained)
+	}
+}
+
+// close refuses new requests and returns a channel closed once the requests
+// in flight have finished.
+func (g *gate) close() <-chan struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.closed {
+		g.closed = true
+		g.drained = make(chan struct{})
+		if g.active == 0 {
+			close(g.drained)
+		}
+	}
+	return g.drained
+}
+
+// Shutdown refuses new requests with ErrClientClosed, waits for the
+// requests in flight to finish, and closes idle connections. If ctx ends
+// first, Shutdown closes idle connections anyway and returns ctx.Err();
+// the remaining requests keep running until their own contexts end.
+// Calling it again waits for the same requests.
+func (c *Client) Shutdown(ctx context.Context) error {
+	var err error
+	select {
+	case <-c.gate.close():
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	c.httpClient.CloseIdleConnections()
+	return err
+}
+
+// CloseIdleConnections implements the interface http.Client uses to close
+// the idle connections of its transport.
+func (t *failoverTransport) CloseIdleConnections() {
+	closeIdleConnections(t.next)
+}
+
+// CloseIdleConnections implements the interface http.Client uses to close
+// the idle connections of its transport.
+func (t *hedgingTransport) CloseIdleConnections() {
+	closeIdleConnections(t.next)
+}
+
+func closeIdleConnections(rt http.RoundTripper) {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if ci, ok := rt.(closeIdler); ok {
+		ci.CloseIdleConnections()
+	}
+}
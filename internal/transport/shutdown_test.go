@@ -0,0 +1,89 @@
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestClient_Shutdown(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx := context.Background()
+
+	reqErr := make(chan error, 1)
+	go func() { reqErr <- c.Get(ctx, "/api/2.0/mlflow/runs/get", nil, nil) }()
+	<-entered
+
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- c.Shutdown(ctx) }()
+
+	// New requests are refused while the first one drains
+	deadline := time.Now().Add(time.Second)
+	for {
+		err = c.Get(ctx, "/api/2.0/mlflow/runs/get", nil, nil)
+		if stderrors.Is(err, errors.ErrClientClosed) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if !stderrors.Is(err, errors.ErrClientClosed) {
+		t.Fatalf("Get() after Shutdown error = %v, want ErrClientClosed", err)
+	}
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown() = %v before the request in flight finished", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-reqErr; err != nil {
+		t.Errorf("request in flight error = %v", err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+	if err := c.Shutdown(ctx); err != nil {
+		t.Errorf("second Shutdown() error = %v", err)
+	}
+}
+
+func TestClient_Shutdown_Deadline(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	go func() { _ = c.Get(context.Background(), "/api/2.0/mlflow/runs/get", nil, nil) }()
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := c.Shutdown(ctx); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() error = %v, want deadline exceeded", err)
+	}
+}
@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"sync/atomic"
+)
+
+// flightGroup deduplicates identical in-flight requests: concurrent callers
+// with the same key share one response.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+
+	// hits counts calls that shared a response; misses, calls that ran fn.
+	hits, misses atomic.Int64
+}
+
+// flight is one in-flight request and, once done is closed, its response.
+type flight struct {
+	done       chan struct{}
+	statusCode int
+	body       []byte
+	err        error
+}
+
+// do runs fn, or waits for the response of an identical call already in
+// flight. Waiting callers stop waiting when their own ctx is done. If the
+// shared call failed only because the first caller's context was canceled,
+// a waiting caller whose context is still live runs fn itself.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (int, []byte, error)) (int, []byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	if f, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		g.hits.Add(1)
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		}
+		if isContextError(f.err) && ctx.Err() == nil {
+			return fn()
+		}
+		return f.statusCode, f.body, f.err
+	}
+
+	f := &flight{done: make(chan struct{})}
+	g.calls[key] = f
+	g.mu.Unlock()
+	g.misses.Add(1)
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(f.done)
+	}()
+
+	f.statusCode, f.body, f.err = fn()
+	return f.statusCode, f.body, f.err
+}
+
+func isContextError(err error) bool {
+	return stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded)
+}
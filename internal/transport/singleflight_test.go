@@ -0,0 +1,141 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Singleflight_DeduplicatesGets(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "shared"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, Singleflight: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	const callers = 50
+	var wg sync.WaitGroup
+	results := make([]string, callers)
+	errs := make([]error, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct {
+				Name string `json:"name"`
+			}
+			errs[i] = client.Get(context.Background(), "/api/2.0/mlflow/registered-models/alias", nil, &out)
+			results[i] = out.Name
+		}()
+	}
+
+	// Let every caller join the in-flight request before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+	for i := range callers {
+		if errs[i] != nil || results[i] != "shared" {
+			t.Fatalf("caller %d: result %q, error %v", i, results[i], errs[i])
+		}
+	}
+}
+
+func TestClient_Singleflight_DistinctRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(Config{BaseURL: server.URL, Singleflight: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	_ = client.Get(ctx, "/a", nil, nil)
+	_ = client.Get(ctx, "/a", nil, nil)
+	_ = client.Post(ctx, "/a", map[string]string{}, nil)
+
+	if n := requests.Load(); n != 3 {
+		t.Errorf("server received %d requests, want 3 (sequential calls and POSTs are not shared)", n)
+	}
+}
+
+func TestFlightGroup_LeaderCanceled(t *testing.T) {
+	var g flightGroup
+	leaderStarted := make(chan struct{})
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		_, _, _ = g.do(leaderCtx, "k", func() (int, []byte, error) {
+			close(leaderStarted)
+			<-leaderCtx.Done()
+			return 0, nil, leaderCtx.Err()
+		})
+	}()
+	<-leaderStarted
+
+	done := make(chan struct{})
+	var (
+		status int
+		err    error
+	)
+	go func() {
+		defer close(done)
+		status, _, err = g.do(context.Background(), "k", func() (int, []byte, error) {
+			return http.StatusOK, nil, nil
+		})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+
+	if err != nil || status != http.StatusOK {
+		t.Errorf("follower got status %d, error %v; want it to retry on its own", status, err)
+	}
+}
+
+func TestFlightGroup_FollowerCanceled(t *testing.T) {
+	var g flightGroup
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	go func() {
+		_, _, _ = g.do(context.Background(), "k", func() (int, []byte, error) {
+			close(started)
+			<-release
+			return http.StatusOK, nil, nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := g.do(ctx, "k", nil); err != context.DeadlineExceeded {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
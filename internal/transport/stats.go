@@ -0,0 +1,192 @@
+package transport
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxStatsEndpoints bounds the number of endpoints tracked; requests to
+// further endpoints are counted under otherEndpoint.
+const maxStatsEndpoints = 256
+
+const otherEndpoint = "other"
+
+// Stats is a snapshot of a client's request counters.
+type Stats struct {
+	// InFlight is the number of requests waiting for a response.
+	InFlight int64 `json:"in_flight"`
+
+	// Requests and Errors count requests sent and those that failed, with a
+	// network error or an error status. Dry-run requests are not counted.
+	Requests int64 `json:"requests"`
+	Errors   int64 `json:"errors"`
+
+	// Retries counts requests sent again to a failover server.
+	Retries int64 `json:"retries"`
+
+	// RetriesDenied counts failover retries and hedged reads refused by the
+	// retry budget.
+	RetriesDenied int64 `json:"retries_denied"`
+
+	// Hedges counts second attempts sent for reads slower than the hedging
+	// delay (see Config.HedgeDelay).
+	Hedges int64 `json:"hedges"`
+
+	// Dedup reports how often identical concurrent reads shared one request
+	// (see WithSingleflight).
+	Dedup DedupStats `json:"dedup"`
+
+	// Endpoints holds the counters by "METHOD /path". Path segments that
+	// look like IDs are replaced by {id}.
+	Endpoints map[string]EndpointStats `json:"endpoints"`
+}
+
+// DedupStats counts reads that started a request (misses) and reads that
+// shared the response of one already in flight (hits).
+type DedupStats struct {
+	Hits    int64   `json:"hits"`
+	Misses  int64   `json:"misses"`
+	HitRate float64 `json:"hit_rate"`
+}
+
+// EndpointStats are the counters of one endpoint.
+type EndpointStats struct {
+	InFlight int64 `json:"in_flight"`
+	Requests int64 `json:"requests"`
+	Errors   int64 `json:"errors"`
+	Retries  int64 `json:"retries"`
+
+	// LastError is the message of the most recent failure, and LastErrorTime
+	// when it happened. Empty if the endpoint has not failed.
+	LastError     string    `json:"last_error,omitempty"`
+	LastErrorTime time.Time `json:"last_error_time,omitzero"`
+}
+
+// statsRecorder collects the counters behind Stats.
+type statsRecorder struct {
+	inFlight atomic.Int64
+	requests atomic.Int64
+	errors   atomic.Int64
+	retries  atomic.Int64
+
+	retriesDenied atomic.Int64
+	hedges        atomic.Int64
+
+	mu        sync.Mutex
+	endpoints map[string]*EndpointStats
+}
+
+// endpoint returns the counters for method and path. It must be called
+// with r.mu held.
+func (r *statsRecorder) endpoint(method, path string) *EndpointStats {
+	key := method + " " + statsPath(path)
+	if r.endpoints == nil {
+		r.endpoints = make(map[string]*EndpointStats)
+	}
+	ep, ok := r.endpoints[key]
+	if !ok {
+		if len(r.endpoints) >= maxStatsEndpoints {
+			key = otherEndpoint
+			if ep, ok = r.endpoints[key]; ok {
+				return ep
+			}
+		}
+		ep = &EndpointStats{}
+		r.endpoints[key] = ep
+	}
+	return ep
+}
+
+// start records that a request was sent. The returned function records its
+// outcome.
+func (r *statsRecorder) start(method, path string) func(err error) {
+	r.inFlight.Add(1)
+	r.requests.Add(1)
+	r.mu.Lock()
+	ep := r.endpoint(method, path)
+	ep.InFlight++
+	ep.Requests++
+	r.mu.Unlock()
+
+	return func(err error) {
+		r.inFlight.Add(-1)
+		if err != nil {
+			r.errors.Add(1)
+		}
+		r.mu.Lock()
+		ep.InFlight--
+		if err != nil {
+			ep.Errors++
+			ep.LastError = err.Error()
+			ep.LastErrorTime = time.Now()
+		}
+		r.mu.Unlock()
+	}
+}
+
+// retry records that a request was sent again.
+func (r *statsRecorder) retry(method, path string) {
+	r.retries.Add(1)
+	r.mu.Lock()
+	r.endpoint(method, path).Retries++
+	r.mu.Unlock()
+}
+
+// retryDenied records that the retry budget refused a retry.
+func (r *statsRecorder) retryDenied() {
+	r.retriesDenied.Add(1)
+}
+
+// hedge records that a second attempt of a read was sent.
+func (r *statsRecorder) hedge() {
+	r.hedges.Add(1)
+}
+
+func (r *statsRecorder) snapshot() Stats {
+	s := Stats{
+		InFlight:      r.inFlight.Load(),
+		Requests:      r.requests.Load(),
+		Errors:        r.errors.Load(),
+		Retries:       r.retries.Load(),
+		RetriesDenied: r.retriesDenied.Load(),
+		Hedges:        r.hedges.Load(),
+	}
+	r.mu.Lock()
+	s.Endpoints = make(map[string]EndpointStats, len(r.endpoints))
+	for key, ep := range r.endpoints {
+		s.Endpoints[key] = *ep
+	}
+	r.mu.Unlock()
+	return s
+}
+
+// statsPath replaces the segments of path that look like IDs, such as
+// run, model and trace IDs, with {id}, so requests for different resources
+// share one endpoint. The API prefix, such as /api/2.0/mlflow, is kept.
+func statsPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if i < 4 || seg == "" {
+			continue
+		}
+		if strings.ContainsAny(seg, "0123456789") || len(seg) > 32 {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Stats returns a snapshot of the client's request counters.
+func (c *Client) Stats() Stats {
+	s := c.stats.snapshot()
+	if c.flights != nil {
+		s.Dedup.Hits = c.flights.hits.Load()
+		s.Dedup.Misses = c.flights.misses.Load()
+		if total := s.Dedup.Hits + s.Dedup.Misses; total > 0 {
+			s.Dedup.HitRate = float64(s.Dedup.Hits) / float64(total)
+		}
+	}
+	return s
+}
@@ -0,0 +1,131 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_Stats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no such run"}`))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx := context.Background()
+
+	_ = c.Get(ctx, "/api/2.0/mlflow/runs/get", nil, nil)
+	_ = c.Get(ctx, "/api/2.0/mlflow/logged-models/m-123", nil, nil)
+	_ = c.Get(ctx, "/api/2.0/mlflow/logged-models/m-456", nil, nil)
+	if err = c.Get(ctx, "/api/2.0/mlflow/runs/missing", nil, nil); err == nil {
+		t.Fatal("expected error")
+	}
+
+	s := c.Stats()
+	if s.Requests != 4 || s.Errors != 1 || s.InFlight != 0 {
+		t.Errorf("Stats() = %+v", s)
+	}
+	if got := s.Endpoints["GET /api/2.0/mlflow/logged-models/{id}"].Requests; got != 2 {
+		t.Errorf("logged-models requests = %d, want 2 (endpoints %v)", got, s.Endpoints)
+	}
+	missing := s.Endpoints["GET /api/2.0/mlflow/runs/missing"]
+	if missing.Errors != 1 || !strings.Contains(missing.LastError, "no such run") || missing.LastErrorTime.IsZero() {
+		t.Errorf("missing endpoint = %+v", missing)
+	}
+}
+
+func TestClient_Stats_InFlightAndDedup(t *testing.T) {
+	release := make(chan struct{})
+	arrived := make(chan struct{}, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c, err := New(Config{BaseURL: server.URL, Singleflight: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = c.Get(context.Background(), "/api/2.0/mlflow/experiments/get", nil, nil)
+		}()
+	}
+	<-arrived
+
+	// Wait for the other callers to join the flight
+	deadline := time.Now().Add(time.Second)
+	for c.Stats().Dedup.Hits < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if s := c.Stats(); s.InFlight != 3 || s.Endpoints["GET /api/2.0/mlflow/experiments/get"].InFlight != 3 {
+		t.Errorf("in flight = %d, want 3", s.InFlight)
+	}
+	close(release)
+	wg.Wait()
+
+	s := c.Stats()
+	if s.InFlight != 0 || s.Dedup.Hits != 2 || s.Dedup.Misses != 1 {
+		t.Errorf("Stats() = %+v", s)
+	}
+	if s.Dedup.HitRate < 0.66 || s.Dedup.HitRate > 0.67 {
+		t.Errorf("HitRate = %v, want 2/3", s.Dedup.HitRate)
+	}
+}
+
+func TestClient_Stats_FailoverRetries(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer up.Close()
+
+	c, err := New(Config{BaseURL: down.URL, FailoverURLs: []string{up.URL}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err = c.Get(context.Background(), "/api/2.0/mlflow/runs/get", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	s := c.Stats()
+	if s.Retries != 1 || s.Endpoints["GET /api/2.0/mlflow/runs/get"].Retries != 1 {
+		t.Errorf("Stats() = %+v", s)
+	}
+}
+
+func TestStatsPath(t *testing.T) {
+	tests := map[string]string{
+		"/api/2.0/mlflow/runs/get":                  "/api/2.0/mlflow/runs/get",
+		"/api/3.0/mlflow/traces/tr-1/assessments":   "/api/3.0/mlflow/traces/{id}/assessments",
+		"/api/2.0/mlflow/logged-models/m-abc/tags":  "/api/2.0/mlflow/logged-models/m-abc/tags",
+		"/api/2.0/mlflow/logged-models/m-a1b2/tags": "/api/2.0/mlflow/logged-models/{id}/tags",
+	}
+	for in, want := range tests {
+		if got := statsPath(in); got != want {
+			t.Errorf("statsPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GetStream performs a GET request and decodes the response incrementally.
+// Each element of the top-level array field is passed to fn as it is read,
+// so only one element is held in memory at a time. The remaining top-level
+// fields, such as next_page_token, are decoded into result, which may be nil.
+//
+// If fn returns an error, the response is abandoned and the error is returned
+// unchanged. Streamed requests are never shared by singleflight.
+func (c *Client) GetStream(ctx context.Context, path string, query url.Values, field string, fn func(json.RawMessage) error, result any) error {
+	return c.send(ctx, http.MethodGet, path, query, payload{}, &stream{field: field, fn: fn, rest: result})
+}
+
+// PostStream is like GetStream for endpoints that take a JSON request body,
+// such as the MLflow search APIs.
+func (c *Client) PostStream(ctx context.Context, path string, body any, field string, fn func(json.RawMessage) error, result any) error {
+	return c.do(ctx, http.MethodPost, path, nil, body, &stream{field: field, fn: fn, rest: result})
+}
+
+// DownloadTo performs a GET request and copies the response body to w as
+// it is read, for files too large to hold in memory. Unlike Download, it
+// uses the artifact timeout but never shares the response. If w returns an
+// error, the response is abandoned and the error returned.
+func (c *Client) DownloadTo(ctx context.Context, path string, w io.Writer) error {
+	return c.send(ctx, http.MethodGet, path, nil, payload{}, &stream{w: w})
+}
+
+// stream is a result that decodes a response object as it is read, or
+// copies the response body to w if set.
+type stream struct {
+	field string
+	fn    func(json.RawMessage) error
+	rest  any
+
+	w io.Writer
+}
+
+// callbackError marks an error returned by the item callback, so it is not
+// reported as a decode failure.
+type callbackError struct{ err error }
+
+func (e *callbackError) Error() string { return e.err.Error() }
+
+// decode reads a JSON object from r, streaming the elements of s.field to
+// s.fn and collecting the other fields into s.rest.
+func (s *stream) decode(r io.Reader) error {
+	if s.w != nil {
+		if _, err := io.Copy(s.w, r); err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil
+	}
+	err := s.decodeObject(json.NewDecoder(r))
+	if cbErr, ok := err.(*callbackError); ok {
+		return cbErr.err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func (s *stream) decodeObject(dec *json.Decoder) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	rest := make(map[string]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+
+		if key != s.field {
+			var raw json.RawMessage
+			if err = dec.Decode(&raw); err != nil {
+				return err
+			}
+			rest[key] = raw
+			continue
+		}
+		if err = s.decodeItems(dec); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	if s.rest == nil || len(rest) == 0 {
+		return nil
+	}
+	// The remaining fields are small, so round-tripping them is cheap
+	data, err := json.Marshal(rest)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, s.rest)
+}
+
+// decodeItems streams the elements of an array, which may also be null.
+func (s *stream) decodeItems(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("field %q: expected array, got %v", s.field, tok)
+	}
+
+	for dec.More() {
+		var item json.RawMessage
+		if err = dec.Decode(&item); err != nil {
+			return err
+		}
+		if err = s.fn(item); err != nil {
+			return &callbackError{err: err}
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %v, got %v", want, tok)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func newStreamClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return client
+}
+
+func TestClient_GetStream(t *testing.T) {
+	client := newStreamClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "x" {
+			t.Errorf("query = %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"total": 3, "items": [{"id": 1}, {"id": 2}, {"id": 3}], "next_page_token": "p2"}`)
+	})
+
+	var ids []int
+	var rest struct {
+		Total         int    `json:"total"`
+		NextPageToken string `json:"next_page_token"`
+	}
+	err := client.GetStream(context.Background(), "/api/items", map[string][]string{"q": {"x"}}, "items",
+		func(raw json.RawMessage) error {
+			var item struct{ ID int }
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			ids = append(ids, item.ID)
+			return nil
+		}, &rest)
+	if err != nil {
+		t.Fatalf("GetStream() error = %v", err)
+	}
+
+	if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+		t.Errorf("ids = %v, want [1 2 3]", ids)
+	}
+	if rest.Total != 3 || rest.NextPageToken != "p2" {
+		t.Errorf("rest = %+v", rest)
+	}
+}
+
+func TestClient_PostStream_MissingOrNullField(t *testing.T) {
+	for _, body := range []string{`{}`, `{"items": null}`, `{"items": []}`} {
+		client := newStreamClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			fmt.Fprint(w, body)
+		})
+
+		calls := 0
+		err := client.PostStream(context.Background(), "/api/search", map[string]string{"filter": ""}, "items",
+			func(json.RawMessage) error {
+				calls++
+				return nil
+			}, nil)
+		if err != nil {
+			t.Errorf("PostStream(%s) error = %v", body, err)
+		}
+		if calls != 0 {
+			t.Errorf("PostStream(%s) called fn %d times", body, calls)
+		}
+	}
+}
+
+func TestClient_GetStream_CallbackError(t *testing.T) {
+	client := newStreamClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"items": [1, 2, 3]}`)
+	})
+
+	stop := stderrors.New("stop")
+	calls := 0
+	err := client.GetStream(context.Background(), "/api/items", nil, "items", func(json.RawMessage) error {
+		calls++
+		return stop
+	}, nil)
+	if !stderrors.Is(err, stop) {
+		t.Errorf("GetStream() error = %v, want the callback error unchanged", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestClient_GetStream_Malformed(t *testing.T) {
+	for _, body := range []string{`[]`, `{"items": {}}`, `{"items": [1, }`} {
+		client := newStreamClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, body)
+		})
+
+		err := client.GetStream(context.Background(), "/api/items", nil, "items", func(json.RawMessage) error { return nil }, nil)
+		if err == nil || !strings.Contains(err.Error(), "failed to decode response") {
+			t.Errorf("GetStream(%s) error = %v, want decode error", body, err)
+		}
+	}
+}
+
+func TestClient_GetStream_APIError(t *testing.T) {
+	client := newStreamClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "gone"}`)
+	})
+
+	err := client.GetStream(context.Background(), "/api/items", nil, "items", func(json.RawMessage) error {
+		t.Error("fn called for error response")
+		return nil
+	}, nil)
+	if !errors.IsNotFound(err) {
+		t.Errorf("GetStream() error = %v, want not found", err)
+	}
+}
+
+func TestClient_DownloadTo(t *testing.T) {
+	body := strings.Repeat("0123456789", 100000)
+	client := newStreamClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/files/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no file"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, body)
+	})
+
+	var got strings.Builder
+	if err := client.DownloadTo(context.Background(), "/files/big", &got); err != nil {
+		t.Fatalf("DownloadTo() error = %v", err)
+	}
+	if got.String() != body {
+		t.Errorf("DownloadTo() wrote %d bytes, want %d", got.Len(), len(body))
+	}
+
+	got.Reset()
+	err := client.DownloadTo(context.Background(), "/files/missing", &got)
+	if !errors.IsNotFound(err) || got.Len() != 0 {
+		t.Errorf("DownloadTo(missing) error = %v, wrote %q", err, got.String())
+	}
+}
@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"time"
+)
+
+// TimeoutProfile sets request timeouts by class of request, so a short
+// timeout for API calls does not cut off a large artifact upload. A zero
+// duration means the class has no timeout of its own. The caller's context
+// deadline always applies as well; whichever is earlier wins.
+type TimeoutProfile struct {
+	// Metadata applies to ordinary JSON API calls: gets, searches, creates,
+	// updates, and deletes.
+	Metadata time.Duration
+
+	// Artifact applies to artifact uploads and downloads.
+	Artifact time.Duration
+
+	// Stream applies to streamed responses (GetStream and PostStream),
+	// which may take a long time to read in full.
+	Stream time.Duration
+}
+
+// timeoutFor returns the timeout for a request with the given body and
+// result, or zero if it has none.
+func (p *TimeoutProfile) timeoutFor(body payload, result any) time.Duration {
+	switch r := result.(type) {
+	case *stream:
+		if r.w != nil {
+			return p.Artifact
+		}
+		return p.Stream
+	case *rawBody:
+		return p.Artifact
+	}
+	if body.contentType == contentTypeOctetStream {
+		return p.Artifact
+	}
+	return p.Metadata
+}
@@ -0,0 +1,117 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items": []}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTimeoutProfile_PerClass(t *testing.T) {
+	server := newSlowServer(t, 100*time.Millisecond)
+
+	client, err := New(Config{
+		BaseURL: server.URL,
+		// Ignored in favor of the profile
+		Timeout:        10 * time.Millisecond,
+		TimeoutProfile: &TimeoutProfile{Metadata: 20 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx := context.Background()
+
+	err = client.Get(ctx, "/api/test", nil, nil)
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want deadline exceeded", err)
+	}
+
+	// Artifact and stream requests have no timeout in this profile
+	if err = client.Upload(ctx, "/artifacts/file", strings.NewReader("data"), 4); err != nil {
+		t.Errorf("Upload() error = %v", err)
+	}
+	if _, err = client.Download(ctx, "/artifacts/file"); err != nil {
+		t.Errorf("Download() error = %v", err)
+	}
+	err = client.GetStream(ctx, "/api/items", nil, "items", func(json.RawMessage) error { return nil }, nil)
+	if err != nil {
+		t.Errorf("GetStream() error = %v", err)
+	}
+}
+
+func TestTimeoutProfile_ArtifactTimeout(t *testing.T) {
+	server := newSlowServer(t, 100*time.Millisecond)
+
+	client, err := New(Config{
+		BaseURL:        server.URL,
+		TimeoutProfile: &TimeoutProfile{Artifact: 20 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = client.Upload(context.Background(), "/artifacts/file", strings.NewReader("data"), 4)
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Upload() error = %v, want deadline exceeded", err)
+	}
+	if err = client.Get(context.Background(), "/api/test", nil, nil); err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+}
+
+func TestTimeoutProfile_CallerDeadlineWins(t *testing.T) {
+	server := newSlowServer(t, 100*time.Millisecond)
+
+	client, err := New(Config{
+		BaseURL:        server.URL,
+		TimeoutProfile: &TimeoutProfile{Metadata: time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = client.Get(ctx, "/api/test", nil, nil)
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 90*time.Millisecond {
+		t.Errorf("Get() took %v, want the caller's 20ms deadline", elapsed)
+	}
+}
+
+func TestNew_TimeoutProfileDisablesClientTimeout(t *testing.T) {
+	client, err := New(Config{
+		BaseURL:        "https://example.com",
+		Timeout:        5 * time.Second,
+		TimeoutProfile: &TimeoutProfile{},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if client.httpClient.Timeout != 0 {
+		t.Errorf("httpClient.Timeout = %v, want 0", client.httpClient.Timeout)
+	}
+}
@@ -0,0 +1,638 @@
+// Package yaml decodes the subset of YAML used by prompt files and setup
+// manifests, so the SDK does not need a YAML dependency.
+//
+// Supported: block mappings and sequences, plain, single- and double-quoted
+// scalars, literal (|) and folded (>) block scalars with chomping indicators,
+// flow sequences of scalars ([a, b]), empty flow mappings ({}), comments and
+// a leading document marker (---). Anchors, tags, multi-line flow
+// collections and multiple documents are not supported.
+package yaml
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unmarshal decodes a YAML document into v, which must be a non-nil pointer.
+//
+// Struct fields are matched by their `yaml:"name"` tag, or by their
+// lower-cased name if untagged; keys without a matching field are an error,
+// so misspelled keys are caught. Scalars are converted to the field type, so
+// unquoted values such as true or 42 may be decoded into string fields.
+func Unmarshal(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("yaml: Unmarshal requires a non-nil pointer")
+	}
+	node, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	return decode(node, rv.Elem(), "")
+}
+
+// Parse parses a YAML document into map[string]any, []any, string and nil
+// values. Scalars are always strings.
+func Parse(data []byte) (any, error) {
+	p := &parser{}
+	for i, text := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimLeft(text, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("yaml: line %d: tabs are not allowed in indentation", i+1)
+		}
+		p.lines = append(p.lines, line{
+			num:    i + 1,
+			indent: len(text) - len(trimmed),
+			text:   strings.TrimRight(trimmed, " \t"),
+			raw:    text,
+		})
+	}
+
+	p.skipBlank()
+	if p.pos < len(p.lines) && p.lines[p.pos].text == "---" {
+		p.pos++
+		p.skipBlank()
+	}
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+
+	node, err := p.parseNode(p.lines[p.pos].indent)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+	if p.pos < len(p.lines) {
+		return nil, p.errorf("unexpected content %q", p.lines[p.pos].text)
+	}
+	return node, nil
+}
+
+type line struct {
+	num    int
+	indent int
+	text   string // without indentation and trailing spaces
+	raw    string
+}
+
+type parser struct {
+	lines []line
+	pos   int
+}
+
+// errorf returns an error for the current line.
+func (p *parser) errorf(format string, args ...any) error {
+	num := len(p.lines)
+	if p.pos < len(p.lines) {
+		num = p.lines[p.pos].num
+	}
+	return p.errorAt(num, format, args...)
+}
+
+// errorAt returns an error for line num, for content of a line already
+// consumed.
+func (p *parser) errorAt(num int, format string, args ...any) error {
+	return fmt.Errorf("yaml: line %d: %s", num, fmt.Sprintf(format, args...))
+}
+
+// skipBlank advances past blank and comment-only lines.
+func (p *parser) skipBlank() {
+	for p.pos < len(p.lines) {
+		t := p.lines[p.pos].text
+		if t != "" && !strings.HasPrefix(t, "#") {
+			return
+		}
+		p.pos++
+	}
+}
+
+// isSeqItem reports whether t starts a sequence item.
+func isSeqItem(t string) bool {
+	return t == "-" || strings.HasPrefix(t, "- ")
+}
+
+// parseNode parses the block node starting at the current line, which is
+// indented by indent.
+func (p *parser) parseNode(indent int) (any, error) {
+	l := p.lines[p.pos]
+	if isSeqItem(l.text) {
+		return p.parseSequence(indent)
+	}
+	if _, _, ok, err := splitKey(l.text); err != nil {
+		return nil, p.errorf("%v", err)
+	} else if ok {
+		return p.parseMapping(indent)
+	}
+	p.pos++
+	return p.parseInline(l.num, l.text)
+}
+
+func (p *parser) parseMapping(indent int) (map[string]any, error) {
+	m := make(map[string]any)
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.lines) {
+			return m, nil
+		}
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			return m, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		if isSeqItem(l.text) {
+			return m, nil
+		}
+
+		key, rest, ok, err := splitKey(l.text)
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		if !ok {
+			return nil, p.errorf("expected a key, got %q", l.text)
+		}
+		if _, dup := m[key]; dup {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+		p.pos++
+
+		value, err := p.parseValue(indent, rest, true)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = value
+	}
+}
+
+func (p *parser) parseSequence(indent int) ([]any, error) {
+	seq := []any{}
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.lines) {
+			return seq, nil
+		}
+		l := p.lines[p.pos]
+		if l.indent < indent || !isSeqItem(l.text) {
+			if l.indent > indent {
+				return nil, p.errorf("unexpected indentation")
+			}
+			return seq, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+
+		content := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if _, _, isMap, err := splitKey(content); err != nil {
+			return nil, p.errorf("%v", err)
+		} else if isMap {
+			// "- key: value" starts a mapping indented to the key's column
+			p.lines[p.pos].indent = l.indent + len(l.text) - len(content)
+			p.lines[p.pos].text = content
+			item, err := p.parseMapping(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, item)
+			continue
+		}
+
+		p.pos++
+		item, err := p.parseValue(indent, content, false)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, item)
+	}
+}
+
+// parseValue parses the value that follows a key or sequence dash on the
+// line just consumed. inMapping allows a sequence at the parent's indent
+// to be the value, as in "key:\n- a\n- b".
+func (p *parser) parseValue(parentIndent int, rest string, inMapping bool) (any, error) {
+	num := p.lines[p.pos-1].num
+	rest = stripComment(rest)
+	switch {
+	case rest == "":
+		p.skipBlank()
+		if p.pos >= len(p.lines) {
+			return nil, nil
+		}
+		next := p.lines[p.pos]
+		if next.indent > parentIndent || (inMapping && next.indent == parentIndent && isSeqItem(next.text)) {
+			return p.parseNode(next.indent)
+		}
+		return nil, nil
+	case rest[0] == '|' || rest[0] == '>':
+		return p.parseBlockScalar(parentIndent, num, rest)
+	}
+
+	value, err := p.parseInline(num, rest)
+	if err != nil {
+		return nil, err
+	}
+	// A plain scalar continued on the next lines is not supported
+	p.skipBlank()
+	if p.pos < len(p.lines) && p.lines[p.pos].indent > parentIndent && !isSeqItem(p.lines[p.pos].text) {
+		if _, _, isKey, _ := splitKey(p.lines[p.pos].text); !isKey {
+			return nil, p.errorf("multi-line scalars are not supported; use a | block")
+		}
+	}
+	return value, nil
+}
+
+// parseBlockScalar parses a literal (|) or folded (>) block scalar whose
+// header is header, on line num.
+func (p *parser) parseBlockScalar(parentIndent, num int, header string) (string, error) {
+	folded := header[0] == '>'
+	chomp := byte(0)
+	for _, c := range []byte(header[1:]) {
+		switch {
+		case c == '-' || c == '+':
+			chomp = c
+		case c >= '1' && c <= '9':
+			// Explicit indentation indicators are accepted but detected instead
+		default:
+			return "", p.errorAt(num, "invalid block scalar header %q", header)
+		}
+	}
+
+	// The first non-blank line sets the block's indentation
+	blockIndent := -1
+	var lines []string
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.text == "" {
+			lines = append(lines, "")
+			p.pos++
+			continue
+		}
+		if blockIndent < 0 {
+			if l.indent <= parentIndent {
+				break
+			}
+			blockIndent = l.indent
+		}
+		if l.indent < blockIndent {
+			break
+		}
+		lines = append(lines, strings.TrimRight(l.raw[blockIndent:], "\r"))
+		p.pos++
+	}
+
+	// Trailing blank lines belong to the block only for keep chomping
+	content := len(lines)
+	for content > 0 && lines[content-1] == "" {
+		content--
+	}
+	trailing := lines[content:]
+	lines = lines[:content]
+
+	var b strings.Builder
+	for i, l := range lines {
+		if i > 0 {
+			switch {
+			case !folded:
+				b.WriteByte('\n')
+			case l == "" || lines[i-1] == "":
+				// Blank lines in folded scalars are kept as newlines
+				if lines[i-1] != "" {
+					break
+				}
+				b.WriteByte('\n')
+			case strings.HasPrefix(l, " ") || strings.HasPrefix(lines[i-1], " "):
+				// More-indented lines in folded scalars keep their newlines
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(l)
+	}
+
+	s := b.String()
+	switch chomp {
+	case '-':
+	case '+':
+		if len(lines) > 0 {
+			s += "\n"
+		}
+		s += strings.Repeat("\n", len(trailing))
+	default:
+		if len(lines) > 0 {
+			s += "\n"
+		}
+	}
+	return s, nil
+}
+
+// parseInline parses a scalar or a flow collection written on line num.
+func (p *parser) parseInline(num int, s string) (any, error) {
+	s = stripComment(s)
+	switch {
+	case s == "{}":
+		return map[string]any{}, nil
+	case strings.HasPrefix(s, "{"):
+		return nil, p.errorAt(num, "flow mappings are not supported")
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return nil, p.errorAt(num, "unterminated flow sequence")
+		}
+		seq := []any{}
+		inner := strings.TrimSpace(s[1 : len(s)-1])
+		if inner == "" {
+			return seq, nil
+		}
+		for _, item := range splitFlow(inner) {
+			v, err := p.parseInline(num, strings.TrimSpace(item))
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+		}
+		return seq, nil
+	case strings.HasPrefix(s, "&"), strings.HasPrefix(s, "*"), strings.HasPrefix(s, "!"):
+		return nil, p.errorAt(num, "anchors, aliases and tags are not supported")
+	}
+
+	v, err := unquote(s)
+	if err != nil {
+		return nil, p.errorAt(num, "%v", err)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return *v, nil
+}
+
+// unquote returns the value of a scalar, or nil for null.
+func unquote(s string) (*string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		if len(s) < 2 || !strings.HasSuffix(s, `"`) {
+			return nil, fmt.Errorf("unterminated double-quoted string")
+		}
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid double-quoted string %s", s)
+		}
+		return &v, nil
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return nil, fmt.Errorf("unterminated single-quoted string")
+		}
+		v := strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+		return &v, nil
+	case s == "" || s == "~" || s == "null" || s == "Null" || s == "NULL":
+		return nil, nil
+	}
+	return &s, nil
+}
+
+// splitKey splits "key: value" into its key and the rest of the line.
+// ok is false if t is not a mapping entry.
+func splitKey(t string) (key, rest string, ok bool, err error) {
+	if t == "" || t[0] == '[' || t[0] == '{' {
+		return "", "", false, nil
+	}
+	var end int
+	if t[0] == '"' || t[0] == '\'' {
+		// Quoted key: find the closing quote, then require a colon
+		i := quotedEnd(t, 0)
+		if i >= len(t) {
+			return "", "", false, nil
+		}
+		end = i + 1
+		if end >= len(t) || t[end] != ':' || (end+1 < len(t) && t[end+1] != ' ') {
+			return "", "", false, nil
+		}
+		k, uerr := unquote(t[:end])
+		if uerr != nil {
+			return "", "", false, uerr
+		}
+		return *k, strings.TrimSpace(t[end+1:]), true, nil
+	}
+
+	for i := 0; i < len(t); i++ {
+		switch {
+		case t[i] == ':' && (i+1 == len(t) || t[i+1] == ' '):
+			return strings.TrimSpace(t[:i]), strings.TrimSpace(t[i+1:]), true, nil
+		case t[i] == ' ' && i+1 < len(t) && t[i+1] == '#':
+			return "", "", false, nil
+		}
+	}
+	return "", "", false, nil
+}
+
+// quotedEnd returns the index of the quote closing the scalar quoted by
+// s[start], or len(s) if it is unterminated. A single-quoted scalar escapes
+// its quote by doubling it, and a double-quoted one with a backslash.
+func quotedEnd(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case q == '"' && s[i] == '\\':
+			i++
+		case s[i] != q:
+		case q == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		default:
+			return i
+		}
+	}
+	return len(s)
+}
+
+// stripComment removes a trailing comment from a value, outside quotes.
+func stripComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case (c == '"' || c == '\'') && (i == 0 || s[i-1] == ' ' || s[i-1] == '[' || s[i-1] == ','):
+			i = quotedEnd(s, i)
+		case c == '#' && (i == 0 || s[i-1] == ' '):
+			return strings.TrimSpace(s[:i])
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// splitFlow splits the items of a flow sequence at commas outside quotes.
+func splitFlow(s string) []string {
+	var (
+		items []string
+		last  int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\'':
+			i = quotedEnd(s, i)
+		case c == ',':
+			items = append(items, s[last:i])
+			last = i + 1
+		}
+	}
+	return append(items, s[last:])
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// decode stores node in v, converting scalars to v's type.
+func decode(node any, v reflect.Value, path string) error {
+	where := path
+	if where == "" {
+		where = "document"
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if node == nil {
+			v.SetZero()
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decode(node, v.Elem(), path)
+	}
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		if node != nil {
+			v.Set(reflect.ValueOf(node))
+		}
+		return nil
+	}
+	if node == nil {
+		v.SetZero()
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		m, ok := node.(map[string]any)
+		if !ok {
+			return fmt.Errorf("yaml: %s: expected a mapping", where)
+		}
+		fields := structFields(v.Type())
+		for key, child := range m {
+			idx, ok := fields[key]
+			if !ok {
+				return fmt.Errorf("yaml: %s: unknown field %q", where, key)
+			}
+			if err := decode(child, v.Field(idx), joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		m, ok := node.(map[string]any)
+		if !ok {
+			return fmt.Errorf("yaml: %s: expected a mapping", where)
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("yaml: %s: map keys must be strings", where)
+		}
+		out := reflect.MakeMapWithSize(v.Type(), len(m))
+		for key, child := range m {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := decode(child, elem, joinPath(path, key)); err != nil {
+				return err
+			}
+			out.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		v.Set(out)
+		return nil
+
+	case reflect.Slice:
+		seq, ok := node.([]any)
+		if !ok {
+			return fmt.Errorf("yaml: %s: expected a sequence", where)
+		}
+		out := reflect.MakeSlice(v.Type(), len(seq), len(seq))
+		for i, child := range seq {
+			if err := decode(child, out.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(out)
+		return nil
+	}
+
+	s, ok := node.(string)
+	if !ok {
+		return fmt.Errorf("yaml: %s: expected a scalar", where)
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("yaml: %s: invalid duration %q", where, s)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("yaml: %s: invalid boolean %q", where, s)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("yaml: %s: invalid integer %q", where, s)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("yaml: %s: invalid integer %q", where, s)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("yaml: %s: invalid number %q", where, s)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("yaml: %s: cannot decode into %s", where, v.Type())
+	}
+	return nil
+}
+
+// structFields maps the YAML keys of t's exported fields to field indexes.
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.ToLower(f.Name)
+		if tag, ok := f.Tag.Lookup("yaml"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
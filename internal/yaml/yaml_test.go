@@ -0,0 +1,204 @@
+package yaml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want any
+	}{
+		{
+			name: "scalars",
+			in:   "---\na: plain text # comment\nb: \"quoted # not a comment\"\nc: 'it''s'\nd: ~\n",
+			want: map[string]any{"a": "plain text", "b": "quoted # not a comment", "c": "it's", "d": nil},
+		},
+		{
+			name: "nested mapping",
+			in:   "outer:\n  inner: 1\n  other: two\n",
+			want: map[string]any{"outer": map[string]any{"inner": "1", "other": "two"}},
+		},
+		{
+			name: "sequences",
+			in:   "indented:\n  - a\n  - b\nflush:\n- c\nflow: [d, \"e, f\"]\nempty: []\n",
+			want: map[string]any{
+				"indented": []any{"a", "b"},
+				"flush":    []any{"c"},
+				"flow":     []any{"d", "e, f"},
+				"empty":    []any{},
+			},
+		},
+		{
+			name: "sequence of mappings",
+			in:   "items:\n  - role: system\n    content: Be brief.\n  - role: user\n    content: |\n      Hi\n      there\n",
+			want: map[string]any{"items": []any{
+				map[string]any{"role": "system", "content": "Be brief."},
+				map[string]any{"role": "user", "content": "Hi\nthere\n"},
+			}},
+		},
+		{
+			name: "literal block chomping",
+			in:   "clip: |\n  a\n\n  b\n\nstrip: |-\n  c\nkeep: |+\n  d\n\nend: x\n",
+			want: map[string]any{"clip": "a\n\nb\n", "strip": "c", "keep": "d\n\n", "end": "x"},
+		},
+		{
+			name: "literal block keeps extra indentation",
+			in:   "code: |\n  if x:\n      y\n",
+			want: map[string]any{"code": "if x:\n    y\n"},
+		},
+		{
+			name: "folded block",
+			in:   "text: >\n  one\n  two\n\n  three\n",
+			want: map[string]any{"text": "one two\nthree\n"},
+		},
+		{
+			name: "escaped quotes before a hash",
+			in:   "a: 'it''s # y'\ntemplate: \"Say \\\"hi # there\\\" now\" # comment\nflow: ['a'', # b', \"c\\\", d\"]\n",
+			want: map[string]any{"a": "it's # y", "template": `Say "hi # there" now`, "flow": []any{"a', # b", `c", d`}},
+		},
+		{
+			name: "quoted key",
+			in:   "\"a: b\": c\n",
+			want: map[string]any{"a: b": "c"},
+		},
+		{
+			name: "empty document",
+			in:   "# only a comment\n",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"tab indentation", "a:\n\tb: c\n"},
+		{"duplicate key", "a: 1\na: 2\n"},
+		{"bad indentation", "a: 1\n  b: 2\n"},
+		{"multi-line plain scalar", "a: one\n  two\n"},
+		{"unterminated quote", "a: \"open\n"},
+		{"flow mapping", "a: {b: c}\n"},
+		{"anchor", "a: &x b\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.in)); err == nil {
+				t.Errorf("Parse(%q) expected error", tt.in)
+			}
+		})
+	}
+}
+
+func TestParse_ErrorLine(t *testing.T) {
+	for in, want := range map[string]string{
+		"a: \"open\n":        "line 1:",
+		"a: 1\nb: [c\n":      "line 2:",
+		"a: 1\nb: |x\n  c\n": "line 2:",
+	} {
+		_, err := Parse([]byte(in))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) error = %v, want it on %s", in, err, want)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	type message struct {
+		Role    string `yaml:"role"`
+		Content string `yaml:"content"`
+	}
+	type doc struct {
+		Name     string            `yaml:"name"`
+		Enabled  bool              `yaml:"enabled"`
+		Count    int               `yaml:"count"`
+		Ratio    float64           `yaml:"ratio"`
+		Timeout  time.Duration     `yaml:"timeout"`
+		Tags     map[string]string `yaml:"tags"`
+		Messages []message         `yaml:"messages"`
+		Note     *string           `yaml:"note"`
+		Untagged string
+	}
+
+	in := `
+name: demo
+enabled: true
+count: 3
+ratio: 0.5
+timeout: 30s
+tags:
+  flag: true
+messages:
+  - role: user
+    content: hello
+note: set
+untagged: ok
+`
+	var got doc
+	if err := Unmarshal([]byte(in), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	note := "set"
+	want := doc{
+		Name:     "demo",
+		Enabled:  true,
+		Count:    3,
+		Ratio:    0.5,
+		Timeout:  30 * time.Second,
+		Tags:     map[string]string{"flag": "true"},
+		Messages: []message{{Role: "user", Content: "hello"}},
+		Note:     &note,
+		Untagged: "ok",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	type doc struct {
+		Name  string   `yaml:"name"`
+		Count int      `yaml:"count"`
+		List  []string `yaml:"list"`
+	}
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"unknown field", "nmae: x\n"},
+		{"invalid integer", "count: many\n"},
+		{"scalar for sequence", "list: x\n"},
+		{"sequence for scalar", "name: [a]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d doc
+			if err := Unmarshal([]byte(tt.in), &d); err == nil {
+				t.Errorf("Unmarshal(%q) expected error", tt.in)
+			}
+		})
+	}
+
+	var d doc
+	if err := Unmarshal([]byte("name: x\n"), d); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
@@ -0,0 +1,355 @@
+package mlflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	internalerrors "github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/yaml"
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// Spec declares the MLflow resources a team needs, for Apply. In YAML:
+//
+//	default_tags:
+//	  team: search
+//	experiments:
+//	  - name: ranking
+//	    artifact_location: s3://ml-artifacts/ranking
+//	    tags:
+//	      cost_center: "1234"
+//	    permissions:
+//	      alice: MANAGE
+//	      bob: READ
+//	prompts:
+//	  - name: qa
+//	    template: "Answer: {{question}}"
+//	    aliases: [production]
+//
+// Prompts use the keys of promptregistry.PromptFile.
+type Spec struct {
+	// DefaultTags are set on every experiment and prompt version; tags
+	// declared on the resource itself take precedence.
+	DefaultTags map[string]string           `yaml:"default_tags" json:"default_tags"`
+	Experiments []ExperimentSpec            `yaml:"experiments" json:"experiments"`
+	Prompts     []promptregistry.PromptFile `yaml:"prompts" json:"prompts"`
+}
+
+// ExperimentSpec declares an experiment.
+type ExperimentSpec struct {
+	Name string `yaml:"name" json:"name"`
+
+	// ArtifactLocation is only used when the experiment is created; MLflow
+	// cannot change it afterwards.
+	ArtifactLocation string            `yaml:"artifact_location" json:"artifact_location"`
+	Tags             map[string]string `yaml:"tags" json:"tags"`
+
+	// Permissions maps usernames to the permission they are granted. It
+	// requires a server with authentication enabled. Users not listed are
+	// left alone.
+	Permissions map[string]tracking.Permission `yaml:"permissions" json:"permissions"`
+}
+
+// LoadSpec reads a Spec from a YAML file, or a JSON file if path ends in
+// .json. Unknown keys are an error.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(spec)
+	} else {
+		err = yaml.Unmarshal(data, spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: invalid spec %s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// ExperimentPlan is the planned change for one experiment.
+type ExperimentPlan struct {
+	Action promptregistry.SyncAction
+
+	// Desired is the experiment as declared, with the default tags merged in.
+	Desired ExperimentSpec
+
+	// Current is the experiment on the server, or nil if it will be created.
+	Current *tracking.Experiment
+
+	// Changes describe each difference, such as "tag team: set to \"search\"".
+	// Empty for SyncNoop.
+	Changes []string
+
+	// tags and permissions are what Apply sets on an existing experiment;
+	// grants are the permissions it creates.
+	tags        map[string]string
+	grants      map[string]tracking.Permission
+	permissions map[string]tracking.Permission
+}
+
+// SpecPlan is the result of PlanSpec.
+type SpecPlan struct {
+	// Experiments has one item per declared experiment, in spec order.
+	Experiments []ExperimentPlan
+
+	// Prompts is the plan for the declared prompts; see promptregistry.SyncPlan.
+	Prompts *promptregistry.Plan
+}
+
+// HasChanges reports whether applying the plan would change the server.
+func (p *SpecPlan) HasChanges() bool {
+	for _, item := range p.Experiments {
+		if item.Action != promptregistry.SyncNoop {
+			return true
+		}
+	}
+	return p.Prompts != nil && p.Prompts.HasChanges()
+}
+
+// String renders the plan one resource per line, followed by its changes.
+func (p *SpecPlan) String() string {
+	var b strings.Builder
+	for _, item := range p.Experiments {
+		fmt.Fprintf(&b, "%s experiment %s\n", item.Action, item.Desired.Name)
+		for _, change := range item.Changes {
+			fmt.Fprintf(&b, "  %s\n", change)
+		}
+	}
+	if p.Prompts != nil {
+		for _, item := range p.Prompts.Items {
+			fmt.Fprintf(&b, "%s prompt %s\n", item.Action, item.Desired.Name)
+			for _, change := range item.Changes {
+				fmt.Fprintf(&b, "  %s\n", change)
+			}
+		}
+	}
+	return b.String()
+}
+
+// PlanSpec compares spec with the server and returns the changes Apply
+// would make, without making them.
+//
+// Experiments are matched by name. Tags and permissions are only compared
+// for the keys and users the spec lists, so settings managed elsewhere are
+// left alone, and nothing the spec does not mention is ever deleted. An
+// experiment whose name belongs to a deleted experiment, or whose artifact
+// location differs from the spec, is an error: neither can be changed in
+// place.
+func PlanSpec(ctx context.Context, c *Client, spec *Spec) (*SpecPlan, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("mlflow: spec is required")
+	}
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &SpecPlan{Experiments: make([]ExperimentPlan, 0, len(spec.Experiments))}
+	for _, exp := range spec.Experiments {
+		exp.Tags = withDefaults(spec.DefaultTags, exp.Tags)
+		item, err := planExperiment(ctx, c.Tracking(), exp)
+		if err != nil {
+			return nil, err
+		}
+		plan.Experiments = append(plan.Experiments, item)
+	}
+
+	prompts := make([]promptregistry.PromptFile, len(spec.Prompts))
+	for i, p := range spec.Prompts {
+		p.Tags = withDefaults(spec.DefaultTags, p.Tags)
+		prompts[i] = p
+	}
+	var err error
+	if plan.Prompts, err = promptregistry.PlanPrompts(ctx, c.PromptRegistry(), prompts); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Apply brings the server in line with spec: it computes a plan with
+// PlanSpec and makes its changes. Applying the same spec again changes
+// nothing, so Apply can run on every deploy.
+//
+// Experiments are applied before prompts. Every resource is attempted even
+// if some fail; the returned error is a *MultiError with one entry per
+// resource that failed. The returned plan lists what Apply attempted.
+func Apply(ctx context.Context, c *Client, spec *Spec) (*SpecPlan, error) {
+	plan, err := PlanSpec(ctx, c, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs internalerrors.MultiError
+	t := c.Tracking()
+	for i, item := range plan.Experiments {
+		if item.Action == promptregistry.SyncNoop {
+			continue
+		}
+		errs.Add(i, "experiment "+item.Desired.Name, applyExperiment(ctx, t, item))
+	}
+
+	var promptErrs *internalerrors.MultiError
+	if err = promptregistry.Apply(ctx, c.PromptRegistry(), plan.Prompts); errors.As(err, &promptErrs) {
+		// Prompt indexes refer to the prompt plan, not the spec
+		for _, item := range promptErrs.Errors {
+			errs.Add(-1, item.Resource, item.Err)
+		}
+	} else if err != nil {
+		return plan, err
+	}
+	return plan, errs.Err()
+}
+
+func (s *Spec) validate() error {
+	seen := make(map[string]bool, len(s.Experiments))
+	for _, exp := range s.Experiments {
+		if exp.Name == "" {
+			return fmt.Errorf("mlflow: experiment name is required")
+		}
+		if seen[exp.Name] {
+			return fmt.Errorf("mlflow: experiment %q is declared twice", exp.Name)
+		}
+		seen[exp.Name] = true
+
+		for user, permission := range exp.Permissions {
+			switch permission {
+			case tracking.PermissionNone, tracking.PermissionRead, tracking.PermissionEdit, tracking.PermissionManage:
+			default:
+				return fmt.Errorf("mlflow: experiment %q: invalid permission %q for %s", exp.Name, permission, user)
+			}
+		}
+	}
+	return nil
+}
+
+// withDefaults returns tags with defaults added for missing keys.
+func withDefaults(defaults, tags map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return tags
+	}
+	merged := maps.Clone(defaults)
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// planExperiment plans the changes that bring the experiment named in exp
+// in line with it.
+func planExperiment(ctx context.Context, t tracking.API, exp ExperimentSpec) (ExperimentPlan, error) {
+	item := ExperimentPlan{Action: promptregistry.SyncNoop, Desired: exp}
+	users := slices.Sorted(maps.Keys(exp.Permissions))
+
+	current, err := t.GetExperimentByName(ctx, exp.Name)
+	if IsNotFound(err) {
+		item.Action = promptregistry.SyncCreate
+		item.Changes = []string{"new experiment"}
+		item.grants = exp.Permissions
+		for _, user := range users {
+			item.Changes = append(item.Changes, fmt.Sprintf("permission %s: %s", user, exp.Permissions[user]))
+		}
+		return item, nil
+	}
+	if err != nil {
+		return item, fmt.Errorf("failed to get experiment %q: %w", exp.Name, err)
+	}
+	if current.LifecycleStage == "deleted" {
+		return item, fmt.Errorf("mlflow: experiment %q is deleted; restore it or choose another name", exp.Name)
+	}
+	if exp.ArtifactLocation != "" && exp.ArtifactLocation != current.ArtifactLocation {
+		return item, fmt.Errorf("mlflow: experiment %q has artifact location %q, which cannot be changed to %q",
+			exp.Name, current.ArtifactLocation, exp.ArtifactLocation)
+	}
+	item.Current = current
+
+	for _, key := range slices.Sorted(maps.Keys(exp.Tags)) {
+		want := exp.Tags[key]
+		value, ok := current.Tags[key]
+		switch {
+		case ok && value == want:
+			continue
+		case ok:
+			item.Changes = append(item.Changes, fmt.Sprintf("tag %s: %q -> %q", key, value, want))
+		default:
+			item.Changes = append(item.Changes, fmt.Sprintf("tag %s: set to %q", key, want))
+		}
+		if item.tags == nil {
+			item.tags = make(map[string]string)
+		}
+		item.tags[key] = want
+	}
+
+	for _, user := range users {
+		want := exp.Permissions[user]
+		have, getErr := t.GetExperimentPermission(ctx, current.ID, user)
+		switch {
+		case IsNotFound(getErr):
+			item.Changes = append(item.Changes, fmt.Sprintf("permission %s: %s", user, want))
+			if item.grants == nil {
+				item.grants = make(map[string]tracking.Permission)
+			}
+			item.grants[user] = want
+		case getErr != nil:
+			return item, fmt.Errorf("failed to get permission of %s on experiment %q: %w", user, exp.Name, getErr)
+		case have.Permission != want:
+			item.Changes = append(item.Changes, fmt.Sprintf("permission %s: %s -> %s", user, have.Permission, want))
+			if item.permissions == nil {
+				item.permissions = make(map[string]tracking.Permission)
+			}
+			item.permissions[user] = want
+		}
+	}
+
+	if len(item.Changes) > 0 {
+		item.Action = promptregistry.SyncUpdate
+	}
+	return item, nil
+}
+
+func applyExperiment(ctx context.Context, t tracking.API, item ExperimentPlan) error {
+	var id string
+	if item.Current == nil {
+		opts := []tracking.CreateExperimentOption{tracking.WithExperimentTags(item.Desired.Tags)}
+		if item.Desired.ArtifactLocation != "" {
+			opts = append(opts, tracking.WithArtifactLocation(item.Desired.ArtifactLocation))
+		}
+		var err error
+		if id, err = t.CreateExperiment(ctx, item.Desired.Name, opts...); err != nil {
+			return err
+		}
+	} else {
+		id = item.Current.ID
+		for _, key := range slices.Sorted(maps.Keys(item.tags)) {
+			if err := t.SetExperimentTag(ctx, id, key, item.tags[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, user := range slices.Sorted(maps.Keys(item.grants)) {
+		if err := t.CreateExperimentPermission(ctx, id, user, item.grants[user]); err != nil {
+			return err
+		}
+	}
+	for _, user := range slices.Sorted(maps.Keys(item.permissions)) {
+		if err := t.UpdateExperimentPermission(ctx, id, user, item.permissions[user]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package mlflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// fakeServer keeps experiments, their permissions and prompt versions in
+// memory, and records every mutating request.
+type fakeServer struct {
+	mu          sync.Mutex
+	experiments map[string]map[string]any // by name
+	permissions map[string]string         // by "experimentID/user"
+	prompts     map[string]int            // latest version by name
+	promptTags  map[string][]any          // latest version's tags by name
+	mutations   []string
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var req map[string]any
+	if r.Method != http.MethodGet {
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		f.mutations = append(f.mutations, r.Method+" "+r.URL.Path)
+	}
+	str := func(key string) string {
+		s, _ := req[key].(string)
+		return s
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "not found"})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	var resp any = map[string]any{}
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/experiments/get-by-name":
+		exp, ok := f.experiments[r.URL.Query().Get("experiment_name")]
+		if !ok {
+			notFound()
+			return
+		}
+		resp = map[string]any{"experiment": exp}
+	case "/api/2.0/mlflow/experiments/create":
+		id := strconv.Itoa(len(f.experiments) + 1)
+		f.experiments[str("name")] = map[string]any{
+			"experiment_id": id, "name": str("name"), "lifecycle_stage": "active",
+			"artifact_location": str("artifact_location"), "tags": req["tags"],
+		}
+		resp = map[string]any{"experiment_id": id}
+	case "/api/2.0/mlflow/experiments/set-experiment-tag":
+		for _, exp := range f.experiments {
+			if exp["experiment_id"] == str("experiment_id") {
+				tags, _ := exp["tags"].([]any)
+				exp["tags"] = append(tags, map[string]any{"key": str("key"), "value": str("value")})
+			}
+		}
+	case "/api/2.0/mlflow/experiments/permissions/get":
+		q := r.URL.Query()
+		perm, ok := f.permissions[q.Get("experiment_id")+"/"+q.Get("username")]
+		if !ok {
+			notFound()
+			return
+		}
+		resp = map[string]any{"experiment_permission": map[string]any{"experiment_id": q.Get("experiment_id"), "permission": perm}}
+	case "/api/2.0/mlflow/experiments/permissions/create", "/api/2.0/mlflow/experiments/permissions/update":
+		f.permissions[str("experiment_id")+"/"+str("username")] = str("permission")
+	case "/api/2.0/mlflow/registered-models/alias":
+		if r.Method != http.MethodGet {
+			break
+		}
+		name := r.URL.Query().Get("name")
+		v, ok := f.prompts[name]
+		if !ok {
+			notFound()
+			return
+		}
+		resp = map[string]any{"model_version": map[string]any{"name": name, "version": strconv.Itoa(v), "tags": f.promptTags[name]}}
+	case "/api/2.0/mlflow/registered-models/create":
+	case "/api/2.0/mlflow/model-versions/create":
+		f.prompts[str("name")]++
+		tags, _ := req["tags"].([]any)
+		if f.promptTags == nil {
+			f.promptTags = map[string][]any{}
+		}
+		f.promptTags[str("name")] = tags
+		resp = map[string]any{"model_version": map[string]any{"name": str("name"), "version": strconv.Itoa(f.prompts[str("name")])}}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func newApplyTestClient(t *testing.T, f *fakeServer) *Client {
+	t.Helper()
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(WithTrackingURI(server.URL), WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestApply_Idempotent(t *testing.T) {
+	f := &fakeServer{
+		experiments: map[string]map[string]any{
+			"existing": {
+				"experiment_id": "10", "name": "existing", "lifecycle_stage": "active",
+				"tags": []any{map[string]any{"key": "team", "value": "ranking"}},
+			},
+		},
+		permissions: map[string]string{"10/alice": "READ"},
+		prompts:     map[string]int{},
+	}
+	client := newApplyTestClient(t, f)
+
+	spec := &Spec{
+		DefaultTags: map[string]string{"team": "search"},
+		Experiments: []ExperimentSpec{
+			{Name: "new", Permissions: map[string]tracking.Permission{"bob": tracking.PermissionEdit}},
+			{Name: "existing", Permissions: map[string]tracking.Permission{"alice": tracking.PermissionManage}},
+		},
+		Prompts: []promptregistry.PromptFile{{Name: "qa", Template: "Answer: {{question}}"}},
+	}
+
+	plan, err := Apply(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if plan.Experiments[0].Action != promptregistry.SyncCreate || plan.Experiments[1].Action != promptregistry.SyncUpdate {
+		t.Errorf("plan =\n%s", plan)
+	}
+	wantChanges := []string{`tag team: "ranking" -> "search"`, "permission alice: READ -> MANAGE"}
+	if !slices.Equal(plan.Experiments[1].Changes, wantChanges) {
+		t.Errorf("existing changes = %v, want %v", plan.Experiments[1].Changes, wantChanges)
+	}
+	if f.permissions["2/bob"] != "EDIT" || f.permissions["10/alice"] != "MANAGE" {
+		t.Errorf("permissions = %v", f.permissions)
+	}
+	if f.prompts["qa"] != 1 {
+		t.Errorf("qa version = %d, want 1", f.prompts["qa"])
+	}
+
+	// A second run finds nothing to do
+	f.mutations = nil
+	plan, err = Apply(context.Background(), client, spec)
+	if err != nil {
+		t.Fatalf("second Apply() error = %v", err)
+	}
+	if plan.HasChanges() || len(f.mutations) > 0 {
+		t.Errorf("second Apply() made changes %v; plan =\n%s", f.mutations, plan)
+	}
+}
+
+func TestPlanSpec_Errors(t *testing.T) {
+	f := &fakeServer{
+		experiments: map[string]map[string]any{
+			"gone":  {"experiment_id": "1", "name": "gone", "lifecycle_stage": "deleted"},
+			"fixed": {"experiment_id": "2", "name": "fixed", "lifecycle_stage": "active", "artifact_location": "s3://a"},
+		},
+		prompts: map[string]int{},
+	}
+	client := newApplyTestClient(t, f)
+
+	tests := []struct {
+		name string
+		spec *Spec
+	}{
+		{"nil spec", nil},
+		{"missing name", &Spec{Experiments: []ExperimentSpec{{}}}},
+		{"duplicate", &Spec{Experiments: []ExperimentSpec{{Name: "a"}, {Name: "a"}}}},
+		{"invalid permission", &Spec{Experiments: []ExperimentSpec{{Name: "a", Permissions: map[string]tracking.Permission{"bob": "OWNER"}}}}},
+		{"deleted experiment", &Spec{Experiments: []ExperimentSpec{{Name: "gone"}}}},
+		{"artifact location change", &Spec{Experiments: []ExperimentSpec{{Name: "fixed", ArtifactLocation: "s3://b"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := PlanSpec(context.Background(), client, tt.spec); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+	if len(f.mutations) > 0 {
+		t.Errorf("PlanSpec made changes: %v", f.mutations)
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mlflow.yaml")
+	content := `
+default_tags:
+  team: search
+experiments:
+  - name: ranking
+    permissions:
+      alice: MANAGE
+prompts:
+  - name: qa
+    template: |
+      Answer: {{question}}
+    aliases: [production]
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := LoadSpec(path)
+	if err != nil {
+		t.Fatalf("LoadSpec() error = %v", err)
+	}
+	if spec.DefaultTags["team"] != "search" || len(spec.Experiments) != 1 || spec.Experiments[0].Permissions["alice"] != tracking.PermissionManage {
+		t.Errorf("LoadSpec() = %+v", spec)
+	}
+	if len(spec.Prompts) != 1 || spec.Prompts[0].Template != "Answer: {{question}}\n" || !slices.Equal(spec.Prompts[0].Aliases, []string{"production"}) {
+		t.Errorf("prompts = %+v", spec.Prompts)
+	}
+
+	if err := os.WriteFile(path, []byte("experiment: []\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSpec(path); err == nil {
+		t.Error("expected error for unknown key")
+	}
+}
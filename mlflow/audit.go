@@ -0,0 +1,7 @@
+package mlflow
+
+import "github.com/opendatahub-io/mlflow-go/internal/transport"
+
+// AuditEvent describes a completed mutating API call.
+// See WithAuditHook.
+type AuditEvent = transport.AuditEvent
@@ -0,0 +1,7 @@
+package mlflow
+
+import "github.com/opendatahub-io/mlflow-go/internal/transport"
+
+// RetryBudget caps failover retries relative to the requests a client
+// sends. See WithRetryBudget.
+type RetryBudget = transport.RetryBudget
@@ -3,14 +3,19 @@
 package mlflow
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
-	"os"
 	"strings"
 	"sync"
 
 	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/modelregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/models"
 	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
 	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
 )
 
@@ -19,18 +24,30 @@ import (
 type Client struct {
 	transport *transport.Client
 	opts      options
+	userAgent string
 
 	promptRegistryOnce sync.Once
 	promptRegistry     *promptregistry.Client
 
 	trackingOnce sync.Once
 	tracking     *tracking.Client
+
+	tracingOnce sync.Once
+	tracing     *tracing.Client
+
+	modelsOnce sync.Once
+	models     *models.Client
+
+	modelRegistryOnce sync.Once
+	modelRegistry     *modelregistry.Client
 }
 
 // NewClient creates a new MLflow client with the given options.
 // If no options are provided, configuration is read from environment variables:
 //   - MLFLOW_TRACKING_URI: MLflow server URL (required)
 //   - MLFLOW_INSECURE_SKIP_TLS_VERIFY: Allow HTTP (optional, default false)
+//   - MLFLOW_TRACKING_HEADERS: Default headers as "k1=v1,k2=v2" (optional);
+//     headers set with WithHeaders take precedence
 func NewClient(clientOpts ...Option) (*Client, error) {
 	opts := options{}
 
@@ -40,45 +57,83 @@ func NewClient(clientOpts ...Option) (*Client, error) {
 	}
 
 	// Fill in missing values from environment variables
+	env, _ := ConfigFromEnv()
 	if opts.trackingURI == "" {
-		opts.trackingURI = os.Getenv("MLFLOW_TRACKING_URI")
+		opts.trackingURI = env.TrackingURI
 	}
 	if !opts.insecure {
-		if v := os.Getenv("MLFLOW_INSECURE_SKIP_TLS_VERIFY"); v == "true" || v == "1" {
-			opts.insecure = true
-		}
+		opts.insecure = env.Insecure
+	}
+	if env.headersErr != nil {
+		return nil, fmt.Errorf("mlflow: invalid %s: %w", envHeaders, env.headersErr)
 	}
+	opts.headers = withEnvHeaders(opts.headers, env.Headers)
 
 	// Validate tracking URI is provided
 	if opts.trackingURI == "" {
 		return nil, fmt.Errorf("mlflow: tracking URI is required (set MLFLOW_TRACKING_URI or use WithTrackingURI)")
 	}
 
-	// Normalize bare host:port input (e.g., "localhost:5000") by prepending https://.
-	// Without a scheme, url.Parse treats the host as the scheme and the port as opaque data.
-	if !strings.Contains(opts.trackingURI, "://") {
-		opts.trackingURI = "https://" + opts.trackingURI
+	var err error
+	if opts.trackingURI, err = normalizeTrackingURI(opts.trackingURI, opts.insecure); err != nil {
+		return nil, err
+	}
+	if opts.readURI != "" {
+		if opts.readURI, err = normalizeTrackingURI(opts.readURI, opts.insecure); err != nil {
+			return nil, err
+		}
+	}
+	for i, uri := range opts.failoverURIs {
+		if opts.failoverURIs[i], err = normalizeTrackingURI(uri, opts.insecure); err != nil {
+			return nil, err
+		}
 	}
 
-	// Parse and validate the URI
-	parsedURL, err := url.Parse(opts.trackingURI)
-	if err != nil {
-		return nil, fmt.Errorf("mlflow: invalid tracking URI: %w", err)
+	for _, suffix := range opts.userAgentSuffixes {
+		if strings.ContainsAny(suffix, "\r\n") {
+			return nil, fmt.Errorf("mlflow: user agent suffix %q must not contain line breaks", suffix)
+		}
 	}
+	ua := userAgent(opts.userAgentSuffixes)
 
-	// Enforce HTTPS unless insecure mode is enabled
-	if !opts.insecure && parsedURL.Scheme == "http" {
-		return nil, fmt.Errorf("mlflow: HTTP is not allowed (use HTTPS or enable insecure mode with WithInsecure)")
+	if opts.hedgeDelay < 0 {
+		return nil, fmt.Errorf("mlflow: hedging delay must not be negative")
+	}
+	if opts.retryBudget != nil && opts.retryBudget.Ratio < 0 {
+		return nil, fmt.Errorf("mlflow: retry budget ratio must not be negative")
+	}
+
+	if strings.ContainsAny(opts.impersonateUser, "\r\n") {
+		return nil, fmt.Errorf("mlflow: impersonated user must not contain line breaks")
+	}
+	if strings.ContainsAny(opts.impersonationHeader, " \t\r\n:") {
+		return nil, fmt.Errorf("mlflow: invalid impersonation header name %q", opts.impersonationHeader)
 	}
 
 	// Create transport client
 	transportCfg := transport.Config{
-		BaseURL:    opts.trackingURI,
-		Headers:    opts.headers,
-		HTTPClient: opts.httpClient,
-		Logger:     opts.logger,
-		Timeout:    opts.timeout,
-		Insecure:   opts.insecure,
+		UserAgent:      ua,
+		BaseURL:        opts.trackingURI,
+		Headers:        opts.headers,
+		HTTPClient:     opts.httpClient,
+		Logger:         opts.logger,
+		Timeout:        opts.timeout,
+		Insecure:       opts.insecure,
+		DryRun:         opts.dryRun,
+		AuditHook:      opts.auditHook,
+		AuditActor:     opts.auditActor,
+		Singleflight:   opts.singleflight,
+		TimeoutProfile: opts.timeoutProfile,
+		RequestSigner:  opts.requestSigner,
+		FailoverURLs:   opts.failoverURIs,
+		RetryBudget:    opts.retryBudget,
+		ReadURL:        opts.readURI,
+		HedgeDelay:     opts.hedgeDelay,
+
+		ConcurrencyLimits:   opts.concurrencyLimits,
+		ImpersonateUser:     opts.impersonateUser,
+		ImpersonationHeader: opts.impersonationHeader,
+		DeleteProtection:    opts.deleteProtection,
 	}
 
 	transportClient, err := transport.New(transportCfg)
@@ -89,9 +144,49 @@ func NewClient(clientOpts ...Option) (*Client, error) {
 	return &Client{
 		transport: transportClient,
 		opts:      opts,
+		userAgent: ua,
 	}, nil
 }
 
+// withEnvHeaders returns headers with the headers from the environment added
+// for names it does not set, compared case-insensitively.
+func withEnvHeaders(headers, env map[string]string) map[string]string {
+	if len(env) == 0 {
+		return headers
+	}
+	merged := make(map[string]string, len(headers)+len(env))
+	for name, value := range env {
+		merged[http.CanonicalHeaderKey(name)] = value
+	}
+	for name, value := range headers {
+		delete(merged, http.CanonicalHeaderKey(name))
+		merged[name] = value
+	}
+	return merged
+}
+
+// normalizeTrackingURI adds a scheme to a bare host and checks that the
+// result is a valid URI allowed by the insecure setting.
+func normalizeTrackingURI(uri string, insecure bool) (string, error) {
+	// Normalize bare host:port input (e.g., "localhost:5000") by prepending https://.
+	// Without a scheme, url.Parse treats the host as the scheme and the port as opaque data.
+	if !strings.Contains(uri, "://") {
+		uri = "https://" + uri
+	}
+
+	// Parse and validate the URI
+	parsedURL, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("mlflow: invalid tracking URI: %w", err)
+	}
+
+	// Enforce HTTPS unless insecure mode is enabled
+	if !insecure && parsedURL.Scheme == "http" {
+		return "", fmt.Errorf("mlflow: HTTP is not allowed (use HTTPS or enable insecure mode with WithInsecure)")
+	}
+	return uri, nil
+}
+
 // TrackingURI returns the configured MLflow tracking URI.
 func (c *Client) TrackingURI() string {
 	return c.opts.trackingURI
@@ -102,6 +197,53 @@ func (c *Client) IsInsecure() bool {
 	return c.opts.insecure
 }
 
+// IsDryRun returns whether the client was created with WithDryRun.
+func (c *Client) IsDryRun() bool {
+	return c.opts.dryRun
+}
+
+// ContextWithDryRun returns a context that puts mutating calls made with it
+// into dry-run mode, even on a client created without WithDryRun.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return transport.WithDryRun(ctx)
+}
+
+// ContextWithPrimary returns a context whose reads go to the tracking URI
+// even on a client created with WithReadURI, for reads that must observe a
+// write the replica may not have yet.
+func ContextWithPrimary(ctx context.Context) context.Context {
+	return transport.WithPrimary(ctx)
+}
+
+// workspaceHeader is the header the midstream MLflow server reads the
+// workspace from.
+const workspaceHeader = "X-MLFLOW-WORKSPACE"
+
+// ContextWithWorkspace returns a context whose calls are made in workspace,
+// overriding the workspace header the client was created with. One client
+// can then serve many tenants, such as in an HTTP handler that takes the
+// workspace from the incoming request. Identical concurrent reads (see
+// WithSingleflight) are only shared within a workspace.
+func ContextWithWorkspace(ctx context.Context, workspace string) context.Context {
+	return transport.WithHeader(ctx, workspaceHeader, workspace)
+}
+
+// WorkspaceFromContext returns the workspace set with ContextWithWorkspace.
+func WorkspaceFromContext(ctx context.Context) (string, bool) {
+	return transport.HeaderFromContext(ctx, workspaceHeader)
+}
+
+// DefaultImpersonationHeader carries the impersonated user unless
+// WithImpersonationHeader names another header.
+const DefaultImpersonationHeader = transport.DefaultImpersonationHeader
+
+// ContextWithImpersonation returns a context whose calls are made on behalf
+// of user, overriding WithImpersonation, such as in a service that acts for
+// the user of each incoming request. See WithImpersonation.
+func ContextWithImpersonation(ctx context.Context, user string) context.Context {
+	return transport.WithImpersonation(ctx, user)
+}
+
 // PromptRegistry returns the Prompt Registry client for managing prompts.
 // The sub-client is created lazily on first access.
 func (c *Client) PromptRegistry() *promptregistry.Client {
@@ -119,3 +261,56 @@ func (c *Client) Tracking() *tracking.Client {
 	})
 	return c.tracking
 }
+
+// Tracing returns the Tracing client for searching and retrieving traces.
+// The sub-client is created lazily on first access.
+func (c *Client) Tracing() *tracing.Client {
+	c.tracingOnce.Do(func() {
+		c.tracing = tracing.NewClient(c.transport)
+	})
+	return c.tracing
+}
+
+// Models returns the Models client for MLflow 3 logged models.
+// The sub-client is created lazily on first access.
+func (c *Client) Models() *models.Client {
+	c.modelsOnce.Do(func() {
+		c.models = models.NewClient(c.transport, c.Tracking())
+	})
+	return c.models
+}
+
+// ModelRegistry returns the Model Registry client for registered models and
+// their versions. The sub-client is created lazily on first access.
+func (c *Client) ModelRegistry() *modelregistry.Client {
+	c.modelRegistryOnce.Do(func() {
+		c.modelRegistry = modelregistry.NewClient(c.transport)
+	})
+	return c.modelRegistry
+}
+
+// Query runs a SQL-like query over experiments and runs, such as
+// "SELECT runs WHERE metrics.acc > 0.9 AND tags.team = 'x' LIMIT 10".
+// See tracking.ParseQuery for the syntax.
+func (c *Client) Query(ctx context.Context, q string) (*tracking.QueryResult, error) {
+	return tracking.RunQuery(ctx, c.Tracking(), q)
+}
+
+// Flush sends everything buffered by the BufferedLoggers created with
+// this client's Tracking client and returns their errors joined.
+func (c *Client) Flush(ctx context.Context) error {
+	return c.Tracking().FlushBufferedLoggers(ctx)
+}
+
+// Close shuts the client down for a service's shutdown sequence: it closes
+// the BufferedLoggers created with its Tracking client, sending their
+// trailing values, then refuses new requests with ErrClientClosed, waits
+// for the requests in flight, and closes idle connections. Give ctx a
+// deadline to bound the wait; if it ends first, Close still closes idle
+// connections and returns ctx.Err() joined with any flush errors.
+//
+// Close is safe to call more than once. Requests made after it fail.
+func (c *Client) Close(ctx context.Context) error {
+	flushErr := c.Tracking().CloseBufferedLoggers(ctx)
+	return errors.Join(flushErr, c.transport.Shutdown(ctx))
+}
@@ -1,8 +1,17 @@
 package mlflow
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
 )
 
 func TestNewClient_WithTrackingURI(t *testing.T) {
@@ -207,3 +216,377 @@ func TestClient_PromptRegistry_ReturnsSameInstance(t *testing.T) {
 		t.Error("PromptRegistry() should return same instance")
 	}
 }
+
+func TestNewClient_WithDryRun(t *testing.T) {
+	client, err := NewClient(
+		WithTrackingURI("https://mlflow.example.com"),
+		WithDryRun(),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if !client.IsDryRun() {
+		t.Error("IsDryRun() = false, want true")
+	}
+
+	// Mutating calls succeed without reaching the (unreachable) server
+	if err := client.Tracking().DeleteRun(context.Background(), "abc-123"); err != nil {
+		t.Errorf("DeleteRun() in dry-run error = %v", err)
+	}
+}
+
+func TestNewClient_WithTimeoutProfile(t *testing.T) {
+	profile := DefaultTimeoutProfile()
+	client, err := NewClient(
+		WithTrackingURI("https://mlflow.example.com"),
+		WithTimeout(time.Second),
+		WithTimeoutProfile(profile),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if client.opts.timeoutProfile == nil || *client.opts.timeoutProfile != profile {
+		t.Errorf("timeoutProfile = %v, want %v", client.opts.timeoutProfile, profile)
+	}
+}
+
+func TestNewClient_WithRequestSigner(t *testing.T) {
+	var signed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "sig" {
+			t.Errorf("X-Signature = %q, want sig", r.Header.Get("X-Signature"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithRequestSigner(func(req *http.Request) error {
+			signed = true
+			req.Header.Set("X-Signature", "sig")
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+	if !signed {
+		t.Error("expected signer to be called")
+	}
+}
+
+func TestNewClient_WithTrackingURIs(t *testing.T) {
+	client, err := NewClient(WithTrackingURIs("mlflow-east.example.com", "mlflow-west.example.com:5000"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.TrackingURI() != "https://mlflow-east.example.com" {
+		t.Errorf("TrackingURI() = %q, want the primary", client.TrackingURI())
+	}
+	if want := []string{"https://mlflow-west.example.com:5000"}; len(client.opts.failoverURIs) != 1 || client.opts.failoverURIs[0] != want[0] {
+		t.Errorf("failoverURIs = %v, want %v", client.opts.failoverURIs, want)
+	}
+
+	if _, err := NewClient(WithTrackingURIs("https://a.example.com", "http://b.example.com")); err == nil {
+		t.Error("expected error for HTTP secondary without WithInsecure")
+	}
+}
+
+func TestNewClient_WithRetryBudget(t *testing.T) {
+	client, err := NewClient(
+		WithTrackingURIs("mlflow-east.example.com", "mlflow-west.example.com"),
+		WithRetryBudget(RetryBudget{Ratio: 0.1}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.opts.retryBudget == nil || client.opts.retryBudget.Ratio != 0.1 {
+		t.Errorf("retryBudget = %+v, want ratio 0.1", client.opts.retryBudget)
+	}
+
+	if _, err := NewClient(WithTrackingURI("https://a.example.com"), WithRetryBudget(RetryBudget{Ratio: -1})); err == nil {
+		t.Error("expected error for negative ratio")
+	}
+}
+
+func TestNewClient_WithConcurrencyLimit(t *testing.T) {
+	client, err := NewClient(
+		WithTrackingURI("https://mlflow.example.com"),
+		WithConcurrencyLimit("PUT /api/2.0/mlflow-artifacts/artifacts/*", 8),
+		WithConcurrencyLimit("/api/2.0/mlflow/runs/search", 2),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if len(client.opts.concurrencyLimits) != 2 {
+		t.Errorf("concurrencyLimits = %v, want 2 limits", client.opts.concurrencyLimits)
+	}
+
+	if _, err := NewClient(WithTrackingURI("https://mlflow.example.com"), WithConcurrencyLimit("/api/*", 0)); err == nil {
+		t.Error("expected error for a limit of 0")
+	}
+}
+
+func TestNewClient_WithHedging(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			time.Sleep(500 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"experiment": {"experiment_id": "1", "name": "exp"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithTrackingURI(server.URL), WithInsecure(), WithHedging(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	start := time.Now()
+	if _, err := client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+	if d := time.Since(start); d >= 500*time.Millisecond || client.Stats().Hedges != 1 {
+		t.Errorf("GetExperiment() took %v with %d hedges, want the hedged response", d, client.Stats().Hedges)
+	}
+
+	if _, err := NewClient(WithTrackingURI(server.URL), WithInsecure(), WithHedging(-time.Second)); err == nil {
+		t.Error("expected error for negative delay")
+	}
+}
+
+func TestNewClient_WithReadURI(t *testing.T) {
+	client, err := NewClient(
+		WithTrackingURI("https://mlflow.example.com"),
+		WithReadURI("mlflow-replica.example.com"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.opts.readURI != "https://mlflow-replica.example.com" {
+		t.Errorf("readURI = %q, want normalized replica URI", client.opts.readURI)
+	}
+
+	if _, err := NewClient(WithTrackingURI("https://a.example.com"), WithReadURI("http://b.example.com")); err == nil {
+		t.Error("expected error for HTTP read URI without WithInsecure")
+	}
+}
+
+func TestNewClient_HeadersFromEnvVar(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("MLFLOW_TRACKING_HEADERS", "x-mlflow-workspace=team-a, X-Tenant = acme")
+
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithHeaders(map[string]string{"X-MLFLOW-WORKSPACE": "team-b"}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+
+	if v := got.Get("X-Tenant"); v != "acme" {
+		t.Errorf("X-Tenant = %q, want acme", v)
+	}
+	// WithHeaders takes precedence over the environment
+	if v := got.Values("X-MLFLOW-WORKSPACE"); len(v) != 1 || v[0] != "team-b" {
+		t.Errorf("X-MLFLOW-WORKSPACE = %q, want [team-b]", v)
+	}
+}
+
+func TestNewClient_InvalidHeadersEnvVar(t *testing.T) {
+	t.Setenv("MLFLOW_TRACKING_HEADERS", "X-Tenant")
+
+	_, err := NewClient(WithTrackingURI("https://mlflow.example.com"))
+	if err == nil {
+		t.Error("expected error for malformed MLFLOW_TRACKING_HEADERS")
+	}
+}
+
+func TestContextWithWorkspace(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Values("X-MLFLOW-WORKSPACE")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithHeaders(map[string]string{"X-MLFLOW-WORKSPACE": "default"}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := ContextWithWorkspace(context.Background(), "team-a")
+	if ws, ok := WorkspaceFromContext(ctx); !ok || ws != "team-a" {
+		t.Errorf("WorkspaceFromContext() = %q, %v", ws, ok)
+	}
+	if _, err := client.Tracking().GetExperiment(ctx, "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "team-a" {
+		t.Errorf("X-MLFLOW-WORKSPACE = %q, want [team-a]", got)
+	}
+}
+
+func TestNewClient_WithImpersonation(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Remote-User"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"experiment_id": "1"}`))
+	}))
+	defer server.Close()
+
+	var events []AuditEvent
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithImpersonation("alice"),
+		WithImpersonationHeader("X-Remote-User"),
+		WithAuditHook(func(e AuditEvent) { events = append(events, e) }),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Tracking().CreateExperiment(ctx, "a"); err != nil {
+		t.Fatalf("CreateExperiment() error = %v", err)
+	}
+	if _, err := client.Tracking().CreateExperiment(ContextWithImpersonation(ctx, "bob"), "b"); err != nil {
+		t.Fatalf("CreateExperiment() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0] != "alice" || got[1] != "bob" {
+		t.Errorf("X-Remote-User = %q, want [alice bob]", got)
+	}
+	if len(events) != 2 || events[0].OnBehalfOf != "alice" || events[1].OnBehalfOf != "bob" {
+		t.Errorf("audit events = %+v", events)
+	}
+}
+
+func TestNewClient_InvalidImpersonation(t *testing.T) {
+	if _, err := NewClient(WithTrackingURI("https://mlflow.example.com"), WithImpersonation("alice\r\nX-Admin: 1")); err == nil {
+		t.Error("expected error for impersonated user with line breaks")
+	}
+	if _, err := NewClient(WithTrackingURI("https://mlflow.example.com"), WithImpersonationHeader("X User")); err == nil {
+		t.Error("expected error for invalid impersonation header")
+	}
+}
+
+func TestNewClient_WithDeleteProtection(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithTrackingURI(server.URL), WithInsecure(), WithDeleteProtection())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	prompts := client.PromptRegistry()
+	if err := prompts.DeletePrompt(ctx, "qa"); !errors.Is(err, ErrDeleteNotConfirmed) {
+		t.Errorf("DeletePrompt() error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if err := prompts.DeletePrompt(ctx, "qa", promptregistry.WithConfirm("other")); !errors.Is(err, ErrDeleteNotConfirmed) {
+		t.Errorf("DeletePrompt() with other target: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	// A bare name confirms only the client's own namespace
+	if err := prompts.DeletePrompt(ctx, "team/qa", promptregistry.WithConfirm("qa")); !errors.Is(err, ErrDeleteNotConfirmed) {
+		t.Errorf("DeletePrompt() in another namespace: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if requests != 0 {
+		t.Fatalf("server received %d requests, want 0", requests)
+	}
+
+	if err := prompts.DeletePrompt(ctx, "qa", promptregistry.WithConfirm("qa")); err != nil {
+		t.Errorf("DeletePrompt() error = %v", err)
+	}
+	team, err := prompts.WithNamespace("team")
+	if err != nil {
+		t.Fatalf("WithNamespace() error = %v", err)
+	}
+	if err := team.DeletePrompt(ctx, "qa", promptregistry.WithConfirm("qa")); err != nil {
+		t.Errorf("namespaced DeletePrompt() error = %v", err)
+	}
+	if err := client.Tracking().DeleteExperiment(ctx, "7", tracking.WithConfirm("7")); err != nil {
+		t.Errorf("DeleteExperiment() error = %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("server received %d requests, want 3", requests)
+	}
+
+	// A confirmation covers only the call it was passed to
+	if err := client.Tracking().DeleteRun(ctx, "7"); !errors.Is(err, ErrDeleteNotConfirmed) {
+		t.Errorf("DeleteRun() after a confirmed call: error = %v, want ErrDeleteNotConfirmed", err)
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	var batches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/2.0/mlflow/runs/log-batch" {
+			batches.Add(1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithTrackingURI(server.URL), WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	logger, err := tracking.NewBufferedLogger(ctx, client.Tracking(), "run-1", tracking.WithFlushInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+	logger.LogMetric("loss", 0.1, 100)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Close(shutdownCtx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if batches.Load() != 1 {
+		t.Errorf("log-batch requests = %d, want the trailing metric flushed", batches.Load())
+	}
+
+	if err := client.Tracking().SetTag(ctx, "run-1", "k", "v"); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("SetTag() after Close error = %v, want ErrClientClosed", err)
+	}
+	if err := client.Close(ctx); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package mlflow
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/yaml"
+)
+
+// defaultConfigPath is the config file read when MLFLOW_CONFIG is not set,
+// relative to the user's home directory.
+const defaultConfigPath = ".mlflow/config"
+
+// ConfigFile is a client configuration file with named profiles, read by
+// NewClientFromProfile. It is YAML:
+//
+//	default_profile: dev
+//	profiles:
+//	  dev:
+//	    tracking_uri: http://localhost:5000
+//	    insecure: true
+//	  prod:
+//	    tracking_uri: https://mlflow.example.com
+//	    token: ${MLFLOW_PROD_TOKEN}
+//	    workspace: team-a
+//	    ca_file: /etc/ssl/internal-ca.pem
+//	    timeout: 1m
+//	    headers:
+//	      X-Request-Source: batch
+//
+// ${VAR} references in token, password and header values are expanded from
+// the environment, so secrets need not be stored in the file.
+type ConfigFile struct {
+	// DefaultProfile is used when no profile is named. Default: "default".
+	DefaultProfile string `yaml:"default_profile"`
+
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// Profile is one named client configuration in a ConfigFile.
+type Profile struct {
+	TrackingURI string `yaml:"tracking_uri"`
+
+	// Token is sent as a bearer token. Mutually exclusive with Username.
+	Token string `yaml:"token"`
+
+	// Username and Password are sent with HTTP basic authentication, as
+	// used by MLflow's basic-auth app.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+
+	// Workspace is sent in the X-MLFLOW-WORKSPACE header.
+	Workspace string `yaml:"workspace"`
+
+	Headers map[string]string `yaml:"headers"`
+
+	// Insecure allows HTTP and skips TLS verification, as WithInsecure.
+	Insecure bool `yaml:"insecure"`
+
+	// CAFile is a PEM file of certificate authorities trusted in addition to
+	// the system pool, for servers with internal certificates.
+	CAFile string `yaml:"ca_file"`
+
+	// Timeout is the request timeout, as WithTimeout, such as "45s".
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// LoadConfigFile reads a ConfigFile.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: failed to read config file: %w", err)
+	}
+	cfg := &ConfigFile{}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("mlflow: invalid config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ConfigFilePath returns the config file NewClientFromProfile reads: the
+// MLFLOW_CONFIG environment variable if set, otherwise ~/.mlflow/config.
+func ConfigFilePath() (string, error) {
+	if path := os.Getenv(envConfig); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("mlflow: cannot locate config file: %w", err)
+	}
+	return filepath.Join(home, defaultConfigPath), nil
+}
+
+// NewClientFromProfile creates a client from a profile in the config file
+// (see ConfigFilePath and ConfigFile), like AWS CLI profiles. An empty name
+// selects the file's default profile. opts are applied after the profile's
+// settings, so they take precedence; note that WithHeaders replaces the
+// profile's headers, including those carrying its token and workspace.
+func NewClientFromProfile(name string, opts ...Option) (*Client, error) {
+	path, err := ConfigFilePath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := LoadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = cfg.DefaultProfile
+	}
+	if name == "" {
+		name = "default"
+	}
+	profile, ok := cfg.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("mlflow: profile %q not found in %s", name, path)
+	}
+
+	profileOpts, err := profile.Options()
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: profile %q: %w", name, err)
+	}
+	return NewClient(append(profileOpts, opts...)...)
+}
+
+// Options returns the client options equivalent to the profile.
+func (p Profile) Options() ([]Option, error) {
+	if p.TrackingURI == "" {
+		return nil, fmt.Errorf("tracking_uri is required")
+	}
+	if p.Token != "" && p.Username != "" {
+		return nil, fmt.Errorf("token and username are mutually exclusive")
+	}
+
+	opts := []Option{WithTrackingURI(p.TrackingURI)}
+	if p.Insecure {
+		opts = append(opts, WithInsecure())
+	}
+	if p.Timeout > 0 {
+		opts = append(opts, WithTimeout(p.Timeout))
+	}
+
+	headers := make(map[string]string, len(p.Headers)+2)
+	for k, v := range p.Headers {
+		headers[k] = os.ExpandEnv(v)
+	}
+	if p.Workspace != "" {
+		headers[workspaceHeader] = p.Workspace
+	}
+	switch {
+	case p.Token != "":
+		headers["Authorization"] = "Bearer " + os.ExpandEnv(p.Token)
+	case p.Username != "":
+		creds := p.Username + ":" + os.ExpandEnv(p.Password)
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(creds))
+	}
+	if len(headers) > 0 {
+		opts = append(opts, WithHeaders(headers))
+	}
+
+	if p.CAFile != "" {
+		client, err := p.httpClientWithCA()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithHTTPClient(client))
+	}
+	return opts, nil
+}
+
+// httpClientWithCA returns an HTTP client that also trusts the profile's
+// certificate authorities.
+func (p Profile) httpClientWithCA() (*http.Client, error) {
+	pem, err := os.ReadFile(p.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca_file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("ca_file %s contains no PEM certificates", p.CAFile)
+	}
+
+	tlsConfig := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12, NextProtos: []string{"h2", "http/1.1"}}
+	if p.Insecure {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // user-requested via insecure
+	}
+	var tr *http.Transport
+	if dt, ok := http.DefaultTransport.(*http.Transport); ok {
+		tr = dt.Clone()
+	} else {
+		tr = &http.Transport{ForceAttemptHTTP2: true}
+	}
+	tr.TLSClientConfig = tlsConfig
+
+	// WithTimeout does not apply to a custom client, so set it here
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	return &http.Client{Transport: tr, Timeout: timeout}, nil
+}
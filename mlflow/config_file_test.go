@@ -0,0 +1,191 @@
+package mlflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Setenv("MLFLOW_CONFIG", path)
+	return path
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("MLFLOW_TEST_PROD_TOKEN", "secret")
+	writeConfigFile(t, `
+default_profile: dev
+profiles:
+  dev:
+    tracking_uri: http://localhost:1
+  prod:
+    tracking_uri: `+server.URL+`
+    insecure: true
+    token: ${MLFLOW_TEST_PROD_TOKEN}
+    workspace: team-a
+    timeout: 45s
+    headers:
+      X-Source: batch
+`)
+
+	client, err := NewClientFromProfile("prod")
+	if err != nil {
+		t.Fatalf("NewClientFromProfile() error = %v", err)
+	}
+	if _, err = client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+
+	if v := got.Get("Authorization"); v != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", v, "Bearer secret")
+	}
+	if v := got.Get("X-MLFLOW-WORKSPACE"); v != "team-a" {
+		t.Errorf("X-MLFLOW-WORKSPACE = %q, want %q", v, "team-a")
+	}
+	if v := got.Get("X-Source"); v != "batch" {
+		t.Errorf("X-Source = %q, want %q", v, "batch")
+	}
+}
+
+func TestNewClientFromProfile_Default(t *testing.T) {
+	writeConfigFile(t, `
+default_profile: dev
+profiles:
+  dev:
+    tracking_uri: http://localhost:5000
+    insecure: true
+`)
+
+	if _, err := NewClientFromProfile(""); err != nil {
+		t.Fatalf("NewClientFromProfile() error = %v", err)
+	}
+}
+
+func TestNewClientFromProfile_OptionsOverrideProfile(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	writeConfigFile(t, `
+profiles:
+  default:
+    tracking_uri: http://localhost:1
+`)
+
+	client, err := NewClientFromProfile("", WithTrackingURI(server.URL), WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClientFromProfile() error = %v", err)
+	}
+	if _, err = client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+	if gotPath != "/api/2.0/mlflow/experiments/get" {
+		t.Errorf("request path = %q", gotPath)
+	}
+}
+
+func TestNewClientFromProfile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		profile string
+		wantErr string
+	}{
+		{
+			name:    "missing profile",
+			config:  "profiles:\n  dev:\n    tracking_uri: http://localhost:5000\n",
+			profile: "prod",
+			wantErr: `profile "prod" not found`,
+		},
+		{
+			name:    "missing tracking URI",
+			config:  "profiles:\n  dev:\n    workspace: team-a\n",
+			profile: "dev",
+			wantErr: "tracking_uri is required",
+		},
+		{
+			name:    "token and username",
+			config:  "profiles:\n  dev:\n    tracking_uri: http://localhost:5000\n    token: t\n    username: u\n",
+			profile: "dev",
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:    "unknown key",
+			config:  "profiles:\n  dev:\n    tracking_url: http://localhost:5000\n",
+			profile: "dev",
+			wantErr: "invalid config file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeConfigFile(t, tt.config)
+			_, err := NewClientFromProfile(tt.profile)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewClientFromProfile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClientFromProfile_MissingFile(t *testing.T) {
+	t.Setenv("MLFLOW_CONFIG", filepath.Join(t.TempDir(), "missing"))
+
+	if _, err := NewClientFromProfile("dev"); err == nil {
+		t.Error("NewClientFromProfile() error = nil, want error")
+	}
+}
+
+func TestProfile_Options_BasicAuth(t *testing.T) {
+	p := Profile{TrackingURI: "http://localhost:5000", Username: "alice", Password: "pw", Timeout: time.Minute}
+
+	opts, err := p.Options()
+	if err != nil {
+		t.Fatalf("Options() error = %v", err)
+	}
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	// base64("alice:pw")
+	if got := o.headers["Authorization"]; got != "Basic YWxpY2U6cHc=" {
+		t.Errorf("Authorization = %q", got)
+	}
+	if o.timeout != time.Minute {
+		t.Errorf("timeout = %v, want 1m", o.timeout)
+	}
+}
+
+func TestProfile_Options_CAFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(path, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p := Profile{TrackingURI: "https://mlflow.example.com", CAFile: path}
+
+	if _, err := p.Options(); err == nil || !strings.Contains(err.Error(), "no PEM certificates") {
+		t.Errorf("Options() error = %v, want PEM error", err)
+	}
+}
@@ -0,0 +1,184 @@
+package mlflow
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Environment variables read by NewClient and NewClientFromProfile.
+const (
+	envTrackingURI = "MLFLOW_TRACKING_URI"
+	envInsecure    = "MLFLOW_INSECURE_SKIP_TLS_VERIFY"
+	envConfig      = "MLFLOW_CONFIG"
+	envHeaders     = "MLFLOW_TRACKING_HEADERS"
+)
+
+// supportedEnv lists the environment variables the SDK reads.
+var supportedEnv = []string{envTrackingURI, envInsecure, envConfig, envHeaders}
+
+// unsupportedEnv maps variables read by the MLflow Python client, but not
+// by this SDK, to what to use instead.
+var unsupportedEnv = map[string]string{
+	"MLFLOW_TRACKING_TOKEN":            `WithHeaders with "Authorization: Bearer <token>", or a config file profile`,
+	"MLFLOW_TRACKING_USERNAME":         "a config file profile with username and password",
+	"MLFLOW_TRACKING_PASSWORD":         "a config file profile with username and password",
+	"MLFLOW_TRACKING_INSECURE_TLS":     envInsecure,
+	"MLFLOW_TRACKING_SERVER_CERT_PATH": "a config file profile with ca_file",
+}
+
+// Config is the client configuration NewClient reads from environment
+// variables when no option sets it.
+type Config struct {
+	// TrackingURI is MLFLOW_TRACKING_URI.
+	TrackingURI string
+
+	// Insecure is MLFLOW_INSECURE_SKIP_TLS_VERIFY set to "true" or "1".
+	Insecure bool
+
+	// Headers is MLFLOW_TRACKING_HEADERS, a comma-separated list of
+	// name=value pairs, such as "X-MLFLOW-WORKSPACE=team-a,X-Tenant=acme".
+	// Nil if the variable is unset or malformed.
+	Headers map[string]string
+
+	// ConfigFile is the file NewClientFromProfile reads: MLFLOW_CONFIG, or
+	// ~/.mlflow/config.
+	ConfigFile string
+
+	// headersErr is why MLFLOW_TRACKING_HEADERS could not be parsed.
+	headersErr error
+}
+
+// Warning describes a likely mistake in the environment, such as a
+// misspelled variable or a value the SDK ignores.
+type Warning struct {
+	// Variable is the environment variable the warning is about.
+	Variable string
+	Message  string
+}
+
+func (w Warning) String() string {
+	return w.Variable + ": " + w.Message
+}
+
+// ConfigFromEnv returns the configuration NewClient would read from the
+// environment, with warnings about variables that look misconfigured:
+// misspelled names (MLFLOW_TRACKING_URL), variables only the Python client
+// reads, values that are ignored, and a tracking URI NewClient would reject.
+// Log the warnings at startup to debug a deployment that does not reach the
+// expected server.
+func ConfigFromEnv() (Config, []Warning) {
+	var warnings []Warning
+	warn := func(variable, format string, args ...any) {
+		warnings = append(warnings, Warning{Variable: variable, Message: fmt.Sprintf(format, args...)})
+	}
+
+	cfg := Config{TrackingURI: os.Getenv(envTrackingURI)}
+
+	switch v := os.Getenv(envInsecure); v {
+	case "true", "1":
+		cfg.Insecure = true
+	case "", "false", "0":
+	default:
+		warn(envInsecure, `value %q is ignored; use "true" or "1"`, v)
+	}
+
+	if v := os.Getenv(envHeaders); v != "" {
+		if cfg.Headers, cfg.headersErr = parseHeaderList(v); cfg.headersErr != nil {
+			cfg.Headers = nil
+			warn(envHeaders, "%v", cfg.headersErr)
+		}
+	}
+
+	if cfg.ConfigFile = os.Getenv(envConfig); cfg.ConfigFile != "" {
+		if _, err := os.Stat(cfg.ConfigFile); err != nil {
+			warn(envConfig, "config file is not readable: %v", err)
+		}
+	} else if path, err := ConfigFilePath(); err == nil {
+		cfg.ConfigFile = path
+	}
+
+	switch {
+	case cfg.TrackingURI == "":
+		warn(envTrackingURI, "not set; NewClient requires WithTrackingURI")
+	case strings.TrimSpace(cfg.TrackingURI) != cfg.TrackingURI:
+		warn(envTrackingURI, "value has leading or trailing whitespace")
+	default:
+		if _, err := normalizeTrackingURI(cfg.TrackingURI, cfg.Insecure); err != nil {
+			warn(envTrackingURI, "%s", strings.TrimPrefix(err.Error(), "mlflow: "))
+		}
+	}
+
+	var names []string
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if slices.Contains(supportedEnv, name) {
+			continue
+		}
+		if instead, ok := unsupportedEnv[name]; ok {
+			warn(name, "read by the Python client but not by this SDK; use %s", instead)
+			continue
+		}
+		if !strings.HasPrefix(strings.ToUpper(name), "MLFLOW") {
+			continue
+		}
+		for _, supported := range supportedEnv {
+			if name != supported && editDistance(strings.ToUpper(name), supported) <= 2 {
+				warn(name, "not read by the SDK; did you mean %s?", supported)
+				break
+			}
+		}
+	}
+
+	return cfg, warnings
+}
+
+// parseHeaderList parses a comma-separated list of name=value pairs. Spaces
+// around names and values are trimmed and empty entries are skipped; values
+// cannot contain commas.
+func parseHeaderList(list string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for entry := range strings.SplitSeq(list, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("entry %q is not name=value", entry)
+		case name == "" || strings.ContainsAny(name, " \t:"):
+			return nil, fmt.Errorf("entry %q has an invalid header name", entry)
+		case strings.ContainsAny(value, "\r\n"):
+			return nil, fmt.Errorf("header %s must not contain line breaks", name)
+		}
+		headers[name] = value
+	}
+	return headers, nil
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,170 @@
+package mlflow
+
+import (
+	"maps"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func findWarning(warnings []Warning, variable string) *Warning {
+	for i := range warnings {
+		if warnings[i].Variable == variable {
+			return &warnings[i]
+		}
+	}
+	return nil
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("MLFLOW_TRACKING_URI", "http://localhost:5000")
+	t.Setenv("MLFLOW_INSECURE_SKIP_TLS_VERIFY", "1")
+	path := filepath.Join(t.TempDir(), "config")
+	t.Setenv("MLFLOW_CONFIG", path)
+
+	cfg, warnings := ConfigFromEnv()
+	if cfg.TrackingURI != "http://localhost:5000" || !cfg.Insecure || cfg.ConfigFile != path {
+		t.Errorf("ConfigFromEnv() = %+v", cfg)
+	}
+	// The config file does not exist
+	if w := findWarning(warnings, "MLFLOW_CONFIG"); w == nil {
+		t.Errorf("warnings = %v, want one for MLFLOW_CONFIG", warnings)
+	}
+}
+
+func TestConfigFromEnv_Warnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		variable string
+		want     string
+	}{
+		{
+			name:     "typo",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "https://mlflow.example.com", "MLFLOW_TRACKING_URL": "x"},
+			variable: "MLFLOW_TRACKING_URL",
+			want:     "did you mean MLFLOW_TRACKING_URI",
+		},
+		{
+			name:     "lower case",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "https://mlflow.example.com", "mlflow_insecure_skip_tls_verify": "1"},
+			variable: "mlflow_insecure_skip_tls_verify",
+			want:     "did you mean MLFLOW_INSECURE_SKIP_TLS_VERIFY",
+		},
+		{
+			name:     "python only",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "https://mlflow.example.com", "MLFLOW_TRACKING_TOKEN": "t"},
+			variable: "MLFLOW_TRACKING_TOKEN",
+			want:     "not by this SDK",
+		},
+		{
+			name:     "ignored insecure value",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "https://mlflow.example.com", "MLFLOW_INSECURE_SKIP_TLS_VERIFY": "yes"},
+			variable: "MLFLOW_INSECURE_SKIP_TLS_VERIFY",
+			want:     `"yes" is ignored`,
+		},
+		{
+			name:     "http without insecure",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "http://localhost:5000"},
+			variable: "MLFLOW_TRACKING_URI",
+			want:     "HTTP is not allowed",
+		},
+		{
+			name:     "missing URI",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": ""},
+			variable: "MLFLOW_TRACKING_URI",
+			want:     "not set",
+		},
+		{
+			name:     "whitespace",
+			env:      map[string]string{"MLFLOW_TRACKING_URI": "https://mlflow.example.com "},
+			variable: "MLFLOW_TRACKING_URI",
+			want:     "whitespace",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			_, warnings := ConfigFromEnv()
+			w := findWarning(warnings, tt.variable)
+			if w == nil || !strings.Contains(w.Message, tt.want) {
+				t.Errorf("warnings = %v, want %s: ...%s...", warnings, tt.variable, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv_NoWarnings(t *testing.T) {
+	t.Setenv("MLFLOW_TRACKING_URI", "https://mlflow.example.com")
+	t.Setenv("MLFLOW_EXPERIMENT_NAME", "unrelated")
+
+	if _, warnings := ConfigFromEnv(); len(warnings) != 0 {
+		t.Errorf("warnings = %v, want none", warnings)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"URL", "URI", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestParseHeaderList(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "pairs", list: "k1=v1,k2=v2", want: map[string]string{"k1": "v1", "k2": "v2"}},
+		{name: "spaces and empty entries", list: " k1 = v1 ,, k2=v2,", want: map[string]string{"k1": "v1", "k2": "v2"}},
+		{name: "value with equals", list: "Authorization=Basic YWxpY2U6cHc=", want: map[string]string{"Authorization": "Basic YWxpY2U6cHc="}},
+		{name: "empty value", list: "k1=", want: map[string]string{"k1": ""}},
+		{name: "missing equals", list: "k1=v1,k2", wantErr: true},
+		{name: "empty name", list: "=v1", wantErr: true},
+		{name: "name with colon", list: "k1:x=v1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHeaderList(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseHeaderList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !maps.Equal(got, tt.want) {
+				t.Errorf("parseHeaderList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv_Headers(t *testing.T) {
+	t.Setenv("MLFLOW_TRACKING_URI", "https://mlflow.example.com")
+	t.Setenv("MLFLOW_TRACKING_HEADERS", "X-Tenant=acme")
+
+	cfg, warnings := ConfigFromEnv()
+	if cfg.Headers["X-Tenant"] != "acme" || len(warnings) != 0 {
+		t.Errorf("ConfigFromEnv() = %+v, %v", cfg, warnings)
+	}
+
+	t.Setenv("MLFLOW_TRACKING_HEADERS", "X-Tenant")
+	if cfg, warnings = ConfigFromEnv(); cfg.Headers != nil || findWarning(warnings, "MLFLOW_TRACKING_HEADERS") == nil {
+		t.Errorf("ConfigFromEnv() = %+v, %v, want a warning", cfg, warnings)
+	}
+}
@@ -7,6 +7,60 @@ import (
 // APIError represents an error response from the MLflow API.
 type APIError = internalerrors.APIError
 
+// MultiError collects the item failures of a bulk operation such as
+// DeletePromptCompletely, SearchRunsAcross, or LogBatchChunked. errors.Is and
+// errors.As match it against every item's error, as with errors.Join.
+//
+//	var multi *mlflow.MultiError
+//	if errors.As(err, &multi) {
+//		for _, item := range multi.Errors {
+//			log.Printf("%s failed: %v", item.Resource, item.Err)
+//		}
+//	}
+type MultiError = internalerrors.MultiError
+
+// ItemError is the failure of one item in a bulk operation, with the item's
+// index in the caller's input (-1 if it has none) and a resource name.
+type ItemError = internalerrors.ItemError
+
+// Hinter is implemented by errors that can suggest how to fix their cause.
+// APIError implements it for common failures such as 401 and 404.
+type Hinter = internalerrors.Hinter
+
+// ParamAlreadyLoggedError is returned when a run already has a param with the
+// same key and a different value, with the key and values parsed from the
+// server's message. It wraps the APIError.
+type ParamAlreadyLoggedError = internalerrors.ParamAlreadyLoggedError
+
+// AliasConflictError is returned when an operation is refused because aliases
+// point to the resource. It wraps the APIError.
+type AliasConflictError = internalerrors.AliasConflictError
+
+// InvalidFilterError is returned when the server cannot parse a search filter
+// or order_by clause. It wraps the APIError.
+type InvalidFilterError = internalerrors.InvalidFilterError
+
+// Hint returns a suggested fix for err, or "" if none is known:
+//
+//	if _, err := client.Tracking().SearchRuns(ctx, ids, tracking.WithRunsFilter(f)); err != nil {
+//		log.Printf("%v (hint: %s)", err, mlflow.Hint(err))
+//	}
+func Hint(err error) string {
+	return internalerrors.Hint(err)
+}
+
+// ErrUnsupportedByServer is returned when the connected MLflow server has no
+// API for the requested operation. Check with errors.Is.
+var ErrUnsupportedByServer = internalerrors.ErrUnsupportedByServer
+
+// ErrDeleteNotConfirmed is returned when a client created with
+// WithDeleteProtection refuses a destructive call whose target was not
+// confirmed with a WithConfirm option.
+var ErrDeleteNotConfirmed = internalerrors.ErrDeleteNotConfirmed
+
+// ErrClientClosed is returned for requests made after Client.Close.
+var ErrClientClosed = internalerrors.ErrClientClosed
+
 // IsNotFound reports whether err indicates a resource was not found (404).
 func IsNotFound(err error) bool {
 	return internalerrors.IsNotFound(err)
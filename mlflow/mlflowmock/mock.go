@@ -0,0 +1,103 @@
+// Package mlflowmock provides mocks of the SDK's sub-client interfaces, for
+// unit testing code that uses MLflow without running a server or an HTTP fake.
+//
+// Code under test should accept tracking.API, promptregistry.API,
+// tracing.API, models.API or modelregistry.API rather than the concrete
+// clients. Tests then pass a mock with the Func fields of the methods they
+// expect to be called:
+//
+//	mock := &mlflowmock.Tracking{
+//		LogMetricFunc: func(_ context.Context, runID, key string, value float64, _ ...tracking.LogMetricOption) error {
+//			return nil
+//		},
+//	}
+//	train(ctx, mock)
+//	if got := len(mock.CallsTo("LogMetric")); got != 3 {
+//		t.Errorf("LogMetric called %d times, want 3", got)
+//	}
+//
+// The mocks are safe for concurrent use; calling a method whose Func field is
+// nil panics.
+package mlflowmock
+
+//go:generate go run ../../tools/mockgen -src ../tracking/api.go -import github.com/opendatahub-io/mlflow-go/mlflow/tracking -mock Tracking -out tracking.go
+//go:generate go run ../../tools/mockgen -src ../promptregistry/api.go -import github.com/opendatahub-io/mlflow-go/mlflow/promptregistry -mock PromptRegistry -out promptregistry.go
+//go:generate go run ../../tools/mockgen -src ../tracing/api.go -import github.com/opendatahub-io/mlflow-go/mlflow/tracing -mock Tracing -out tracing.go
+//go:generate go run ../../tools/mockgen -src ../models/api.go -import github.com/opendatahub-io/mlflow-go/mlflow/models -mock Models -out models.go
+//go:generate go run ../../tools/mockgen -src ../modelregistry/api.go -import github.com/opendatahub-io/mlflow-go/mlflow/modelregistry -mock ModelRegistry -out modelregistry.go
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+)
+
+// Call is a recorded method call.
+type Call struct {
+	// Method is the name of the method called.
+	Method string
+
+	// Args are the arguments in order. Variadic arguments are recorded as a
+	// single slice.
+	Args []any
+}
+
+// recorder records the calls made to a mock.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every call made to the mock so far, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to method so far, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// NewCursor returns a cursor that yields pages in order, for mocking the
+// Cursor methods (e.g. SearchRunsCursor). The result can be returned as
+// tracking.Cursor[T], promptregistry.Cursor[T], tracing.Cursor[T],
+// models.Cursor[T] or modelregistry.Cursor[T].
+func NewCursor[T any](pages ...[]T) *pagination.Cursor[T] {
+	return pagination.New(func(_ context.Context, pageToken string) (pagination.Page[T], error) {
+		// Page tokens are indexes into pages
+		i := 0
+		if pageToken != "" {
+			var err error
+			if i, err = strconv.Atoi(pageToken); err != nil {
+				return pagination.Page[T]{}, fmt.Errorf("mlflowmock: invalid page token %q", pageToken)
+			}
+		}
+		if i >= len(pages) {
+			return pagination.Page[T]{}, nil
+		}
+		page := pagination.Page[T]{Items: pages[i]}
+		if i+1 < len(pages) {
+			page.NextPageToken = strconv.Itoa(i + 1)
+		}
+		return page, nil
+	})
+}
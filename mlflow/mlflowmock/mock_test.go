@@ -0,0 +1,124 @@
+package mlflowmock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// logLoss is code under test that depends on tracking.API.
+func logLoss(ctx context.Context, api tracking.API, runID string, losses []float64) error {
+	for i, loss := range losses {
+		if err := api.LogMetric(ctx, runID, "loss", loss, tracking.WithStep(int64(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTracking_RecordsCalls(t *testing.T) {
+	mock := &Tracking{
+		LogMetricFunc: func(_ context.Context, _, _ string, _ float64, _ ...tracking.LogMetricOption) error {
+			return nil
+		},
+	}
+
+	if err := logLoss(context.Background(), mock, "run-1", []float64{0.9, 0.5}); err != nil {
+		t.Fatalf("logLoss() error = %v", err)
+	}
+
+	calls := mock.CallsTo("LogMetric")
+	if len(calls) != 2 {
+		t.Fatalf("LogMetric calls = %d, want 2", len(calls))
+	}
+	if got := calls[1].Args[1]; got != "run-1" {
+		t.Errorf("runID = %v, want run-1", got)
+	}
+	if got := calls[1].Args[3]; got != 0.5 {
+		t.Errorf("value = %v, want 0.5", got)
+	}
+	if opts, ok := calls[1].Args[4].([]tracking.LogMetricOption); !ok || len(opts) != 1 {
+		t.Errorf("opts = %#v, want one LogMetricOption", calls[1].Args[4])
+	}
+	if got := len(mock.Calls()); got != 2 {
+		t.Errorf("Calls() = %d, want 2", got)
+	}
+}
+
+func TestTracking_ReturnsFuncError(t *testing.T) {
+	wantErr := errors.New("boom")
+	mock := &Tracking{
+		LogMetricFunc: func(_ context.Context, _, _ string, _ float64, _ ...tracking.LogMetricOption) error {
+			return wantErr
+		},
+	}
+
+	if err := logLoss(context.Background(), mock, "run-1", []float64{1, 2}); !errors.Is(err, wantErr) {
+		t.Errorf("logLoss() error = %v, want %v", err, wantErr)
+	}
+	if got := len(mock.CallsTo("LogMetric")); got != 1 {
+		t.Errorf("LogMetric calls = %d, want 1", got)
+	}
+}
+
+func TestTracking_UnsetFuncPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for unset GetRunFunc")
+		}
+	}()
+
+	mock := &Tracking{}
+	_, _ = mock.GetRun(context.Background(), "run-1")
+}
+
+func TestPromptRegistry_ConcurrentCalls(t *testing.T) {
+	mock := &PromptRegistry{
+		LoadPromptFunc: func(_ context.Context, name string, _ ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error) {
+			return &promptregistry.PromptVersion{Name: name, Template: "Hi {{name}}"}, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = mock.LoadPrompt(context.Background(), "greeting")
+		}()
+	}
+	wg.Wait()
+
+	if got := len(mock.CallsTo("LoadPrompt")); got != 10 {
+		t.Errorf("LoadPrompt calls = %d, want 10", got)
+	}
+}
+
+func TestNewCursor(t *testing.T) {
+	mock := &Tracing{
+		SearchTracesCursorFunc: func(_ []string, _ ...tracing.SearchTracesOption) *tracing.Cursor[tracing.TraceInfo] {
+			return NewCursor(
+				[]tracing.TraceInfo{{TraceID: "a"}, {TraceID: "b"}},
+				[]tracing.TraceInfo{{TraceID: "c"}},
+			)
+		},
+	}
+
+	traces, err := mock.SearchTracesCursor([]string{"1"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(traces) != 3 || traces[2].TraceID != "c" {
+		t.Errorf("traces = %+v, want a, b, c", traces)
+	}
+
+	empty, err := NewCursor[tracing.TraceInfo]().All(context.Background())
+	if err != nil || len(empty) != 0 {
+		t.Errorf("empty cursor All() = %v, %v; want no items", empty, err)
+	}
+}
@@ -0,0 +1,99 @@
+// Code generated by tools/mockgen from modelregistry/api.go. DO NOT EDIT.
+
+package mlflowmock
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/modelregistry"
+)
+
+// ModelRegistry is a mock implementation of modelregistry.API.
+// Set the Func field of each method the code under test calls;
+// calling a method whose Func field is nil panics.
+type ModelRegistry struct {
+	SearchRegisteredModelsFunc       func(ctx context.Context, filter string, opts ...modelregistry.SearchRegisteredModelsOption) (*modelregistry.RegisteredModelList, error)
+	SearchRegisteredModelsCursorFunc func(filter string, opts ...modelregistry.SearchRegisteredModelsOption) *modelregistry.Cursor[modelregistry.RegisteredModel]
+	GetModelVersionFunc              func(ctx context.Context, name string, version string) (*modelregistry.ModelVersion, error)
+	SetModelVersionTagFunc           func(ctx context.Context, name string, version string, key string, value string) error
+	DeleteModelVersionTagFunc        func(ctx context.Context, name string, version string, key string) error
+	SetModelVersionDependenciesFunc  func(ctx context.Context, name string, version string, deps modelregistry.Dependencies) error
+	SetRegisteredModelAliasFunc      func(ctx context.Context, name string, alias string, version string) error
+	TransitionModelVersionStageFunc  func(ctx context.Context, name string, version string, stage string, archiveExisting bool) (*modelregistry.ModelVersion, error)
+
+	recorder
+}
+
+var _ modelregistry.API = (*ModelRegistry)(nil)
+
+// SearchRegisteredModels calls SearchRegisteredModelsFunc.
+func (mock *ModelRegistry) SearchRegisteredModels(ctx context.Context, filter string, opts ...modelregistry.SearchRegisteredModelsOption) (*modelregistry.RegisteredModelList, error) {
+	mock.record("SearchRegisteredModels", ctx, filter, opts)
+	if mock.SearchRegisteredModelsFunc == nil {
+		panic("mlflowmock: ModelRegistry.SearchRegisteredModels called but SearchRegisteredModelsFunc is not set")
+	}
+	return mock.SearchRegisteredModelsFunc(ctx, filter, opts...)
+}
+
+// SearchRegisteredModelsCursor calls SearchRegisteredModelsCursorFunc.
+func (mock *ModelRegistry) SearchRegisteredModelsCursor(filter string, opts ...modelregistry.SearchRegisteredModelsOption) *modelregistry.Cursor[modelregistry.RegisteredModel] {
+	mock.record("SearchRegisteredModelsCursor", filter, opts)
+	if mock.SearchRegisteredModelsCursorFunc == nil {
+		panic("mlflowmock: ModelRegistry.SearchRegisteredModelsCursor called but SearchRegisteredModelsCursorFunc is not set")
+	}
+	return mock.SearchRegisteredModelsCursorFunc(filter, opts...)
+}
+
+// GetModelVersion calls GetModelVersionFunc.
+func (mock *ModelRegistry) GetModelVersion(ctx context.Context, name string, version string) (*modelregistry.ModelVersion, error) {
+	mock.record("GetModelVersion", ctx, name, version)
+	if mock.GetModelVersionFunc == nil {
+		panic("mlflowmock: ModelRegistry.GetModelVersion called but GetModelVersionFunc is not set")
+	}
+	return mock.GetModelVersionFunc(ctx, name, version)
+}
+
+// SetModelVersionTag calls SetModelVersionTagFunc.
+func (mock *ModelRegistry) SetModelVersionTag(ctx context.Context, name string, version string, key string, value string) error {
+	mock.record("SetModelVersionTag", ctx, name, version, key, value)
+	if mock.SetModelVersionTagFunc == nil {
+		panic("mlflowmock: ModelRegistry.SetModelVersionTag called but SetModelVersionTagFunc is not set")
+	}
+	return mock.SetModelVersionTagFunc(ctx, name, version, key, value)
+}
+
+// DeleteModelVersionTag calls DeleteModelVersionTagFunc.
+func (mock *ModelRegistry) DeleteModelVersionTag(ctx context.Context, name string, version string, key string) error {
+	mock.record("DeleteModelVersionTag", ctx, name, version, key)
+	if mock.DeleteModelVersionTagFunc == nil {
+		panic("mlflowmock: ModelRegistry.DeleteModelVersionTag called but DeleteModelVersionTagFunc is not set")
+	}
+	return mock.DeleteModelVersionTagFunc(ctx, name, version, key)
+}
+
+// SetModelVersionDependencies calls SetModelVersionDependenciesFunc.
+func (mock *ModelRegistry) SetModelVersionDependencies(ctx context.Context, name string, version string, deps modelregistry.Dependencies) error {
+	mock.record("SetModelVersionDependencies", ctx, name, version, deps)
+	if mock.SetModelVersionDependenciesFunc == nil {
+		panic("mlflowmock: ModelRegistry.SetModelVersionDependencies called but SetModelVersionDependenciesFunc is not set")
+	}
+	return mock.SetModelVersionDependenciesFunc(ctx, name, version, deps)
+}
+
+// SetRegisteredModelAlias calls SetRegisteredModelAliasFunc.
+func (mock *ModelRegistry) SetRegisteredModelAlias(ctx context.Context, name string, alias string, version string) error {
+	mock.record("SetRegisteredModelAlias", ctx, name, alias, version)
+	if mock.SetRegisteredModelAliasFunc == nil {
+		panic("mlflowmock: ModelRegistry.SetRegisteredModelAlias called but SetRegisteredModelAliasFunc is not set")
+	}
+	return mock.SetRegisteredModelAliasFunc(ctx, name, alias, version)
+}
+
+// TransitionModelVersionStage calls TransitionModelVersionStageFunc.
+func (mock *ModelRegistry) TransitionModelVersionStage(ctx context.Context, name string, version string, stage string, archiveExisting bool) (*modelregistry.ModelVersion, error) {
+	mock.record("TransitionModelVersionStage", ctx, name, version, stage, archiveExisting)
+	if mock.TransitionModelVersionStageFunc == nil {
+		panic("mlflowmock: ModelRegistry.TransitionModelVersionStage called but TransitionModelVersionStageFunc is not set")
+	}
+	return mock.TransitionModelVersionStageFunc(ctx, name, version, stage, archiveExisting)
+}
@@ -0,0 +1,120 @@
+// Code generated by tools/mockgen from models/api.go. DO NOT EDIT.
+
+package mlflowmock
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/models"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// Models is a mock implementation of models.API.
+// Set the Func field of each method the code under test calls;
+// calling a method whose Func field is nil panics.
+type Models struct {
+	CreateLoggedModelFunc        func(ctx context.Context, experimentID string, opts ...models.CreateLoggedModelOption) (*models.LoggedModel, error)
+	GetLoggedModelFunc           func(ctx context.Context, modelID string) (*models.LoggedModel, error)
+	FinalizeLoggedModelFunc      func(ctx context.Context, modelID string, status models.LoggedModelStatus) (*models.LoggedModel, error)
+	DeleteLoggedModelFunc        func(ctx context.Context, modelID string, opts ...models.DeleteOption) error
+	SearchLoggedModelsFunc       func(ctx context.Context, experimentIDs []string, opts ...models.SearchLoggedModelsOption) (*models.LoggedModelList, error)
+	SearchLoggedModelsCursorFunc func(experimentIDs []string, opts ...models.SearchLoggedModelsOption) *models.Cursor[models.LoggedModel]
+	SetLoggedModelTagsFunc       func(ctx context.Context, modelID string, tags map[string]string) error
+	DeleteLoggedModelTagFunc     func(ctx context.Context, modelID string, key string) error
+	LogLoggedModelParamsFunc     func(ctx context.Context, modelID string, params map[string]string) error
+	LogMetricFunc                func(ctx context.Context, modelID string, runID string, key string, value float64, opts ...tracking.LogMetricOption) error
+
+	recorder
+}
+
+var _ models.API = (*Models)(nil)
+
+// CreateLoggedModel calls CreateLoggedModelFunc.
+func (mock *Models) CreateLoggedModel(ctx context.Context, experimentID string, opts ...models.CreateLoggedModelOption) (*models.LoggedModel, error) {
+	mock.record("CreateLoggedModel", ctx, experimentID, opts)
+	if mock.CreateLoggedModelFunc == nil {
+		panic("mlflowmock: Models.CreateLoggedModel called but CreateLoggedModelFunc is not set")
+	}
+	return mock.CreateLoggedModelFunc(ctx, experimentID, opts...)
+}
+
+// GetLoggedModel calls GetLoggedModelFunc.
+func (mock *Models) GetLoggedModel(ctx context.Context, modelID string) (*models.LoggedModel, error) {
+	mock.record("GetLoggedModel", ctx, modelID)
+	if mock.GetLoggedModelFunc == nil {
+		panic("mlflowmock: Models.GetLoggedModel called but GetLoggedModelFunc is not set")
+	}
+	return mock.GetLoggedModelFunc(ctx, modelID)
+}
+
+// FinalizeLoggedModel calls FinalizeLoggedModelFunc.
+func (mock *Models) FinalizeLoggedModel(ctx context.Context, modelID string, status models.LoggedModelStatus) (*models.LoggedModel, error) {
+	mock.record("FinalizeLoggedModel", ctx, modelID, status)
+	if mock.FinalizeLoggedModelFunc == nil {
+		panic("mlflowmock: Models.FinalizeLoggedModel called but FinalizeLoggedModelFunc is not set")
+	}
+	return mock.FinalizeLoggedModelFunc(ctx, modelID, status)
+}
+
+// DeleteLoggedModel calls DeleteLoggedModelFunc.
+func (mock *Models) DeleteLoggedModel(ctx context.Context, modelID string, opts ...models.DeleteOption) error {
+	mock.record("DeleteLoggedModel", ctx, modelID, opts)
+	if mock.DeleteLoggedModelFunc == nil {
+		panic("mlflowmock: Models.DeleteLoggedModel called but DeleteLoggedModelFunc is not set")
+	}
+	return mock.DeleteLoggedModelFunc(ctx, modelID, opts...)
+}
+
+// SearchLoggedModels calls SearchLoggedModelsFunc.
+func (mock *Models) SearchLoggedModels(ctx context.Context, experimentIDs []string, opts ...models.SearchLoggedModelsOption) (*models.LoggedModelList, error) {
+	mock.record("SearchLoggedModels", ctx, experimentIDs, opts)
+	if mock.SearchLoggedModelsFunc == nil {
+		panic("mlflowmock: Models.SearchLoggedModels called but SearchLoggedModelsFunc is not set")
+	}
+	return mock.SearchLoggedModelsFunc(ctx, experimentIDs, opts...)
+}
+
+// SearchLoggedModelsCursor calls SearchLoggedModelsCursorFunc.
+func (mock *Models) SearchLoggedModelsCursor(experimentIDs []string, opts ...models.SearchLoggedModelsOption) *models.Cursor[models.LoggedModel] {
+	mock.record("SearchLoggedModelsCursor", experimentIDs, opts)
+	if mock.SearchLoggedModelsCursorFunc == nil {
+		panic("mlflowmock: Models.SearchLoggedModelsCursor called but SearchLoggedModelsCursorFunc is not set")
+	}
+	return mock.SearchLoggedModelsCursorFunc(experimentIDs, opts...)
+}
+
+// SetLoggedModelTags calls SetLoggedModelTagsFunc.
+func (mock *Models) SetLoggedModelTags(ctx context.Context, modelID string, tags map[string]string) error {
+	mock.record("SetLoggedModelTags", ctx, modelID, tags)
+	if mock.SetLoggedModelTagsFunc == nil {
+		panic("mlflowmock: Models.SetLoggedModelTags called but SetLoggedModelTagsFunc is not set")
+	}
+	return mock.SetLoggedModelTagsFunc(ctx, modelID, tags)
+}
+
+// DeleteLoggedModelTag calls DeleteLoggedModelTagFunc.
+func (mock *Models) DeleteLoggedModelTag(ctx context.Context, modelID string, key string) error {
+	mock.record("DeleteLoggedModelTag", ctx, modelID, key)
+	if mock.DeleteLoggedModelTagFunc == nil {
+		panic("mlflowmock: Models.DeleteLoggedModelTag called but DeleteLoggedModelTagFunc is not set")
+	}
+	return mock.DeleteLoggedModelTagFunc(ctx, modelID, key)
+}
+
+// LogLoggedModelParams calls LogLoggedModelParamsFunc.
+func (mock *Models) LogLoggedModelParams(ctx context.Context, modelID string, params map[string]string) error {
+	mock.record("LogLoggedModelParams", ctx, modelID, params)
+	if mock.LogLoggedModelParamsFunc == nil {
+		panic("mlflowmock: Models.LogLoggedModelParams called but LogLoggedModelParamsFunc is not set")
+	}
+	return mock.LogLoggedModelParamsFunc(ctx, modelID, params)
+}
+
+// LogMetric calls LogMetricFunc.
+func (mock *Models) LogMetric(ctx context.Context, modelID string, runID string, key string, value float64, opts ...tracking.LogMetricOption) error {
+	mock.record("LogMetric", ctx, modelID, runID, key, value, opts)
+	if mock.LogMetricFunc == nil {
+		panic("mlflowmock: Models.LogMetric called but LogMetricFunc is not set")
+	}
+	return mock.LogMetricFunc(ctx, modelID, runID, key, value, opts...)
+}
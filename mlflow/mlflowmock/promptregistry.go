@@ -0,0 +1,249 @@
+// Code generated by tools/mockgen from promptregistry/api.go. DO NOT EDIT.
+
+package mlflowmock
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+// PromptRegistry is a mock implementation of promptregistry.API.
+// Set the Func field of each method the code under test calls;
+// calling a method whose Func field is nil panics.
+type PromptRegistry struct {
+	NamespaceFunc                func() string
+	LoadPromptFunc               func(ctx context.Context, name string, opts ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error)
+	PromptExistsFunc             func(ctx context.Context, name string) (bool, error)
+	RegisterPromptFunc           func(ctx context.Context, name string, template string, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error)
+	RegisterChatPromptFunc       func(ctx context.Context, name string, messages []promptregistry.ChatMessage, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error)
+	RegisterPromptTxFunc         func(ctx context.Context, name string, draft promptregistry.PromptDraft, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error)
+	ListPromptsFunc              func(ctx context.Context, opts ...promptregistry.ListPromptsOption) (*promptregistry.PromptList, error)
+	ListPromptsCursorFunc        func(opts ...promptregistry.ListPromptsOption) *promptregistry.Cursor[promptregistry.Prompt]
+	ListPromptVersionsFunc       func(ctx context.Context, name string, opts ...promptregistry.ListVersionsOption) (*promptregistry.PromptVersionList, error)
+	ListPromptVersionsCursorFunc func(name string, opts ...promptregistry.ListVersionsOption) *promptregistry.Cursor[promptregistry.PromptVersion]
+	RenamePromptFunc             func(ctx context.Context, oldName string, newName string) (*promptregistry.Prompt, error)
+	SearchPromptContentFunc      func(ctx context.Context, pattern string, opts ...promptregistry.SearchContentOption) ([]promptregistry.ContentMatch, error)
+	SetPromptAliasFunc           func(ctx context.Context, name string, alias string, version int) error
+	DeletePromptAliasFunc        func(ctx context.Context, name string, alias string) error
+	DeletePromptVersionFunc      func(ctx context.Context, name string, version int, opts ...promptregistry.DeleteOption) error
+	DeletePromptFunc             func(ctx context.Context, name string, opts ...promptregistry.DeleteOption) error
+	RestorePromptFunc            func(ctx context.Context, name string) error
+	RestorePromptVersionFunc     func(ctx context.Context, name string, version int) error
+	SetPromptTagFunc             func(ctx context.Context, name string, key string, value string) error
+	SetPromptVersionTagFunc      func(ctx context.Context, name string, version int, key string, value string) error
+	DeletePromptTagFunc          func(ctx context.Context, name string, key string) error
+	DeletePromptVersionTagFunc   func(ctx context.Context, name string, version int, key string) error
+	RolloutFunc                  func(ctx context.Context, name string, newVersion int, cfg promptregistry.RolloutConfig) (*promptregistry.RolloutResult, error)
+
+	recorder
+}
+
+var _ promptregistry.API = (*PromptRegistry)(nil)
+
+// Namespace calls NamespaceFunc.
+func (mock *PromptRegistry) Namespace() string {
+	mock.record("Namespace")
+	if mock.NamespaceFunc == nil {
+		panic("mlflowmock: PromptRegistry.Namespace called but NamespaceFunc is not set")
+	}
+	return mock.NamespaceFunc()
+}
+
+// LoadPrompt calls LoadPromptFunc.
+func (mock *PromptRegistry) LoadPrompt(ctx context.Context, name string, opts ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error) {
+	mock.record("LoadPrompt", ctx, name, opts)
+	if mock.LoadPromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.LoadPrompt called but LoadPromptFunc is not set")
+	}
+	return mock.LoadPromptFunc(ctx, name, opts...)
+}
+
+// PromptExists calls PromptExistsFunc.
+func (mock *PromptRegistry) PromptExists(ctx context.Context, name string) (bool, error) {
+	mock.record("PromptExists", ctx, name)
+	if mock.PromptExistsFunc == nil {
+		panic("mlflowmock: PromptRegistry.PromptExists called but PromptExistsFunc is not set")
+	}
+	return mock.PromptExistsFunc(ctx, name)
+}
+
+// RegisterPrompt calls RegisterPromptFunc.
+func (mock *PromptRegistry) RegisterPrompt(ctx context.Context, name string, template string, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error) {
+	mock.record("RegisterPrompt", ctx, name, template, opts)
+	if mock.RegisterPromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.RegisterPrompt called but RegisterPromptFunc is not set")
+	}
+	return mock.RegisterPromptFunc(ctx, name, template, opts...)
+}
+
+// RegisterChatPrompt calls RegisterChatPromptFunc.
+func (mock *PromptRegistry) RegisterChatPrompt(ctx context.Context, name string, messages []promptregistry.ChatMessage, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error) {
+	mock.record("RegisterChatPrompt", ctx, name, messages, opts)
+	if mock.RegisterChatPromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.RegisterChatPrompt called but RegisterChatPromptFunc is not set")
+	}
+	return mock.RegisterChatPromptFunc(ctx, name, messages, opts...)
+}
+
+// RegisterPromptTx calls RegisterPromptTxFunc.
+func (mock *PromptRegistry) RegisterPromptTx(ctx context.Context, name string, draft promptregistry.PromptDraft, opts ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error) {
+	mock.record("RegisterPromptTx", ctx, name, draft, opts)
+	if mock.RegisterPromptTxFunc == nil {
+		panic("mlflowmock: PromptRegistry.RegisterPromptTx called but RegisterPromptTxFunc is not set")
+	}
+	return mock.RegisterPromptTxFunc(ctx, name, draft, opts...)
+}
+
+// ListPrompts calls ListPromptsFunc.
+func (mock *PromptRegistry) ListPrompts(ctx context.Context, opts ...promptregistry.ListPromptsOption) (*promptregistry.PromptList, error) {
+	mock.record("ListPrompts", ctx, opts)
+	if mock.ListPromptsFunc == nil {
+		panic("mlflowmock: PromptRegistry.ListPrompts called but ListPromptsFunc is not set")
+	}
+	return mock.ListPromptsFunc(ctx, opts...)
+}
+
+// ListPromptsCursor calls ListPromptsCursorFunc.
+func (mock *PromptRegistry) ListPromptsCursor(opts ...promptregistry.ListPromptsOption) *promptregistry.Cursor[promptregistry.Prompt] {
+	mock.record("ListPromptsCursor", opts)
+	if mock.ListPromptsCursorFunc == nil {
+		panic("mlflowmock: PromptRegistry.ListPromptsCursor called but ListPromptsCursorFunc is not set")
+	}
+	return mock.ListPromptsCursorFunc(opts...)
+}
+
+// ListPromptVersions calls ListPromptVersionsFunc.
+func (mock *PromptRegistry) ListPromptVersions(ctx context.Context, name string, opts ...promptregistry.ListVersionsOption) (*promptregistry.PromptVersionList, error) {
+	mock.record("ListPromptVersions", ctx, name, opts)
+	if mock.ListPromptVersionsFunc == nil {
+		panic("mlflowmock: PromptRegistry.ListPromptVersions called but ListPromptVersionsFunc is not set")
+	}
+	return mock.ListPromptVersionsFunc(ctx, name, opts...)
+}
+
+// ListPromptVersionsCursor calls ListPromptVersionsCursorFunc.
+func (mock *PromptRegistry) ListPromptVersionsCursor(name string, opts ...promptregistry.ListVersionsOption) *promptregistry.Cursor[promptregistry.PromptVersion] {
+	mock.record("ListPromptVersionsCursor", name, opts)
+	if mock.ListPromptVersionsCursorFunc == nil {
+		panic("mlflowmock: PromptRegistry.ListPromptVersionsCursor called but ListPromptVersionsCursorFunc is not set")
+	}
+	return mock.ListPromptVersionsCursorFunc(name, opts...)
+}
+
+// RenamePrompt calls RenamePromptFunc.
+func (mock *PromptRegistry) RenamePrompt(ctx context.Context, oldName string, newName string) (*promptregistry.Prompt, error) {
+	mock.record("RenamePrompt", ctx, oldName, newName)
+	if mock.RenamePromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.RenamePrompt called but RenamePromptFunc is not set")
+	}
+	return mock.RenamePromptFunc(ctx, oldName, newName)
+}
+
+// SearchPromptContent calls SearchPromptContentFunc.
+func (mock *PromptRegistry) SearchPromptContent(ctx context.Context, pattern string, opts ...promptregistry.SearchContentOption) ([]promptregistry.ContentMatch, error) {
+	mock.record("SearchPromptContent", ctx, pattern, opts)
+	if mock.SearchPromptContentFunc == nil {
+		panic("mlflowmock: PromptRegistry.SearchPromptContent called but SearchPromptContentFunc is not set")
+	}
+	return mock.SearchPromptContentFunc(ctx, pattern, opts...)
+}
+
+// SetPromptAlias calls SetPromptAliasFunc.
+func (mock *PromptRegistry) SetPromptAlias(ctx context.Context, name string, alias string, version int) error {
+	mock.record("SetPromptAlias", ctx, name, alias, version)
+	if mock.SetPromptAliasFunc == nil {
+		panic("mlflowmock: PromptRegistry.SetPromptAlias called but SetPromptAliasFunc is not set")
+	}
+	return mock.SetPromptAliasFunc(ctx, name, alias, version)
+}
+
+// DeletePromptAlias calls DeletePromptAliasFunc.
+func (mock *PromptRegistry) DeletePromptAlias(ctx context.Context, name string, alias string) error {
+	mock.record("DeletePromptAlias", ctx, name, alias)
+	if mock.DeletePromptAliasFunc == nil {
+		panic("mlflowmock: PromptRegistry.DeletePromptAlias called but DeletePromptAliasFunc is not set")
+	}
+	return mock.DeletePromptAliasFunc(ctx, name, alias)
+}
+
+// DeletePromptVersion calls DeletePromptVersionFunc.
+func (mock *PromptRegistry) DeletePromptVersion(ctx context.Context, name string, version int, opts ...promptregistry.DeleteOption) error {
+	mock.record("DeletePromptVersion", ctx, name, version, opts)
+	if mock.DeletePromptVersionFunc == nil {
+		panic("mlflowmock: PromptRegistry.DeletePromptVersion called but DeletePromptVersionFunc is not set")
+	}
+	return mock.DeletePromptVersionFunc(ctx, name, version, opts...)
+}
+
+// DeletePrompt calls DeletePromptFunc.
+func (mock *PromptRegistry) DeletePrompt(ctx context.Context, name string, opts ...promptregistry.DeleteOption) error {
+	mock.record("DeletePrompt", ctx, name, opts)
+	if mock.DeletePromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.DeletePrompt called but DeletePromptFunc is not set")
+	}
+	return mock.DeletePromptFunc(ctx, name, opts...)
+}
+
+// RestorePrompt calls RestorePromptFunc.
+func (mock *PromptRegistry) RestorePrompt(ctx context.Context, name string) error {
+	mock.record("RestorePrompt", ctx, name)
+	if mock.RestorePromptFunc == nil {
+		panic("mlflowmock: PromptRegistry.RestorePrompt called but RestorePromptFunc is not set")
+	}
+	return mock.RestorePromptFunc(ctx, name)
+}
+
+// RestorePromptVersion calls RestorePromptVersionFunc.
+func (mock *PromptRegistry) RestorePromptVersion(ctx context.Context, name string, version int) error {
+	mock.record("RestorePromptVersion", ctx, name, version)
+	if mock.RestorePromptVersionFunc == nil {
+		panic("mlflowmock: PromptRegistry.RestorePromptVersion called but RestorePromptVersionFunc is not set")
+	}
+	return mock.RestorePromptVersionFunc(ctx, name, version)
+}
+
+// SetPromptTag calls SetPromptTagFunc.
+func (mock *PromptRegistry) SetPromptTag(ctx context.Context, name string, key string, value string) error {
+	mock.record("SetPromptTag", ctx, name, key, value)
+	if mock.SetPromptTagFunc == nil {
+		panic("mlflowmock: PromptRegistry.SetPromptTag called but SetPromptTagFunc is not set")
+	}
+	return mock.SetPromptTagFunc(ctx, name, key, value)
+}
+
+// SetPromptVersionTag calls SetPromptVersionTagFunc.
+func (mock *PromptRegistry) SetPromptVersionTag(ctx context.Context, name string, version int, key string, value string) error {
+	mock.record("SetPromptVersionTag", ctx, name, version, key, value)
+	if mock.SetPromptVersionTagFunc == nil {
+		panic("mlflowmock: PromptRegistry.SetPromptVersionTag called but SetPromptVersionTagFunc is not set")
+	}
+	return mock.SetPromptVersionTagFunc(ctx, name, version, key, value)
+}
+
+// DeletePromptTag calls DeletePromptTagFunc.
+func (mock *PromptRegistry) DeletePromptTag(ctx context.Context, name string, key string) error {
+	mock.record("DeletePromptTag", ctx, name, key)
+	if mock.DeletePromptTagFunc == nil {
+		panic("mlflowmock: PromptRegistry.DeletePromptTag called but DeletePromptTagFunc is not set")
+	}
+	return mock.DeletePromptTagFunc(ctx, name, key)
+}
+
+// DeletePromptVersionTag calls DeletePromptVersionTagFunc.
+func (mock *PromptRegistry) DeletePromptVersionTag(ctx context.Context, name string, version int, key string) error {
+	mock.record("DeletePromptVersionTag", ctx, name, version, key)
+	if mock.DeletePromptVersionTagFunc == nil {
+		panic("mlflowmock: PromptRegistry.DeletePromptVersionTag called but DeletePromptVersionTagFunc is not set")
+	}
+	return mock.DeletePromptVersionTagFunc(ctx, name, version, key)
+}
+
+// Rollout calls RolloutFunc.
+func (mock *PromptRegistry) Rollout(ctx context.Context, name string, newVersion int, cfg promptregistry.RolloutConfig) (*promptregistry.RolloutResult, error) {
+	mock.record("Rollout", ctx, name, newVersion, cfg)
+	if mock.RolloutFunc == nil {
+		panic("mlflowmock: PromptRegistry.Rollout called but RolloutFunc is not set")
+	}
+	return mock.RolloutFunc(ctx, name, newVersion, cfg)
+}
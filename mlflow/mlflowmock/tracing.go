@@ -0,0 +1,79 @@
+// Code generated by tools/mockgen from tracing/api.go. DO NOT EDIT.
+
+package mlflowmock
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+)
+
+// Tracing is a mock implementation of tracing.API.
+// Set the Func field of each method the code under test calls;
+// calling a method whose Func field is nil panics.
+type Tracing struct {
+	SearchTracesFunc       func(ctx context.Context, experimentIDs []string, opts ...tracing.SearchTracesOption) (*tracing.TraceList, error)
+	SearchTracesCursorFunc func(experimentIDs []string, opts ...tracing.SearchTracesOption) *tracing.Cursor[tracing.TraceInfo]
+	GetTraceInfoFunc       func(ctx context.Context, traceID string) (*tracing.TraceInfo, error)
+	GetTraceFunc           func(ctx context.Context, traceID string) (*tracing.Trace, error)
+	LogAssessmentFunc      func(ctx context.Context, a tracing.Assessment) (*tracing.Assessment, error)
+	LogFeedbackFunc        func(ctx context.Context, traceID string, name string, value any, rationale string, opts ...tracing.LogFeedbackOption) (*tracing.Assessment, error)
+
+	recorder
+}
+
+var _ tracing.API = (*Tracing)(nil)
+
+// SearchTraces calls SearchTracesFunc.
+func (mock *Tracing) SearchTraces(ctx context.Context, experimentIDs []string, opts ...tracing.SearchTracesOption) (*tracing.TraceList, error) {
+	mock.record("SearchTraces", ctx, experimentIDs, opts)
+	if mock.SearchTracesFunc == nil {
+		panic("mlflowmock: Tracing.SearchTraces called but SearchTracesFunc is not set")
+	}
+	return mock.SearchTracesFunc(ctx, experimentIDs, opts...)
+}
+
+// SearchTracesCursor calls SearchTracesCursorFunc.
+func (mock *Tracing) SearchTracesCursor(experimentIDs []string, opts ...tracing.SearchTracesOption) *tracing.Cursor[tracing.TraceInfo] {
+	mock.record("SearchTracesCursor", experimentIDs, opts)
+	if mock.SearchTracesCursorFunc == nil {
+		panic("mlflowmock: Tracing.SearchTracesCursor called but SearchTracesCursorFunc is not set")
+	}
+	return mock.SearchTracesCursorFunc(experimentIDs, opts...)
+}
+
+// GetTraceInfo calls GetTraceInfoFunc.
+func (mock *Tracing) GetTraceInfo(ctx context.Context, traceID string) (*tracing.TraceInfo, error) {
+	mock.record("GetTraceInfo", ctx, traceID)
+	if mock.GetTraceInfoFunc == nil {
+		panic("mlflowmock: Tracing.GetTraceInfo called but GetTraceInfoFunc is not set")
+	}
+	return mock.GetTraceInfoFunc(ctx, traceID)
+}
+
+// GetTrace calls GetTraceFunc.
+func (mock *Tracing) GetTrace(ctx context.Context, traceID string) (*tracing.Trace, error) {
+	mock.record("GetTrace", ctx, traceID)
+	if mock.GetTraceFunc == nil {
+		panic("mlflowmock: Tracing.GetTrace called but GetTraceFunc is not set")
+	}
+	return mock.GetTraceFunc(ctx, traceID)
+}
+
+// LogAssessment calls LogAssessmentFunc.
+func (mock *Tracing) LogAssessment(ctx context.Context, a tracing.Assessment) (*tracing.Assessment, error) {
+	mock.record("LogAssessment", ctx, a)
+	if mock.LogAssessmentFunc == nil {
+		panic("mlflowmock: Tracing.LogAssessment called but LogAssessmentFunc is not set")
+	}
+	return mock.LogAssessmentFunc(ctx, a)
+}
+
+// LogFeedback calls LogFeedbackFunc.
+func (mock *Tracing) LogFeedback(ctx context.Context, traceID string, name string, value any, rationale string, opts ...tracing.LogFeedbackOption) (*tracing.Assessment, error) {
+	mock.record("LogFeedback", ctx, traceID, name, value, rationale, opts)
+	if mock.LogFeedbackFunc == nil {
+		panic("mlflowmock: Tracing.LogFeedback called but LogFeedbackFunc is not set")
+	}
+	return mock.LogFeedbackFunc(ctx, traceID, name, value, rationale, opts...)
+}
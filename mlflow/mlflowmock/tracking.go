@@ -0,0 +1,421 @@
+// Code generated by tools/mockgen from tracking/api.go. DO NOT EDIT.
+
+package mlflowmock
+
+import (
+	"context"
+	"io"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// Tracking is a mock implementation of tracking.API.
+// Set the Func field of each method the code under test calls;
+// calling a method whose Func field is nil panics.
+type Tracking struct {
+	CreateExperimentFunc           func(ctx context.Context, name string, opts ...tracking.CreateExperimentOption) (string, error)
+	GetExperimentFunc              func(ctx context.Context, experimentID string) (*tracking.Experiment, error)
+	GetExperimentOrNilFunc         func(ctx context.Context, experimentID string) (*tracking.Experiment, error)
+	GetExperimentByNameFunc        func(ctx context.Context, name string) (*tracking.Experiment, error)
+	ExperimentExistsFunc           func(ctx context.Context, name string) (bool, error)
+	UpdateExperimentFunc           func(ctx context.Context, experimentID string, name string) error
+	DeleteExperimentFunc           func(ctx context.Context, experimentID string, opts ...tracking.DeleteOption) error
+	SearchExperimentsFunc          func(ctx context.Context, opts ...tracking.SearchExperimentsOption) (*tracking.ExperimentList, error)
+	SearchExperimentsCursorFunc    func(opts ...tracking.SearchExperimentsOption) *tracking.Cursor[tracking.Experiment]
+	SetExperimentTagFunc           func(ctx context.Context, experimentID string, key string, value string) error
+	SetExperimentTagsFunc          func(ctx context.Context, experimentID string, tags map[string]string) error
+	UpdateExperimentTagsFunc       func(ctx context.Context, experimentID string, mutate func(cur map[string]string) map[string]string) error
+	ListDeletedExperimentsFunc     func(ctx context.Context) ([]tracking.Experiment, error)
+	HardDeleteExperimentFunc       func(ctx context.Context, experimentID string, opts ...tracking.DeleteOption) error
+	GetExperimentPermissionFunc    func(ctx context.Context, experimentID string, username string) (*tracking.ExperimentPermission, error)
+	CreateExperimentPermissionFunc func(ctx context.Context, experimentID string, username string, permission tracking.Permission) error
+	UpdateExperimentPermissionFunc func(ctx context.Context, experimentID string, username string, permission tracking.Permission) error
+	DeleteExperimentPermissionFunc func(ctx context.Context, experimentID string, username string) error
+	CreateRunFunc                  func(ctx context.Context, experimentID string, opts ...tracking.CreateRunOption) (*tracking.Run, error)
+	GetRunFunc                     func(ctx context.Context, runID string, opts ...tracking.GetRunOption) (*tracking.Run, error)
+	UpdateRunFunc                  func(ctx context.Context, runID string, opts ...tracking.UpdateRunOption) (*tracking.RunInfo, error)
+	DeleteRunFunc                  func(ctx context.Context, runID string, opts ...tracking.DeleteOption) error
+	SearchRunsFunc                 func(ctx context.Context, experimentIDs []string, opts ...tracking.SearchRunsOption) (*tracking.RunList, error)
+	SearchRunsCursorFunc           func(experimentIDs []string, opts ...tracking.SearchRunsOption) *tracking.Cursor[tracking.Run]
+	StreamRunsFunc                 func(ctx context.Context, experimentIDs []string, fn func(tracking.Run) error, opts ...tracking.SearchRunsOption) error
+	ListDeletedRunsFunc            func(ctx context.Context, experimentID string) ([]tracking.Run, error)
+	LogMetricFunc                  func(ctx context.Context, runID string, key string, value float64, opts ...tracking.LogMetricOption) error
+	LogParamFunc                   func(ctx context.Context, runID string, key string, value string) error
+	SetTagFunc                     func(ctx context.Context, runID string, key string, value string) error
+	DeleteTagFunc                  func(ctx context.Context, runID string, key string) error
+	LogBatchFunc                   func(ctx context.Context, runID string, metrics []tracking.Metric, params []tracking.Param, tags map[string]string, opts ...tracking.LogBatchOption) error
+	GetMetricHistoryFunc           func(ctx context.Context, runID string, key string) ([]tracking.Metric, error)
+	LogAssessmentFunc              func(ctx context.Context, runID string, a tracing.Assessment) (*tracing.Assessment, error)
+	LogArtifactFunc                func(ctx context.Context, runID string, localPath string, artifactPath string) error
+	LogArtifactsFunc               func(ctx context.Context, runID string, localDir string, artifactPath string) error
+	OpenArtifactFunc               func(ctx context.Context, runID string, artifactPath string) (io.ReadCloser, error)
+	CreateArtifactFunc             func(ctx context.Context, runID string, artifactPath string) (io.WriteCloser, error)
+	DownloadArtifactFunc           func(ctx context.Context, runID string, artifactPath string, localPath string, opts ...tracking.DownloadArtifactOption) (string, error)
+	LogRunRecipeFunc               func(ctx context.Context, runID string, recipe *tracking.RunRecipe) error
+	LoadRunRecipeFunc              func(ctx context.Context, runID string) (*tracking.RunRecipe, error)
+
+	recorder
+}
+
+var _ tracking.API = (*Tracking)(nil)
+
+// CreateExperiment calls CreateExperimentFunc.
+func (mock *Tracking) CreateExperiment(ctx context.Context, name string, opts ...tracking.CreateExperimentOption) (string, error) {
+	mock.record("CreateExperiment", ctx, name, opts)
+	if mock.CreateExperimentFunc == nil {
+		panic("mlflowmock: Tracking.CreateExperiment called but CreateExperimentFunc is not set")
+	}
+	return mock.CreateExperimentFunc(ctx, name, opts...)
+}
+
+// GetExperiment calls GetExperimentFunc.
+func (mock *Tracking) GetExperiment(ctx context.Context, experimentID string) (*tracking.Experiment, error) {
+	mock.record("GetExperiment", ctx, experimentID)
+	if mock.GetExperimentFunc == nil {
+		panic("mlflowmock: Tracking.GetExperiment called but GetExperimentFunc is not set")
+	}
+	return mock.GetExperimentFunc(ctx, experimentID)
+}
+
+// GetExperimentOrNil calls GetExperimentOrNilFunc.
+func (mock *Tracking) GetExperimentOrNil(ctx context.Context, experimentID string) (*tracking.Experiment, error) {
+	mock.record("GetExperimentOrNil", ctx, experimentID)
+	if mock.GetExperimentOrNilFunc == nil {
+		panic("mlflowmock: Tracking.GetExperimentOrNil called but GetExperimentOrNilFunc is not set")
+	}
+	return mock.GetExperimentOrNilFunc(ctx, experimentID)
+}
+
+// GetExperimentByName calls GetExperimentByNameFunc.
+func (mock *Tracking) GetExperimentByName(ctx context.Context, name string) (*tracking.Experiment, error) {
+	mock.record("GetExperimentByName", ctx, name)
+	if mock.GetExperimentByNameFunc == nil {
+		panic("mlflowmock: Tracking.GetExperimentByName called but GetExperimentByNameFunc is not set")
+	}
+	return mock.GetExperimentByNameFunc(ctx, name)
+}
+
+// ExperimentExists calls ExperimentExistsFunc.
+func (mock *Tracking) ExperimentExists(ctx context.Context, name string) (bool, error) {
+	mock.record("ExperimentExists", ctx, name)
+	if mock.ExperimentExistsFunc == nil {
+		panic("mlflowmock: Tracking.ExperimentExists called but ExperimentExistsFunc is not set")
+	}
+	return mock.ExperimentExistsFunc(ctx, name)
+}
+
+// UpdateExperiment calls UpdateExperimentFunc.
+func (mock *Tracking) UpdateExperiment(ctx context.Context, experimentID string, name string) error {
+	mock.record("UpdateExperiment", ctx, experimentID, name)
+	if mock.UpdateExperimentFunc == nil {
+		panic("mlflowmock: Tracking.UpdateExperiment called but UpdateExperimentFunc is not set")
+	}
+	return mock.UpdateExperimentFunc(ctx, experimentID, name)
+}
+
+// DeleteExperiment calls DeleteExperimentFunc.
+func (mock *Tracking) DeleteExperiment(ctx context.Context, experimentID string, opts ...tracking.DeleteOption) error {
+	mock.record("DeleteExperiment", ctx, experimentID, opts)
+	if mock.DeleteExperimentFunc == nil {
+		panic("mlflowmock: Tracking.DeleteExperiment called but DeleteExperimentFunc is not set")
+	}
+	return mock.DeleteExperimentFunc(ctx, experimentID, opts...)
+}
+
+// SearchExperiments calls SearchExperimentsFunc.
+func (mock *Tracking) SearchExperiments(ctx context.Context, opts ...tracking.SearchExperimentsOption) (*tracking.ExperimentList, error) {
+	mock.record("SearchExperiments", ctx, opts)
+	if mock.SearchExperimentsFunc == nil {
+		panic("mlflowmock: Tracking.SearchExperiments called but SearchExperimentsFunc is not set")
+	}
+	return mock.SearchExperimentsFunc(ctx, opts...)
+}
+
+// SearchExperimentsCursor calls SearchExperimentsCursorFunc.
+func (mock *Tracking) SearchExperimentsCursor(opts ...tracking.SearchExperimentsOption) *tracking.Cursor[tracking.Experiment] {
+	mock.record("SearchExperimentsCursor", opts)
+	if mock.SearchExperimentsCursorFunc == nil {
+		panic("mlflowmock: Tracking.SearchExperimentsCursor called but SearchExperimentsCursorFunc is not set")
+	}
+	return mock.SearchExperimentsCursorFunc(opts...)
+}
+
+// SetExperimentTag calls SetExperimentTagFunc.
+func (mock *Tracking) SetExperimentTag(ctx context.Context, experimentID string, key string, value string) error {
+	mock.record("SetExperimentTag", ctx, experimentID, key, value)
+	if mock.SetExperimentTagFunc == nil {
+		panic("mlflowmock: Tracking.SetExperimentTag called but SetExperimentTagFunc is not set")
+	}
+	return mock.SetExperimentTagFunc(ctx, experimentID, key, value)
+}
+
+// SetExperimentTags calls SetExperimentTagsFunc.
+func (mock *Tracking) SetExperimentTags(ctx context.Context, experimentID string, tags map[string]string) error {
+	mock.record("SetExperimentTags", ctx, experimentID, tags)
+	if mock.SetExperimentTagsFunc == nil {
+		panic("mlflowmock: Tracking.SetExperimentTags called but SetExperimentTagsFunc is not set")
+	}
+	return mock.SetExperimentTagsFunc(ctx, experimentID, tags)
+}
+
+// UpdateExperimentTags calls UpdateExperimentTagsFunc.
+func (mock *Tracking) UpdateExperimentTags(ctx context.Context, experimentID string, mutate func(cur map[string]string) map[string]string) error {
+	mock.record("UpdateExperimentTags", ctx, experimentID, mutate)
+	if mock.UpdateExperimentTagsFunc == nil {
+		panic("mlflowmock: Tracking.UpdateExperimentTags called but UpdateExperimentTagsFunc is not set")
+	}
+	return mock.UpdateExperimentTagsFunc(ctx, experimentID, mutate)
+}
+
+// ListDeletedExperiments calls ListDeletedExperimentsFunc.
+func (mock *Tracking) ListDeletedExperiments(ctx context.Context) ([]tracking.Experiment, error) {
+	mock.record("ListDeletedExperiments", ctx)
+	if mock.ListDeletedExperimentsFunc == nil {
+		panic("mlflowmock: Tracking.ListDeletedExperiments called but ListDeletedExperimentsFunc is not set")
+	}
+	return mock.ListDeletedExperimentsFunc(ctx)
+}
+
+// HardDeleteExperiment calls HardDeleteExperimentFunc.
+func (mock *Tracking) HardDeleteExperiment(ctx context.Context, experimentID string, opts ...tracking.DeleteOption) error {
+	mock.record("HardDeleteExperiment", ctx, experimentID, opts)
+	if mock.HardDeleteExperimentFunc == nil {
+		panic("mlflowmock: Tracking.HardDeleteExperiment called but HardDeleteExperimentFunc is not set")
+	}
+	return mock.HardDeleteExperimentFunc(ctx, experimentID, opts...)
+}
+
+// GetExperimentPermission calls GetExperimentPermissionFunc.
+func (mock *Tracking) GetExperimentPermission(ctx context.Context, experimentID string, username string) (*tracking.ExperimentPermission, error) {
+	mock.record("GetExperimentPermission", ctx, experimentID, username)
+	if mock.GetExperimentPermissionFunc == nil {
+		panic("mlflowmock: Tracking.GetExperimentPermission called but GetExperimentPermissionFunc is not set")
+	}
+	return mock.GetExperimentPermissionFunc(ctx, experimentID, username)
+}
+
+// CreateExperimentPermission calls CreateExperimentPermissionFunc.
+func (mock *Tracking) CreateExperimentPermission(ctx context.Context, experimentID string, username string, permission tracking.Permission) error {
+	mock.record("CreateExperimentPermission", ctx, experimentID, username, permission)
+	if mock.CreateExperimentPermissionFunc == nil {
+		panic("mlflowmock: Tracking.CreateExperimentPermission called but CreateExperimentPermissionFunc is not set")
+	}
+	return mock.CreateExperimentPermissionFunc(ctx, experimentID, username, permission)
+}
+
+// UpdateExperimentPermission calls UpdateExperimentPermissionFunc.
+func (mock *Tracking) UpdateExperimentPermission(ctx context.Context, experimentID string, username string, permission tracking.Permission) error {
+	mock.record("UpdateExperimentPermission", ctx, experimentID, username, permission)
+	if mock.UpdateExperimentPermissionFunc == nil {
+		panic("mlflowmock: Tracking.UpdateExperimentPermission called but UpdateExperimentPermissionFunc is not set")
+	}
+	return mock.UpdateExperimentPermissionFunc(ctx, experimentID, username, permission)
+}
+
+// DeleteExperimentPermission calls DeleteExperimentPermissionFunc.
+func (mock *Tracking) DeleteExperimentPermission(ctx context.Context, experimentID string, username string) error {
+	mock.record("DeleteExperimentPermission", ctx, experimentID, username)
+	if mock.DeleteExperimentPermissionFunc == nil {
+		panic("mlflowmock: Tracking.DeleteExperimentPermission called but DeleteExperimentPermissionFunc is not set")
+	}
+	return mock.DeleteExperimentPermissionFunc(ctx, experimentID, username)
+}
+
+// CreateRun calls CreateRunFunc.
+func (mock *Tracking) CreateRun(ctx context.Context, experimentID string, opts ...tracking.CreateRunOption) (*tracking.Run, error) {
+	mock.record("CreateRun", ctx, experimentID, opts)
+	if mock.CreateRunFunc == nil {
+		panic("mlflowmock: Tracking.CreateRun called but CreateRunFunc is not set")
+	}
+	return mock.CreateRunFunc(ctx, experimentID, opts...)
+}
+
+// GetRun calls GetRunFunc.
+func (mock *Tracking) GetRun(ctx context.Context, runID string, opts ...tracking.GetRunOption) (*tracking.Run, error) {
+	mock.record("GetRun", ctx, runID, opts)
+	if mock.GetRunFunc == nil {
+		panic("mlflowmock: Tracking.GetRun called but GetRunFunc is not set")
+	}
+	return mock.GetRunFunc(ctx, runID, opts...)
+}
+
+// UpdateRun calls UpdateRunFunc.
+func (mock *Tracking) UpdateRun(ctx context.Context, runID string, opts ...tracking.UpdateRunOption) (*tracking.RunInfo, error) {
+	mock.record("UpdateRun", ctx, runID, opts)
+	if mock.UpdateRunFunc == nil {
+		panic("mlflowmock: Tracking.UpdateRun called but UpdateRunFunc is not set")
+	}
+	return mock.UpdateRunFunc(ctx, runID, opts...)
+}
+
+// DeleteRun calls DeleteRunFunc.
+func (mock *Tracking) DeleteRun(ctx context.Context, runID string, opts ...tracking.DeleteOption) error {
+	mock.record("DeleteRun", ctx, runID, opts)
+	if mock.DeleteRunFunc == nil {
+		panic("mlflowmock: Tracking.DeleteRun called but DeleteRunFunc is not set")
+	}
+	return mock.DeleteRunFunc(ctx, runID, opts...)
+}
+
+// SearchRuns calls SearchRunsFunc.
+func (mock *Tracking) SearchRuns(ctx context.Context, experimentIDs []string, opts ...tracking.SearchRunsOption) (*tracking.RunList, error) {
+	mock.record("SearchRuns", ctx, experimentIDs, opts)
+	if mock.SearchRunsFunc == nil {
+		panic("mlflowmock: Tracking.SearchRuns called but SearchRunsFunc is not set")
+	}
+	return mock.SearchRunsFunc(ctx, experimentIDs, opts...)
+}
+
+// SearchRunsCursor calls SearchRunsCursorFunc.
+func (mock *Tracking) SearchRunsCursor(experimentIDs []string, opts ...tracking.SearchRunsOption) *tracking.Cursor[tracking.Run] {
+	mock.record("SearchRunsCursor", experimentIDs, opts)
+	if mock.SearchRunsCursorFunc == nil {
+		panic("mlflowmock: Tracking.SearchRunsCursor called but SearchRunsCursorFunc is not set")
+	}
+	return mock.SearchRunsCursorFunc(experimentIDs, opts...)
+}
+
+// StreamRuns calls StreamRunsFunc.
+func (mock *Tracking) StreamRuns(ctx context.Context, experimentIDs []string, fn func(tracking.Run) error, opts ...tracking.SearchRunsOption) error {
+	mock.record("StreamRuns", ctx, experimentIDs, fn, opts)
+	if mock.StreamRunsFunc == nil {
+		panic("mlflowmock: Tracking.StreamRuns called but StreamRunsFunc is not set")
+	}
+	return mock.StreamRunsFunc(ctx, experimentIDs, fn, opts...)
+}
+
+// ListDeletedRuns calls ListDeletedRunsFunc.
+func (mock *Tracking) ListDeletedRuns(ctx context.Context, experimentID string) ([]tracking.Run, error) {
+	mock.record("ListDeletedRuns", ctx, experimentID)
+	if mock.ListDeletedRunsFunc == nil {
+		panic("mlflowmock: Tracking.ListDeletedRuns called but ListDeletedRunsFunc is not set")
+	}
+	return mock.ListDeletedRunsFunc(ctx, experimentID)
+}
+
+// LogMetric calls LogMetricFunc.
+func (mock *Tracking) LogMetric(ctx context.Context, runID string, key string, value float64, opts ...tracking.LogMetricOption) error {
+	mock.record("LogMetric", ctx, runID, key, value, opts)
+	if mock.LogMetricFunc == nil {
+		panic("mlflowmock: Tracking.LogMetric called but LogMetricFunc is not set")
+	}
+	return mock.LogMetricFunc(ctx, runID, key, value, opts...)
+}
+
+// LogParam calls LogParamFunc.
+func (mock *Tracking) LogParam(ctx context.Context, runID string, key string, value string) error {
+	mock.record("LogParam", ctx, runID, key, value)
+	if mock.LogParamFunc == nil {
+		panic("mlflowmock: Tracking.LogParam called but LogParamFunc is not set")
+	}
+	return mock.LogParamFunc(ctx, runID, key, value)
+}
+
+// SetTag calls SetTagFunc.
+func (mock *Tracking) SetTag(ctx context.Context, runID string, key string, value string) error {
+	mock.record("SetTag", ctx, runID, key, value)
+	if mock.SetTagFunc == nil {
+		panic("mlflowmock: Tracking.SetTag called but SetTagFunc is not set")
+	}
+	return mock.SetTagFunc(ctx, runID, key, value)
+}
+
+// DeleteTag calls DeleteTagFunc.
+func (mock *Tracking) DeleteTag(ctx context.Context, runID string, key string) error {
+	mock.record("DeleteTag", ctx, runID, key)
+	if mock.DeleteTagFunc == nil {
+		panic("mlflowmock: Tracking.DeleteTag called but DeleteTagFunc is not set")
+	}
+	return mock.DeleteTagFunc(ctx, runID, key)
+}
+
+// LogBatch calls LogBatchFunc.
+func (mock *Tracking) LogBatch(ctx context.Context, runID string, metrics []tracking.Metric, params []tracking.Param, tags map[string]string, opts ...tracking.LogBatchOption) error {
+	mock.record("LogBatch", ctx, runID, metrics, params, tags, opts)
+	if mock.LogBatchFunc == nil {
+		panic("mlflowmock: Tracking.LogBatch called but LogBatchFunc is not set")
+	}
+	return mock.LogBatchFunc(ctx, runID, metrics, params, tags, opts...)
+}
+
+// GetMetricHistory calls GetMetricHistoryFunc.
+func (mock *Tracking) GetMetricHistory(ctx context.Context, runID string, key string) ([]tracking.Metric, error) {
+	mock.record("GetMetricHistory", ctx, runID, key)
+	if mock.GetMetricHistoryFunc == nil {
+		panic("mlflowmock: Tracking.GetMetricHistory called but GetMetricHistoryFunc is not set")
+	}
+	return mock.GetMetricHistoryFunc(ctx, runID, key)
+}
+
+// LogAssessment calls LogAssessmentFunc.
+func (mock *Tracking) LogAssessment(ctx context.Context, runID string, a tracing.Assessment) (*tracing.Assessment, error) {
+	mock.record("LogAssessment", ctx, runID, a)
+	if mock.LogAssessmentFunc == nil {
+		panic("mlflowmock: Tracking.LogAssessment called but LogAssessmentFunc is not set")
+	}
+	return mock.LogAssessmentFunc(ctx, runID, a)
+}
+
+// LogArtifact calls LogArtifactFunc.
+func (mock *Tracking) LogArtifact(ctx context.Context, runID string, localPath string, artifactPath string) error {
+	mock.record("LogArtifact", ctx, runID, localPath, artifactPath)
+	if mock.LogArtifactFunc == nil {
+		panic("mlflowmock: Tracking.LogArtifact called but LogArtifactFunc is not set")
+	}
+	return mock.LogArtifactFunc(ctx, runID, localPath, artifactPath)
+}
+
+// LogArtifacts calls LogArtifactsFunc.
+func (mock *Tracking) LogArtifacts(ctx context.Context, runID string, localDir string, artifactPath string) error {
+	mock.record("LogArtifacts", ctx, runID, localDir, artifactPath)
+	if mock.LogArtifactsFunc == nil {
+		panic("mlflowmock: Tracking.LogArtifacts called but LogArtifactsFunc is not set")
+	}
+	return mock.LogArtifactsFunc(ctx, runID, localDir, artifactPath)
+}
+
+// OpenArtifact calls OpenArtifactFunc.
+func (mock *Tracking) OpenArtifact(ctx context.Context, runID string, artifactPath string) (io.ReadCloser, error) {
+	mock.record("OpenArtifact", ctx, runID, artifactPath)
+	if mock.OpenArtifactFunc == nil {
+		panic("mlflowmock: Tracking.OpenArtifact called but OpenArtifactFunc is not set")
+	}
+	return mock.OpenArtifactFunc(ctx, runID, artifactPath)
+}
+
+// CreateArtifact calls CreateArtifactFunc.
+func (mock *Tracking) CreateArtifact(ctx context.Context, runID string, artifactPath string) (io.WriteCloser, error) {
+	mock.record("CreateArtifact", ctx, runID, artifactPath)
+	if mock.CreateArtifactFunc == nil {
+		panic("mlflowmock: Tracking.CreateArtifact called but CreateArtifactFunc is not set")
+	}
+	return mock.CreateArtifactFunc(ctx, runID, artifactPath)
+}
+
+// DownloadArtifact calls DownloadArtifactFunc.
+func (mock *Tracking) DownloadArtifact(ctx context.Context, runID string, artifactPath string, localPath string, opts ...tracking.DownloadArtifactOption) (string, error) {
+	mock.record("DownloadArtifact", ctx, runID, artifactPath, localPath, opts)
+	if mock.DownloadArtifactFunc == nil {
+		panic("mlflowmock: Tracking.DownloadArtifact called but DownloadArtifactFunc is not set")
+	}
+	return mock.DownloadArtifactFunc(ctx, runID, artifactPath, localPath, opts...)
+}
+
+// LogRunRecipe calls LogRunRecipeFunc.
+func (mock *Tracking) LogRunRecipe(ctx context.Context, runID string, recipe *tracking.RunRecipe) error {
+	mock.record("LogRunRecipe", ctx, runID, recipe)
+	if mock.LogRunRecipeFunc == nil {
+		panic("mlflowmock: Tracking.LogRunRecipe called but LogRunRecipeFunc is not set")
+	}
+	return mock.LogRunRecipeFunc(ctx, runID, recipe)
+}
+
+// LoadRunRecipe calls LoadRunRecipeFunc.
+func (mock *Tracking) LoadRunRecipe(ctx context.Context, runID string) (*tracking.RunRecipe, error) {
+	mock.record("LoadRunRecipe", ctx, runID)
+	if mock.LoadRunRecipeFunc == nil {
+		panic("mlflowmock: Tracking.LoadRunRecipe called but LoadRunRecipeFunc is not set")
+	}
+	return mock.LoadRunRecipeFunc(ctx, runID)
+}
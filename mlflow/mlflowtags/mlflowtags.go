@@ -0,0 +1,284 @@
+// Package mlflowtags defines the tag keys MLflow reserves for its own use,
+// and helpers for setting them safely.
+//
+// MLflow reserves keys beginning with "mlflow." or "_mlflow". The UI and
+// server give them meaning: mlflow.parentRunId nests a run under another,
+// mlflow.note.content is rendered as the run description, and so on. A typo
+// in a reserved key is silently stored as an ordinary tag, so prefer these
+// constants and Set over string literals:
+//
+//	tags := map[string]string{}
+//	if err := mlflowtags.Set(tags, mlflowtags.ParentRunID, parentID); err != nil {
+//		return err
+//	}
+//	run, err := client.Tracking().CreateRun(ctx, expID, tracking.WithRunTags(tags))
+//
+// Reference: https://github.com/mlflow/mlflow/blob/master/mlflow/utils/mlflow_tags.py
+package mlflowtags
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// Run tags.
+const (
+	// RunName is the run's display name. CreateRun sets it from the run name.
+	RunName = "mlflow.runName"
+	// ParentRunID nests a run under another run in the UI.
+	ParentRunID = "mlflow.parentRunId"
+	// User is the user who created the run.
+	User = "mlflow.user"
+	// Note is the Markdown description of a run or experiment.
+	Note = "mlflow.note.content"
+
+	// SourceType is the kind of program that created the run; see SourceType values.
+	SourceType = "mlflow.source.type"
+	// SourceName is the program, file or job that created the run.
+	SourceName = "mlflow.source.name"
+	// SourceGitCommit is the commit of the code that created the run.
+	SourceGitCommit = "mlflow.source.git.commit"
+	// SourceGitBranch is the branch of the code that created the run.
+	SourceGitBranch = "mlflow.source.git.branch"
+	// SourceGitRepoURL is the repository of the code that created the run.
+	SourceGitRepoURL = "mlflow.source.git.repoURL"
+
+	// DockerImageName and DockerImageID identify the image the run ran in.
+	DockerImageName = "mlflow.docker.image.name"
+	DockerImageID   = "mlflow.docker.image.id"
+
+	// ProjectEntryPoint and ProjectBackend describe runs started by MLflow Projects.
+	ProjectEntryPoint = "mlflow.project.entryPoint"
+	ProjectBackend    = "mlflow.project.backend"
+
+	// LoggedModels is the JSON history of models logged to the run.
+	// It is maintained by MLflow.
+	LoggedModels = "mlflow.log-model.history"
+
+	// LinkedPrompts is the JSON list of prompt versions loaded while a run or
+	// trace was active.
+	LinkedPrompts = "mlflow.linkedPrompts"
+)
+
+// Experiment tags. Experiments also use Note for their description.
+const (
+	// ExperimentKind is the kind of work an experiment is for, such as
+	// "genai_development". See tracking.ExperimentKind.
+	ExperimentKind = "mlflow.experimentKind"
+)
+
+// Trace metadata keys.
+const (
+	// TraceSourceRun is the run that was active when a trace was created.
+	TraceSourceRun = "mlflow.sourceRun"
+)
+
+// Prompt registry tags. They store a prompt's content and are maintained by
+// the promptregistry package, so Set rejects them.
+const (
+	PromptText        = "mlflow.prompt.text"
+	IsPrompt          = "mlflow.prompt.is_prompt"
+	PromptDescription = "mlflow.prompt.description"
+	PromptType        = "_mlflow_prompt_type"
+	PromptModelConfig = "_mlflow_prompt_model_config"
+
+	// PromptAliasPrefix prefixes the model version tags that record aliases.
+	PromptAliasPrefix = "mlflow.prompt.alias."
+)
+
+// SDKPrefix starts the keys of the tags defined by this SDK rather than
+// MLflow. It is in MLflow's reserved namespace, so UserTags drops them.
+const SDKPrefix = "_mlflow_go."
+
+// SDK run tags. Except where noted, they are maintained by the tracking
+// package, so Set rejects them.
+const (
+	// Heartbeat is the time of the last tracking.StartHeartbeat beat.
+	Heartbeat = SDKPrefix + "heartbeat"
+
+	// FailureReason is the error or panic value that failed a run, and
+	// FailureStack the stack trace of the panic. See tracking.Finalize.
+	FailureReason = SDKPrefix + "failure.reason"
+	FailureStack  = SDKPrefix + "failure.stack"
+
+	// RunGroup is the ID of the run group a run belongs to.
+	RunGroup = SDKPrefix + "run_group"
+
+	// ExpiresAt is the time a run expires, and ArchivedAt the time
+	// tracking.ExpireRuns archived it, both in RFC 3339 UTC.
+	ExpiresAt  = SDKPrefix + "expires_at"
+	ArchivedAt = SDKPrefix + "archived_at"
+
+	// EarlyStopReason is the reason a tracking.EarlyStopper stopped a run.
+	EarlyStopReason = SDKPrefix + "early_stopping.reason"
+
+	// PromptABPrefix prefixes the tags recording the prompt alias and
+	// version an A/B selection served, followed by the prompt name.
+	PromptABPrefix = SDKPrefix + "prompt_ab."
+)
+
+// SDK model version tags, maintained by the promptregistry and
+// modelregistry packages. Set rejects them, except PromptLocale, which
+// callers set when registering a prompt variant.
+const (
+	// PromptInputSchema is the JSON-encoded input schema of a prompt version.
+	PromptInputSchema = SDKPrefix + "prompt_input_schema"
+
+	// PromptLocale is the locale of a prompt variant, e.g. "de-DE".
+	PromptLocale = SDKPrefix + "locale"
+
+	// PromptRolloutPercent is the share of traffic, in percent, a prompt
+	// rollout sends to the canary alias.
+	PromptRolloutPercent = SDKPrefix + "prompt_rollout.canary_percent"
+
+	// PromotionPrefix prefixes the tags recording the checks of a model
+	// version promotion.
+	PromotionPrefix = SDKPrefix + "promotion."
+
+	// DependenciesPrefix prefixes the tags recording the runtime
+	// dependencies of a model version.
+	DependenciesPrefix = SDKPrefix + "dependencies."
+)
+
+// Values of the SourceType tag.
+const (
+	SourceTypeNotebook = "NOTEBOOK"
+	SourceTypeJob      = "JOB"
+	SourceTypeProject  = "PROJECT"
+	SourceTypeLocal    = "LOCAL"
+	SourceTypeUnknown  = "UNKNOWN"
+)
+
+// keyKind is how Set treats a reserved key.
+type keyKind int
+
+const (
+	// settable keys may be set freely.
+	settable keyKind = iota + 1
+	// required keys may be set but not to an empty value.
+	required
+	// internal keys are maintained by MLflow or this SDK.
+	internal
+)
+
+// known lists every reserved key defined by this package.
+var known = map[string]keyKind{
+	RunName:           settable,
+	ParentRunID:       required,
+	User:              required,
+	Note:              settable,
+	SourceType:        required,
+	SourceName:        settable,
+	SourceGitCommit:   settable,
+	SourceGitBranch:   settable,
+	SourceGitRepoURL:  settable,
+	DockerImageName:   settable,
+	DockerImageID:     settable,
+	ProjectEntryPoint: settable,
+	ProjectBackend:    settable,
+	LoggedModels:      internal,
+	LinkedPrompts:     internal,
+	ExperimentKind:    required,
+	TraceSourceRun:    internal,
+	PromptText:        internal,
+	IsPrompt:          internal,
+	PromptDescription: internal,
+	PromptType:        internal,
+	PromptModelConfig: internal,
+
+	Heartbeat:            internal,
+	FailureReason:        internal,
+	FailureStack:         internal,
+	RunGroup:             internal,
+	ExpiresAt:            internal,
+	ArchivedAt:           internal,
+	EarlyStopReason:      internal,
+	PromptInputSchema:    internal,
+	PromptLocale:         settable,
+	PromptRolloutPercent: internal,
+}
+
+// knownPrefixes lists the reserved key prefixes defined by this package.
+// Every key they start is maintained by MLflow or this SDK.
+var knownPrefixes = []string{
+	PromptAliasPrefix,
+	PromptABPrefix,
+	PromotionPrefix,
+	DependenciesPrefix,
+}
+
+var sourceTypes = map[string]bool{
+	SourceTypeNotebook: true,
+	SourceTypeJob:      true,
+	SourceTypeProject:  true,
+	SourceTypeLocal:    true,
+	SourceTypeUnknown:  true,
+}
+
+// IsReserved reports whether key is in MLflow's reserved namespace.
+func IsReserved(key string) bool {
+	return strings.HasPrefix(key, "mlflow.") || strings.HasPrefix(key, "_mlflow")
+}
+
+// IsKnown reports whether key is a reserved key defined by this package.
+func IsKnown(key string) bool {
+	return kindOf(key) != 0
+}
+
+// kindOf returns how Set treats key, or 0 if it is not a known reserved key.
+func kindOf(key string) keyKind {
+	if kind, ok := known[key]; ok {
+		return kind
+	}
+	for _, prefix := range knownPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return internal
+		}
+	}
+	return 0
+}
+
+// Validate reports whether value may be stored under key. Keys outside the
+// reserved namespace are always accepted. Reserved keys must be known to
+// this package, so a misspelled key fails instead of being stored as an
+// ordinary tag, and must not be one MLflow maintains itself.
+func Validate(key, value string) error {
+	if !IsReserved(key) {
+		return nil
+	}
+	switch kindOf(key) {
+	case 0:
+		return fmt.Errorf("mlflow: unknown reserved tag %q", key)
+	case internal:
+		return fmt.Errorf("mlflow: tag %q is maintained by MLflow", key)
+	case required:
+		if value == "" {
+			return fmt.Errorf("mlflow: tag %q requires a value", key)
+		}
+	}
+	if key == SourceType && !sourceTypes[value] {
+		return fmt.Errorf("mlflow: invalid source type %q", value)
+	}
+	return nil
+}
+
+// Set validates value with Validate and stores it in tags under key.
+// tags must not be nil.
+func Set(tags map[string]string, key, value string) error {
+	if err := Validate(key, value); err != nil {
+		return err
+	}
+	tags[key] = value
+	return nil
+}
+
+// UserTags returns a copy of tags without reserved keys, for displaying or
+// copying only the tags a user set.
+func UserTags(tags map[string]string) map[string]string {
+	out := maps.Clone(tags)
+	maps.DeleteFunc(out, func(k, _ string) bool {
+		return IsReserved(k)
+	})
+	return out
+}
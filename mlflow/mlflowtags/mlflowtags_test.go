@@ -0,0 +1,105 @@
+package mlflowtags
+
+import (
+	"testing"
+)
+
+func TestIsReserved(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{ParentRunID, true},
+		{PromptType, true},
+		{"mlflow.custom", true},
+		{"team", false},
+		{"mlflowish", false},
+	}
+	for _, tt := range tests {
+		if got := IsReserved(tt.key); got != tt.want {
+			t.Errorf("IsReserved(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	if !IsKnown(SourceGitCommit) {
+		t.Errorf("IsKnown(%q) = false, want true", SourceGitCommit)
+	}
+	if !IsKnown(PromptAliasPrefix + "production") {
+		t.Error("IsKnown(alias tag) = false, want true")
+	}
+	if !IsKnown(PromptInputSchema) || !IsKnown(PromotionPrefix+"alias.champion") {
+		t.Error("IsKnown(SDK tag) = false, want true")
+	}
+	if IsKnown(SDKPrefix + "runGroup") {
+		t.Error("IsKnown(misspelled SDK key) = true, want false")
+	}
+	if IsKnown("mlflow.parentRunID") {
+		t.Error("IsKnown(misspelled key) = true, want false")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{"user tag", "team", "", false},
+		{"settable", Note, "", false},
+		{"required", ParentRunID, "abc123", false},
+		{"required empty", ParentRunID, "", true},
+		{"misspelled", "mlflow.parentRunID", "abc123", true},
+		{"internal", PromptText, "Hello", true},
+		{"alias", PromptAliasPrefix + "production", "3", true},
+		{"heartbeat", Heartbeat, "2025-01-01T00:00:00.000Z", true},
+		{"sdk internal", RunGroup, "group-1", true},
+		{"sdk prefix", PromptABPrefix + "qa.alias", "champion", true},
+		{"sdk settable", PromptLocale, "de-DE", false},
+		{"sdk misspelled", SDKPrefix + "run-group", "group-1", true},
+		{"source type", SourceType, SourceTypeJob, false},
+		{"invalid source type", SourceType, "CRON", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q, %q) error = %v, wantErr %v", tt.key, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	tags := map[string]string{}
+
+	if err := Set(tags, ParentRunID, "abc123"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := Set(tags, "mlflow.parentRunID", "abc123"); err == nil {
+		t.Error("expected error for misspelled key")
+	}
+
+	if len(tags) != 1 || tags[ParentRunID] != "abc123" {
+		t.Errorf("tags = %v, want only %s", tags, ParentRunID)
+	}
+}
+
+func TestUserTags(t *testing.T) {
+	tags := map[string]string{
+		"team":     "search",
+		RunName:    "bouncy-owl-123",
+		PromptType: "text",
+		RunGroup:   "group-1",
+	}
+
+	got := UserTags(tags)
+	if len(got) != 1 || got["team"] != "search" {
+		t.Errorf("UserTags() = %v, want only team", got)
+	}
+	if len(tags) != 4 {
+		t.Errorf("UserTags modified its input: %v", tags)
+	}
+}
@@ -0,0 +1,22 @@
+package modelregistry
+
+import "context"
+
+// API is the set of methods implemented by Client. Accept an API instead of
+// a *Client in code you want to unit test without an MLflow server, and pass
+// a mlflowmock.ModelRegistry in tests.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to stay source compatible.
+type API interface {
+	SearchRegisteredModels(ctx context.Context, filter string, opts ...SearchRegisteredModelsOption) (*RegisteredModelList, error)
+	SearchRegisteredModelsCursor(filter string, opts ...SearchRegisteredModelsOption) *Cursor[RegisteredModel]
+	GetModelVersion(ctx context.Context, name, version string) (*ModelVersion, error)
+	SetModelVersionTag(ctx context.Context, name, version, key, value string) error
+	DeleteModelVersionTag(ctx context.Context, name, version, key string) error
+	SetModelVersionDependencies(ctx context.Context, name, version string, deps Dependencies) error
+	SetRegisteredModelAlias(ctx context.Context, name, alias, version string) error
+	TransitionModelVersionStage(ctx context.Context, name, version, stage string, archiveExisting bool) (*ModelVersion, error)
+}
+
+var _ API = (*Client)(nil)
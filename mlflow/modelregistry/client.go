@@ -0,0 +1,235 @@
+package modelregistry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// defaultSearchMaxResults is the default page size for
+// SearchRegisteredModels, matching the server's default.
+const defaultSearchMaxResults = 100
+
+// Client provides operations for the MLflow Model Registry.
+// It is safe for concurrent use.
+type Client struct {
+	transport *transport.Client
+}
+
+// NewClient creates a new Model Registry client.
+// This is typically called internally by the root mlflow.Client.
+func NewClient(t *transport.Client) *Client {
+	return &Client{transport: t}
+}
+
+// SearchRegisteredModels returns one page of registered models matching
+// filter, such as "name LIKE 'fraud-%'" or "tags.team = 'risk'". An empty
+// filter matches every model.
+//
+// Prompts are stored as registered models too, and are returned unless the
+// filter excludes them, such as with "tags.`mlflow.prompt.is_prompt` !=
+// 'true'". Use promptregistry to work with prompts.
+func (c *Client) SearchRegisteredModels(ctx context.Context, filter string, opts ...SearchRegisteredModelsOption) (*RegisteredModelList, error) {
+	o := &searchRegisteredModelsOptions{maxResults: defaultSearchMaxResults}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	if o.maxResults > 0 {
+		query.Set("max_results", strconv.Itoa(o.maxResults))
+	}
+	if o.pageToken != "" {
+		query.Set("page_token", o.pageToken)
+	}
+	for _, key := range o.orderBy {
+		query.Add("order_by", key)
+	}
+
+	var resp mlflowpb.SearchRegisteredModels_Response
+
+	err := c.transport.Get(ctx, "/api/2.0/mlflow/registered-models/search", query, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search registered models: %w", err)
+	}
+
+	result := &RegisteredModelList{
+		Models:        make([]RegisteredModel, 0, len(resp.GetRegisteredModels())),
+		NextPageToken: resp.GetNextPageToken(),
+	}
+	for _, rm := range resp.GetRegisteredModels() {
+		result.Models = append(result.Models, registeredModelFromProto(rm))
+	}
+
+	return result, nil
+}
+
+// SearchRegisteredModelsCursor returns a cursor over all pages of
+// SearchRegisteredModels results. WithPageToken sets the starting page;
+// later pages are fetched on demand.
+func (c *Client) SearchRegisteredModelsCursor(filter string, opts ...SearchRegisteredModelsOption) *Cursor[RegisteredModel] {
+	o := &searchRegisteredModelsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[RegisteredModel], error) {
+		pageOpts := append(slices.Clone(opts), WithPageToken(pageToken))
+		list, err := c.SearchRegisteredModels(ctx, filter, pageOpts...)
+		if err != nil {
+			return Page[RegisteredModel]{}, err
+		}
+		return Page[RegisteredModel]{Items: list.Models, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, o.pageToken)
+}
+
+// GetModelVersion retrieves a version of a registered model.
+func (c *Client) GetModelVersion(ctx context.Context, name, version string) (*ModelVersion, error) {
+	if err := validateVersionArgs(name, version); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"name":    []string{name},
+		"version": []string{version},
+	}
+
+	var resp mlflowpb.GetModelVersion_Response
+
+	err := c.transport.Get(ctx, "/api/2.0/mlflow/model-versions/get", query, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version: %w", err)
+	}
+
+	mv := modelVersionFromProto(resp.GetModelVersion())
+	return &mv, nil
+}
+
+// SetModelVersionTag sets a tag on a model version, overwriting an existing
+// value for the same key.
+func (c *Client) SetModelVersionTag(ctx context.Context, name, version, key, value string) error {
+	if err := validateVersionArgs(name, version); err != nil {
+		return err
+	}
+	if key == "" {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	req := &mlflowpb.SetModelVersionTag{
+		Name:    &name,
+		Version: &version,
+		Key:     &key,
+		Value:   &value,
+	}
+
+	var resp mlflowpb.SetModelVersionTag_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/model-versions/set-tag", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to set model version tag: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteModelVersionTag deletes a tag from a model version.
+func (c *Client) DeleteModelVersionTag(ctx context.Context, name, version, key string) error {
+	if err := validateVersionArgs(name, version); err != nil {
+		return err
+	}
+	if key == "" {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	req := &mlflowpb.DeleteModelVersionTag{
+		Name:    &name,
+		Version: &version,
+		Key:     &key,
+	}
+
+	var resp mlflowpb.DeleteModelVersionTag_Response
+
+	err := c.transport.Delete(ctx, "/api/2.0/mlflow/model-versions/delete-tag", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to delete model version tag: %w", err)
+	}
+
+	return nil
+}
+
+// SetRegisteredModelAlias points alias at a version of a registered model,
+// moving it if it already points to another version.
+func (c *Client) SetRegisteredModelAlias(ctx context.Context, name, alias, version string) error {
+	if err := validateVersionArgs(name, version); err != nil {
+		return err
+	}
+	if alias == "" {
+		return fmt.Errorf("mlflow: alias is required")
+	}
+
+	req := &mlflowpb.SetRegisteredModelAlias{
+		Name:    &name,
+		Alias:   &alias,
+		Version: &version,
+	}
+
+	var resp mlflowpb.SetRegisteredModelAlias_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/registered-models/alias", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to set alias: %w", err)
+	}
+
+	return nil
+}
+
+// TransitionModelVersionStage moves a model version to stage, such as
+// "Staging" or "Production". If archiveExisting is true, the versions
+// already in stage are moved to "Archived". Stages are deprecated in
+// MLflow in favor of aliases.
+func (c *Client) TransitionModelVersionStage(ctx context.Context, name, version, stage string, archiveExisting bool) (*ModelVersion, error) {
+	if err := validateVersionArgs(name, version); err != nil {
+		return nil, err
+	}
+	if stage == "" {
+		return nil, fmt.Errorf("mlflow: stage is required")
+	}
+
+	req := &mlflowpb.TransitionModelVersionStage{
+		Name:                    &name,
+		Version:                 &version,
+		Stage:                   &stage,
+		ArchiveExistingVersions: &archiveExisting,
+	}
+
+	var resp mlflowpb.TransitionModelVersionStage_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/model-versions/transition-stage", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transition model version stage: %w", err)
+	}
+
+	mv := modelVersionFromProto(resp.GetModelVersion())
+	return &mv, nil
+}
+
+func validateVersionArgs(name, version string) error {
+	if name == "" {
+		return fmt.Errorf("mlflow: model name is required")
+	}
+	if version == "" {
+		return fmt.Errorf("mlflow: model version is required")
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package modelregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	tc, err := transport.New(transport.Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+
+	return NewClient(tc)
+}
+
+func mustEncodeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Fatalf("failed to encode response: %v", err)
+	}
+}
+
+// registeredModelJSON is a registered model payload as returned by MLflow.
+func registeredModelJSON(name string) map[string]any {
+	return map[string]any{
+		"name":                   name,
+		"description":            "Fraud scoring model",
+		"user_id":                "alice",
+		"creation_timestamp":     1748772000000,
+		"last_updated_timestamp": 1748772060000,
+		"latest_versions": []map[string]any{{
+			"name":                   name,
+			"version":                "3",
+			"source":                 "models:/m-123",
+			"run_id":                 "run-1",
+			"model_id":               "m-123",
+			"current_stage":          "None",
+			"status":                 "READY",
+			"creation_timestamp":     1748772000000,
+			"last_updated_timestamp": 1748772060000,
+			"aliases":                []string{"champion"},
+			"tags":                   []map[string]any{{"key": "validated", "value": "true"}},
+		}},
+		"aliases": []map[string]any{{"alias": "champion", "version": "3"}},
+		"tags":    []map[string]any{{"key": "team", "value": "risk"}},
+	}
+}
+
+func TestSearchRegisteredModels(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/registered-models/search" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if got := q.Get("filter"); got != "tags.team = 'risk'" {
+			t.Errorf("filter = %q", got)
+		}
+		if got := q.Get("max_results"); got != "10" {
+			t.Errorf("max_results = %q, want 10", got)
+		}
+		if got := q["order_by"]; !slices.Equal(got, []string{"name ASC"}) {
+			t.Errorf("order_by = %v", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"registered_models": []map[string]any{registeredModelJSON("fraud")},
+			"next_page_token":   "p2",
+		})
+	}))
+
+	list, err := client.SearchRegisteredModels(context.Background(), "tags.team = 'risk'",
+		WithMaxResults(10), WithOrderBy("name ASC"))
+	if err != nil {
+		t.Fatalf("SearchRegisteredModels() error = %v", err)
+	}
+	if list.NextPageToken != "p2" || len(list.Models) != 1 {
+		t.Fatalf("SearchRegisteredModels() = %+v", list)
+	}
+
+	m := list.Models[0]
+	if m.Name != "fraud" || m.Description != "Fraud scoring model" || m.UserID != "alice" {
+		t.Errorf("model = %+v", m)
+	}
+	if !m.CreationTime.Equal(time.UnixMilli(1748772000000)) || !m.LastUpdatedTime.Equal(time.UnixMilli(1748772060000)) {
+		t.Errorf("times = %v, %v", m.CreationTime, m.LastUpdatedTime)
+	}
+	if m.Aliases["champion"] != "3" || m.Tags["team"] != "risk" {
+		t.Errorf("aliases = %v, tags = %v", m.Aliases, m.Tags)
+	}
+	if len(m.LatestVersions) != 1 {
+		t.Fatalf("LatestVersions = %+v", m.LatestVersions)
+	}
+	v := m.LatestVersions[0]
+	if v.Version != "3" || v.RunID != "run-1" || v.ModelID != "m-123" || v.Status != "READY" ||
+		!slices.Equal(v.Aliases, []string{"champion"}) || v.Tags["validated"] != "true" {
+		t.Errorf("version = %+v", v)
+	}
+}
+
+func TestSearchRegisteredModels_NoFilter(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Has("filter") {
+			t.Errorf("filter = %q, want none", q.Get("filter"))
+		}
+		if got := q.Get("max_results"); got != "100" {
+			t.Errorf("max_results = %q, want default 100", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	list, err := client.SearchRegisteredModels(context.Background(), "")
+	if err != nil {
+		t.Fatalf("SearchRegisteredModels() error = %v", err)
+	}
+	if list.Models == nil || len(list.Models) != 0 {
+		t.Errorf("Models = %#v, want empty", list.Models)
+	}
+}
+
+func TestSearchRegisteredModelsCursor(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page_token") == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"registered_models": []map[string]any{registeredModelJSON("a")},
+				"next_page_token":   "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"registered_models": []map[string]any{registeredModelJSON("b")},
+		})
+	}))
+
+	models, err := client.SearchRegisteredModelsCursor("").All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(models) != 2 || models[0].Name != "a" || models[1].Name != "b" {
+		t.Errorf("All() = %+v", models)
+	}
+}
@@ -0,0 +1,279 @@
+package modelregistry
+
+import (
+	"context"
+	"fmt"
+	"go/version"
+	"maps"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// DependencyTagPrefix starts the keys of the model version tags that record
+// Dependencies.
+const DependencyTagPrefix = mlflowtags.DependenciesPrefix
+
+// Dependency tag keys. The module list is split across the go_modules tag,
+// go_modules.1, and so on, since tag values are limited to 5000 bytes.
+const (
+	tagDepsGoVersion      = DependencyTagPrefix + "go_version"
+	tagDepsPlatform       = DependencyTagPrefix + "platform"
+	tagDepsContainerImage = DependencyTagPrefix + "container_image"
+	tagDepsCUDAVersion    = DependencyTagPrefix + "cuda_version"
+	tagDepsModules        = DependencyTagPrefix + "go_modules"
+)
+
+// maxTagValueLength is the longest tag value every MLflow backend accepts.
+const maxTagValueLength = 5000
+
+// Dependencies describes the runtime a model version was built for, so
+// deployment tooling can check a target runtime before rolling it out.
+// Empty fields are not recorded and not checked.
+type Dependencies struct {
+	// GoVersion is the Go release the model was built with, such as
+	// "go1.24.2".
+	GoVersion string
+
+	// Platform is the target OS and architecture, such as "linux/amd64".
+	Platform string
+
+	// Modules are the Go modules the model was built with.
+	Modules []Module
+
+	// ContainerImage is the image the model runs in, such as
+	// "quay.io/acme/scorer@sha256:...".
+	ContainerImage string
+
+	// CUDAVersion is the CUDA toolkit version the model needs, such as
+	// "12.2".
+	CUDAVersion string
+}
+
+// Module is a Go module dependency.
+type Module struct {
+	Path    string
+	Version string
+}
+
+// CurrentDependencies returns the Go version, platform, and module list of
+// the running binary, from its embedded build information. Set
+// ContainerImage and CUDAVersion on the result before recording it, since
+// they cannot be detected reliably from inside the process.
+func CurrentDependencies() Dependencies {
+	deps := Dependencies{
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range bi.Deps {
+			m := Module{Path: dep.Path, Version: dep.Version}
+			if dep.Replace != nil {
+				m.Version = dep.Replace.Version
+				if m.Version == "" {
+					m.Version = dep.Replace.Path
+				}
+			}
+			deps.Modules = append(deps.Modules, m)
+		}
+	}
+	return deps
+}
+
+// IsZero reports whether d records nothing.
+func (d Dependencies) IsZero() bool {
+	return d.GoVersion == "" && d.Platform == "" && len(d.Modules) == 0 &&
+		d.ContainerImage == "" && d.CUDAVersion == ""
+}
+
+// Tags returns d as model version tags, the form
+// SetModelVersionDependencies records it in.
+func (d Dependencies) Tags() map[string]string {
+	tags := make(map[string]string)
+	for key, value := range map[string]string{
+		tagDepsGoVersion:      d.GoVersion,
+		tagDepsPlatform:       d.Platform,
+		tagDepsContainerImage: d.ContainerImage,
+		tagDepsCUDAVersion:    d.CUDAVersion,
+	} {
+		if value != "" {
+			tags[key] = value
+		}
+	}
+
+	// One "path version" line per module, split at line boundaries
+	var chunk strings.Builder
+	n := 0
+	flush := func() {
+		key := tagDepsModules
+		if n > 0 {
+			key += "." + strconv.Itoa(n)
+		}
+		tags[key] = chunk.String()
+		chunk.Reset()
+		n++
+	}
+	for _, m := range d.Modules {
+		line := m.Path + " " + m.Version + "\n"
+		if chunk.Len() > 0 && chunk.Len()+len(line) > maxTagValueLength {
+			flush()
+		}
+		chunk.WriteString(line)
+	}
+	if chunk.Len() > 0 {
+		flush()
+	}
+	return tags
+}
+
+// DependenciesFromTags parses the dependency tags of a model version, as
+// written by Tags. Tags without DependencyTagPrefix are ignored; it returns
+// a zero Dependencies if there are none.
+func DependenciesFromTags(tags map[string]string) (Dependencies, error) {
+	d := Dependencies{
+		GoVersion:      tags[tagDepsGoVersion],
+		Platform:       tags[tagDepsPlatform],
+		ContainerImage: tags[tagDepsContainerImage],
+		CUDAVersion:    tags[tagDepsCUDAVersion],
+	}
+	for n := 0; ; n++ {
+		key := tagDepsModules
+		if n > 0 {
+			key += "." + strconv.Itoa(n)
+		}
+		chunk, ok := tags[key]
+		if !ok {
+			break
+		}
+		for line := range strings.Lines(chunk) {
+			path, ver, ok := strings.Cut(strings.TrimSuffix(line, "\n"), " ")
+			if !ok || path == "" || ver == "" {
+				return Dependencies{}, fmt.Errorf("mlflow: invalid module %q in tag %s", line, key)
+			}
+			d.Modules = append(d.Modules, Module{Path: path, Version: ver})
+		}
+	}
+	return d, nil
+}
+
+// Dependencies returns the dependency metadata recorded on v with
+// SetModelVersionDependencies, or a zero Dependencies if there is none.
+func (v *ModelVersion) Dependencies() (Dependencies, error) {
+	return DependenciesFromTags(v.Tags)
+}
+
+// SetModelVersionDependencies records deps on a model version as tags
+// under DependencyTagPrefix, replacing dependency tags recorded before.
+func (c *Client) SetModelVersionDependencies(ctx context.Context, name, version string, deps Dependencies) error {
+	mv, err := c.GetModelVersion(ctx, name, version)
+	if err != nil {
+		return err
+	}
+
+	tags := deps.Tags()
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		if err := c.SetModelVersionTag(ctx, name, version, key, tags[key]); err != nil {
+			return err
+		}
+	}
+	// Remove fields no longer set and leftover module chunks
+	for _, key := range slices.Sorted(maps.Keys(mv.Tags)) {
+		if _, ok := tags[key]; !ok && strings.HasPrefix(key, DependencyTagPrefix) {
+			if err := c.DeleteModelVersionTag(ctx, name, version, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DependencyMismatchError is returned by Dependencies.Check when a runtime
+// does not satisfy the recorded dependencies.
+type DependencyMismatchError struct {
+	// Mismatches describes each incompatibility, such as
+	// `platform: want "linux/amd64", have "linux/arm64"`.
+	Mismatches []string
+}
+
+func (e *DependencyMismatchError) Error() string {
+	return fmt.Sprintf("mlflow: incompatible runtime: %s", strings.Join(e.Mismatches, "; "))
+}
+
+// Check reports whether the runtime described by env satisfies d, the
+// dependencies of a model version, returning a *DependencyMismatchError if
+// not. Only fields set in both are compared:
+//
+//   - Platform and ContainerImage must be equal.
+//   - GoVersion in env must be the same language version or newer.
+//   - CUDAVersion in env must have the same major version and the same or a
+//     newer minor version.
+//   - Modules in both must have the same version.
+func (d Dependencies) Check(env Dependencies) error {
+	var mismatches []string
+	mismatch := func(field, want, have string) {
+		mismatches = append(mismatches, fmt.Sprintf("%s: want %q, have %q", field, want, have))
+	}
+
+	if d.Platform != "" && env.Platform != "" && d.Platform != env.Platform {
+		mismatch("platform", d.Platform, env.Platform)
+	}
+	if d.ContainerImage != "" && env.ContainerImage != "" && d.ContainerImage != env.ContainerImage {
+		mismatch("container image", d.ContainerImage, env.ContainerImage)
+	}
+	if d.GoVersion != "" && env.GoVersion != "" &&
+		version.Compare(version.Lang(env.GoVersion), version.Lang(d.GoVersion)) < 0 {
+		mismatch("go version", d.GoVersion+" or newer", env.GoVersion)
+	}
+	if d.CUDAVersion != "" && env.CUDAVersion != "" && !cudaCompatible(d.CUDAVersion, env.CUDAVersion) {
+		mismatch("cuda version", d.CUDAVersion, env.CUDAVersion)
+	}
+
+	have := make(map[string]string, len(env.Modules))
+	for _, m := range env.Modules {
+		have[m.Path] = m.Version
+	}
+	for _, m := range d.Modules {
+		if v, ok := have[m.Path]; ok && v != m.Version {
+			mismatch("module "+m.Path, m.Version, v)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return &DependencyMismatchError{Mismatches: mismatches}
+	}
+	return nil
+}
+
+// cudaCompatible reports whether CUDA version have can run code built for
+// want: the same major version and the same or a newer minor version.
+// Versions that do not parse must be equal.
+func cudaCompatible(want, have string) bool {
+	wantMajor, wantMinor, ok1 := parseMajorMinor(want)
+	haveMajor, haveMinor, ok2 := parseMajorMinor(have)
+	if !ok1 || !ok2 {
+		return want == have
+	}
+	return haveMajor == wantMajor && haveMinor >= wantMinor
+}
+
+// parseMajorMinor parses the first two numbers of a version such as "12.2"
+// or "12.2.140".
+func parseMajorMinor(v string) (major, minor int, ok bool) {
+	parts := strings.Split(v, ".")
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	nums := make([]int, 2)
+	for i := range nums {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return 0, 0, false
+		}
+		nums[i] = n
+	}
+	return nums[0], nums[1], true
+}
@@ -0,0 +1,182 @@
+package modelregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDependencies_TagsRoundTrip(t *testing.T) {
+	deps := Dependencies{
+		GoVersion:      "go1.24.2",
+		Platform:       "linux/amd64",
+		ContainerImage: "quay.io/acme/scorer:1.4",
+		CUDAVersion:    "12.2",
+	}
+	// Enough modules to need several tags
+	for i := range 300 {
+		deps.Modules = append(deps.Modules, Module{Path: fmt.Sprintf("example.com/some/long/module/path%d", i), Version: "v1.2.3"})
+	}
+
+	tags := deps.Tags()
+	if _, ok := tags["_mlflow_go.dependencies.go_modules.1"]; !ok {
+		t.Fatalf("Tags() did not split the module list: %d tags", len(tags))
+	}
+	for key, value := range tags {
+		if len(value) > maxTagValueLength {
+			t.Errorf("tag %s is %d bytes, over the limit", key, len(value))
+		}
+	}
+	tags["team"] = "risk"
+
+	got, err := DependenciesFromTags(tags)
+	if err != nil {
+		t.Fatalf("DependenciesFromTags() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, deps) {
+		t.Errorf("DependenciesFromTags() = %+v, want %+v", got, deps)
+	}
+
+	if got, err := DependenciesFromTags(map[string]string{"team": "risk"}); err != nil || !got.IsZero() {
+		t.Errorf("DependenciesFromTags(no dependencies) = %+v, %v, want zero", got, err)
+	}
+	if _, err := DependenciesFromTags(map[string]string{"_mlflow_go.dependencies.go_modules": "broken\n"}); err == nil {
+		t.Error("DependenciesFromTags(invalid module) error = nil")
+	}
+}
+
+func TestCurrentDependencies(t *testing.T) {
+	deps := CurrentDependencies()
+	if !strings.HasPrefix(deps.GoVersion, "go") || !strings.Contains(deps.Platform, "/") {
+		t.Errorf("CurrentDependencies() = %+v", deps)
+	}
+	if err := deps.Check(CurrentDependencies()); err != nil {
+		t.Errorf("Check(self) error = %v", err)
+	}
+}
+
+func TestDependencies_Check(t *testing.T) {
+	want := Dependencies{
+		GoVersion:   "go1.23.4",
+		Platform:    "linux/amd64",
+		CUDAVersion: "12.2",
+		Modules:     []Module{{Path: "example.com/a", Version: "v1.0.0"}, {Path: "example.com/b", Version: "v2.0.0"}},
+	}
+
+	tests := []struct {
+		name     string
+		env      Dependencies
+		wantErrs []string
+	}{
+		{name: "empty env", env: Dependencies{}},
+		{
+			name: "compatible",
+			env: Dependencies{
+				GoVersion: "go1.24.0", Platform: "linux/amd64", CUDAVersion: "12.4.1",
+				Modules: []Module{{Path: "example.com/a", Version: "v1.0.0"}},
+			},
+		},
+		{
+			name: "incompatible",
+			env: Dependencies{
+				GoVersion: "go1.22.9", Platform: "linux/arm64", CUDAVersion: "11.8",
+				Modules: []Module{{Path: "example.com/b", Version: "v2.1.0"}},
+			},
+			wantErrs: []string{"platform", "go version", "cuda version", "module example.com/b"},
+		},
+		{name: "older cuda minor", env: Dependencies{CUDAVersion: "12.1"}, wantErrs: []string{"cuda version"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := want.Check(tt.env)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("Check() error = %v", err)
+				}
+				return
+			}
+			var mismatch *DependencyMismatchError
+			if !errors.As(err, &mismatch) || len(mismatch.Mismatches) != len(tt.wantErrs) {
+				t.Fatalf("Check() error = %v, want %d mismatches", err, len(tt.wantErrs))
+			}
+			for i, prefix := range tt.wantErrs {
+				if !strings.HasPrefix(mismatch.Mismatches[i], prefix) {
+					t.Errorf("mismatch %d = %q, want prefix %q", i, mismatch.Mismatches[i], prefix)
+				}
+			}
+		})
+	}
+}
+
+func TestSetModelVersionDependencies(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		set     []string
+		deleted []string
+	)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+			Key     string `json:"key"`
+		}
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/get":
+			if r.URL.Query().Get("name") != "fraud" || r.URL.Query().Get("version") != "3" {
+				t.Errorf("get query = %v", r.URL.Query())
+			}
+			mustEncodeJSON(t, w, map[string]any{"model_version": map[string]any{
+				"name": "fraud", "version": "3",
+				"tags": []map[string]any{
+					{"key": "_mlflow_go.dependencies.cuda_version", "value": "11.8"},
+					{"key": "_mlflow_go.dependencies.go_modules.1", "value": "example.com/old v0.1.0\n"},
+					{"key": "team", "value": "risk"},
+				},
+			}})
+			return
+		case "/api/2.0/mlflow/model-versions/set-tag":
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			set = append(set, req.Key)
+		case "/api/2.0/mlflow/model-versions/delete-tag":
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			deleted = append(deleted, req.Key)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		if req.Name != "fraud" || req.Version != "3" {
+			t.Errorf("%s request = %+v", r.URL.Path, req)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	deps := Dependencies{GoVersion: "go1.24.2", Modules: []Module{{Path: "example.com/a", Version: "v1.0.0"}}}
+	if err := client.SetModelVersionDependencies(context.Background(), "fraud", "3", deps); err != nil {
+		t.Fatalf("SetModelVersionDependencies() error = %v", err)
+	}
+
+	if want := []string{"_mlflow_go.dependencies.go_modules", "_mlflow_go.dependencies.go_version"}; !slices.Equal(set, want) {
+		t.Errorf("set tags = %v, want %v", set, want)
+	}
+	if want := []string{"_mlflow_go.dependencies.cuda_version", "_mlflow_go.dependencies.go_modules.1"}; !slices.Equal(deleted, want) {
+		t.Errorf("deleted tags = %v, want %v", deleted, want)
+	}
+}
+
+func TestModelVersion_Dependencies(t *testing.T) {
+	mv := &ModelVersion{Tags: Dependencies{Platform: "linux/amd64"}.Tags()}
+	deps, err := mv.Dependencies()
+	if err != nil || deps.Platform != "linux/amd64" {
+		t.Errorf("Dependencies() = %+v, %v", deps, err)
+	}
+}
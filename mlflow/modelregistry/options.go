@@ -0,0 +1,35 @@
+package modelregistry
+
+// searchRegisteredModelsOptions holds configuration for a
+// SearchRegisteredModels call.
+type searchRegisteredModelsOptions struct {
+	maxResults int
+	orderBy    []string
+	pageToken  string
+}
+
+// SearchRegisteredModelsOption configures a SearchRegisteredModels call.
+type SearchRegisteredModelsOption func(*searchRegisteredModelsOptions)
+
+// WithMaxResults sets the maximum number of models per page. The default
+// is 100; the server caps it at 1000.
+func WithMaxResults(n int) SearchRegisteredModelsOption {
+	return func(o *searchRegisteredModelsOptions) {
+		o.maxResults = n
+	}
+}
+
+// WithOrderBy sets the ordering of results, such as "name ASC" or
+// "last_updated_timestamp DESC".
+func WithOrderBy(orderBy ...string) SearchRegisteredModelsOption {
+	return func(o *searchRegisteredModelsOptions) {
+		o.orderBy = orderBy
+	}
+}
+
+// WithPageToken sets the pagination token for fetching the next page.
+func WithPageToken(token string) SearchRegisteredModelsOption {
+	return func(o *searchRegisteredModelsOptions) {
+		o.pageToken = token
+	}
+}
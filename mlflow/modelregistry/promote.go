@@ -0,0 +1,154 @@
+package modelregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// PromotionTagPrefix starts the key of the version tag Promote records its
+// results in: "_mlflow_go.promotion.alias.<alias>" or
+// "_mlflow_go.promotion.stage.<stage>".
+const PromotionTagPrefix = mlflowtags.PromotionPrefix
+
+// maxCheckErrorLength caps each check error in the promotion tag, so the
+// tag stays under the tag value limit.
+const maxCheckErrorLength = 500
+
+// Target is where Promote moves a model version, and the checks it must
+// pass first. Set exactly one of Alias and Stage.
+type Target struct {
+	// Alias is pointed at the version, such as "champion".
+	Alias string
+
+	// Stage is the stage the version is moved to, such as "Production".
+	Stage string
+
+	// ArchiveExistingVersions moves the versions already in Stage to
+	// "Archived".
+	ArchiveExistingVersions bool
+
+	// Checks validate the version, such as a load test or a schema check.
+	// They run in order, and all of them run even after one fails.
+	Checks []PromotionCheck
+}
+
+// PromotionCheck is a named validation of a model version.
+type PromotionCheck struct {
+	// Name identifies the check in the results, such as "load-test".
+	Name string
+
+	// Func returns an error if the version must not be promoted.
+	Func func(ctx context.Context, mv *ModelVersion) error
+}
+
+// CheckResult is the outcome of one PromotionCheck.
+type CheckResult struct {
+	Name     string        `json:"name"`
+	Passed   bool          `json:"passed"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// PromotionResult reports what Promote did.
+type PromotionResult struct {
+	// Promoted is true if the alias or stage now points to the version.
+	Promoted bool
+
+	// Checks are the results of Target.Checks, in order.
+	Checks []CheckResult
+
+	// TagKey is the version tag the results were recorded in.
+	TagKey string
+}
+
+// promotionRecord is the JSON value of the promotion tag.
+type promotionRecord struct {
+	Target   string        `json:"target"`
+	Promoted bool          `json:"promoted"`
+	Checks   []CheckResult `json:"checks"`
+	Time     time.Time     `json:"time"`
+}
+
+// Promote runs the checks of target against a model version and, only if
+// every check passes, points target's alias at the version or moves it to
+// target's stage. Either way it records the check results as JSON in a
+// version tag named PromotionTagPrefix plus "alias.<alias>" or
+// "stage.<stage>", for auditing who could promote what and why.
+//
+// Failed checks are reported in the result with Promoted false and a nil
+// error; Promote returns an error only if the version cannot be loaded, a
+// request fails, or ctx is done.
+func Promote(ctx context.Context, client API, name, version string, target Target) (*PromotionResult, error) {
+	if client == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if err := validateVersionArgs(name, version); err != nil {
+		return nil, err
+	}
+	if (target.Alias == "") == (target.Stage == "") {
+		return nil, fmt.Errorf("mlflow: exactly one of alias and stage is required")
+	}
+	for i, check := range target.Checks {
+		if check.Func == nil {
+			return nil, fmt.Errorf("mlflow: promotion check %d (%q) has no func", i, check.Name)
+		}
+	}
+
+	mv, err := client.GetModelVersion(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	record := promotionRecord{Target: "alias " + target.Alias}
+	result := &PromotionResult{TagKey: PromotionTagPrefix + "alias." + target.Alias}
+	if target.Stage != "" {
+		record.Target = "stage " + target.Stage
+		result.TagKey = PromotionTagPrefix + "stage." + target.Stage
+	}
+
+	passed := true
+	for _, check := range target.Checks {
+		start := time.Now()
+		checkErr := check.Func(ctx, mv.Clone())
+		cr := CheckResult{Name: check.Name, Passed: checkErr == nil, Duration: time.Since(start)}
+		if checkErr != nil {
+			passed = false
+			cr.Error = checkErr.Error()
+			if len(cr.Error) > maxCheckErrorLength {
+				cr.Error = cr.Error[:maxCheckErrorLength] + "..."
+			}
+		}
+		result.Checks = append(result.Checks, cr)
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+	}
+
+	if passed {
+		if target.Alias != "" {
+			err = client.SetRegisteredModelAlias(ctx, name, target.Alias, version)
+		} else {
+			_, err = client.TransitionModelVersionStage(ctx, name, version, target.Stage, target.ArchiveExistingVersions)
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to promote %s version %s: %w", name, version, err)
+		}
+		result.Promoted = true
+	}
+
+	record.Promoted = result.Promoted
+	record.Checks = result.Checks
+	record.Time = time.Now().UTC()
+	data, err := json.Marshal(record)
+	if err != nil {
+		return result, err
+	}
+	if err := client.SetModelVersionTag(ctx, name, version, result.TagKey, string(data)); err != nil {
+		return result, fmt.Errorf("failed to record promotion: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,157 @@
+package modelregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// promotionServer records the alias, stage, and tag requests Promote makes.
+type promotionServer struct {
+	mu      sync.Mutex
+	aliases map[string]string
+	stage   string
+	archive bool
+	tags    map[string]string
+}
+
+func newPromotionServer(t *testing.T) (*promotionServer, *Client) {
+	s := &promotionServer{aliases: map[string]string{}, tags: map[string]string{}}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Alias   string `json:"alias"`
+			Version string `json:"version"`
+			Stage   string `json:"stage"`
+			Archive bool   `json:"archive_existing_versions"`
+			Key     string `json:"key"`
+			Value   string `json:"value"`
+		}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&req)
+		}
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/get":
+			mustEncodeJSON(t, w, map[string]any{"model_version": map[string]any{"name": "fraud", "version": "3"}})
+			return
+		case "/api/2.0/mlflow/registered-models/alias":
+			s.aliases[req.Alias] = req.Version
+		case "/api/2.0/mlflow/model-versions/transition-stage":
+			s.stage, s.archive = req.Stage, req.Archive
+		case "/api/2.0/mlflow/model-versions/set-tag":
+			s.tags[req.Key] = req.Value
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+	return s, client
+}
+
+func (s *promotionServer) record(t *testing.T, key string) promotionRecord {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var rec promotionRecord
+	if err := json.Unmarshal([]byte(s.tags[key]), &rec); err != nil {
+		t.Fatalf("promotion tag %s = %q: %v", key, s.tags[key], err)
+	}
+	return rec
+}
+
+func passCheck(name string) PromotionCheck {
+	return PromotionCheck{Name: name, Func: func(context.Context, *ModelVersion) error { return nil }}
+}
+
+func TestPromote_Alias(t *testing.T) {
+	server, client := newPromotionServer(t)
+
+	var checked string
+	result, err := Promote(context.Background(), client, "fraud", "3", Target{
+		Alias: "champion",
+		Checks: []PromotionCheck{
+			passCheck("schema"),
+			{Name: "load-test", Func: func(_ context.Context, mv *ModelVersion) error {
+				checked = mv.Name + "/" + mv.Version
+				return nil
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Promote() error = %v", err)
+	}
+	if !result.Promoted || len(result.Checks) != 2 || !result.Checks[1].Passed {
+		t.Errorf("Promote() = %+v", result)
+	}
+	if checked != "fraud/3" {
+		t.Errorf("check saw %q, want fraud/3", checked)
+	}
+	if server.aliases["champion"] != "3" {
+		t.Errorf("aliases = %v, want champion -> 3", server.aliases)
+	}
+
+	rec := server.record(t, "_mlflow_go.promotion.alias.champion")
+	if rec.Target != "alias champion" || !rec.Promoted || len(rec.Checks) != 2 || rec.Time.IsZero() {
+		t.Errorf("promotion record = %+v", rec)
+	}
+}
+
+func TestPromote_FailedCheck(t *testing.T) {
+	server, client := newPromotionServer(t)
+
+	result, err := Promote(context.Background(), client, "fraud", "3", Target{
+		Stage: "Production",
+		Checks: []PromotionCheck{
+			{Name: "load-test", Func: func(context.Context, *ModelVersion) error { return errors.New("p99 latency 480ms > 200ms") }},
+			passCheck("schema"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Promote() error = %v", err)
+	}
+	if result.Promoted {
+		t.Error("Promoted = true after a failed check")
+	}
+	if len(result.Checks) != 2 || result.Checks[0].Passed || !result.Checks[1].Passed {
+		t.Errorf("Checks = %+v, want every check run", result.Checks)
+	}
+	if server.stage != "" {
+		t.Errorf("stage transitioned to %q", server.stage)
+	}
+
+	rec := server.record(t, "_mlflow_go.promotion.stage.Production")
+	if rec.Promoted || rec.Checks[0].Error != "p99 latency 480ms > 200ms" {
+		t.Errorf("promotion record = %+v", rec)
+	}
+}
+
+func TestPromote_Stage(t *testing.T) {
+	server, client := newPromotionServer(t)
+
+	result, err := Promote(context.Background(), client, "fraud", "3", Target{Stage: "Staging", ArchiveExistingVersions: true})
+	if err != nil || !result.Promoted {
+		t.Fatalf("Promote() = %+v, %v", result, err)
+	}
+	if server.stage != "Staging" || !server.archive {
+		t.Errorf("stage = %q, archive = %v", server.stage, server.archive)
+	}
+}
+
+func TestPromote_InvalidTarget(t *testing.T) {
+	_, client := newPromotionServer(t)
+	for _, target := range []Target{
+		{},
+		{Alias: "champion", Stage: "Production"},
+		{Alias: "champion", Checks: []PromotionCheck{{Name: "nil"}}},
+	} {
+		if _, err := Promote(context.Background(), client, "fraud", "3", target); err == nil {
+			t.Errorf("Promote(%+v) error = nil", target)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Package modelregistry provides types and operations for the MLflow Model
+// Registry: registered models and their versions.
+package modelregistry
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+)
+
+// RegisteredModel is a named model in the registry, with its versions.
+type RegisteredModel struct {
+	Name        string
+	Description string
+	// UserID is the user who created the model, if the server records it.
+	UserID          string
+	CreationTime    time.Time
+	LastUpdatedTime time.Time
+	// LatestVersions holds the latest version of the model in each stage.
+	LatestVersions []ModelVersion
+	// Aliases maps each alias, such as "champion", to the version it points
+	// to.
+	Aliases map[string]string
+	Tags    map[string]string
+}
+
+// ModelVersion is a version of a registered model.
+type ModelVersion struct {
+	Name    string
+	Version string
+	// Source is the URI of the model artifacts the version was created from.
+	Source string
+	// RunID is the run that produced the version, if any.
+	RunID string
+	// ModelID is the MLflow 3 logged model the version was created from, if
+	// any.
+	ModelID      string
+	Description  string
+	UserID       string
+	CurrentStage string
+	// Status is the registration state, such as "READY".
+	Status          string
+	StatusMessage   string
+	CreationTime    time.Time
+	LastUpdatedTime time.Time
+	Aliases         []string
+	Tags            map[string]string
+}
+
+// Clone returns a deep copy of v.
+func (v *ModelVersion) Clone() *ModelVersion {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	c.Aliases = slices.Clone(v.Aliases)
+	c.Tags = maps.Clone(v.Tags)
+	return &c
+}
+
+// RegisteredModelList contains registered models and a pagination token.
+type RegisteredModelList struct {
+	Models        []RegisteredModel
+	NextPageToken string
+}
+
+// Page is one page of results from a Cursor.
+type Page[T any] = pagination.Page[T]
+
+// Cursor iterates over paginated results. See pagination.Cursor.
+type Cursor[T any] = pagination.Cursor[T]
+
+// registeredModelFromProto converts a protobuf RegisteredModel to a domain
+// RegisteredModel.
+func registeredModelFromProto(rm *mlflowpb.RegisteredModel) RegisteredModel {
+	if rm == nil {
+		return RegisteredModel{}
+	}
+	model := RegisteredModel{
+		Name:        rm.GetName(),
+		Description: rm.GetDescription(),
+		UserID:      rm.GetUserId(),
+		Aliases:     make(map[string]string, len(rm.GetAliases())),
+		Tags:        make(map[string]string, len(rm.GetTags())),
+	}
+	if rm.CreationTimestamp != nil {
+		model.CreationTime = time.UnixMilli(*rm.CreationTimestamp)
+	}
+	if rm.LastUpdatedTimestamp != nil {
+		model.LastUpdatedTime = time.UnixMilli(*rm.LastUpdatedTimestamp)
+	}
+	for _, mv := range rm.GetLatestVersions() {
+		model.LatestVersions = append(model.LatestVersions, modelVersionFromProto(mv))
+	}
+	for _, a := range rm.GetAliases() {
+		model.Aliases[a.GetAlias()] = a.GetVersion()
+	}
+	for _, t := range rm.GetTags() {
+		model.Tags[t.GetKey()] = t.GetValue()
+	}
+	return model
+}
+
+// modelVersionFromProto converts a protobuf ModelVersion to a domain
+// ModelVersion.
+func modelVersionFromProto(mv *mlflowpb.ModelVersion) ModelVersion {
+	if mv == nil {
+		return ModelVersion{}
+	}
+	version := ModelVersion{
+		Name:          mv.GetName(),
+		Version:       mv.GetVersion(),
+		Source:        mv.GetSource(),
+		RunID:         mv.GetRunId(),
+		ModelID:       mv.GetModelId(),
+		Description:   mv.GetDescription(),
+		UserID:        mv.GetUserId(),
+		CurrentStage:  mv.GetCurrentStage(),
+		StatusMessage: mv.GetStatusMessage(),
+		Aliases:       mv.GetAliases(),
+		Tags:          make(map[string]string, len(mv.GetTags())),
+	}
+	if mv.Status != nil {
+		version.Status = mv.GetStatus().String()
+	}
+	if mv.CreationTimestamp != nil {
+		version.CreationTime = time.UnixMilli(*mv.CreationTimestamp)
+	}
+	if mv.LastUpdatedTimestamp != nil {
+		version.LastUpdatedTime = time.UnixMilli(*mv.LastUpdatedTimestamp)
+	}
+	for _, t := range mv.GetTags() {
+		version.Tags[t.GetKey()] = t.GetValue()
+	}
+	return version
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// API is the set of methods implemented by Client. Accept an API instead of
+// a *Client in code you want to unit test without an MLflow server, and pass
+// a mlflowmock.Models in tests.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to stay source compatible.
+type API interface {
+	CreateLoggedModel(ctx context.Context, experimentID string, opts ...CreateLoggedModelOption) (*LoggedModel, error)
+	GetLoggedModel(ctx context.Context, modelID string) (*LoggedModel, error)
+	FinalizeLoggedModel(ctx context.Context, modelID string, status LoggedModelStatus) (*LoggedModel, error)
+	DeleteLoggedModel(ctx context.Context, modelID string, opts ...DeleteOption) error
+	SearchLoggedModels(ctx context.Context, experimentIDs []string, opts ...SearchLoggedModelsOption) (*LoggedModelList, error)
+	SearchLoggedModelsCursor(experimentIDs []string, opts ...SearchLoggedModelsOption) *Cursor[LoggedModel]
+	SetLoggedModelTags(ctx context.Context, modelID string, tags map[string]string) error
+	DeleteLoggedModelTag(ctx context.Context, modelID, key string) error
+	LogLoggedModelParams(ctx context.Context, modelID string, params map[string]string) error
+	LogMetric(ctx context.Context, modelID, runID, key string, value float64, opts ...tracking.LogMetricOption) error
+}
+
+var _ API = (*Client)(nil)
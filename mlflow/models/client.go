@@ -0,0 +1,419 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/conv"
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// defaultSearchMaxResults is the default page size for SearchLoggedModels.
+// Matches the server's maximum.
+const defaultSearchMaxResults = 50
+
+// Client provides operations for MLflow logged models.
+// It is safe for concurrent use.
+//
+// The logged model APIs were added in MLflow 3.0; older servers return 404.
+type Client struct {
+	transport *transport.Client
+	tracking  *tracking.Client
+}
+
+// NewClient creates a new Models client. Model metrics are logged through tc.
+// This is typically called internally by the root mlflow.Client.
+func NewClient(t *transport.Client, tc *tracking.Client) *Client {
+	return &Client{transport: t, tracking: tc}
+}
+
+// CreateLoggedModel creates a logged model in an experiment. The model starts
+// in LoggedModelStatusPending; call FinalizeLoggedModel once its artifacts
+// are uploaded.
+func (c *Client) CreateLoggedModel(ctx context.Context, experimentID string, opts ...CreateLoggedModelOption) (*LoggedModel, error) {
+	if experimentID == "" {
+		return nil, fmt.Errorf("mlflow: experiment ID is required")
+	}
+
+	o := &createLoggedModelOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	req := &mlflowpb.CreateLoggedModel{
+		ExperimentId: &experimentID,
+		Params:       paramsToProto(o.params),
+		Tags:         tagsToProto(o.tags),
+	}
+	if o.name != "" {
+		req.Name = &o.name
+	}
+	if o.modelType != "" {
+		req.ModelType = &o.modelType
+	}
+	if o.sourceRunID != "" {
+		req.SourceRunId = &o.sourceRunID
+	}
+
+	var resp mlflowpb.CreateLoggedModel_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/logged-models", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logged model: %w", err)
+	}
+
+	// The server always returns the created model; an empty response means
+	// the request was not sent (dry-run), so describe what would have been
+	// created.
+	if resp.Model == nil {
+		now := time.Now().UnixMilli()
+		resp.Model = &mlflowpb.LoggedModel{
+			Info: &mlflowpb.LoggedModelInfo{
+				ModelId:                conv.Ptr(tracking.DryRunID),
+				ExperimentId:           req.ExperimentId,
+				Name:                   req.Name,
+				ModelType:              req.ModelType,
+				SourceRunId:            req.SourceRunId,
+				Status:                 mlflowpb.LoggedModelStatus_LOGGED_MODEL_PENDING.Enum(),
+				CreationTimestampMs:    &now,
+				LastUpdatedTimestampMs: &now,
+				Tags:                   req.Tags,
+			},
+			Data: &mlflowpb.LoggedModelData{Params: req.Params},
+		}
+	}
+
+	model := loggedModelFromProto(resp.Model)
+	return &model, nil
+}
+
+// GetLoggedModel retrieves a logged model by ID.
+func (c *Client) GetLoggedModel(ctx context.Context, modelID string) (*LoggedModel, error) {
+	if modelID == "" {
+		return nil, fmt.Errorf("mlflow: model ID is required")
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp mlflowpb.GetLoggedModel_Response
+
+	err = c.transport.Get(ctx, path, nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logged model: %w", err)
+	}
+
+	model := loggedModelFromProto(resp.Model)
+	return &model, nil
+}
+
+// FinalizeLoggedModel sets the final status of a logged model after its
+// artifacts are uploaded. status must be LoggedModelStatusReady or
+// LoggedModelStatusUploadFailed.
+func (c *Client) FinalizeLoggedModel(ctx context.Context, modelID string, status LoggedModelStatus) (*LoggedModel, error) {
+	if modelID == "" {
+		return nil, fmt.Errorf("mlflow: model ID is required")
+	}
+	protoStatus, ok := loggedModelStatusToProto[status]
+	if !ok {
+		return nil, fmt.Errorf("mlflow: invalid logged model status: %s", status)
+	}
+
+	req := &mlflowpb.FinalizeLoggedModel{
+		ModelId: &modelID,
+		Status:  &protoStatus,
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp mlflowpb.FinalizeLoggedModel_Response
+
+	err = c.transport.Patch(ctx, path, req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize logged model: %w", err)
+	}
+
+	// An empty response means the request was not sent (dry-run)
+	if resp.Model == nil {
+		resp.Model = &mlflowpb.LoggedModel{
+			Info: &mlflowpb.LoggedModelInfo{ModelId: req.ModelId, Status: req.Status},
+		}
+	}
+
+	model := loggedModelFromProto(resp.Model)
+	return &model, nil
+}
+
+// DeleteLoggedModel deletes a logged model.
+// On a client with delete protection, confirm the ID with WithConfirm.
+func (c *Client) DeleteLoggedModel(ctx context.Context, modelID string, opts ...DeleteOption) error {
+	if modelID == "" {
+		return fmt.Errorf("mlflow: model ID is required")
+	}
+	var o deleteOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.confirm == modelID {
+		// Scoped to this call only
+		ctx = transport.WithConfirm(ctx, modelID)
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return err
+	}
+
+	var resp mlflowpb.DeleteLoggedModel_Response
+
+	err = c.transport.Delete(ctx, path, nil, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to delete logged model: %w", err)
+	}
+
+	return nil
+}
+
+// SearchLoggedModels searches for logged models in the given experiments.
+func (c *Client) SearchLoggedModels(ctx context.Context, experimentIDs []string, opts ...SearchLoggedModelsOption) (*LoggedModelList, error) {
+	if len(experimentIDs) == 0 {
+		return nil, fmt.Errorf("mlflow: at least one experiment ID is required")
+	}
+
+	o := &searchLoggedModelsOptions{
+		maxResults: defaultSearchMaxResults,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.maxResults <= 0 {
+		return nil, fmt.Errorf("mlflow: max results must be positive")
+	}
+
+	req := &mlflowpb.SearchLoggedModels{
+		ExperimentIds: experimentIDs,
+	}
+	if o.filter != "" {
+		req.Filter = &o.filter
+	}
+	n := o.maxResults
+	if n > math.MaxInt32 {
+		n = math.MaxInt32
+	}
+	maxResults := int32(n) //nolint:gosec // bounds checked above
+	req.MaxResults = &maxResults
+	if o.pageToken != "" {
+		req.PageToken = &o.pageToken
+	}
+	for _, d := range o.datasets {
+		if d.Name == "" {
+			return nil, fmt.Errorf("mlflow: dataset name is required")
+		}
+		ds := &mlflowpb.SearchLoggedModels_Dataset{DatasetName: &d.Name}
+		if d.Digest != "" {
+			ds.DatasetDigest = &d.Digest
+		}
+		req.Datasets = append(req.Datasets, ds)
+	}
+	for _, ob := range o.orderBy {
+		if ob.Field == "" {
+			return nil, fmt.Errorf("mlflow: order by field is required")
+		}
+		if ob.DatasetDigest != "" && ob.DatasetName == "" {
+			return nil, fmt.Errorf("mlflow: order by dataset digest requires a dataset name")
+		}
+		pob := &mlflowpb.SearchLoggedModels_OrderBy{
+			FieldName: &ob.Field,
+			Ascending: &ob.Ascending,
+		}
+		if ob.DatasetName != "" {
+			pob.DatasetName = &ob.DatasetName
+		}
+		if ob.DatasetDigest != "" {
+			pob.DatasetDigest = &ob.DatasetDigest
+		}
+		req.OrderBy = append(req.OrderBy, pob)
+	}
+
+	var resp mlflowpb.SearchLoggedModels_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/logged-models/search", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search logged models: %w", err)
+	}
+
+	result := &LoggedModelList{
+		Models:        make([]LoggedModel, 0, len(resp.Models)),
+		NextPageToken: resp.GetNextPageToken(),
+	}
+	for _, m := range resp.Models {
+		result.Models = append(result.Models, loggedModelFromProto(m))
+	}
+
+	return result, nil
+}
+
+// SearchLoggedModelsCursor returns a cursor over all pages of
+// SearchLoggedModels results. WithModelsPageToken sets the starting page;
+// later pages are fetched on demand.
+func (c *Client) SearchLoggedModelsCursor(experimentIDs []string, opts ...SearchLoggedModelsOption) *Cursor[LoggedModel] {
+	o := &searchLoggedModelsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[LoggedModel], error) {
+		pageOpts := append(slices.Clone(opts), WithModelsPageToken(pageToken))
+		list, err := c.SearchLoggedModels(ctx, experimentIDs, pageOpts...)
+		if err != nil {
+			return Page[LoggedModel]{}, err
+		}
+		return Page[LoggedModel]{Items: list.Models, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, o.pageToken)
+}
+
+// SetLoggedModelTags sets tags on a logged model, overwriting existing values
+// for the same keys.
+func (c *Client) SetLoggedModelTags(ctx context.Context, modelID string, tags map[string]string) error {
+	if modelID == "" {
+		return fmt.Errorf("mlflow: model ID is required")
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	req := &mlflowpb.SetLoggedModelTags{
+		ModelId: &modelID,
+		Tags:    tagsToProto(tags),
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return err
+	}
+
+	var resp mlflowpb.SetLoggedModelTags_Response
+
+	err = c.transport.Patch(ctx, path+"/tags", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to set logged model tags: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLoggedModelTag removes a tag from a logged model.
+func (c *Client) DeleteLoggedModelTag(ctx context.Context, modelID, key string) error {
+	if modelID == "" {
+		return fmt.Errorf("mlflow: model ID is required")
+	}
+	if key == "" {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return err
+	}
+	keySegment, err := transport.PathSegment(key)
+	if err != nil {
+		return fmt.Errorf("mlflow: invalid tag key: %w", err)
+	}
+
+	var resp mlflowpb.DeleteLoggedModelTag_Response
+
+	err = c.transport.Delete(ctx, path+"/tags/"+keySegment, nil, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to delete logged model tag: %w", err)
+	}
+
+	return nil
+}
+
+// LogLoggedModelParams logs params to a logged model. Params cannot be changed
+// once logged.
+func (c *Client) LogLoggedModelParams(ctx context.Context, modelID string, params map[string]string) error {
+	if modelID == "" {
+		return fmt.Errorf("mlflow: model ID is required")
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	req := &mlflowpb.LogLoggedModelParamsRequest{
+		ModelId: &modelID,
+		Params:  paramsToProto(params),
+	}
+
+	path, err := modelPath(modelID)
+	if err != nil {
+		return err
+	}
+
+	var resp mlflowpb.LogLoggedModelParamsRequest_Response
+
+	err = c.transport.Post(ctx, path+"/params", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to log logged model params: %w", err)
+	}
+
+	return nil
+}
+
+// LogMetric logs a metric value for a logged model. MLflow records model
+// metrics within a run, such as the evaluation run that computed them, so
+// runID is required. It is equivalent to tracking's LogMetric with
+// tracking.WithModelID, and accepts the same options.
+func (c *Client) LogMetric(ctx context.Context, modelID, runID, key string, value float64, opts ...tracking.LogMetricOption) error {
+	if modelID == "" {
+		return fmt.Errorf("mlflow: model ID is required")
+	}
+	opts = append(slices.Clone(opts), tracking.WithModelID(modelID))
+	return c.tracking.LogMetric(ctx, runID, key, value, opts...)
+}
+
+// modelPath returns the escaped REST path of a logged model. The ID is
+// escaped as one path segment, so it cannot address another model or
+// endpoint.
+func modelPath(modelID string) (string, error) {
+	segment, err := transport.PathSegment(modelID)
+	if err != nil {
+		return "", fmt.Errorf("mlflow: invalid model ID: %w", err)
+	}
+	return "/api/2.0/mlflow/logged-models/" + segment, nil
+}
+
+// paramsToProto converts params to protobuf, sorted by key.
+func paramsToProto(params map[string]string) []*mlflowpb.LoggedModelParameter {
+	out := make([]*mlflowpb.LoggedModelParameter, 0, len(params))
+	for _, k := range slices.Sorted(maps.Keys(params)) {
+		v := params[k]
+		out = append(out, &mlflowpb.LoggedModelParameter{Key: &k, Value: &v})
+	}
+	return out
+}
+
+// tagsToProto converts tags to protobuf, sorted by key.
+func tagsToProto(tags map[string]string) []*mlflowpb.LoggedModelTag {
+	out := make([]*mlflowpb.LoggedModelTag, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		v := tags[k]
+		out = append(out, &mlflowpb.LoggedModelTag{Key: &k, Value: &v})
+	}
+	return out
+}
@@ -0,0 +1,491 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	tc, err := transport.New(transport.Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+
+	return NewClient(tc, tracking.NewClient(tc))
+}
+
+func mustDecodeJSON(t *testing.T, r *http.Request, dst any) {
+	t.Helper()
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+}
+
+func mustEncodeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Fatalf("failed to encode response: %v", err)
+	}
+}
+
+// loggedModelJSON is a logged model payload as returned by MLflow 3.
+func loggedModelJSON(modelID, status string) map[string]any {
+	return map[string]any{
+		"info": map[string]any{
+			"model_id":                  modelID,
+			"experiment_id":             "7",
+			"name":                      "agent",
+			"model_type":                "Agent",
+			"source_run_id":             "run-1",
+			"artifact_uri":              "mlflow-artifacts:/7/models/" + modelID + "/artifacts",
+			"status":                    status,
+			"creation_timestamp_ms":     1748772000000,
+			"last_updated_timestamp_ms": 1748772060000,
+			"tags":                      []map[string]any{{"key": "env", "value": "prod"}},
+			"registrations":             []map[string]any{{"name": "support-agent", "version": "2"}},
+		},
+		"data": map[string]any{
+			"params": []map[string]any{{"key": "temperature", "value": "0.2"}},
+			"metrics": []map[string]any{{
+				"key":            "accuracy",
+				"value":          0.91,
+				"timestamp":      1748772030000,
+				"step":           0,
+				"model_id":       modelID,
+				"dataset_name":   "eval",
+				"dataset_digest": "abc123",
+			}},
+		},
+	}
+}
+
+func TestCreateLoggedModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/2.0/mlflow/logged-models" {
+			t.Errorf("request = %s %s, want POST /api/2.0/mlflow/logged-models", r.Method, r.URL.Path)
+		}
+
+		var body map[string]any
+		mustDecodeJSON(t, r, &body)
+		if body["experiment_id"] != "7" || body["name"] != "agent" || body["model_type"] != "Agent" || body["source_run_id"] != "run-1" {
+			t.Errorf("body = %v", body)
+		}
+		params, _ := body["params"].([]any)
+		if len(params) != 2 {
+			t.Fatalf("params = %v, want 2", body["params"])
+		}
+		// Params are sent sorted by key
+		if first, _ := params[0].(map[string]any); first["key"] != "max_tokens" {
+			t.Errorf("params[0] = %v, want max_tokens", first)
+		}
+		tags, _ := body["tags"].([]any)
+		if len(tags) != 1 {
+			t.Errorf("tags = %v, want 1", body["tags"])
+		}
+
+		mustEncodeJSON(t, w, map[string]any{"model": loggedModelJSON("m-1", "LOGGED_MODEL_PENDING")})
+	}))
+
+	model, err := client.CreateLoggedModel(context.Background(), "7",
+		WithModelName("agent"),
+		WithModelType("Agent"),
+		WithSourceRunID("run-1"),
+		WithModelParams(map[string]string{"temperature": "0.2", "max_tokens": "256"}),
+		WithModelTags(map[string]string{"env": "prod"}),
+	)
+	if err != nil {
+		t.Fatalf("CreateLoggedModel() error = %v", err)
+	}
+	if model.ModelID != "m-1" || model.Status != LoggedModelStatusPending {
+		t.Errorf("model = %+v", model)
+	}
+}
+
+func TestCreateLoggedModel_RequiresExperimentID(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := client.CreateLoggedModel(context.Background(), ""); err == nil {
+		t.Error("expected error for empty experiment ID")
+	}
+}
+
+func TestGetLoggedModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1" {
+			t.Errorf("request = %s %s, want GET /api/2.0/mlflow/logged-models/m-1", r.Method, r.URL.Path)
+		}
+		mustEncodeJSON(t, w, map[string]any{"model": loggedModelJSON("m-1", "LOGGED_MODEL_READY")})
+	}))
+
+	model, err := client.GetLoggedModel(context.Background(), "m-1")
+	if err != nil {
+		t.Fatalf("GetLoggedModel() error = %v", err)
+	}
+
+	if model.ExperimentID != "7" || model.Name != "agent" || model.ModelType != "Agent" || model.SourceRunID != "run-1" {
+		t.Errorf("model = %+v", model)
+	}
+	if model.Status != LoggedModelStatusReady {
+		t.Errorf("Status = %q, want %q", model.Status, LoggedModelStatusReady)
+	}
+	if !model.CreationTime.Equal(time.UnixMilli(1748772000000)) || !model.UpdateTime.Equal(time.UnixMilli(1748772060000)) {
+		t.Errorf("times = %v, %v", model.CreationTime, model.UpdateTime)
+	}
+	if model.Tags["env"] != "prod" || model.Params["temperature"] != "0.2" {
+		t.Errorf("tags = %v, params = %v", model.Tags, model.Params)
+	}
+	if len(model.Registrations) != 1 || model.Registrations[0] != (Registration{Name: "support-agent", Version: "2"}) {
+		t.Errorf("Registrations = %v", model.Registrations)
+	}
+	if len(model.Metrics) != 1 {
+		t.Fatalf("Metrics = %v, want 1", model.Metrics)
+	}
+	m := model.Metrics[0]
+	if m.Key != "accuracy" || m.Value != 0.91 || m.ModelID != "m-1" || m.DatasetName != "eval" || m.DatasetDigest != "abc123" {
+		t.Errorf("metric = %+v", m)
+	}
+}
+
+func TestGetLoggedModel_NotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		mustEncodeJSON(t, w, map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    "Logged model m-9 not found",
+		})
+	}))
+
+	_, err := client.GetLoggedModel(context.Background(), "m-9")
+	if !errors.IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+}
+
+func TestFinalizeLoggedModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1" {
+			t.Errorf("request = %s %s, want PATCH /api/2.0/mlflow/logged-models/m-1", r.Method, r.URL.Path)
+		}
+
+		var body map[string]any
+		mustDecodeJSON(t, r, &body)
+		// Enums are sent by number, which the server accepts
+		if body["model_id"] != "m-1" || body["status"] != float64(2) {
+			t.Errorf("body = %v", body)
+		}
+
+		mustEncodeJSON(t, w, map[string]any{"model": loggedModelJSON("m-1", "LOGGED_MODEL_READY")})
+	}))
+
+	model, err := client.FinalizeLoggedModel(context.Background(), "m-1", LoggedModelStatusReady)
+	if err != nil {
+		t.Fatalf("FinalizeLoggedModel() error = %v", err)
+	}
+	if model.Status != LoggedModelStatusReady {
+		t.Errorf("Status = %q, want %q", model.Status, LoggedModelStatusReady)
+	}
+}
+
+func TestFinalizeLoggedModel_InvalidStatus(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := client.FinalizeLoggedModel(context.Background(), "m-1", LoggedModelStatusPending); err == nil {
+		t.Error("expected error for pending status")
+	}
+}
+
+func TestDeleteLoggedModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1" {
+			t.Errorf("request = %s %s, want DELETE /api/2.0/mlflow/logged-models/m-1", r.Method, r.URL.Path)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	if err := client.DeleteLoggedModel(context.Background(), "m-1"); err != nil {
+		t.Fatalf("DeleteLoggedModel() error = %v", err)
+	}
+}
+
+func TestDeleteLoggedModel_EscapesID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.EscapedPath(); got != "/api/2.0/mlflow/logged-models/x%2F..%2Fm-2" {
+			t.Errorf("escaped path = %q, want the ID as one segment", got)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+	t.Cleanup(server.Close)
+	tc, err := transport.New(transport.Config{BaseURL: server.URL, DeleteProtection: true})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+	client := NewClient(tc, tracking.NewClient(tc))
+	ctx := context.Background()
+
+	if err := client.DeleteLoggedModel(ctx, "x/../m-2"); !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Errorf("DeleteLoggedModel() without confirmation error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if err := client.DeleteLoggedModel(ctx, "x/../m-2", WithConfirm("x/../m-2")); err != nil {
+		t.Errorf("DeleteLoggedModel() error = %v", err)
+	}
+	if err := client.DeleteLoggedModel(ctx, "..", WithConfirm("..")); err == nil {
+		t.Error("DeleteLoggedModel(\"..\") error = nil")
+	}
+}
+
+func TestSearchLoggedModels(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/2.0/mlflow/logged-models/search" {
+			t.Errorf("request = %s %s, want POST /api/2.0/mlflow/logged-models/search", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			ExperimentIDs []string `json:"experiment_ids"`
+			Filter        string   `json:"filter"`
+			MaxResults    int      `json:"max_results"`
+			PageToken     string   `json:"page_token"`
+			Datasets      []struct {
+				DatasetName   string `json:"dataset_name"`
+				DatasetDigest string `json:"dataset_digest"`
+			} `json:"datasets"`
+			OrderBy []struct {
+				FieldName   string `json:"field_name"`
+				Ascending   bool   `json:"ascending"`
+				DatasetName string `json:"dataset_name"`
+			} `json:"order_by"`
+		}
+		mustDecodeJSON(t, r, &body)
+		if len(body.ExperimentIDs) != 1 || body.ExperimentIDs[0] != "7" {
+			t.Errorf("experiment_ids = %v", body.ExperimentIDs)
+		}
+		if body.Filter != "metrics.accuracy > 0.9" || body.MaxResults != 10 || body.PageToken != "p1" {
+			t.Errorf("body = %+v", body)
+		}
+		if len(body.Datasets) != 1 || body.Datasets[0].DatasetName != "eval" || body.Datasets[0].DatasetDigest != "abc123" {
+			t.Errorf("datasets = %+v", body.Datasets)
+		}
+		if len(body.OrderBy) != 1 || body.OrderBy[0].FieldName != "metrics.accuracy" || body.OrderBy[0].Ascending || body.OrderBy[0].DatasetName != "eval" {
+			t.Errorf("order_by = %+v", body.OrderBy)
+		}
+
+		mustEncodeJSON(t, w, map[string]any{
+			"models":          []any{loggedModelJSON("m-1", "LOGGED_MODEL_READY")},
+			"next_page_token": "p2",
+		})
+	}))
+
+	list, err := client.SearchLoggedModels(context.Background(), []string{"7"},
+		WithModelsFilter("metrics.accuracy > 0.9"),
+		WithModelsMaxResults(10),
+		WithModelsPageToken("p1"),
+		WithModelsDatasets(Dataset{Name: "eval", Digest: "abc123"}),
+		WithModelsOrderBy(OrderBy{Field: "metrics.accuracy", DatasetName: "eval"}),
+	)
+	if err != nil {
+		t.Fatalf("SearchLoggedModels() error = %v", err)
+	}
+	if len(list.Models) != 1 || list.Models[0].ModelID != "m-1" || list.NextPageToken != "p2" {
+		t.Errorf("list = %+v", list)
+	}
+}
+
+func TestSearchLoggedModels_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		experimentIDs []string
+		opts          []SearchLoggedModelsOption
+	}{
+		{"no experiments", nil, nil},
+		{"zero max results", []string{"7"}, []SearchLoggedModelsOption{WithModelsMaxResults(0)}},
+		{"dataset without name", []string{"7"}, []SearchLoggedModelsOption{WithModelsDatasets(Dataset{Digest: "abc"})}},
+		{"order by without field", []string{"7"}, []SearchLoggedModelsOption{WithModelsOrderBy(OrderBy{})}},
+		{"digest without dataset name", []string{"7"}, []SearchLoggedModelsOption{WithModelsOrderBy(OrderBy{Field: "metrics.a", DatasetDigest: "abc"})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.SearchLoggedModels(ctx, tt.experimentIDs, tt.opts...); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestSearchLoggedModelsCursor(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &body)
+
+		switch body.PageToken {
+		case "":
+			mustEncodeJSON(t, w, map[string]any{
+				"models":          []any{loggedModelJSON("m-1", "LOGGED_MODEL_READY")},
+				"next_page_token": "p2",
+			})
+		case "p2":
+			mustEncodeJSON(t, w, map[string]any{
+				"models": []any{loggedModelJSON("m-2", "LOGGED_MODEL_READY")},
+			})
+		default:
+			t.Errorf("unexpected page token %q", body.PageToken)
+		}
+	}))
+
+	all, err := client.SearchLoggedModelsCursor([]string{"7"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	var ids []string
+	for _, m := range all {
+		ids = append(ids, m.ModelID)
+	}
+	if len(ids) != 2 || ids[0] != "m-1" || ids[1] != "m-2" {
+		t.Errorf("ids = %v, want [m-1 m-2]", ids)
+	}
+}
+
+func TestSetLoggedModelTags(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1/tags" {
+			t.Errorf("request = %s %s, want PATCH /api/2.0/mlflow/logged-models/m-1/tags", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			ModelID string `json:"model_id"`
+			Tags    []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"tags"`
+		}
+		mustDecodeJSON(t, r, &body)
+		if body.ModelID != "m-1" || len(body.Tags) != 2 || body.Tags[0].Key != "env" || body.Tags[1].Value != "alice" {
+			t.Errorf("body = %+v", body)
+		}
+
+		mustEncodeJSON(t, w, map[string]any{"model": loggedModelJSON("m-1", "LOGGED_MODEL_READY")})
+	}))
+
+	err := client.SetLoggedModelTags(context.Background(), "m-1", map[string]string{"owner": "alice", "env": "prod"})
+	if err != nil {
+		t.Fatalf("SetLoggedModelTags() error = %v", err)
+	}
+}
+
+func TestDeleteLoggedModelTag(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1/tags/model owner" {
+			t.Errorf("request = %s %s, want DELETE /api/2.0/mlflow/logged-models/m-1/tags/model owner", r.Method, r.URL.Path)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	if err := client.DeleteLoggedModelTag(context.Background(), "m-1", "model owner"); err != nil {
+		t.Fatalf("DeleteLoggedModelTag() error = %v", err)
+	}
+}
+
+func TestLogLoggedModelParams(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/2.0/mlflow/logged-models/m-1/params" {
+			t.Errorf("request = %s %s, want POST /api/2.0/mlflow/logged-models/m-1/params", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			ModelID string `json:"model_id"`
+			Params  []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"params"`
+		}
+		mustDecodeJSON(t, r, &body)
+		if body.ModelID != "m-1" || len(body.Params) != 1 || body.Params[0].Key != "temperature" || body.Params[0].Value != "0.2" {
+			t.Errorf("body = %+v", body)
+		}
+
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	if err := client.LogLoggedModelParams(context.Background(), "m-1", map[string]string{"temperature": "0.2"}); err != nil {
+		t.Fatalf("LogLoggedModelParams() error = %v", err)
+	}
+}
+
+func TestLogMetric(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/runs/log-metric" {
+			t.Errorf("path = %s, want /api/2.0/mlflow/runs/log-metric", r.URL.Path)
+		}
+
+		var body map[string]any
+		mustDecodeJSON(t, r, &body)
+		if body["run_id"] != "run-1" || body["model_id"] != "m-1" || body["key"] != "accuracy" || body["dataset_name"] != "eval" {
+			t.Errorf("body = %v", body)
+		}
+
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	err := client.LogMetric(context.Background(), "m-1", "run-1", "accuracy", 0.91, tracking.WithDataset("eval", "abc123"))
+	if err != nil {
+		t.Fatalf("LogMetric() error = %v", err)
+	}
+}
+
+func TestLogMetric_RequiresModelID(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if err := client.LogMetric(context.Background(), "", "run-1", "accuracy", 0.91); err == nil {
+		t.Error("expected error for empty model ID")
+	}
+}
+
+func TestClient_DryRun_LoggedModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	ctx := transport.WithDryRun(context.Background())
+
+	model, err := client.CreateLoggedModel(ctx, "1", WithModelName("clf"), WithModelParams(map[string]string{"lr": "0.01"}))
+	if err != nil {
+		t.Fatalf("CreateLoggedModel() error = %v", err)
+	}
+	if model.ModelID != tracking.DryRunID || model.ExperimentID != "1" || model.Name != "clf" {
+		t.Errorf("model = %+v, want a dry-run placeholder for clf in experiment 1", model)
+	}
+	if model.Status != LoggedModelStatusPending || model.CreationTime.IsZero() || model.Params["lr"] != "0.01" {
+		t.Errorf("model = %+v, want pending with params and a creation time", model)
+	}
+
+	model, err = client.FinalizeLoggedModel(ctx, model.ModelID, LoggedModelStatusReady)
+	if err != nil {
+		t.Fatalf("FinalizeLoggedModel() error = %v", err)
+	}
+	if model.ModelID != tracking.DryRunID || model.Status != LoggedModelStatusReady {
+		t.Errorf("model = %+v, want the dry-run placeholder marked ready", model)
+	}
+}
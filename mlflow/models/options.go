@@ -0,0 +1,116 @@
+package models
+
+// createLoggedModelOptions holds configuration for a CreateLoggedModel call.
+type createLoggedModelOptions struct {
+	name        string
+	modelType   string
+	sourceRunID string
+	params      map[string]string
+	tags        map[string]string
+}
+
+// CreateLoggedModelOption configures a CreateLoggedModel call.
+type CreateLoggedModelOption func(*createLoggedModelOptions)
+
+// WithModelName sets the model name. The server generates one if unset.
+func WithModelName(name string) CreateLoggedModelOption {
+	return func(o *createLoggedModelOptions) {
+		o.name = name
+	}
+}
+
+// WithModelType sets the kind of model, such as "Agent", "Classifier" or "LLM".
+func WithModelType(modelType string) CreateLoggedModelOption {
+	return func(o *createLoggedModelOptions) {
+		o.modelType = modelType
+	}
+}
+
+// WithSourceRunID links the model to the run that created it.
+func WithSourceRunID(runID string) CreateLoggedModelOption {
+	return func(o *createLoggedModelOptions) {
+		o.sourceRunID = runID
+	}
+}
+
+// WithModelParams sets the model's params. Params cannot be changed after
+// they are logged.
+func WithModelParams(params map[string]string) CreateLoggedModelOption {
+	return func(o *createLoggedModelOptions) {
+		o.params = params
+	}
+}
+
+// WithModelTags sets the model's tags.
+func WithModelTags(tags map[string]string) CreateLoggedModelOption {
+	return func(o *createLoggedModelOptions) {
+		o.tags = tags
+	}
+}
+
+// searchLoggedModelsOptions holds configuration for a SearchLoggedModels call.
+type searchLoggedModelsOptions struct {
+	filter     string
+	datasets   []Dataset
+	maxResults int
+	orderBy    []OrderBy
+	pageToken  string
+}
+
+// SearchLoggedModelsOption configures a SearchLoggedModels call.
+type SearchLoggedModelsOption func(*searchLoggedModelsOptions)
+
+// WithModelsFilter sets the search filter string for logged models
+// (e.g., "params.alpha < 0.3 AND metrics.accuracy > 0.9").
+func WithModelsFilter(filter string) SearchLoggedModelsOption {
+	return func(o *searchLoggedModelsOptions) {
+		o.filter = filter
+	}
+}
+
+// WithModelsDatasets restricts metric filters to values computed on the
+// given datasets. By default metrics from any dataset are considered.
+func WithModelsDatasets(datasets ...Dataset) SearchLoggedModelsOption {
+	return func(o *searchLoggedModelsOptions) {
+		o.datasets = datasets
+	}
+}
+
+// WithModelsMaxResults sets the maximum number of models per page.
+// The server caps this at 50.
+func WithModelsMaxResults(n int) SearchLoggedModelsOption {
+	return func(o *searchLoggedModelsOptions) {
+		o.maxResults = n
+	}
+}
+
+// WithModelsOrderBy sets the ordering for model results.
+func WithModelsOrderBy(keys ...OrderBy) SearchLoggedModelsOption {
+	return func(o *searchLoggedModelsOptions) {
+		o.orderBy = keys
+	}
+}
+
+// WithModelsPageToken sets the pagination token for fetching the next page.
+func WithModelsPageToken(token string) SearchLoggedModelsOption {
+	return func(o *searchLoggedModelsOptions) {
+		o.pageToken = token
+	}
+}
+
+// deleteOptions holds configuration for a DeleteLoggedModel call.
+type deleteOptions struct {
+	confirm string
+}
+
+// DeleteOption configures a DeleteLoggedModel call.
+type DeleteOption func(*deleteOptions)
+
+// WithConfirm confirms that the call deletes the logged model id, as a
+// client created with mlflow.WithDeleteProtection requires; without
+// protection it has no effect. It confirms only the call it is passed to.
+func WithConfirm(id string) DeleteOption {
+	return func(o *deleteOptions) {
+		o.confirm = id
+	}
+}
@@ -0,0 +1,140 @@
+// Package models provides types and operations for MLflow logged models.
+package models
+
+import (
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// LoggedModelStatus is the upload state of a logged model.
+type LoggedModelStatus string
+
+// Logged model status constants.
+const (
+	LoggedModelStatusUnspecified  LoggedModelStatus = "LOGGED_MODEL_STATUS_UNSPECIFIED"
+	LoggedModelStatusPending      LoggedModelStatus = "LOGGED_MODEL_PENDING"
+	LoggedModelStatusReady        LoggedModelStatus = "LOGGED_MODEL_READY"
+	LoggedModelStatusUploadFailed LoggedModelStatus = "LOGGED_MODEL_UPLOAD_FAILED"
+)
+
+// loggedModelStatusToProto maps the statuses a model can be finalized with
+// to protobuf enum values.
+var loggedModelStatusToProto = map[LoggedModelStatus]mlflowpb.LoggedModelStatus{
+	LoggedModelStatusReady:        mlflowpb.LoggedModelStatus_LOGGED_MODEL_READY,
+	LoggedModelStatusUploadFailed: mlflowpb.LoggedModelStatus_LOGGED_MODEL_UPLOAD_FAILED,
+}
+
+// LoggedModel is a model logged to an experiment, such as an agent, a
+// fine-tuned LLM or a classifier. LoggedModels were added in MLflow 3.0.
+type LoggedModel struct {
+	ModelID      string
+	ExperimentID string
+	Name         string
+	// ModelType describes the model, such as "Agent", "Classifier" or "LLM".
+	ModelType string
+	// SourceRunID is the run that created the model, if any.
+	SourceRunID   string
+	ArtifactURI   string
+	Status        LoggedModelStatus
+	StatusMessage string
+	CreationTime  time.Time
+	UpdateTime    time.Time
+	Tags          map[string]string
+	Params        map[string]string
+	// Metrics are the values logged against the model, from any run.
+	Metrics []tracking.Metric
+	// Registrations lists the registered model versions the model has been
+	// promoted to.
+	Registrations []Registration
+}
+
+// Registration identifies a registered model version created from a logged
+// model.
+type Registration struct {
+	Name    string
+	Version string
+}
+
+// LoggedModelList contains logged models and a pagination token.
+type LoggedModelList struct {
+	Models        []LoggedModel
+	NextPageToken string
+}
+
+// OrderBy is a sort key for SearchLoggedModels.
+type OrderBy struct {
+	// Field is the field to sort by, such as "creation_timestamp" or
+	// "metrics.accuracy".
+	Field     string
+	Ascending bool
+	// DatasetName and DatasetDigest restrict a metric sort key to values
+	// computed on that dataset. DatasetDigest requires DatasetName.
+	DatasetName   string
+	DatasetDigest string
+}
+
+// Dataset identifies the dataset that metric filters in SearchLoggedModels
+// apply to. Digest is optional.
+type Dataset struct {
+	Name   string
+	Digest string
+}
+
+// Page is one page of results from a Cursor.
+type Page[T any] = pagination.Page[T]
+
+// Cursor iterates over paginated results. See pagination.Cursor.
+type Cursor[T any] = pagination.Cursor[T]
+
+// loggedModelFromProto converts a protobuf LoggedModel to a domain LoggedModel.
+func loggedModelFromProto(m *mlflowpb.LoggedModel) LoggedModel {
+	info := m.GetInfo()
+	model := LoggedModel{
+		ModelID:       info.GetModelId(),
+		ExperimentID:  info.GetExperimentId(),
+		Name:          info.GetName(),
+		ModelType:     info.GetModelType(),
+		SourceRunID:   info.GetSourceRunId(),
+		ArtifactURI:   info.GetArtifactUri(),
+		Status:        LoggedModelStatus(info.GetStatus().String()),
+		StatusMessage: info.GetStatusMessage(),
+		Tags:          make(map[string]string, len(info.GetTags())),
+		Params:        make(map[string]string, len(m.GetData().GetParams())),
+	}
+	if ms := info.GetCreationTimestampMs(); ms != 0 {
+		model.CreationTime = time.UnixMilli(ms)
+	}
+	if ms := info.GetLastUpdatedTimestampMs(); ms != 0 {
+		model.UpdateTime = time.UnixMilli(ms)
+	}
+	for _, t := range info.GetTags() {
+		model.Tags[t.GetKey()] = t.GetValue()
+	}
+	for _, r := range info.GetRegistrations() {
+		model.Registrations = append(model.Registrations, Registration{
+			Name:    r.GetName(),
+			Version: r.GetVersion(),
+		})
+	}
+	for _, p := range m.GetData().GetParams() {
+		model.Params[p.GetKey()] = p.GetValue()
+	}
+	for _, pm := range m.GetData().GetMetrics() {
+		metric := tracking.Metric{
+			Key:           pm.GetKey(),
+			Value:         pm.GetValue(),
+			Step:          pm.GetStep(),
+			ModelID:       pm.GetModelId(),
+			DatasetName:   pm.GetDatasetName(),
+			DatasetDigest: pm.GetDatasetDigest(),
+		}
+		if pm.Timestamp != nil {
+			metric.Timestamp = time.UnixMilli(*pm.Timestamp)
+		}
+		model.Metrics = append(model.Metrics, metric)
+	}
+	return model
+}
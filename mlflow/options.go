@@ -5,16 +5,50 @@ import (
 	"maps"
 	"net/http"
 	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
 )
 
 // options holds the configuration for a Client.
 type options struct {
-	trackingURI string
-	headers     map[string]string
-	httpClient  *http.Client
-	logger      *slog.Logger
-	insecure    bool
-	timeout     time.Duration
+	trackingURI  string
+	headers      map[string]string
+	httpClient   *http.Client
+	logger       *slog.Logger
+	insecure     bool
+	timeout      time.Duration
+	dryRun       bool
+	auditHook    func(AuditEvent)
+	auditActor   string
+	singleflight bool
+
+	// timeoutProfile replaces timeout when set.
+	timeoutProfile *TimeoutProfile
+
+	requestSigner func(*http.Request) error
+
+	// failoverURIs are secondary tracking servers, in order of preference.
+	failoverURIs []string
+
+	// retryBudget caps failover retries when set.
+	retryBudget *RetryBudget
+
+	concurrencyLimits []transport.ConcurrencyLimit
+
+	// hedgeDelay enables hedged reads when positive.
+	hedgeDelay time.Duration
+
+	// readURI receives read requests when set.
+	readURI string
+
+	// userAgentSuffixes are appended to the default User-Agent.
+	userAgentSuffixes []string
+
+	// impersonateUser is sent in impersonationHeader when set.
+	impersonateUser     string
+	impersonationHeader string
+
+	deleteProtection bool
 }
 
 // Option configures a Client.
@@ -28,6 +62,87 @@ func WithTrackingURI(uri string) Option {
 	}
 }
 
+// WithTrackingURIs sets a primary MLflow server URL and secondary servers
+// for high-availability deployments, such as one server per region sharing a
+// backend store.
+//
+// Requests go to the primary until it fails with a connection error or a
+// server error, then to the next server. Reads fail over on any connection
+// error or 5xx; writes only when they cannot have reached the server (a
+// failed dial, or a 502 or 503 from a gateway), so a write is not
+// applied twice. Once failed over, the client stays on the secondary and
+// probes the primary's /health endpoint every 30 seconds, returning to it
+// when it responds.
+//
+// Artifact uploads stream their body and are not replayed on a secondary.
+// TrackingURI reports the primary.
+func WithTrackingURIs(primary string, secondaries ...string) Option {
+	return func(o *options) {
+		o.trackingURI = primary
+		o.failoverURIs = append([]string(nil), secondaries...)
+	}
+}
+
+// WithRetryBudget caps the requests this client sends again to a secondary
+// server (see WithTrackingURIs) relative to the requests it sends, so that a
+// systemic server failure does not turn into a retry storm from every pod
+// running the client. Once the budget is spent, a failing request returns
+// its error instead of failing over; refusals are counted in
+// Stats.RetriesDenied and reported to budget.OnExhausted.
+//
+//	client, err := mlflow.NewClient(
+//		mlflow.WithTrackingURIs(primary, secondary),
+//		mlflow.WithRetryBudget(mlflow.RetryBudget{Ratio: 0.1}),
+//	)
+func WithRetryBudget(budget RetryBudget) Option {
+	return func(o *options) {
+		o.retryBudget = &budget
+	}
+}
+
+// WithHedging makes read calls that have not responded within delay send a
+// second, identical request, and use whichever response arrives first,
+// canceling the other. This trims tail latency on latency-sensitive paths,
+// such as loading a prompt while serving a request, at the cost of extra
+// load on the server. Only GET requests are hedged; searches sent as POST
+// and writes never are. Pick a delay near the p95 latency of the calls to
+// hedge. Hedges count against WithRetryBudget, and are reported in
+// Stats.Hedges.
+func WithHedging(delay time.Duration) Option {
+	return func(o *options) {
+		o.hedgeDelay = delay
+	}
+}
+
+// WithConcurrencyLimit bounds the number of requests to endpoints matching
+// endpointPattern that the client has in flight at once, so fan-out in
+// calling code, such as one goroutine per artifact, cannot exhaust the
+// server's connections. Further requests wait for a free slot or until
+// their context ends. The pattern is an API path, optionally preceded by a
+// method and a space; a trailing "*" matches any rest of the path. May be
+// given more than once; a request waits for every limit it matches.
+//
+//	// At most 8 artifact uploads at a time
+//	mlflow.WithConcurrencyLimit("PUT /api/2.0/mlflow-artifacts/artifacts/*", 8)
+func WithConcurrencyLimit(endpointPattern string, n int) Option {
+	return func(o *options) {
+		o.concurrencyLimits = append(o.concurrencyLimits, transport.ConcurrencyLimit{Pattern: endpointPattern, N: n})
+	}
+}
+
+// WithReadURI routes requests that do not modify server state (gets,
+// searches, metric history, artifact downloads) to a read replica or caching
+// proxy at uri, while writes go to the tracking URI. Headers, signing, and
+// timeouts apply to both.
+//
+// A replica may lag behind the primary. Use ContextWithPrimary for reads
+// that must observe a write just made.
+func WithReadURI(uri string) Option {
+	return func(o *options) {
+		o.readURI = uri
+	}
+}
+
 // WithHeaders sets custom HTTP headers sent on every API request.
 // Use this to pass workspace headers, additional auth, or other metadata.
 func WithHeaders(headers map[string]string) Option {
@@ -39,6 +154,18 @@ func WithHeaders(headers map[string]string) Option {
 	}
 }
 
+// WithUserAgentSuffix appends an application identifier, such as
+// "my-service/1.2.3", to the User-Agent header, so server operators can tell
+// which service sent a request. The default User-Agent names the SDK and Go
+// versions. May be given more than once.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(o *options) {
+		if suffix != "" {
+			o.userAgentSuffixes = append(o.userAgentSuffixes, suffix)
+		}
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client.
 // Use this to configure timeouts, TLS, or proxies.
 // When a custom client is provided, WithTimeout is ignored;
@@ -68,9 +195,122 @@ func WithInsecure() Option {
 }
 
 // WithTimeout sets the default timeout for API operations.
-// Default: 30 seconds.
+// Default: 30 seconds. The timeout covers the whole request, including
+// artifact uploads; use WithTimeoutProfile to time those separately.
 func WithTimeout(d time.Duration) Option {
 	return func(o *options) {
 		o.timeout = d
 	}
 }
+
+// WithDryRun makes the client validate and log every mutating request
+// (create, update, delete, log) instead of sending it. Reads are still sent.
+// Mutating methods return synthesized results: the submitted data, zero
+// values where the server would have assigned them (version numbers,
+// timestamps), and tracking.DryRunID for new experiment, run, and logged
+// model IDs, so calls chained on them still work. Use ContextWithDryRun for a single call.
+func WithDryRun() Option {
+	return func(o *options) {
+		o.dryRun = true
+	}
+}
+
+// WithAuditHook registers a function called after every mutating API call
+// (create, update, delete, log) with the actor, endpoint, resource identifiers,
+// and outcome. Dry-run calls are reported with DryRun set.
+// The hook runs synchronously on the calling goroutine; hand events off to a
+// channel or queue if shipping them is slow.
+func WithAuditHook(hook func(AuditEvent)) Option {
+	return func(o *options) {
+		o.auditHook = hook
+	}
+}
+
+// WithAuditActor sets the actor reported in audit events.
+// Defaults to the operating system user.
+func WithAuditActor(actor string) Option {
+	return func(o *options) {
+		o.auditActor = actor
+	}
+}
+
+// WithImpersonation makes every call on behalf of user, for platforms whose
+// proxy trusts a service account to pass through the identity of the end
+// user. The user is sent in the impersonation header (see
+// WithImpersonationHeader) and reported as AuditEvent.OnBehalfOf, alongside
+// the service account as Actor. Use ContextWithImpersonation for a single
+// call.
+func WithImpersonation(user string) Option {
+	return func(o *options) {
+		o.impersonateUser = user
+	}
+}
+
+// WithImpersonationHeader sets the header that carries the impersonated
+// user, for proxies that expect another header than the default
+// X-Forwarded-User, such as "X-Remote-User" or "Impersonate-User".
+func WithImpersonationHeader(name string) Option {
+	return func(o *options) {
+		o.impersonationHeader = name
+	}
+}
+
+// WithDeleteProtection makes the client refuse destructive calls unless the
+// call itself confirms its target with a WithConfirm option, so a bug in
+// automation cannot delete resources it did not name. Protected calls are
+// DeletePrompt, DeletePromptVersion, DeleteExperiment, HardDeleteExperiment,
+// DeleteRun, and DeleteLoggedModel, each confirmed with its package's
+// WithConfirm, and the bulk helpers built on them, confirmed with their own
+// options (promptregistry.WithDeleteConfirm for DeletePromptCompletely,
+// promptregistry.WithUnreferencedConfirm, tracking.WithExpireConfirm). A
+// confirmation covers only the call it is passed to. Refused calls return an
+// error wrapping ErrDeleteNotConfirmed without contacting the server, and are
+// reported to the audit hook.
+func WithDeleteProtection() Option {
+	return func(o *options) {
+		o.deleteProtection = true
+	}
+}
+
+// WithSingleflight makes concurrent identical read requests share one HTTP
+// round trip. With it, 100 goroutines loading the same prompt alias or
+// resolving the same experiment name at once send a single request and
+// decode the shared response. Only GET requests are deduplicated; a caller
+// whose context ends stops waiting without affecting the others.
+func WithSingleflight() Option {
+	return func(o *options) {
+		o.singleflight = true
+	}
+}
+
+// WithTimeoutProfile sets timeouts per class of request instead of one
+// timeout for every call, so large artifact uploads and streamed searches are
+// not cut off by the limit meant for metadata calls. It overrides
+// WithTimeout. Each call also honors its context deadline; whichever is
+// earlier applies. When WithHTTPClient is used, that client's own Timeout
+// still applies on top of the profile.
+//
+//	client, err := mlflow.NewClient(mlflow.WithTimeoutProfile(mlflow.TimeoutProfile{
+//		Metadata: 10 * time.Second,
+//		Artifact: time.Hour,
+//	}))
+func WithTimeoutProfile(profile TimeoutProfile) Option {
+	return func(o *options) {
+		o.timeoutProfile = &profile
+	}
+}
+
+// WithRequestSigner registers a function called on every request just before
+// it is sent, after all headers are set, so it can add a signature header
+// such as an HMAC or a short-lived JWT demanded by an API gateway. An error
+// from the signer fails the call without sending the request.
+//
+// JSON request bodies can be read for signing with req.GetBody. Artifact
+// uploads stream their body and have no GetBody; sign their URL and headers.
+// Dry-run requests are not signed, and neither are redirects followed by the
+// HTTP client.
+func WithRequestSigner(sign func(*http.Request) error) Option {
+	return func(o *options) {
+		o.requestSigner = sign
+	}
+}
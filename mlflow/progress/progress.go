@@ -0,0 +1,183 @@
+// Package progress defines the events long-running helpers send to report
+// their progress, such as to draw a progress bar in a CLI or UI.
+//
+// Helpers that support it take a WithEvents option with a channel:
+//
+//	events := make(chan progress.Event, 16)
+//	go func() {
+//		for ev := range events {
+//			fmt.Printf("%s: %d/%d %s\n", ev.Op, ev.Done, ev.Total, ev.Item)
+//		}
+//	}()
+//	err := promptregistry.DeletePromptCompletely(ctx, client.PromptRegistry(), "qa",
+//		promptregistry.WithEvents(events))
+//	close(events)
+//
+// A helper sends Started first and Finished last, with ItemDone and
+// Retrying events in between. Sends block until the event is received or
+// the helper's context ends, so the channel must be read while the helper
+// runs; a buffer keeps a slow reader from slowing the helper down. Helpers
+// never close the channel, so one channel can follow several operations.
+package progress
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Kind is the kind of an Event.
+type Kind int
+
+const (
+	// Started is sent once, before any work.
+	Started Kind = iota + 1
+
+	// ItemDone is sent after each item, whether it succeeded or failed.
+	ItemDone
+
+	// Retrying is sent before an item is retried after a transient error.
+	Retrying
+
+	// Finished is sent once, after all work, with the operation's result.
+	Finished
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Started:
+		return "started"
+	case ItemDone:
+		return "item done"
+	case Retrying:
+		return "retrying"
+	case Finished:
+		return "finished"
+	}
+	return "unknown"
+}
+
+// Event reports the progress of an operation.
+type Event struct {
+	// Kind is what happened.
+	Kind Kind
+
+	// Op names the operation, such as "delete prompt".
+	Op string
+
+	// Item names the item of an ItemDone or Retrying event, such as
+	// "version 3".
+	Item string
+
+	// Done is how many items are done, including this one.
+	Done int
+
+	// Total is how many items the operation has found so far. It may grow
+	// as the operation finds more, and is 0 if the total is unknown.
+	Total int
+
+	// Attempt is the attempt a Retrying event announces: 2 for the first
+	// retry.
+	Attempt int
+
+	// Err is the item's error for ItemDone, the error being retried for
+	// Retrying, and the operation's result for Finished.
+	Err error
+
+	// Time is when the event happened.
+	Time time.Time
+}
+
+// Reporter sends the events of one operation to a channel. Helpers create
+// one per call; it is exported so that long operations built on the SDK
+// can report progress the same way. A nil *Reporter discards events. It is
+// safe for concurrent use.
+type Reporter struct {
+	ch chan<- Event
+	op string
+
+	mu    sync.Mutex
+	done  int
+	total int
+}
+
+// NewReporter returns a reporter for the operation op, or nil if ch is nil.
+func NewReporter(ch chan<- Event, op string) *Reporter {
+	if ch == nil {
+		return nil
+	}
+	return &Reporter{ch: ch, op: op}
+}
+
+// Start sends Started with the number of items known up front, or 0.
+func (r *Reporter) Start(ctx context.Context, total int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.total = total
+	ev := r.event(Started)
+	r.mu.Unlock()
+	r.send(ctx, ev)
+}
+
+// AddTotal adds n items found after Start. It sends no event; the new
+// total is reported with the next one.
+func (r *Reporter) AddTotal(n int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.total += n
+	r.mu.Unlock()
+}
+
+// ItemDone sends ItemDone for item with its error, if any.
+func (r *Reporter) ItemDone(ctx context.Context, item string, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.done++
+	ev := r.event(ItemDone)
+	r.mu.Unlock()
+	ev.Item, ev.Err = item, err
+	r.send(ctx, ev)
+}
+
+// Retrying sends Retrying for item before its given attempt.
+func (r *Reporter) Retrying(ctx context.Context, item string, attempt int, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	ev := r.event(Retrying)
+	r.mu.Unlock()
+	ev.Item, ev.Attempt, ev.Err = item, attempt, err
+	r.send(ctx, ev)
+}
+
+// Finish sends Finished with the operation's result.
+func (r *Reporter) Finish(ctx context.Context, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	ev := r.event(Finished)
+	r.mu.Unlock()
+	ev.Err = err
+	r.send(ctx, ev)
+}
+
+// event returns an event of the given kind with the current counts. The
+// caller must hold r.mu.
+func (r *Reporter) event(kind Kind) Event {
+	return Event{Kind: kind, Op: r.op, Done: r.done, Total: r.total, Time: time.Now()}
+}
+
+func (r *Reporter) send(ctx context.Context, ev Event) {
+	select {
+	case r.ch <- ev:
+	case <-ctx.Done():
+	}
+}
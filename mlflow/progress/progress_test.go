@@ -0,0 +1,76 @@
+package progress
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestReporter(t *testing.T) {
+	ch := make(chan Event, 8)
+	r := NewReporter(ch, "copy")
+	ctx := context.Background()
+
+	r.Start(ctx, 2)
+	r.ItemDone(ctx, "a", nil)
+	r.Retrying(ctx, "b", 2, errors.New("busy"))
+	r.AddTotal(1)
+	r.ItemDone(ctx, "b", nil)
+	r.ItemDone(ctx, "c", errors.New("failed"))
+	r.Finish(ctx, nil)
+	close(ch)
+
+	type summary struct {
+		kind        Kind
+		item        string
+		done, total int
+		attempt     int
+	}
+	var got []summary
+	for ev := range ch {
+		if ev.Op != "copy" || ev.Time.IsZero() {
+			t.Errorf("event = %+v, want op and time set", ev)
+		}
+		got = append(got, summary{ev.Kind, ev.Item, ev.Done, ev.Total, ev.Attempt})
+	}
+	want := []summary{
+		{Started, "", 0, 2, 0},
+		{ItemDone, "a", 1, 2, 0},
+		{Retrying, "b", 1, 2, 2},
+		{ItemDone, "b", 2, 3, 0},
+		{ItemDone, "c", 3, 3, 0},
+		{Finished, "", 3, 3, 0},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("events = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestReporter_Nil(t *testing.T) {
+	r := NewReporter(nil, "copy")
+	if r != nil {
+		t.Fatalf("NewReporter(nil) = %v, want nil", r)
+	}
+	r.Start(context.Background(), 1)
+	r.ItemDone(context.Background(), "a", nil)
+	r.Finish(context.Background(), nil)
+}
+
+func TestReporter_ContextEndsSend(t *testing.T) {
+	r := NewReporter(make(chan Event), "copy")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		r.Start(ctx, 0)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Start blocked after the context ended")
+	}
+}
@@ -0,0 +1,236 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// abTagPrefix prefixes the tags that record an A/B selection. The prompt
+// name is included so one run can record selections for several prompts.
+const abTagPrefix = mlflowtags.PromptABPrefix
+
+// ABSelector splits traffic for a prompt between aliases, for A/B testing
+// prompt versions in production. It is safe for concurrent use.
+type ABSelector struct {
+	client API
+	name   string
+	arms   []abArm
+	total  int
+	opts   abSelectorOptions
+
+	// rollout is set for selectors created by NewRolloutSelector, whose
+	// weights follow the canary percentage
+	rollout *RolloutConfig
+
+	mu    sync.Mutex
+	cache map[string]abCacheEntry
+}
+
+type abArm struct {
+	alias  string
+	weight int
+}
+
+type abCacheEntry struct {
+	version *PromptVersion
+	err     error // a not-found error, cached like a version
+	expires time.Time
+}
+
+// ABSelection is the prompt version served for one request.
+type ABSelection struct {
+	// Alias is the alias chosen for the request.
+	Alias string
+
+	// Prompt is the version the alias pointed to when it was resolved.
+	Prompt *PromptVersion
+}
+
+// NewABSelector returns a selector that serves the aliases of the named
+// prompt in proportion to their weights, e.g.
+//
+//	sel, err := promptregistry.NewABSelector(client.PromptRegistry(), "qa",
+//		map[string]int{"champion": 90, "challenger": 10})
+//
+// Weights are relative and need not sum to 100. An alias with weight 0 is
+// never served, which pauses it without changing the other assignments.
+func NewABSelector(client API, name string, weights map[string]int, opts ...ABSelectorOption) (*ABSelector, error) {
+	if client == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+
+	s := &ABSelector{
+		client: client,
+		name:   name,
+		opts:   abSelectorOptions{cacheTTL: time.Minute},
+		cache:  make(map[string]abCacheEntry, len(weights)),
+	}
+	for _, opt := range opts {
+		opt(&s.opts)
+	}
+
+	for alias, weight := range weights {
+		if alias == "" {
+			return nil, fmt.Errorf("mlflow: alias is required")
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf("mlflow: weight for alias %q must not be negative", alias)
+		}
+		s.arms = append(s.arms, abArm{alias: alias, weight: weight})
+		s.total += weight
+	}
+	if s.total == 0 {
+		return nil, fmt.Errorf("mlflow: at least one alias must have a positive weight")
+	}
+
+	// Sort so that assignments do not depend on map iteration order
+	slices.SortFunc(s.arms, func(a, b abArm) int { return strings.Compare(a.alias, b.alias) })
+
+	return s, nil
+}
+
+// Select returns the prompt version to serve to userID. The same user always
+// gets the same alias for as long as the weights are unchanged, so a user
+// sees a consistent prompt across requests.
+//
+// Aliases are resolved through LoadPrompt and cached for one minute by
+// default (see WithABCacheTTL), so a moved alias is picked up without
+// restarting. The returned Prompt is a copy and may be modified.
+func (s *ABSelector) Select(ctx context.Context, userID string) (*ABSelection, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("mlflow: user ID is required")
+	}
+
+	if s.rollout != nil {
+		if err := s.refreshRollout(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	alias := s.Alias(userID)
+	pv, err := s.resolve(ctx, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	sel := &ABSelection{Alias: alias, Prompt: pv.Clone()}
+	if s.opts.recorder != nil {
+		s.opts.recorder(ctx, *sel)
+	}
+	return sel, nil
+}
+
+// Alias returns the alias assigned to userID without resolving it. For a
+// rollout selector it uses the canary percentage last seen by Select.
+func (s *ABSelector) Alias(userID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Hash the prompt name too, so a user is not in the same bucket of
+	// every experiment
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s.name))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(userID))
+	bucket := int(h.Sum64() % uint64(s.total)) //nolint:gosec // total is a positive int
+
+	for _, arm := range s.arms {
+		if bucket < arm.weight {
+			return arm.alias
+		}
+		bucket -= arm.weight
+	}
+	// Unreachable: the weights sum to total
+	return s.arms[len(s.arms)-1].alias
+}
+
+// resolve loads the version alias points to, using the cache if fresh.
+// Aliases that do not exist are cached too.
+func (s *ABSelector) resolve(ctx context.Context, alias string) (*PromptVersion, error) {
+	now := time.Now()
+	if s.opts.cacheTTL > 0 {
+		s.mu.Lock()
+		entry, ok := s.cache[alias]
+		s.mu.Unlock()
+		if ok && now.Before(entry.expires) {
+			return entry.version, entry.err
+		}
+	}
+
+	pv, err := s.client.LoadPrompt(ctx, s.name, WithAlias(alias))
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if s.opts.cacheTTL > 0 {
+		s.mu.Lock()
+		s.cache[alias] = abCacheEntry{version: pv, err: err, expires: now.Add(s.opts.cacheTTL)}
+		s.mu.Unlock()
+	}
+	return pv, err
+}
+
+// refreshRollout sets the weights of a rollout selector from the canary
+// percentage recorded on the version the canary alias points to. Without a
+// canary alias all traffic goes to the stable alias.
+func (s *ABSelector) refreshRollout(ctx context.Context) error {
+	percent := 0
+	canary, err := s.resolve(ctx, s.rollout.CanaryAlias)
+	switch {
+	case err == nil:
+		percent = canaryPercent(canary)
+	case !errors.IsNotFound(err):
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.arms {
+		if s.arms[i].alias == s.rollout.CanaryAlias {
+			s.arms[i].weight = percent
+		} else {
+			s.arms[i].weight = 100 - percent
+		}
+	}
+	return nil
+}
+
+// Tags returns the tags that record the selection: the alias and version
+// served, keyed by prompt name ("_mlflow_go.prompt_ab.<name>.alias" and
+// "_mlflow_go.prompt_ab.<name>.version"). Use them as run tags, or as attributes of
+// the span that handled the request, to split results by arm when
+// analyzing the experiment.
+func (s ABSelection) Tags() map[string]string {
+	if s.Prompt == nil {
+		return nil
+	}
+	prefix := abTagPrefix + s.Prompt.Name + "."
+	return map[string]string{
+		prefix + "alias":   s.Alias,
+		prefix + "version": strconv.Itoa(s.Prompt.Version),
+	}
+}
+
+// LogToRun sets the selection's Tags on a run.
+func (s ABSelection) LogToRun(ctx context.Context, t tracking.API, runID string) error {
+	if t == nil {
+		return fmt.Errorf("mlflow: tracking client is required")
+	}
+	if s.Prompt == nil {
+		return fmt.Errorf("mlflow: selection has no prompt")
+	}
+	return t.LogBatch(ctx, runID, nil, nil, s.Tags())
+}
@@ -0,0 +1,223 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// newABTestClient serves the alias endpoint, mapping champion to version 1
+// and challenger to version 2, and counts alias lookups.
+func newABTestClient(t *testing.T, lookups *atomic.Int32) *Client {
+	t.Helper()
+	versions := map[string]string{"champion": "1", "challenger": "2"}
+
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/registered-models/alias" {
+			http.NotFound(w, r)
+			return
+		}
+		lookups.Add(1)
+		version, ok := versions[r.URL.Query().Get("alias")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "alias not found"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{
+				"name":    "qa",
+				"version": version,
+				"tags": []map[string]string{
+					{"key": "mlflow.prompt.text", "value": "Answer v" + version},
+				},
+			},
+		})
+	}))
+}
+
+func TestNewABSelector_Validation(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	tests := []struct {
+		name    string
+		client  API
+		prompt  string
+		weights map[string]int
+	}{
+		{"nil client", nil, "qa", map[string]int{"a": 1}},
+		{"empty name", client, "", map[string]int{"a": 1}},
+		{"no weights", client, "qa", nil},
+		{"all zero", client, "qa", map[string]int{"a": 0, "b": 0}},
+		{"negative", client, "qa", map[string]int{"a": 10, "b": -1}},
+		{"empty alias", client, "qa", map[string]int{"": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewABSelector(tt.client, tt.prompt, tt.weights); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestABSelector_Alias_DeterministicAndWeighted(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+	weights := map[string]int{"champion": 90, "challenger": 10}
+
+	a, err := NewABSelector(client, "qa", weights)
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+	b, err := NewABSelector(client, "qa", map[string]int{"challenger": 10, "champion": 90})
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+
+	counts := map[string]int{}
+	const users = 10000
+	for i := range users {
+		user := fmt.Sprintf("user-%d", i)
+		alias := a.Alias(user)
+		if got := b.Alias(user); got != alias {
+			t.Fatalf("Alias(%q) = %q from second selector, want %q", user, got, alias)
+		}
+		if got := a.Alias(user); got != alias {
+			t.Fatalf("Alias(%q) not stable: %q then %q", user, alias, got)
+		}
+		counts[alias]++
+	}
+
+	if share := float64(counts["challenger"]) / users; share < 0.08 || share > 0.12 {
+		t.Errorf("challenger share = %.3f, want about 0.10 (counts %v)", share, counts)
+	}
+}
+
+func TestABSelector_Alias_ZeroWeightNeverServed(t *testing.T) {
+	s, err := NewABSelector(newTestClient(t, http.NotFoundHandler()), "qa",
+		map[string]int{"champion": 1, "challenger": 0})
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+	for i := range 1000 {
+		if alias := s.Alias(fmt.Sprintf("user-%d", i)); alias != "champion" {
+			t.Fatalf("Alias() = %q, want champion", alias)
+		}
+	}
+}
+
+func TestABSelector_Select(t *testing.T) {
+	var lookups atomic.Int32
+	client := newABTestClient(t, &lookups)
+
+	var recorded []ABSelection
+	s, err := NewABSelector(client, "qa", map[string]int{"challenger": 1},
+		WithABRecorder(func(_ context.Context, sel ABSelection) {
+			recorded = append(recorded, sel)
+		}))
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+
+	sel, err := s.Select(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("Select() error = %v", err)
+	}
+	if sel.Alias != "challenger" || sel.Prompt.Version != 2 || sel.Prompt.Template != "Answer v2" {
+		t.Errorf("Select() = %q v%d %q, want challenger v2", sel.Alias, sel.Prompt.Version, sel.Prompt.Template)
+	}
+
+	// The cached version is copied, so callers cannot modify it
+	sel.Prompt.Template = "changed"
+	again, err := s.Select(context.Background(), "bob")
+	if err != nil {
+		t.Fatalf("Select() error = %v", err)
+	}
+	if again.Prompt.Template != "Answer v2" {
+		t.Errorf("Template = %q, want cached copy unaffected", again.Prompt.Template)
+	}
+	if got := lookups.Load(); got != 1 {
+		t.Errorf("alias lookups = %d, want 1 (cached)", got)
+	}
+
+	if len(recorded) != 2 || recorded[1].Alias != "challenger" {
+		t.Errorf("recorded = %+v, want 2 challenger selections", recorded)
+	}
+
+	want := map[string]string{"_mlflow_go.prompt_ab.qa.alias": "challenger", "_mlflow_go.prompt_ab.qa.version": "2"}
+	tags := again.Tags()
+	if len(tags) != len(want) {
+		t.Errorf("Tags() = %v, want %v", tags, want)
+	}
+	for k, v := range want {
+		if tags[k] != v {
+			t.Errorf("Tags()[%q] = %q, want %q", k, tags[k], v)
+		}
+	}
+}
+
+func TestABSelector_Select_NoCache(t *testing.T) {
+	var lookups atomic.Int32
+	s, err := NewABSelector(newABTestClient(t, &lookups), "qa", map[string]int{"champion": 1}, WithABCacheTTL(0))
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+
+	for range 3 {
+		if _, err := s.Select(context.Background(), "alice"); err != nil {
+			t.Fatalf("Select() error = %v", err)
+		}
+	}
+	if got := lookups.Load(); got != 3 {
+		t.Errorf("alias lookups = %d, want 3", got)
+	}
+}
+
+func TestABSelector_Select_Errors(t *testing.T) {
+	var lookups atomic.Int32
+	s, err := NewABSelector(newABTestClient(t, &lookups), "qa", map[string]int{"missing": 1})
+	if err != nil {
+		t.Fatalf("NewABSelector() error = %v", err)
+	}
+
+	if _, err := s.Select(context.Background(), ""); err == nil {
+		t.Error("expected error for empty user ID")
+	}
+	if _, err := s.Select(context.Background(), "alice"); err == nil {
+		t.Error("expected error for unknown alias")
+	}
+}
+
+// batchRecorder is a tracking.API that records LogBatch tags.
+type batchRecorder struct {
+	tracking.API
+	runID string
+	tags  map[string]string
+}
+
+func (r *batchRecorder) LogBatch(_ context.Context, runID string, _ []tracking.Metric, _ []tracking.Param, tags map[string]string, _ ...tracking.LogBatchOption) error {
+	r.runID, r.tags = runID, tags
+	return nil
+}
+
+func TestABSelection_LogToRun(t *testing.T) {
+	sel := ABSelection{Alias: "champion", Prompt: &PromptVersion{Name: "qa", Version: 1}}
+	rec := &batchRecorder{}
+
+	if err := sel.LogToRun(context.Background(), rec, "run-1"); err != nil {
+		t.Fatalf("LogToRun() error = %v", err)
+	}
+	if rec.runID != "run-1" || rec.tags["_mlflow_go.prompt_ab.qa.alias"] != "champion" || rec.tags["_mlflow_go.prompt_ab.qa.version"] != "1" {
+		t.Errorf("LogBatch got run %q tags %v", rec.runID, rec.tags)
+	}
+
+	if err := (ABSelection{}).LogToRun(context.Background(), rec, "run-1"); err == nil {
+		t.Error("expected error for empty selection")
+	}
+}
@@ -0,0 +1,50 @@
+package promptregistry
+
+import (
+	"context"
+)
+
+// API is the set of methods implemented by Client, except WithNamespace and
+// WithDescriptionPrecedence.
+// Accept an API instead of a *Client in code you want to unit test without
+// an MLflow server, and pass a mlflowmock.PromptRegistry in tests.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to stay source compatible.
+type API interface {
+	Namespace() string
+
+	// Prompts
+	LoadPrompt(ctx context.Context, name string, opts ...LoadOption) (*PromptVersion, error)
+	PromptExists(ctx context.Context, name string) (bool, error)
+	RegisterPrompt(ctx context.Context, name, template string, opts ...RegisterOption) (*PromptVersion, error)
+	RegisterChatPrompt(ctx context.Context, name string, messages []ChatMessage, opts ...RegisterOption) (*PromptVersion, error)
+	RegisterPromptTx(ctx context.Context, name string, draft PromptDraft, opts ...RegisterOption) (*PromptVersion, error)
+	ListPrompts(ctx context.Context, opts ...ListPromptsOption) (*PromptList, error)
+	ListPromptsCursor(opts ...ListPromptsOption) *Cursor[Prompt]
+	ListPromptVersions(ctx context.Context, name string, opts ...ListVersionsOption) (*PromptVersionList, error)
+	ListPromptVersionsCursor(name string, opts ...ListVersionsOption) *Cursor[PromptVersion]
+	RenamePrompt(ctx context.Context, oldName, newName string) (*Prompt, error)
+	SearchPromptContent(ctx context.Context, pattern string, opts ...SearchContentOption) ([]ContentMatch, error)
+
+	// Aliases
+	SetPromptAlias(ctx context.Context, name, alias string, version int) error
+	DeletePromptAlias(ctx context.Context, name, alias string) error
+
+	// Deletion
+	DeletePromptVersion(ctx context.Context, name string, version int, opts ...DeleteOption) error
+	DeletePrompt(ctx context.Context, name string, opts ...DeleteOption) error
+	RestorePrompt(ctx context.Context, name string) error
+	RestorePromptVersion(ctx context.Context, name string, version int) error
+
+	// Tags
+	SetPromptTag(ctx context.Context, name, key, value string) error
+	SetPromptVersionTag(ctx context.Context, name string, version int, key, value string) error
+	DeletePromptTag(ctx context.Context, name, key string) error
+	DeletePromptVersionTag(ctx context.Context, name string, version int, key string) error
+
+	// Rollouts
+	Rollout(ctx context.Context, name string, newVersion int, cfg RolloutConfig) (*RolloutResult, error)
+}
+
+var _ API = (*Client)(nil)
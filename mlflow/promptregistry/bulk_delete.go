@@ -0,0 +1,241 @@
+package promptregistry
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// Defaults for DeletePromptCompletely.
+const (
+	defaultDeleteConcurrency = 4
+	defaultDeleteRetries     = 3
+	defaultDeleteBackoff     = 200 * time.Millisecond
+
+	// deleteBatchSize is how many versions are listed per round; it matches
+	// the server maximum for model-versions/search.
+	deleteBatchSize = 1000
+)
+
+// DeletePromptCompletely removes a prompt and everything attached to it:
+// it deletes every alias, then every version, then the prompt itself.
+//
+// Versions are deleted in parallel with bounded concurrency (see
+// WithDeleteConcurrency). Unlike the rest of the SDK, each request is retried
+// with exponential backoff when the server responds with 429 or 5xx (see
+// WithDeleteRetries); other errors fail immediately. Aliases and versions
+// that are already gone are skipped, so the call can be repeated safely
+// after a partial failure.
+//
+// If any version cannot be deleted, the prompt is left in place and the
+// returned error is a *mlflow.MultiError listing every version that failed.
+//
+// With WithEvents, progress is reported with one item per alias, version,
+// and the prompt itself. On a client with delete protection, confirm the
+// name with WithDeleteConfirm.
+func DeletePromptCompletely(ctx context.Context, c *Client, name string, opts ...DeleteCompletelyOption) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if name == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+
+	delOpts := &deleteCompletelyOptions{
+		concurrency: defaultDeleteConcurrency,
+		retries:     defaultDeleteRetries,
+		backoff:     defaultDeleteBackoff,
+	}
+	for _, opt := range opts {
+		opt(delOpts)
+	}
+	if delOpts.concurrency < 1 {
+		delOpts.concurrency = 1
+	}
+
+	// The confirmation covers the prompt and its versions, which name it
+	ctx = c.confirmed(ctx, name, delOpts.confirm)
+
+	delOpts.reporter = progress.NewReporter(delOpts.events, "delete prompt")
+	delOpts.reporter.Start(ctx, 0)
+	err := c.deletePromptCompletely(ctx, name, delOpts)
+	delOpts.reporter.Finish(ctx, err)
+	return err
+}
+
+func (c *Client) deletePromptCompletely(ctx context.Context, name string, delOpts *deleteCompletelyOptions) error {
+	aliases, err := c.promptAliases(ctx, name)
+	if err != nil {
+		return err
+	}
+	delOpts.reporter.AddTotal(len(aliases) + 1)
+	for _, alias := range aliases {
+		item := "alias " + alias
+		err = delOpts.retry(ctx, item, func() error {
+			return c.DeletePromptAlias(ctx, name, alias)
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			delOpts.reporter.ItemDone(ctx, item, err)
+			return err
+		}
+		delOpts.reporter.ItemDone(ctx, item, nil)
+	}
+
+	// List in batches until nothing new comes back; a stale search index
+	// may keep returning versions that were already deleted.
+	deleted := make(map[int]bool)
+	for {
+		versions, listErr := c.ListPromptVersions(ctx, name, WithVersionsMaxResults(deleteBatchSize))
+		if listErr != nil {
+			return listErr
+		}
+		pending := make([]PromptVersion, 0, len(versions.Versions))
+		for _, v := range versions.Versions {
+			if !deleted[v.Version] {
+				deleted[v.Version] = true
+				pending = append(pending, v)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		delOpts.reporter.AddTotal(len(pending))
+		if err = c.deleteVersions(ctx, name, pending, delOpts); err != nil {
+			return err
+		}
+		if len(versions.Versions) < deleteBatchSize {
+			break
+		}
+	}
+
+	err = delOpts.retry(ctx, "prompt", func() error {
+		return c.DeletePrompt(ctx, name)
+	})
+	if errors.IsNotFound(err) {
+		err = nil
+	}
+	delOpts.reporter.ItemDone(ctx, "prompt", err)
+	return err
+}
+
+// promptAliases returns the alias names set on a prompt.
+func (c *Client) promptAliases(ctx context.Context, name string) ([]string, error) {
+	rm, err := c.getRegisteredModel(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make([]string, 0, len(rm.GetAliases()))
+	for _, a := range rm.GetAliases() {
+		aliases = append(aliases, a.GetAlias())
+	}
+	return aliases, nil
+}
+
+// getRegisteredModel fetches the registered model backing a prompt.
+func (c *Client) getRegisteredModel(ctx context.Context, name string) (*mlflowpb.RegisteredModel, error) {
+	var resp mlflowpb.GetRegisteredModel_Response
+
+	query := url.Values{
+		"name": []string{name},
+	}
+
+	err := c.transport.Get(ctx, "/api/2.0/mlflow/registered-models/get", query, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+
+	return resp.GetRegisteredModel(), nil
+}
+
+// deleteVersions deletes versions with at most opts.concurrency requests in
+// flight. The returned *errors.MultiError has one entry per version that could
+// not be deleted, including versions not attempted because ctx ended.
+func (c *Client) deleteVersions(ctx context.Context, name string, versions []PromptVersion, opts *deleteCompletelyOptions) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs errors.MultiError
+	)
+	sem := make(chan struct{}, opts.concurrency)
+
+	for i, v := range versions {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			for _, skipped := range versions[i:] {
+				errs.Add(-1, versionResource(skipped.Version), ctx.Err())
+			}
+			return errs.Err()
+		}
+
+		wg.Add(1)
+		go func(version int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			item := versionResource(version)
+			err := opts.retry(ctx, item, func() error {
+				return c.DeletePromptVersion(ctx, name, version)
+			})
+			if errors.IsNotFound(err) {
+				err = nil
+			}
+			if err != nil {
+				mu.Lock()
+				errs.Add(-1, item, err)
+				mu.Unlock()
+			}
+			opts.reporter.ItemDone(ctx, item, err)
+		}(v.Version)
+	}
+
+	wg.Wait()
+	return errs.Err()
+}
+
+func versionResource(version int) string {
+	return fmt.Sprintf("version %d", version)
+}
+
+// retry calls fn until it succeeds, fails with a non-retryable error,
+// or opts.retries additional attempts have been made. Retries of item are
+// reported to opts.reporter.
+func (opts *deleteCompletelyOptions) retry(ctx context.Context, item string, fn func() error) error {
+	backoff := opts.backoff
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= opts.retries || !isRetryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		opts.reporter.Retrying(ctx, item, attempt+2, err)
+		backoff *= 2
+	}
+}
+
+// isRetryable reports whether err is a rate-limit or server-side API error.
+func isRetryable(err error) bool {
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+}
@@ -0,0 +1,305 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// fakeRegistry serves the endpoints DeletePromptCompletely uses
+// and records every mutating call.
+type fakeRegistry struct {
+	t        *testing.T
+	mu       sync.Mutex
+	aliases  []string
+	versions []string
+	calls    []string
+
+	// failures returns this status for the first n version deletes.
+	failures     int32
+	failStatus   int
+	inFlight     int32
+	maxInFlight  int32
+	deleteDelay  time.Duration
+	promptExists bool
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req struct {
+		Name    string `json:"name"`
+		Alias   string `json:"alias"`
+		Version string `json:"version"`
+	}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&req)
+	}
+
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/registered-models/get":
+		f.mu.Lock()
+		aliases := make([]map[string]string, 0, len(f.aliases))
+		for _, a := range f.aliases {
+			aliases = append(aliases, map[string]string{"alias": a, "version": "1"})
+		}
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{
+			"registered_model": map[string]any{"name": r.URL.Query().Get("name"), "aliases": aliases},
+		})
+
+	case "/api/2.0/mlflow/model-versions/search":
+		f.mu.Lock()
+		mvs := make([]map[string]string, 0, len(f.versions))
+		for _, v := range f.versions {
+			mvs = append(mvs, map[string]string{"name": "test-prompt", "version": v})
+		}
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{"model_versions": mvs})
+
+	case "/api/2.0/mlflow/registered-models/alias":
+		f.record("alias:" + req.Alias)
+		f.mu.Lock()
+		f.aliases = slices.DeleteFunc(f.aliases, func(a string) bool { return a == req.Alias })
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{})
+
+	case "/api/2.0/mlflow/model-versions/delete":
+		n := atomic.AddInt32(&f.inFlight, 1)
+		defer atomic.AddInt32(&f.inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&f.maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&f.maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(f.deleteDelay)
+
+		if atomic.AddInt32(&f.failures, -1) >= 0 {
+			w.WriteHeader(f.failStatus)
+			json.NewEncoder(w).Encode(map[string]string{"error_code": "INTERNAL_ERROR", "message": "try again"})
+			return
+		}
+		f.record("version:" + req.Version)
+		f.mu.Lock()
+		f.versions = slices.DeleteFunc(f.versions, func(v string) bool { return v == req.Version })
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{})
+
+	case "/api/2.0/mlflow/registered-models/delete":
+		f.record("prompt:" + req.Name)
+		f.mu.Lock()
+		f.promptExists = false
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{})
+
+	default:
+		f.t.Errorf("unexpected path: %s", r.URL.Path)
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeRegistry) record(call string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+}
+
+func TestDeletePromptCompletely_Success(t *testing.T) {
+	fake := &fakeRegistry{
+		t:            t,
+		aliases:      []string{"production", "staging"},
+		versions:     []string{"3", "2", "1"},
+		promptExists: true,
+	}
+	client := newTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt")
+	if err != nil {
+		t.Fatalf("DeletePromptCompletely() error = %v", err)
+	}
+
+	if len(fake.calls) != 6 {
+		t.Fatalf("calls = %v, want 6 calls", fake.calls)
+	}
+	if !slices.Equal(fake.calls[:2], []string{"alias:production", "alias:staging"}) {
+		t.Errorf("aliases not deleted first: %v", fake.calls)
+	}
+	versions := slices.Clone(fake.calls[2:5])
+	slices.Sort(versions)
+	if !slices.Equal(versions, []string{"version:1", "version:2", "version:3"}) {
+		t.Errorf("version deletes = %v", versions)
+	}
+	if fake.calls[5] != "prompt:test-prompt" {
+		t.Errorf("last call = %q, want prompt delete", fake.calls[5])
+	}
+	if fake.promptExists {
+		t.Error("expected prompt to be deleted")
+	}
+}
+
+func TestDeletePromptCompletely_BoundedConcurrency(t *testing.T) {
+	fake := &fakeRegistry{
+		t:           t,
+		versions:    []string{"8", "7", "6", "5", "4", "3", "2", "1"},
+		deleteDelay: 20 * time.Millisecond,
+	}
+	client := newTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt", WithDeleteConcurrency(2))
+	if err != nil {
+		t.Fatalf("DeletePromptCompletely() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&fake.maxInFlight); got > 2 {
+		t.Errorf("max concurrent deletes = %d, want <= 2", got)
+	}
+	if len(fake.versions) != 0 {
+		t.Errorf("remaining versions = %v", fake.versions)
+	}
+}
+
+func TestDeletePromptCompletely_RetriesServerErrors(t *testing.T) {
+	fake := &fakeRegistry{
+		t:          t,
+		versions:   []string{"1"},
+		failures:   2,
+		failStatus: http.StatusServiceUnavailable,
+	}
+	client := newTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt",
+		WithDeleteRetries(2, time.Millisecond))
+	if err != nil {
+		t.Fatalf("DeletePromptCompletely() error = %v", err)
+	}
+	if len(fake.versions) != 0 {
+		t.Errorf("remaining versions = %v", fake.versions)
+	}
+}
+
+func TestDeletePromptCompletely_Events(t *testing.T) {
+	fake := &fakeRegistry{
+		t:          t,
+		aliases:    []string{"production"},
+		versions:   []string{"2", "1"},
+		failures:   1,
+		failStatus: http.StatusServiceUnavailable,
+	}
+	client := newTestClient(t, fake)
+
+	events := make(chan progress.Event, 16)
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt",
+		WithDeleteRetries(1, time.Millisecond), WithDeleteConcurrency(1), WithEvents(events))
+	if err != nil {
+		t.Fatalf("DeletePromptCompletely() error = %v", err)
+	}
+	close(events)
+
+	var kinds []progress.Kind
+	var items []string
+	var last progress.Event
+	for ev := range events {
+		kinds = append(kinds, ev.Kind)
+		if ev.Kind == progress.ItemDone {
+			items = append(items, ev.Item)
+		}
+		if ev.Op != "delete prompt" {
+			t.Errorf("Op = %q", ev.Op)
+		}
+		last = ev
+	}
+	want := []progress.Kind{progress.Started, progress.ItemDone, progress.Retrying,
+		progress.ItemDone, progress.ItemDone, progress.ItemDone, progress.Finished}
+	if !slices.Equal(kinds, want) {
+		t.Errorf("kinds = %v, want %v", kinds, want)
+	}
+	if !slices.Equal(items, []string{"alias production", "version 2", "version 1", "prompt"}) {
+		t.Errorf("items = %v", items)
+	}
+	if last.Done != 4 || last.Total != 4 || last.Err != nil {
+		t.Errorf("Finished = %+v, want 4/4 without error", last)
+	}
+}
+
+func TestDeletePromptCompletely_RetriesExhausted(t *testing.T) {
+	fake := &fakeRegistry{
+		t:            t,
+		versions:     []string{"1"},
+		failures:     5,
+		failStatus:   http.StatusTooManyRequests,
+		promptExists: true,
+	}
+	client := newTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt",
+		WithDeleteRetries(1, time.Millisecond))
+	var multi *errors.MultiError
+	if !stderrors.As(err, &multi) {
+		t.Fatalf("expected *MultiError when retries are exhausted, got %v", err)
+	}
+	if len(multi.Errors) != 1 || multi.Errors[0].Resource != "version 1" {
+		t.Errorf("item errors = %v, want one for version 1", multi.Errors)
+	}
+	if !fake.promptExists {
+		t.Error("prompt should not be deleted when a version delete fails")
+	}
+}
+
+func TestDeletePromptCompletely_NoRetryOnClientError(t *testing.T) {
+	fake := &fakeRegistry{
+		t:          t,
+		versions:   []string{"1"},
+		failures:   1,
+		failStatus: http.StatusBadRequest,
+	}
+	client := newTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt",
+		WithDeleteRetries(3, time.Millisecond))
+	if !errors.IsInvalidArgument(err) {
+		t.Fatalf("expected IsInvalidArgument, got %v", err)
+	}
+}
+
+func TestDeletePromptCompletely_DeleteProtection(t *testing.T) {
+	fake := &fakeRegistry{t: t, versions: []string{"2", "1"}, promptExists: true}
+	client := newProtectedTestClient(t, fake)
+
+	err := DeletePromptCompletely(context.Background(), client, "test-prompt", WithDeleteConfirm("other"))
+	if !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Fatalf("DeletePromptCompletely() without confirmation error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Fatalf("calls = %v, want none", fake.calls)
+	}
+
+	if err := DeletePromptCompletely(context.Background(), client, "test-prompt", WithDeleteConfirm("test-prompt")); err != nil {
+		t.Fatalf("DeletePromptCompletely() error = %v", err)
+	}
+	if len(fake.calls) != 3 {
+		t.Errorf("calls = %v, want both versions and the prompt", fake.calls)
+	}
+}
+
+func TestDeletePromptCompletely_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if err := DeletePromptCompletely(context.Background(), client, ""); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if err := DeletePromptCompletely(context.Background(), nil, "test-prompt"); err == nil {
+		t.Error("expected error for nil client")
+	}
+}
@@ -6,26 +6,31 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/opendatahub-io/mlflow-go/internal/conv"
 	"github.com/opendatahub-io/mlflow-go/internal/errors"
 	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/internal/searchfilter"
 	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
 )
 
 // Prompt tag keys used by MLflow to store prompt metadata.
 const (
-	tagPromptText  = "mlflow.prompt.text"
-	tagIsPrompt    = "mlflow.prompt.is_prompt"
-	tagPromptType  = "_mlflow_prompt_type"
-	tagDescription = "mlflow.prompt.description"
-	tagModelConfig = "_mlflow_prompt_model_config"
+	tagPromptText  = mlflowtags.PromptText
+	tagIsPrompt    = mlflowtags.IsPrompt
+	tagPromptType  = mlflowtags.PromptType
+	tagDescription = mlflowtags.PromptDescription
+	tagModelConfig = mlflowtags.PromptModelConfig
 	promptTypeText = "text"
 	promptTypeChat = "chat"
-	aliasTagPrefix = "mlflow.prompt.alias."
+	aliasTagPrefix = mlflowtags.PromptAliasPrefix
 	aliasLatest    = "latest"
 )
 
@@ -33,12 +38,65 @@ const (
 // It is safe for concurrent use.
 type Client struct {
 	transport *transport.Client
+	namespace string
+
+	// descriptionPrecedence picks PromptVersion.CommitMessage; see
+	// WithDescriptionPrecedence.
+	descriptionPrecedence DescriptionPrecedence
+
+	// fallbackWarned is set once ListPromptVersions has warned about
+	// falling back to individual fetches; shared by namespaced copies.
+	fallbackWarned *atomic.Bool
 }
 
 // NewClient creates a new Prompt Registry client.
 // This is typically called internally by the root mlflow.Client.
 func NewClient(t *transport.Client) *Client {
-	return &Client{transport: t}
+	return &Client{transport: t, fallbackWarned: new(atomic.Bool)}
+}
+
+// WithNamespace returns a copy of the client scoped to the given namespace
+// (e.g., "team/project"). Prompt names passed to the scoped client are
+// prefixed with the namespace unless they already carry it, and ListPrompts
+// only returns prompts inside the namespace. The receiver is not modified.
+func (c *Client) WithNamespace(namespace string) (*Client, error) {
+	namespace = strings.TrimSuffix(namespace, NameSeparator)
+	if err := validateNamespace(namespace); err != nil {
+		return nil, err
+	}
+	scoped := *c
+	scoped.namespace = namespace
+	return &scoped, nil
+}
+
+// Namespace returns the namespace the client is scoped to, or an empty string.
+func (c *Client) Namespace() string {
+	return c.namespace
+}
+
+// qualify prefixes name with the client namespace, if any.
+func (c *Client) qualify(name string) string {
+	if c.namespace == "" {
+		return name
+	}
+	prefix := c.namespace + NameSeparator
+	if strings.HasPrefix(name, prefix) {
+		return name
+	}
+	return prefix + name
+}
+
+// qualifyForRegistration qualifies name and, on a namespaced client,
+// validates the result so malformed names never reach the registry.
+func (c *Client) qualifyForRegistration(name string) (string, error) {
+	if c.namespace == "" {
+		return name, nil
+	}
+	qualified := Name(c.qualify(name))
+	if err := qualified.Validate(); err != nil {
+		return "", err
+	}
+	return qualified.String(), nil
 }
 
 // LoadPrompt loads a prompt from the registry by name.
@@ -47,19 +105,22 @@ func (c *Client) LoadPrompt(ctx context.Context, name string, opts ...LoadOption
 	if name == "" {
 		return nil, fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 
-	loadOpts := &loadOptions{}
-	for _, opt := range opts {
-		opt(loadOpts)
+	var loadOpts LoadConfig
+	loadOpts.Apply(opts...)
+
+	if loadOpts.Locale != "" || len(loadOpts.FallbackLocales) > 0 {
+		return c.loadPromptByLocale(ctx, name, &loadOpts)
 	}
 
 	// If alias is specified, use the alias endpoint directly
-	if loadOpts.alias != "" {
-		return c.loadPromptByAlias(ctx, name, loadOpts.alias)
+	if loadOpts.Alias != "" {
+		return c.loadPromptByAlias(ctx, name, loadOpts.Alias)
 	}
 
-	if loadOpts.version > 0 {
-		return c.loadPromptVersionByNumber(ctx, name, loadOpts.version)
+	if loadOpts.Version > 0 {
+		return c.loadPromptVersionByNumber(ctx, name, loadOpts.Version)
 	}
 
 	// No version specified - use the special "latest" alias
@@ -81,7 +142,7 @@ func (c *Client) loadPromptByAlias(ctx context.Context, name, alias string) (*Pr
 		return nil, fmt.Errorf("failed to get prompt by alias %q: %w", alias, err)
 	}
 
-	return modelVersionToPromptVersion(resp.ModelVersion), nil
+	return modelVersionToPromptVersion(resp.ModelVersion, c.descriptionPrecedence), nil
 }
 
 // loadPromptVersionByNumber loads a specific version of a prompt by version number.
@@ -98,19 +159,19 @@ func (c *Client) loadPromptVersionByNumber(ctx context.Context, name string, ver
 		return nil, fmt.Errorf("failed to get prompt version: %w", err)
 	}
 
-	return modelVersionToPromptVersion(resp.ModelVersion), nil
+	return modelVersionToPromptVersion(resp.ModelVersion, c.descriptionPrecedence), nil
 }
 
-func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion) *PromptVersion {
+func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion, precedence DescriptionPrecedence) *PromptVersion {
 	if mv == nil {
 		return nil
 	}
 
 	pv := &PromptVersion{
-		Name:          mv.GetName(),
-		CommitMessage: mv.GetDescription(),
-		Tags:          make(map[string]string),
+		Name: mv.GetName(),
+		Tags: make(map[string]string, len(mv.Tags)),
 	}
+	setRawFields(pv, mv, precedence)
 
 	// Parse version
 	if v, err := strconv.Atoi(mv.GetVersion()); err == nil {
@@ -128,6 +189,7 @@ func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion) *PromptVersion {
 	var promptType string
 	var promptText string
 	var modelConfigJSON string
+	var inputSchemaJSON string
 
 	// Process tags
 	for _, tag := range mv.Tags {
@@ -140,12 +202,10 @@ func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion) *PromptVersion {
 			promptType = value
 		case tagModelConfig:
 			modelConfigJSON = value
-		case tagDescription:
-			if value != "" {
-				pv.CommitMessage = value
-			}
-		case tagIsPrompt:
-			// Internal tag, don't expose
+		case tagInputSchema:
+			inputSchemaJSON = value
+		case tagDescription, tagIsPrompt:
+			// Internal tags, don't expose
 		default:
 			// Check for alias tags
 			if strings.HasPrefix(key, aliasTagPrefix) {
@@ -155,6 +215,9 @@ func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion) *PromptVersion {
 			}
 		}
 	}
+	// The native aliases field is what SetPromptAlias and the Python SDK
+	// update; alias tags left on the version may be stale, so skip them.
+	pv.Aliases = slices.Clone(mv.GetAliases())
 
 	// Parse template based on type
 	if promptType == promptTypeChat && promptText != "" {
@@ -174,21 +237,28 @@ func modelVersionToPromptVersion(mv *mlflowpb.ModelVersion) *PromptVersion {
 		}
 	}
 
+	if inputSchemaJSON != "" {
+		var schema InputSchema
+		if err := json.Unmarshal([]byte(inputSchemaJSON), &schema); err == nil {
+			pv.InputSchema = schema
+		}
+	}
+
 	return pv
 }
 
 // modelVersionToPromptVersionWithoutTemplate converts a model version to a PromptVersion without loading template.
 // Used for listing operations where template content is not needed.
-func modelVersionToPromptVersionWithoutTemplate(mv *mlflowpb.ModelVersion) PromptVersion {
+func modelVersionToPromptVersionWithoutTemplate(mv *mlflowpb.ModelVersion, precedence DescriptionPrecedence) PromptVersion {
 	if mv == nil {
 		return PromptVersion{}
 	}
 
 	pv := PromptVersion{
-		Name:          mv.GetName(),
-		CommitMessage: mv.GetDescription(),
-		Tags:          make(map[string]string),
+		Name: mv.GetName(),
+		Tags: make(map[string]string),
 	}
+	setRawFields(&pv, mv, precedence)
 
 	// Parse version
 	if v, err := strconv.Atoi(mv.GetVersion()); err == nil {
@@ -208,7 +278,7 @@ func modelVersionToPromptVersionWithoutTemplate(mv *mlflowpb.ModelVersion) Promp
 		key := tag.GetKey()
 		value := tag.GetValue()
 		switch key {
-		case tagPromptText, tagIsPrompt, tagPromptType, tagDescription, tagModelConfig:
+		case tagPromptText, tagIsPrompt, tagPromptType, tagDescription, tagModelConfig, tagInputSchema:
 			// Internal tags, don't expose
 		default:
 			if !strings.HasPrefix(key, aliasTagPrefix) {
@@ -217,17 +287,22 @@ func modelVersionToPromptVersionWithoutTemplate(mv *mlflowpb.ModelVersion) Promp
 		}
 	}
 
-	// Check for commit message in tags (takes precedence)
-	for _, tag := range mv.Tags {
-		if tag.GetKey() == tagDescription && tag.GetValue() != "" {
-			pv.CommitMessage = tag.GetValue()
-			break
-		}
-	}
+	pv.Aliases = slices.Clone(mv.GetAliases())
 
 	return pv
 }
 
+// setRawFields sets RawDescription and RawTags of pv from mv, and its
+// CommitMessage from them with the given precedence.
+func setRawFields(pv *PromptVersion, mv *mlflowpb.ModelVersion, precedence DescriptionPrecedence) {
+	pv.RawDescription = mv.GetDescription()
+	pv.RawTags = make(map[string]string, len(mv.Tags))
+	for _, tag := range mv.Tags {
+		pv.RawTags[tag.GetKey()] = tag.GetValue()
+	}
+	pv.CommitMessage = precedence.commitMessage(pv.RawDescription, pv.RawTags[tagDescription])
+}
+
 func registeredModelToPrompt(rm *mlflowpb.RegisteredModel) Prompt {
 	if rm == nil {
 		return Prompt{}
@@ -277,14 +352,34 @@ func (c *Client) RegisterPrompt(ctx context.Context, name, template string, opts
 	if template == "" {
 		return nil, fmt.Errorf("mlflow: prompt template is required")
 	}
+	name, err := c.qualifyForRegistration(name)
+	if err != nil {
+		return nil, err
+	}
 
 	regOpts := &registerOptions{}
 	for _, opt := range opts {
 		opt(regOpts)
 	}
 
+	if regOpts.inputSchema != nil {
+		if err = regOpts.inputSchema.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if regOpts.lint {
+		if err = lintTemplate(regOpts.lintRules, template); err != nil {
+			return nil, err
+		}
+	}
+	if regOpts.secretPolicy != 0 {
+		if template, err = scanTemplate(regOpts.secretPolicy, name, template); err != nil {
+			return nil, err
+		}
+	}
+
 	// Step 1: Ensure the RegisteredModel exists
-	if err := c.ensureRegisteredModel(ctx, name); err != nil {
+	if err = c.ensureRegisteredModel(ctx, name); err != nil {
 		return nil, err
 	}
 
@@ -302,14 +397,34 @@ func (c *Client) RegisterChatPrompt(ctx context.Context, name string, messages [
 	if len(messages) == 0 {
 		return nil, fmt.Errorf("mlflow: at least one message is required for chat prompts")
 	}
+	name, err := c.qualifyForRegistration(name)
+	if err != nil {
+		return nil, err
+	}
 
 	regOpts := &registerOptions{}
 	for _, opt := range opts {
 		opt(regOpts)
 	}
 
+	if regOpts.inputSchema != nil {
+		if err = regOpts.inputSchema.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if regOpts.lint {
+		if err = lintMessages(regOpts.lintRules, messages); err != nil {
+			return nil, err
+		}
+	}
+	if regOpts.secretPolicy != 0 {
+		if messages, err = scanMessages(regOpts.secretPolicy, name, messages); err != nil {
+			return nil, err
+		}
+	}
+
 	// Step 1: Ensure the RegisteredModel exists
-	if err := c.ensureRegisteredModel(ctx, name); err != nil {
+	if err = c.ensureRegisteredModel(ctx, name); err != nil {
 		return nil, err
 	}
 
@@ -358,6 +473,15 @@ func (c *Client) createTextPromptVersion(ctx context.Context, name, template str
 		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(tagModelConfig), Value: conv.Ptr(string(configJSON))})
 	}
 
+	// Add input schema if provided
+	if opts.inputSchema != nil {
+		schemaJSON, schemaErr := json.Marshal(opts.inputSchema)
+		if schemaErr != nil {
+			return nil, fmt.Errorf("failed to serialize input schema: %w", schemaErr)
+		}
+		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(tagInputSchema), Value: conv.Ptr(string(schemaJSON))})
+	}
+
 	// Add user-provided tags
 	for k, v := range opts.tags {
 		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(k), Value: conv.Ptr(v)})
@@ -378,7 +502,13 @@ func (c *Client) createTextPromptVersion(ctx context.Context, name, template str
 		return nil, fmt.Errorf("failed to create prompt version: %w", err)
 	}
 
-	return modelVersionToPromptVersion(resp.ModelVersion), nil
+	// The server always returns the created version; an empty response means
+	// the request was not sent (dry-run), so describe what would have been created.
+	if resp.ModelVersion == nil {
+		resp.ModelVersion = &mlflowpb.ModelVersion{Name: req.Name, Description: req.Description, Tags: tags}
+	}
+
+	return modelVersionToPromptVersion(resp.ModelVersion, c.descriptionPrecedence), nil
 }
 
 // createChatPromptVersion creates a new version of the prompt with chat messages.
@@ -406,6 +536,15 @@ func (c *Client) createChatPromptVersion(ctx context.Context, name string, messa
 		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(tagModelConfig), Value: conv.Ptr(string(configJSON))})
 	}
 
+	// Add input schema if provided
+	if opts.inputSchema != nil {
+		schemaJSON, schemaErr := json.Marshal(opts.inputSchema)
+		if schemaErr != nil {
+			return nil, fmt.Errorf("failed to serialize input schema: %w", schemaErr)
+		}
+		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(tagInputSchema), Value: conv.Ptr(string(schemaJSON))})
+	}
+
 	// Add user-provided tags
 	for k, v := range opts.tags {
 		tags = append(tags, &mlflowpb.ModelVersionTag{Key: conv.Ptr(k), Value: conv.Ptr(v)})
@@ -426,7 +565,13 @@ func (c *Client) createChatPromptVersion(ctx context.Context, name string, messa
 		return nil, fmt.Errorf("failed to create prompt version: %w", err)
 	}
 
-	return modelVersionToPromptVersion(resp.ModelVersion), nil
+	// The server always returns the created version; an empty response means
+	// the request was not sent (dry-run), so describe what would have been created.
+	if resp.ModelVersion == nil {
+		resp.ModelVersion = &mlflowpb.ModelVersion{Name: req.Name, Description: req.Description, Tags: tags}
+	}
+
+	return modelVersionToPromptVersion(resp.ModelVersion, c.descriptionPrecedence), nil
 }
 
 // ListPrompts returns prompts matching the criteria.
@@ -435,6 +580,7 @@ func (c *Client) createChatPromptVersion(ctx context.Context, name string, messa
 func (c *Client) ListPrompts(ctx context.Context, opts ...ListPromptsOption) (*PromptList, error) {
 	listOpts := &listPromptsOptions{
 		maxResults: 100, // Default page size
+		namespace:  c.namespace,
 	}
 	for _, opt := range opts {
 		opt(listOpts)
@@ -466,28 +612,92 @@ func (c *Client) ListPrompts(ctx context.Context, opts ...ListPromptsOption) (*P
 	}
 
 	for _, rm := range resp.RegisteredModels {
+		// LIKE reads "_" and "%" in the namespace as wildcards, so the
+		// filter may also match names in other namespaces
+		if listOpts.namespace != "" && !strings.HasPrefix(rm.GetName(), listOpts.namespace+NameSeparator) {
+			continue
+		}
 		result.Prompts = append(result.Prompts, registeredModelToPrompt(rm))
 	}
 
+	if listOpts.aliasSummary {
+		if err = c.summarizePrompts(ctx, result.Prompts); err != nil {
+			return nil, err
+		}
+	}
+
+	if listOpts.countUpTo > 0 {
+		first := Page[Prompt]{Items: result.Prompts, NextPageToken: result.NextPageToken}
+		fetch := func(ctx context.Context, pageToken string) (Page[Prompt], error) {
+			list, err := c.ListPrompts(ctx, append(slices.Clone(opts),
+				WithPageToken(pageToken),
+				WithMaxResults(deleteBatchSize),
+				WithCountUpTo(0),
+				func(o *listPromptsOptions) { o.aliasSummary = false },
+			)...)
+			if err != nil {
+				return Page[Prompt]{}, err
+			}
+			return Page[Prompt]{Items: list.Prompts, NextPageToken: list.NextPageToken}, nil
+		}
+		if listOpts.pageToken != "" {
+			if first, err = fetch(ctx, ""); err != nil {
+				return nil, err
+			}
+		}
+		n, capped, err := pagination.Count(ctx, fetch, first, listOpts.countUpTo)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalCount, result.TotalCountCapped = &n, capped
+	}
+
 	return result, nil
 }
 
+// ListPromptsCursor returns a cursor over all pages of ListPrompts results.
+// WithPageToken sets the starting page; later pages are fetched on demand.
+func (c *Client) ListPromptsCursor(opts ...ListPromptsOption) *Cursor[Prompt] {
+	listOpts := &listPromptsOptions{}
+	for _, opt := range opts {
+		opt(listOpts)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[Prompt], error) {
+		pageOpts := append(slices.Clone(opts), WithPageToken(pageToken))
+		list, err := c.ListPrompts(ctx, pageOpts...)
+		if err != nil {
+			return Page[Prompt]{}, err
+		}
+		return Page[Prompt]{Items: list.Prompts, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, listOpts.pageToken)
+}
+
 // buildPromptsFilter constructs the filter string for listing prompts.
 func buildPromptsFilter(opts *listPromptsOptions) string {
 	// Base filter: only return prompts
 	filters := []string{"tags.`" + tagIsPrompt + "` = 'true'"}
 
-	// Add name pattern if specified
-	if opts.nameFilter != "" {
-		filters = append(filters, fmt.Sprintf("name LIKE '%s'", escapeFilterValue(opts.nameFilter)))
+	// Add name pattern if specified, scoped to the namespace
+	namePattern := opts.nameFilter
+	if opts.namespace != "" {
+		if namePattern == "" {
+			namePattern = "%"
+		}
+		namePattern = opts.namespace + NameSeparator + namePattern
+	}
+	if namePattern != "" {
+		filters = append(filters, fmt.Sprintf("name LIKE '%s'", searchfilter.Value(namePattern)))
 	}
 
 	// Add tag filters
 	for k, v := range opts.tagFilter {
-		filters = append(filters, fmt.Sprintf("tags.`%s` = '%s'", escapeFilterKey(k), escapeFilterValue(v)))
+		filters = append(filters, fmt.Sprintf("tags.`%s` = '%s'", searchfilter.Key(k), searchfilter.Value(v)))
 	}
 
-	return joinFilters(filters)
+	return searchfilter.And(filters...)
 }
 
 // ListPromptVersions returns versions for a specific prompt.
@@ -499,11 +709,15 @@ func buildPromptsFilter(opts *listPromptsOptions) string {
 // for detailed reproduction steps and analysis.
 //
 // To work around this, ListPromptVersions tries the search endpoint first,
-// and falls back to individual version fetches if search returns empty.
+// and falls back to individual version fetches if the first page of search
+// returns empty. The fallback returns a single page. No MLflow release is
+// known to fix the bug yet, so the fallback is not gated on the server
+// version; the first fallback logs a single warning per client.
 func (c *Client) ListPromptVersions(ctx context.Context, name string, opts ...ListVersionsOption) (*PromptVersionList, error) {
 	if name == "" {
 		return nil, fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 
 	listOpts := &listVersionsOptions{
 		maxResults: 100,
@@ -513,13 +727,14 @@ func (c *Client) ListPromptVersions(ctx context.Context, name string, opts ...Li
 	}
 
 	// Try efficient search endpoint first
-	result, err := c.listVersionsViaSearch(ctx, name, listOpts.maxResults)
+	result, err := c.listVersionsViaSearch(ctx, name, listOpts.maxResults, listOpts.pageToken)
 	if err != nil {
 		return nil, err
 	}
 
-	// If search returned results, we're done
-	if len(result.Versions) > 0 {
+	// If search returned results, we're done. An empty page after the first
+	// is the end of the results rather than the search bug.
+	if len(result.Versions) > 0 || listOpts.pageToken != "" {
 		return result, nil
 	}
 
@@ -528,16 +743,38 @@ func (c *Client) ListPromptVersions(ctx context.Context, name string, opts ...Li
 	return c.listVersionsViaIndividualFetch(ctx, name, listOpts.maxResults)
 }
 
+// ListPromptVersionsCursor returns a cursor over ListPromptVersions results.
+func (c *Client) ListPromptVersionsCursor(name string, opts ...ListVersionsOption) *Cursor[PromptVersion] {
+	listOpts := &listVersionsOptions{}
+	for _, opt := range opts {
+		opt(listOpts)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[PromptVersion], error) {
+		pageOpts := append(slices.Clone(opts), WithVersionsPageToken(pageToken))
+		list, err := c.ListPromptVersions(ctx, name, pageOpts...)
+		if err != nil {
+			return Page[PromptVersion]{}, err
+		}
+		return Page[PromptVersion]{Items: list.Versions, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, listOpts.pageToken)
+}
+
 // listVersionsViaSearch uses the model-versions/search endpoint.
 // Returns empty list if no versions found (caller should try fallback).
-func (c *Client) listVersionsViaSearch(ctx context.Context, name string, maxResults int) (*PromptVersionList, error) {
+func (c *Client) listVersionsViaSearch(ctx context.Context, name string, maxResults int, pageToken string) (*PromptVersionList, error) {
 	var resp mlflowpb.SearchModelVersions_Response
 
 	query := url.Values{
-		"filter":      []string{fmt.Sprintf("name='%s'", escapeFilterValue(name))},
+		"filter":      []string{fmt.Sprintf("name='%s'", searchfilter.Value(name))},
 		"order_by":    []string{"version_number DESC"},
 		"max_results": []string{strconv.Itoa(maxResults)},
 	}
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
 
 	err := c.transport.Get(ctx, "/api/2.0/mlflow/model-versions/search", query, &resp)
 	if err != nil {
@@ -545,11 +782,12 @@ func (c *Client) listVersionsViaSearch(ctx context.Context, name string, maxResu
 	}
 
 	result := &PromptVersionList{
-		Versions: make([]PromptVersion, 0, len(resp.ModelVersions)),
+		Versions:      make([]PromptVersion, 0, len(resp.ModelVersions)),
+		NextPageToken: resp.GetNextPageToken(),
 	}
 
 	for _, mv := range resp.ModelVersions {
-		result.Versions = append(result.Versions, modelVersionToPromptVersionWithoutTemplate(mv))
+		result.Versions = append(result.Versions, modelVersionToPromptVersionWithoutTemplate(mv, c.descriptionPrecedence))
 	}
 
 	return result, nil
@@ -569,9 +807,11 @@ func (c *Client) listVersionsViaIndividualFetch(ctx context.Context, name string
 	}
 	latestVersion := latestPrompt.Version
 
-	slog.Warn("MLflow search returned empty, falling back to individual fetches",
-		"prompt", name,
-		"latest_version", latestVersion)
+	if !c.fallbackWarned.Swap(true) {
+		slog.Warn("MLflow search returned empty, falling back to individual fetches",
+			"prompt", name,
+			"latest_version", latestVersion)
+	}
 
 	result := &PromptVersionList{
 		Versions: make([]PromptVersion, 0, latestVersion),
@@ -597,17 +837,73 @@ func (c *Client) listVersionsViaIndividualFetch(ctx context.Context, name string
 			return nil, fmt.Errorf("failed to get version %d: %w", v, err)
 		}
 
-		result.Versions = append(result.Versions, modelVersionToPromptVersionWithoutTemplate(resp.ModelVersion))
+		result.Versions = append(result.Versions, modelVersionToPromptVersionWithoutTemplate(resp.ModelVersion, c.descriptionPrecedence))
 	}
 
 	return result, nil
 }
 
+// PromptExists reports whether a prompt with the given name exists. A
+// registered model of that name that is not a prompt does not count.
+func (c *Client) PromptExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return false, fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+
+	rm, err := c.getRegisteredModel(ctx, name)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, tag := range rm.GetTags() {
+		if tag.GetKey() == tagIsPrompt {
+			return tag.GetValue() == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// RenamePrompt renames a prompt in the registry.
+// Versions, aliases, and tags move with the prompt; the old name stops resolving.
+func (c *Client) RenamePrompt(ctx context.Context, oldName, newName string) (*Prompt, error) {
+	if oldName == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+	if newName == "" {
+		return nil, fmt.Errorf("mlflow: new prompt name is required")
+	}
+	oldName, newName = c.qualify(oldName), c.qualify(newName)
+	if oldName == newName {
+		return nil, fmt.Errorf("mlflow: new prompt name must differ from the current name")
+	}
+
+	req := &mlflowpb.RenameRegisteredModel{
+		Name:    &oldName,
+		NewName: &newName,
+	}
+
+	var resp mlflowpb.RenameRegisteredModel_Response
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/registered-models/rename", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename prompt: %w", err)
+	}
+	if resp.RegisteredModel == nil { // dry-run
+		resp.RegisteredModel = &mlflowpb.RegisteredModel{Name: &newName}
+	}
+
+	p := registeredModelToPrompt(resp.RegisteredModel)
+	return &p, nil
+}
+
 // SetPromptAlias sets an alias for a specific version of a prompt.
 func (c *Client) SetPromptAlias(ctx context.Context, name, alias string, version int) error {
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 	if alias == "" {
 		return fmt.Errorf("mlflow: alias is required")
 	}
@@ -637,6 +933,7 @@ func (c *Client) DeletePromptAlias(ctx context.Context, name, alias string) erro
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 	if alias == "" {
 		return fmt.Errorf("mlflow: alias is required")
 	}
@@ -656,13 +953,16 @@ func (c *Client) DeletePromptAlias(ctx context.Context, name, alias string) erro
 }
 
 // DeletePromptVersion deletes a specific version of a prompt from the registry.
-func (c *Client) DeletePromptVersion(ctx context.Context, name string, version int) error {
+// On a client with delete protection, confirm the prompt name with WithConfirm.
+func (c *Client) DeletePromptVersion(ctx context.Context, name string, version int, opts ...DeleteOption) error {
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 	if version <= 0 {
 		return fmt.Errorf("mlflow: version must be positive")
 	}
+	ctx = c.confirmDelete(ctx, name, opts)
 
 	versionStr := strconv.Itoa(version)
 	req := &mlflowpb.DeleteModelVersion{
@@ -682,10 +982,13 @@ func (c *Client) DeletePromptVersion(ctx context.Context, name string, version i
 // DeletePrompt deletes a prompt from the registry.
 // On MLflow OSS, this cascades to delete all versions and aliases automatically.
 // On Databricks, versions must be deleted first.
-func (c *Client) DeletePrompt(ctx context.Context, name string) error {
+// On a client with delete protection, confirm the name with WithConfirm.
+func (c *Client) DeletePrompt(ctx context.Context, name string, opts ...DeleteOption) error {
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
+	ctx = c.confirmDelete(ctx, name, opts)
 
 	req := &mlflowpb.DeleteRegisteredModel{
 		Name: &name,
@@ -700,11 +1003,89 @@ func (c *Client) DeletePrompt(ctx context.Context, name string) error {
 	return nil
 }
 
+// confirmDelete returns ctx confirming the deletion of the qualified prompt
+// name if opts confirm it. The confirmation is scoped to the one call made
+// with the returned context, and a name confirmed on a namespaced client is
+// qualified with that namespace only.
+func (c *Client) confirmDelete(ctx context.Context, name string, opts []DeleteOption) context.Context {
+	var o deleteOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return c.confirmed(ctx, name, o.confirm)
+}
+
+// confirmed returns ctx confirming the deletion of the qualified prompt name
+// if confirm names it.
+func (c *Client) confirmed(ctx context.Context, name, confirm string) context.Context {
+	if confirm == "" || c.qualify(confirm) != name {
+		return ctx
+	}
+	return transport.WithConfirm(ctx, name)
+}
+
+// SetPromptTag sets a tag on a prompt, replacing any existing value for the key.
+func (c *Client) SetPromptTag(ctx context.Context, name, key, value string) error {
+	if name == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+	if key == "" {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	req := &mlflowpb.SetRegisteredModelTag{
+		Name:  &name,
+		Key:   &key,
+		Value: &value,
+	}
+
+	var resp mlflowpb.SetRegisteredModelTag_Response
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/registered-models/set-tag", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to set prompt tag: %w", err)
+	}
+
+	return nil
+}
+
+// SetPromptVersionTag sets a tag on a specific prompt version,
+// replacing any existing value for the key.
+func (c *Client) SetPromptVersionTag(ctx context.Context, name string, version int, key, value string) error {
+	if name == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+	if version <= 0 {
+		return fmt.Errorf("mlflow: version must be positive")
+	}
+	if key == "" {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	versionStr := strconv.Itoa(version)
+	req := &mlflowpb.SetModelVersionTag{
+		Name:    &name,
+		Version: &versionStr,
+		Key:     &key,
+		Value:   &value,
+	}
+
+	var resp mlflowpb.SetModelVersionTag_Response
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/model-versions/set-tag", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to set prompt version tag: %w", err)
+	}
+
+	return nil
+}
+
 // DeletePromptTag removes a tag from a prompt.
 func (c *Client) DeletePromptTag(ctx context.Context, name, key string) error {
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 	if key == "" {
 		return fmt.Errorf("mlflow: tag key is required")
 	}
@@ -728,6 +1109,7 @@ func (c *Client) DeletePromptVersionTag(ctx context.Context, name string, versio
 	if name == "" {
 		return fmt.Errorf("mlflow: prompt name is required")
 	}
+	name = c.qualify(name)
 	if version <= 0 {
 		return fmt.Errorf("mlflow: version must be positive")
 	}
@@ -750,18 +1132,3 @@ func (c *Client) DeletePromptVersionTag(ctx context.Context, name string, versio
 
 	return nil
 }
-
-// escapeFilterKey escapes backticks in filter keys to prevent injection.
-func escapeFilterKey(s string) string {
-	return strings.ReplaceAll(s, "`", "``")
-}
-
-// escapeFilterValue escapes single quotes in filter values to prevent injection.
-func escapeFilterValue(s string) string {
-	return strings.ReplaceAll(s, "'", "''")
-}
-
-// joinFilters joins filter conditions with AND.
-func joinFilters(filters []string) string {
-	return strings.Join(filters, " AND ")
-}
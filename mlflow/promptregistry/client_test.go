@@ -3,12 +3,15 @@ package promptregistry
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
 	"github.com/opendatahub-io/mlflow-go/internal/transport"
 )
 
@@ -25,6 +28,20 @@ func newTestClient(t *testing.T, handler http.Handler) *Client {
 	return NewClient(tc)
 }
 
+// newProtectedTestClient is newTestClient with delete protection on.
+func newProtectedTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	tc, err := transport.New(transport.Config{BaseURL: server.URL, DeleteProtection: true})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+
+	return NewClient(tc)
+}
+
 func TestLoadPrompt_EmptyName(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
@@ -146,6 +163,37 @@ func TestLoadPrompt_WithVersion(t *testing.T) {
 	}
 }
 
+func TestLoadPrompt_NativeAliases(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{
+				"name":    "test-prompt",
+				"version": "3",
+				"aliases": []string{"production", "canary"},
+				"tags": []map[string]string{
+					{"key": "mlflow.prompt.text", "value": "Version 3 template"},
+					// Stale alias tag: the alias was moved natively since
+					{"key": "mlflow.prompt.alias.staging", "value": "3"},
+				},
+			},
+		})
+	}))
+
+	for _, opt := range []LoadOption{WithVersion(3), WithAlias("production")} {
+		prompt, err := client.LoadPrompt(context.Background(), "test-prompt", opt)
+		if err != nil {
+			t.Fatalf("LoadPrompt() error = %v", err)
+		}
+		if !slices.Equal(prompt.Aliases, []string{"production", "canary"}) {
+			t.Errorf("Aliases = %v, want the native aliases", prompt.Aliases)
+		}
+		if _, ok := prompt.Tags["mlflow.prompt.alias.staging"]; ok {
+			t.Error("alias tag exposed as a user tag")
+		}
+	}
+}
+
 func TestLoadPrompt_NotFound(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -632,6 +680,7 @@ func TestListPromptVersions_Success(t *testing.T) {
 						"description":            "Version 3",
 						"creation_timestamp":     1700000300000,
 						"last_updated_timestamp": 1700000300000,
+						"aliases":                []string{"production"},
 						"tags": []map[string]string{
 							{"key": "mlflow.prompt.text", "value": "Template v3"},
 							{"key": "author", "value": "alice"},
@@ -699,6 +748,10 @@ func TestListPromptVersions_Success(t *testing.T) {
 	if result.Versions[0].Tags["author"] != "alice" {
 		t.Errorf("Tags[author] = %q, want %q", result.Versions[0].Tags["author"], "alice")
 	}
+
+	if !slices.Equal(result.Versions[0].Aliases, []string{"production"}) || result.Versions[1].Aliases != nil {
+		t.Errorf("Aliases = %v, %v", result.Versions[0].Aliases, result.Versions[1].Aliases)
+	}
 }
 
 func TestListPromptVersions_FallbackWhenSearchEmpty(t *testing.T) {
@@ -766,6 +819,42 @@ func TestListPromptVersions_FallbackWhenSearchEmpty(t *testing.T) {
 	}
 }
 
+func TestListPromptVersions_FallbackWithoutVersionProbe(t *testing.T) {
+	// The fallback is not gated on the server version, so it sends no
+	// requests beyond search and the individual fetches
+	var paths []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/search":
+			json.NewEncoder(w).Encode(map[string]any{"model_versions": []map[string]any{}})
+		case "/api/2.0/mlflow/registered-models/alias", "/api/2.0/mlflow/model-versions/get":
+			json.NewEncoder(w).Encode(map[string]any{"model_version": map[string]any{"name": "test-prompt", "version": "1"}})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	for range 2 {
+		result, err := client.ListPromptVersions(context.Background(), "test-prompt")
+		if err != nil {
+			t.Fatalf("ListPromptVersions() error = %v", err)
+		}
+		if len(result.Versions) != 1 {
+			t.Errorf("got %d versions, want 1 via the fallback", len(result.Versions))
+		}
+	}
+	want := []string{
+		"/api/2.0/mlflow/model-versions/search", "/api/2.0/mlflow/registered-models/alias", "/api/2.0/mlflow/model-versions/get",
+		"/api/2.0/mlflow/model-versions/search", "/api/2.0/mlflow/registered-models/alias", "/api/2.0/mlflow/model-versions/get",
+	}
+	if !slices.Equal(paths, want) {
+		t.Errorf("requests = %v, want %v", paths, want)
+	}
+}
+
 func TestListPromptVersions_EmptyName(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
@@ -775,6 +864,139 @@ func TestListPromptVersions_EmptyName(t *testing.T) {
 	}
 }
 
+func TestPromptExists(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch name := r.URL.Query().Get("name"); name {
+		case "team/qa":
+			json.NewEncoder(w).Encode(map[string]any{"registered_model": map[string]any{
+				"name": name,
+				"tags": []map[string]string{{"key": "mlflow.prompt.is_prompt", "value": "true"}},
+			}})
+		case "model":
+			json.NewEncoder(w).Encode(map[string]any{"registered_model": map[string]any{"name": name}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST"})
+		}
+	}))
+	team, err := client.WithNamespace("team")
+	if err != nil {
+		t.Fatalf("WithNamespace() error = %v", err)
+	}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		client *Client
+		name   string
+		want   bool
+	}{
+		{team, "qa", true},
+		{client, "team/qa", true},
+		{client, "qa", false},
+		{client, "model", false}, // not a prompt
+	} {
+		got, err := tc.client.PromptExists(ctx, tc.name)
+		if err != nil || got != tc.want {
+			t.Errorf("PromptExists(%q) = %v, %v; want %v, nil", tc.name, got, err, tc.want)
+		}
+	}
+	if _, err := client.PromptExists(ctx, ""); err == nil {
+		t.Error("PromptExists() without a name error = nil")
+	}
+}
+
+func TestRenamePrompt_Success(t *testing.T) {
+	var receivedName, receivedNewName string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/api/2.0/mlflow/registered-models/rename" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var req struct {
+			Name    string `json:"name"`
+			NewName string `json:"new_name"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		receivedName = req.Name
+		receivedNewName = req.NewName
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"registered_model": map[string]any{
+				"name": "team-a.greeting",
+				"tags": []map[string]string{
+					{"key": "mlflow.prompt.is_prompt", "value": "true"},
+					{"key": "owner", "value": "team-a"},
+				},
+				"latest_versions": []map[string]any{
+					{"name": "team-a.greeting", "version": "4"},
+				},
+			},
+		})
+	}))
+
+	prompt, err := client.RenamePrompt(context.Background(), "greeting", "team-a.greeting")
+	if err != nil {
+		t.Fatalf("RenamePrompt() error = %v", err)
+	}
+
+	if receivedName != "greeting" {
+		t.Errorf("name = %q, want %q", receivedName, "greeting")
+	}
+	if receivedNewName != "team-a.greeting" {
+		t.Errorf("new_name = %q, want %q", receivedNewName, "team-a.greeting")
+	}
+	if prompt.Name != "team-a.greeting" {
+		t.Errorf("Name = %q, want %q", prompt.Name, "team-a.greeting")
+	}
+	if prompt.LatestVersion != 4 {
+		t.Errorf("LatestVersion = %d, want %d", prompt.LatestVersion, 4)
+	}
+	if prompt.Tags["owner"] != "team-a" {
+		t.Errorf("Tags[owner] = %q, want %q", prompt.Tags["owner"], "team-a")
+	}
+}
+
+func TestRenamePrompt_AlreadyExists(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error_code": "RESOURCE_ALREADY_EXISTS",
+			"message":    "Registered Model (name=taken) already exists.",
+		})
+	}))
+
+	_, err := client.RenamePrompt(context.Background(), "greeting", "taken")
+	if !errors.IsAlreadyExists(err) {
+		t.Errorf("expected IsAlreadyExists, got %v", err)
+	}
+}
+
+func TestRenamePrompt_InvalidArgs(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	if _, err := client.RenamePrompt(context.Background(), "", "new"); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if _, err := client.RenamePrompt(context.Background(), "old", ""); err == nil {
+		t.Error("expected error for empty new name")
+	}
+	if _, err := client.RenamePrompt(context.Background(), "same", "same"); err == nil {
+		t.Error("expected error for identical names")
+	}
+}
+
 func TestDeletePromptVersion_Success(t *testing.T) {
 	var deleteCalled bool
 	var receivedName, receivedVersion string
@@ -966,6 +1188,147 @@ func TestDeletePrompt_PermissionDenied(t *testing.T) {
 	}
 }
 
+func TestSetPromptTag_Success(t *testing.T) {
+	var receivedName, receivedKey, receivedValue string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/api/2.0/mlflow/registered-models/set-tag" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var req struct {
+			Name  string `json:"name"`
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		receivedName = req.Name
+		receivedKey = req.Key
+		receivedValue = req.Value
+
+		json.NewEncoder(w).Encode(map[string]any{})
+	}))
+
+	err := client.SetPromptTag(context.Background(), "test-prompt", "environment", "prod")
+	if err != nil {
+		t.Fatalf("SetPromptTag() error = %v", err)
+	}
+
+	if receivedName != "test-prompt" {
+		t.Errorf("name = %q, want %q", receivedName, "test-prompt")
+	}
+	if receivedKey != "environment" {
+		t.Errorf("key = %q, want %q", receivedKey, "environment")
+	}
+	if receivedValue != "prod" {
+		t.Errorf("value = %q, want %q", receivedValue, "prod")
+	}
+}
+
+func TestSetPromptTag_EmptyName(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	err := client.SetPromptTag(context.Background(), "", "key", "value")
+	if err == nil {
+		t.Error("expected error for empty name")
+	}
+}
+
+func TestSetPromptTag_EmptyKey(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	err := client.SetPromptTag(context.Background(), "test-prompt", "", "value")
+	if err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
+func TestSetPromptVersionTag_Success(t *testing.T) {
+	var receivedName, receivedVersion, receivedKey, receivedValue string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/api/2.0/mlflow/model-versions/set-tag" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var req struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+			Key     string `json:"key"`
+			Value   string `json:"value"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		receivedName = req.Name
+		receivedVersion = req.Version
+		receivedKey = req.Key
+		receivedValue = req.Value
+
+		json.NewEncoder(w).Encode(map[string]any{})
+	}))
+
+	err := client.SetPromptVersionTag(context.Background(), "test-prompt", 2, "reviewed", "true")
+	if err != nil {
+		t.Fatalf("SetPromptVersionTag() error = %v", err)
+	}
+
+	if receivedName != "test-prompt" {
+		t.Errorf("name = %q, want %q", receivedName, "test-prompt")
+	}
+	if receivedVersion != "2" {
+		t.Errorf("version = %q, want %q", receivedVersion, "2")
+	}
+	if receivedKey != "reviewed" {
+		t.Errorf("key = %q, want %q", receivedKey, "reviewed")
+	}
+	if receivedValue != "true" {
+		t.Errorf("value = %q, want %q", receivedValue, "true")
+	}
+}
+
+func TestSetPromptVersionTag_NotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    "Model version not found",
+		})
+	}))
+
+	err := client.SetPromptVersionTag(context.Background(), "test-prompt", 9, "key", "value")
+	if !errors.IsNotFound(err) {
+		t.Errorf("expected IsNotFound, got %v", err)
+	}
+}
+
+func TestSetPromptVersionTag_InvalidArgs(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	if err := client.SetPromptVersionTag(context.Background(), "", 1, "key", "value"); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if err := client.SetPromptVersionTag(context.Background(), "test-prompt", 0, "key", "value"); err == nil {
+		t.Error("expected error for zero version")
+	}
+	if err := client.SetPromptVersionTag(context.Background(), "test-prompt", 1, "", "value"); err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
 func TestDeletePromptTag_Success(t *testing.T) {
 	var deleteCalled bool
 	var receivedName, receivedKey string
@@ -1146,3 +1509,382 @@ func TestDeletePromptVersionTag_EmptyKey(t *testing.T) {
 		t.Error("expected error for empty key")
 	}
 }
+
+func TestWithNamespace_PrefixesNames(t *testing.T) {
+	var registeredName, listFilter, loadedName string
+
+	base := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/registered-models/create":
+			var req struct {
+				Name string `json:"name"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			registeredName = req.Name
+			json.NewEncoder(w).Encode(map[string]any{})
+		case "/api/2.0/mlflow/model-versions/create":
+			json.NewEncoder(w).Encode(map[string]any{
+				"model_version": map[string]any{"name": registeredName, "version": "1"},
+			})
+		case "/api/2.0/mlflow/registered-models/search":
+			listFilter = r.URL.Query().Get("filter")
+			json.NewEncoder(w).Encode(map[string]any{})
+		case "/api/2.0/mlflow/registered-models/alias":
+			loadedName = r.URL.Query().Get("name")
+			json.NewEncoder(w).Encode(map[string]any{
+				"model_version": map[string]any{"name": loadedName, "version": "1"},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	client, err := base.WithNamespace("team/project")
+	if err != nil {
+		t.Fatalf("WithNamespace() error = %v", err)
+	}
+	if base.Namespace() != "" {
+		t.Errorf("base Namespace() = %q, want empty", base.Namespace())
+	}
+
+	pv, err := client.RegisterPrompt(context.Background(), "greeting", "Hello!")
+	if err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+	if registeredName != "team/project/greeting" {
+		t.Errorf("registered name = %q, want %q", registeredName, "team/project/greeting")
+	}
+	if pv.Name != "team/project/greeting" {
+		t.Errorf("Name = %q, want %q", pv.Name, "team/project/greeting")
+	}
+
+	if _, err := client.ListPrompts(context.Background(), WithNameFilter("greet%")); err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if !strings.Contains(listFilter, "name LIKE 'team/project/greet%'") {
+		t.Errorf("filter = %q, want namespaced name pattern", listFilter)
+	}
+
+	// Already-qualified names are not prefixed twice
+	if _, err := client.LoadPrompt(context.Background(), "team/project/greeting"); err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if loadedName != "team/project/greeting" {
+		t.Errorf("loaded name = %q, want %q", loadedName, "team/project/greeting")
+	}
+}
+
+func TestWithNamespace_ListSkipsWildcardMatches(t *testing.T) {
+	base := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The server applies LIKE, where "_" matches any character
+		json.NewEncoder(w).Encode(map[string]any{"registered_models": []map[string]any{
+			{"name": "my_team/greeting"},
+			{"name": "myXteam/greeting"},
+			{"name": "my_team2/greeting"},
+		}})
+	}))
+	client, err := base.WithNamespace("my_team")
+	if err != nil {
+		t.Fatalf("WithNamespace() error = %v", err)
+	}
+
+	list, err := client.ListPrompts(context.Background())
+	if err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if len(list.Prompts) != 1 || list.Prompts[0].Name != "my_team/greeting" {
+		t.Errorf("prompts = %+v, want only my_team/greeting", list.Prompts)
+	}
+}
+
+func TestWithNamespace_Invalid(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for _, ns := range []string{"", "team//project", "team project"} {
+		if _, err := client.WithNamespace(ns); err == nil {
+			t.Errorf("WithNamespace(%q) expected error", ns)
+		}
+	}
+}
+
+func TestWithNamespace_RegisterRejectsInvalidName(t *testing.T) {
+	base := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	client, err := base.WithNamespace("team")
+	if err != nil {
+		t.Fatalf("WithNamespace() error = %v", err)
+	}
+
+	if _, err := client.RegisterPrompt(context.Background(), "bad name", "Hello!"); err == nil {
+		t.Error("expected error for invalid prompt name")
+	}
+}
+
+func TestRestorePrompt_Success(t *testing.T) {
+	var calls []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		calls = append(calls, r.URL.Path+" "+req["name"]+" "+req["version"])
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{})
+	}))
+
+	if err := client.RestorePrompt(context.Background(), "deleted-prompt"); err != nil {
+		t.Fatalf("RestorePrompt() error = %v", err)
+	}
+	if err := client.RestorePromptVersion(context.Background(), "deleted-prompt", 2); err != nil {
+		t.Fatalf("RestorePromptVersion() error = %v", err)
+	}
+	want := []string{
+		"/api/2.0/mlflow/registered-models/restore deleted-prompt ",
+		"/api/2.0/mlflow/model-versions/restore deleted-prompt 2",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
+
+func TestRestorePrompt_Unsupported(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		body            string
+		wantUnsupported bool
+	}{
+		{"unknown route", http.StatusNotFound, `<html>Not Found</html>`, true},
+		{"method not allowed", http.StatusMethodNotAllowed, `{}`, true},
+		{"prompt not found", http.StatusNotFound, `{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no prompt"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+
+			err := client.RestorePrompt(context.Background(), "deleted-prompt")
+			if err == nil || stderrors.Is(err, errors.ErrUnsupportedByServer) != tt.wantUnsupported {
+				t.Errorf("RestorePrompt() error = %v, want unsupported = %v", err, tt.wantUnsupported)
+			}
+			err = client.RestorePromptVersion(context.Background(), "deleted-prompt", 2)
+			if err == nil || stderrors.Is(err, errors.ErrUnsupportedByServer) != tt.wantUnsupported {
+				t.Errorf("RestorePromptVersion() error = %v, want unsupported = %v", err, tt.wantUnsupported)
+			}
+		})
+	}
+}
+
+func TestRestorePrompt_InvalidArgs(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	if err := client.RestorePrompt(context.Background(), ""); err == nil {
+		t.Error("expected validation error for empty name")
+	}
+	if err := client.RestorePromptVersion(context.Background(), "p", 0); err == nil {
+		t.Error("expected validation error for zero version")
+	}
+}
+
+func TestRegisterPrompt_DryRun(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	ctx := transport.WithDryRun(context.Background())
+	pv, err := client.RegisterPrompt(ctx, "greeting", "Hello, {{name}}!",
+		WithCommitMessage("initial"),
+		WithTags(map[string]string{"team": "ml"}),
+	)
+	if err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+
+	if pv.Name != "greeting" {
+		t.Errorf("Name = %q, want %q", pv.Name, "greeting")
+	}
+	if pv.Version != 0 {
+		t.Errorf("Version = %d, want 0 (not registered)", pv.Version)
+	}
+	if pv.Template != "Hello, {{name}}!" {
+		t.Errorf("Template = %q, want %q", pv.Template, "Hello, {{name}}!")
+	}
+	if pv.CommitMessage != "initial" {
+		t.Errorf("CommitMessage = %q, want %q", pv.CommitMessage, "initial")
+	}
+	if pv.Tags["team"] != "ml" {
+		t.Errorf("Tags[team] = %q, want %q", pv.Tags["team"], "ml")
+	}
+}
+
+func TestListPromptsCursor_All(t *testing.T) {
+	var tokens []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+
+		switch token {
+		case "":
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "a"}, {"name": "b"}},
+				"next_page_token":   "page2",
+			})
+		case "page2":
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "c"}},
+			})
+		default:
+			t.Errorf("unexpected page_token: %q", token)
+		}
+	}))
+
+	prompts, err := client.ListPromptsCursor(WithMaxResults(2)).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+
+	if len(prompts) != 3 || prompts[2].Name != "c" {
+		t.Errorf("prompts = %+v, want a, b, c", prompts)
+	}
+	if len(tokens) != 2 || tokens[1] != "page2" {
+		t.Errorf("page tokens = %v, want [\"\" page2]", tokens)
+	}
+}
+
+func TestListPromptVersionsCursor_All(t *testing.T) {
+	var tokens, maxResults []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/model-versions/search" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		maxResults = append(maxResults, r.URL.Query().Get("max_results"))
+
+		switch token {
+		case "":
+			json.NewEncoder(w).Encode(map[string]any{
+				"model_versions":  []map[string]any{{"name": "test-prompt", "version": "3"}, {"name": "test-prompt", "version": "2"}},
+				"next_page_token": "page2",
+			})
+		case "page2":
+			json.NewEncoder(w).Encode(map[string]any{
+				"model_versions": []map[string]any{{"name": "test-prompt", "version": "1"}},
+			})
+		default:
+			t.Errorf("unexpected page_token: %q", token)
+		}
+	}))
+
+	versions, err := client.ListPromptVersionsCursor("test-prompt", WithVersionsMaxResults(2)).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+
+	if len(versions) != 3 || versions[2].Version != 1 {
+		t.Errorf("versions = %+v, want 3, 2, 1", versions)
+	}
+	if !slices.Equal(tokens, []string{"", "page2"}) {
+		t.Errorf("page tokens = %q, want [\"\" page2]", tokens)
+	}
+	if !slices.Equal(maxResults, []string{"2", "2"}) {
+		t.Errorf("max_results = %q, want [2 2]", maxResults)
+	}
+}
+
+func TestListPromptVersions_EmptyLaterPageSkipsFallback(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/model-versions/search" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"model_versions": []map[string]any{}})
+	}))
+
+	result, err := client.ListPromptVersions(context.Background(), "test-prompt", WithVersionsPageToken("page2"))
+	if err != nil {
+		t.Fatalf("ListPromptVersions() error = %v", err)
+	}
+	if len(result.Versions) != 0 || result.NextPageToken != "" {
+		t.Errorf("result = %+v, want an empty last page", result)
+	}
+}
+
+func BenchmarkLoadPrompt_Decode(b *testing.B) {
+	body, err := json.Marshal(map[string]any{
+		"model_version": map[string]any{
+			"name":                   "support-chat",
+			"version":                "7",
+			"creation_timestamp":     1700000000000,
+			"last_updated_timestamp": 1700000000000,
+			"description":            "tighten tone",
+			"tags": []map[string]string{
+				{"key": "mlflow.prompt.is_prompt", "value": "true"},
+				{"key": "mlflow.prompt.type", "value": "chat"},
+				{"key": "mlflow.prompt.text", "value": `[{"role":"system","content":"You are a {{role}} assistant."},{"role":"user","content":"{{question}}"}]`},
+				{"key": "mlflow.prompt.model_config", "value": `{"model_name":"gpt-4o","temperature":0.2}`},
+				{"key": "team", "value": "support"},
+				{"key": "env", "value": "prod"},
+				{"key": "owner", "value": "alice"},
+			},
+		},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		var resp mlflowpb.GetModelVersion_Response
+		if err := json.Unmarshal(body, &resp); err != nil {
+			b.Fatal(err)
+		}
+		if pv := modelVersionToPromptVersion(resp.GetModelVersion(), DescriptionTagFirst); pv == nil {
+			b.Fatal("nil prompt version")
+		}
+	}
+}
+
+func TestListPrompts_WithCountUpTo(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		resp := map[string]any{"registered_models": []map[string]any{{"name": "a"}, {"name": "b"}}, "next_page_token": "p2"}
+		if r.URL.Query().Get("page_token") == "p2" {
+			resp = map[string]any{"registered_models": []map[string]any{{"name": "c"}}}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+
+	list, err := client.ListPrompts(context.Background(), WithMaxResults(2), WithCountUpTo(10))
+	if err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if len(list.Prompts) != 2 || list.TotalCount == nil || *list.TotalCount != 3 || list.TotalCountCapped {
+		t.Errorf("ListPrompts() = %d prompts, TotalCount %v, capped %v, want 2, 3, false", len(list.Prompts), list.TotalCount, list.TotalCountCapped)
+	}
+
+	list, err = client.ListPrompts(context.Background(), WithMaxResults(2), WithCountUpTo(2))
+	if err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if *list.TotalCount != 2 || !list.TotalCountCapped {
+		t.Errorf("TotalCount = %d, capped %v, want 2, true", *list.TotalCount, list.TotalCountCapped)
+	}
+}
@@ -0,0 +1,129 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ContentMatch is a line of a prompt template that matched
+// SearchPromptContent.
+type ContentMatch struct {
+	// Name and Version identify the prompt version searched: the latest.
+	Name    string
+	Version int
+
+	// Message is the index of the chat message the line belongs to, and Role
+	// its role. Message is -1 and Role empty for text prompts.
+	Message int
+	Role    string
+
+	// Line is the 1-based line number within the template or message, and
+	// Text the line itself.
+	Line int
+	Text string
+
+	// Before and After are up to WithContextLines lines around the match.
+	Before []string
+	After  []string
+}
+
+// String formats the match like grep: "name@v3:12: text", with the message
+// index for chat prompts ("name@v3[1]:12: text").
+func (m ContentMatch) String() string {
+	if m.Message >= 0 {
+		return fmt.Sprintf("%s@v%d[%d]:%d: %s", m.Name, m.Version, m.Message, m.Line, m.Text)
+	}
+	return fmt.Sprintf("%s@v%d:%d: %s", m.Name, m.Version, m.Line, m.Text)
+}
+
+// SearchPromptContent returns the lines of prompt templates matching
+// pattern, a regular expression (use regexp.QuoteMeta to search for a
+// literal phrase), such as a deprecated phrase or "{{\s*user_name\s*}}" to
+// find where a variable is used. The latest version of every prompt is
+// searched; limit the prompts loaded with WithContentNameFilter and
+// WithContentTagFilter. Chat prompts are searched message by message.
+//
+// Matches are ordered by prompt name, message, and line.
+func (c *Client) SearchPromptContent(ctx context.Context, pattern string, opts ...SearchContentOption) ([]ContentMatch, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("mlflow: pattern is required")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: invalid pattern: %w", err)
+	}
+
+	o := &searchContentOptions{concurrency: summaryConcurrency}
+	for _, opt := range opts {
+		opt(o)
+	}
+	o.concurrency = max(o.concurrency, 1)
+	o.contextLines = max(o.contextLines, 0)
+
+	var listOpts []ListPromptsOption
+	if o.nameFilter != "" {
+		listOpts = append(listOpts, WithNameFilter(o.nameFilter))
+	}
+	if o.tagFilter != nil {
+		listOpts = append(listOpts, WithTagFilter(o.tagFilter))
+	}
+	prompts, err := c.ListPromptsCursor(listOpts...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each prompt writes only its own slot, so no lock is needed
+	matches := make([][]ContentMatch, len(prompts))
+	err = forEachConcurrent(ctx, len(prompts), o.concurrency, func(ctx context.Context, i int) error {
+		pv, loadErr := c.LoadPrompt(ctx, prompts[i].Name)
+		if loadErr != nil {
+			return fmt.Errorf("failed to load prompt %q: %w", prompts[i].Name, loadErr)
+		}
+		matches[i] = matchContent(pv, re, o.contextLines)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var all []ContentMatch
+	for _, m := range matches {
+		all = append(all, m...)
+	}
+	return all, nil
+}
+
+// matchContent returns the matching lines of pv.
+func matchContent(pv *PromptVersion, re *regexp.Regexp, contextLines int) []ContentMatch {
+	if !pv.IsChat() {
+		return matchLines(pv.Name, pv.Version, -1, "", pv.Template, re, contextLines)
+	}
+	var matches []ContentMatch
+	for i, msg := range pv.Messages {
+		matches = append(matches, matchLines(pv.Name, pv.Version, i, msg.Role, msg.Content, re, contextLines)...)
+	}
+	return matches
+}
+
+func matchLines(name string, version, message int, role, text string, re *regexp.Regexp, contextLines int) []ContentMatch {
+	lines := strings.Split(text, "\n")
+	var matches []ContentMatch
+	for i, line := range lines {
+		if !re.MatchString(line) {
+			continue
+		}
+		matches = append(matches, ContentMatch{
+			Name:    name,
+			Version: version,
+			Message: message,
+			Role:    role,
+			Line:    i + 1,
+			Text:    line,
+			Before:  lines[max(i-contextLines, 0):i:i],
+			After:   lines[i+1 : min(i+1+contextLines, len(lines))],
+		})
+	}
+	return matches
+}
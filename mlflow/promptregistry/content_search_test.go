@@ -0,0 +1,104 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearchPromptContent(t *testing.T) {
+	texts := map[string][2]string{
+		"greeting": {promptTypeText, "Hello {{ user_name }}!\nHow are you?\nBye {{user_name}}"},
+		"support":  {promptTypeChat, `[{"role":"system","content":"You are helpful."},{"role":"user","content":"Question:\n{{user_name}} asks {{question}}"}]`},
+		"unused":   {promptTypeText, "Nothing here"},
+	}
+
+	var filter string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/registered-models/search":
+			filter = r.URL.Query().Get("filter")
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "greeting"}, {"name": "support"}, {"name": "unused"}},
+			})
+		case "/api/2.0/mlflow/registered-models/alias":
+			name := r.URL.Query().Get("name")
+			json.NewEncoder(w).Encode(map[string]any{
+				"model_version": map[string]any{
+					"name":    name,
+					"version": "3",
+					"tags": []map[string]string{
+						{"key": tagPromptType, "value": texts[name][0]},
+						{"key": tagPromptText, "value": texts[name][1]},
+					},
+				},
+			})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	matches, err := client.SearchPromptContent(context.Background(), `\{\{\s*user_name\s*\}\}`,
+		WithContentNameFilter("%"), WithContextLines(1))
+	if err != nil {
+		t.Fatalf("SearchPromptContent() error = %v", err)
+	}
+	if !strings.Contains(filter, "name LIKE '%'") {
+		t.Errorf("filter = %q, want a name filter", filter)
+	}
+
+	var got []string
+	for _, m := range matches {
+		got = append(got, m.String())
+	}
+	want := []string{
+		"greeting@v3:1: Hello {{ user_name }}!",
+		"greeting@v3:3: Bye {{user_name}}",
+		"support@v3[1]:2: {{user_name}} asks {{question}}",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("matches = %q, want %q", got, want)
+	}
+
+	if m := matches[0]; len(m.Before) != 0 || !slices.Equal(m.After, []string{"How are you?"}) {
+		t.Errorf("first match context = %q / %q", m.Before, m.After)
+	}
+	if m := matches[2]; m.Role != "user" || !slices.Equal(m.Before, []string{"Question:"}) || len(m.After) != 0 {
+		t.Errorf("chat match = %+v", m)
+	}
+}
+
+func TestSearchPromptContent_InvalidPattern(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	if _, err := client.SearchPromptContent(context.Background(), ""); err == nil {
+		t.Error("expected error for empty pattern")
+	}
+	if _, err := client.SearchPromptContent(context.Background(), "("); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
+
+func TestSearchPromptContent_LoadError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/2.0/mlflow/registered-models/search" {
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "a"}},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error_code": "INTERNAL_ERROR", "message": "boom"})
+	}))
+
+	_, err := client.SearchPromptContent(context.Background(), "x")
+	if err == nil || !strings.Contains(err.Error(), `failed to load prompt "a"`) {
+		t.Errorf("SearchPromptContent() error = %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package promptregistry
+
+// DescriptionPrecedence says where PromptVersion.CommitMessage is read from.
+// A version can carry a commit message in two places: the model version's
+// description field, which RegisterPrompt sets, and the
+// mlflow.prompt.description tag, which other clients may set instead. When
+// both are set and differ, the precedence picks one; an empty source never
+// wins over a set one.
+type DescriptionPrecedence int
+
+const (
+	// DescriptionTagFirst reads the mlflow.prompt.description tag, falling
+	// back to the description field. It is the default.
+	DescriptionTagFirst DescriptionPrecedence = iota
+	// DescriptionFieldFirst reads the description field, falling back to
+	// the mlflow.prompt.description tag.
+	DescriptionFieldFirst
+)
+
+// String returns the precedence name, such as "tag first".
+func (p DescriptionPrecedence) String() string {
+	switch p {
+	case DescriptionTagFirst:
+		return "tag first"
+	case DescriptionFieldFirst:
+		return "field first"
+	default:
+		return "unknown"
+	}
+}
+
+// commitMessage picks the commit message from a version's description
+// field and description tag.
+func (p DescriptionPrecedence) commitMessage(field, tag string) string {
+	first, second := tag, field
+	if p == DescriptionFieldFirst {
+		first, second = field, tag
+	}
+	if first != "" {
+		return first
+	}
+	return second
+}
+
+// WithDescriptionPrecedence returns a copy of the client that reads
+// PromptVersion.CommitMessage with precedence p. The receiver is not
+// modified. Both sources stay available as PromptVersion.RawDescription and
+// PromptVersion.RawTags.
+func (c *Client) WithDescriptionPrecedence(p DescriptionPrecedence) *Client {
+	scoped := *c
+	scoped.descriptionPrecedence = p
+	return &scoped
+}
@@ -0,0 +1,86 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDescriptionPrecedence(t *testing.T) {
+	description := "field message"
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mv := map[string]any{
+			"name":        "qa",
+			"version":     "2",
+			"description": description,
+			"tags": []map[string]string{
+				{"key": tagPromptText, "value": "Hello"},
+				{"key": tagDescription, "value": "tag message"},
+			},
+		}
+		if r.URL.Path == "/api/2.0/mlflow/model-versions/search" {
+			json.NewEncoder(w).Encode(map[string]any{"model_versions": []any{mv}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"model_version": mv})
+	}))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name        string
+		client      *Client
+		description string
+		want        string
+	}{
+		{"default", client, "field message", "tag message"},
+		{"field first", client.WithDescriptionPrecedence(DescriptionFieldFirst), "field message", "field message"},
+		{"field first, empty field", client.WithDescriptionPrecedence(DescriptionFieldFirst), "", "tag message"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			description = tc.description
+			pv, err := tc.client.LoadPrompt(ctx, "qa", WithVersion(2))
+			if err != nil {
+				t.Fatalf("LoadPrompt() error = %v", err)
+			}
+			list, err := tc.client.ListPromptVersions(ctx, "qa")
+			if err != nil {
+				t.Fatalf("ListPromptVersions() error = %v", err)
+			}
+			for _, v := range []PromptVersion{*pv, list.Versions[0]} {
+				if v.CommitMessage != tc.want {
+					t.Errorf("CommitMessage = %q, want %q", v.CommitMessage, tc.want)
+				}
+				if v.RawDescription != tc.description || v.RawTags[tagDescription] != "tag message" {
+					t.Errorf("RawDescription = %q, RawTags = %v", v.RawDescription, v.RawTags)
+				}
+				if _, ok := v.Tags[tagDescription]; ok {
+					t.Error("description tag exposed as a user tag")
+				}
+			}
+		})
+	}
+
+	if client.descriptionPrecedence != DescriptionTagFirst {
+		t.Error("WithDescriptionPrecedence modified the receiver")
+	}
+}
+
+func TestPromptVersion_RawFieldsNotSerialized(t *testing.T) {
+	pv := &PromptVersion{Name: "qa", RawDescription: "raw", RawTags: map[string]string{tagPromptText: "secret"}}
+	data, err := json.Marshal(pv)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "raw") || strings.Contains(string(data), "secret") {
+		t.Errorf("JSON = %s, want raw fields left out", data)
+	}
+
+	clone := pv.Clone()
+	clone.RawTags[tagPromptText] = "changed"
+	if clone.RawDescription != "raw" || pv.RawTags[tagPromptText] != "secret" {
+		t.Errorf("Clone() RawDescription = %q, original RawTags = %v", clone.RawDescription, pv.RawTags)
+	}
+}
@@ -0,0 +1,224 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// EmbeddedResolver loads prompts from the registry, falling back to a
+// snapshot built into the binary when the registry is unavailable, so a
+// serving binary starts and keeps serving through registry outages. It is
+// safe for concurrent use.
+//
+// Versions loaded from the registry are reused for one minute by default
+// (see WithEmbeddedRefresh). After a failed registry call, fallbacks are
+// served for 30 seconds (see WithEmbeddedRetryAfter) before the registry
+// is tried again, so the resolver moves back to live versions on its own
+// once the registry is reachable.
+type EmbeddedResolver struct {
+	client   API
+	snapshot map[string][]*PromptVersion // by name, in version order
+	opts     embeddedOptions
+
+	mu      sync.Mutex
+	live    map[string]embeddedEntry
+	retryAt time.Time
+}
+
+type embeddedEntry struct {
+	version *PromptVersion
+	expires time.Time
+}
+
+// NewEmbeddedResolver returns a resolver serving the snapshot in fsys, such
+// as an embed.FS, when client cannot reach the registry:
+//
+//	//go:embed prompts
+//	var promptSnapshot embed.FS
+//
+//	r, err := promptregistry.NewEmbeddedResolver(promptSnapshot, client.PromptRegistry())
+//
+// The snapshot is every .json file in fsys, each a PromptVersion as
+// written by WriteSnapshot.
+func NewEmbeddedResolver(fsys fs.FS, client API, opts ...EmbeddedOption) (*EmbeddedResolver, error) {
+	if fsys == nil {
+		return nil, fmt.Errorf("mlflow: snapshot file system is required")
+	}
+	if client == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+
+	r := &EmbeddedResolver{
+		client:   client,
+		snapshot: make(map[string][]*PromptVersion),
+		opts: embeddedOptions{
+			refresh:    time.Minute,
+			retryAfter: 30 * time.Second,
+			now:        time.Now,
+		},
+		live: make(map[string]embeddedEntry),
+	}
+	for _, opt := range opts {
+		opt(&r.opts)
+	}
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() || strings.ToLower(path.Ext(p)) != ".json" {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		var pv PromptVersion
+		if err := json.Unmarshal(data, &pv); err != nil {
+			return fmt.Errorf("mlflow: invalid prompt snapshot %s: %w", p, err)
+		}
+		if pv.Name == "" || pv.Version <= 0 {
+			return fmt.Errorf("mlflow: invalid prompt snapshot %s: name and version are required", p)
+		}
+		for _, other := range r.snapshot[pv.Name] {
+			if other.Version == pv.Version {
+				return fmt.Errorf("mlflow: prompt snapshot %s: duplicate version %d of %q", p, pv.Version, pv.Name)
+			}
+		}
+		r.snapshot[pv.Name] = append(r.snapshot[pv.Name], &pv)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, versions := range r.snapshot {
+		slices.SortFunc(versions, func(a, b *PromptVersion) int { return a.Version - b.Version })
+	}
+
+	return r, nil
+}
+
+// LoadPrompt loads a prompt like Client.LoadPrompt. If the registry is
+// unavailable, it returns the version last loaded from the registry for
+// the same options or, failing that, the snapshot version: the one with
+// the alias given to WithAlias, the version given to WithVersion, or the
+// highest version. Not-found and invalid-argument errors are returned as
+// is, since the registry answered. Locale variants are only loaded live.
+//
+// The returned version is a copy and may be modified.
+func (r *EmbeddedResolver) LoadPrompt(ctx context.Context, name string, opts ...LoadOption) (*PromptVersion, error) {
+	var cfg LoadConfig
+	cfg.Apply(opts...)
+	key := name + "\x00" + cfg.Alias + "\x00" + strconv.Itoa(cfg.Version) + "\x00" +
+		cfg.Locale + "\x00" + strings.Join(cfg.FallbackLocales, ",")
+
+	now := r.opts.now()
+	r.mu.Lock()
+	entry, cached := r.live[key]
+	down := now.Before(r.retryAt)
+	r.mu.Unlock()
+	if cached && now.Before(entry.expires) {
+		return entry.version.Clone(), nil
+	}
+	if down {
+		return r.fallback(ctx, name, cfg, entry.version, errRegistryDown)
+	}
+
+	pv, err := r.client.LoadPrompt(ctx, name, opts...)
+	if err == nil {
+		r.mu.Lock()
+		r.live[key] = embeddedEntry{version: pv, expires: now.Add(r.opts.refresh)}
+		r.retryAt = time.Time{}
+		r.mu.Unlock()
+		return pv.Clone(), nil
+	}
+	if errors.IsNotFound(err) || errors.IsInvalidArgument(err) || ctx.Err() != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.retryAt = now.Add(r.opts.retryAfter)
+	r.mu.Unlock()
+	return r.fallback(ctx, name, cfg, entry.version, err)
+}
+
+// errRegistryDown is reported to the fallback hook for calls served without
+// trying the registry, after a recent failure.
+var errRegistryDown = fmt.Errorf("mlflow: prompt registry unavailable")
+
+// fallback returns stale, the version last loaded from the registry, or
+// the snapshot version for cfg, or err if there is neither.
+func (r *EmbeddedResolver) fallback(ctx context.Context, name string, cfg LoadConfig, stale *PromptVersion, err error) (*PromptVersion, error) {
+	pv := stale
+	if pv == nil {
+		pv = r.snapshotVersion(name, cfg)
+	}
+	if pv == nil {
+		return nil, err
+	}
+	if r.opts.onFallback != nil {
+		r.opts.onFallback(ctx, name, err)
+	}
+	return pv.Clone(), nil
+}
+
+// snapshotVersion returns the snapshot version for cfg, or nil.
+func (r *EmbeddedResolver) snapshotVersion(name string, cfg LoadConfig) *PromptVersion {
+	versions := r.snapshot[name]
+	if len(versions) == 0 || cfg.Locale != "" || len(cfg.FallbackLocales) > 0 {
+		return nil
+	}
+	switch {
+	case cfg.Alias != "":
+		for _, pv := range versions {
+			if slices.Contains(pv.Aliases, cfg.Alias) {
+				return pv
+			}
+		}
+		return nil
+	case cfg.Version > 0:
+		for _, pv := range versions {
+			if pv.Version == cfg.Version {
+				return pv
+			}
+		}
+		return nil
+	}
+	return versions[len(versions)-1]
+}
+
+// WriteSnapshot writes versions to dir, one JSON file each, for
+// NewEmbeddedResolver to embed. Run it at build time, such as from a
+// go:generate program, with the versions a service loads: those behind its
+// aliases and, if it loads the latest version, that one.
+func WriteSnapshot(dir string, versions ...*PromptVersion) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // snapshots are checked in
+		return err
+	}
+	for _, pv := range versions {
+		if pv == nil || pv.Name == "" || pv.Version <= 0 {
+			return fmt.Errorf("mlflow: snapshot versions need a name and version")
+		}
+		data, err := json.MarshalIndent(pv, "", "  ")
+		if err != nil {
+			return err
+		}
+		file := fmt.Sprintf("%s.v%d.json", strings.ReplaceAll(pv.Name, NameSeparator, "__"), pv.Version)
+		if err := os.WriteFile(filepath.Join(dir, file), append(data, '\n'), 0o644); err != nil { //nolint:gosec // snapshots are checked in
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// embeddedRegistry serves version 3 of "qa" behind every alias while up,
+// and 503s while down.
+func embeddedRegistry(t *testing.T, up *atomic.Bool, calls *atomic.Int32) *Client {
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if !up.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error_code": "TEMPORARILY_UNAVAILABLE", "message": "down"})
+			return
+		}
+		if r.URL.Query().Get("name") != "qa" {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "no prompt"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{
+				"name":    "qa",
+				"version": "3",
+				"tags": []map[string]string{
+					{"key": "mlflow.prompt.is_prompt", "value": "true"},
+					{"key": "mlflow.prompt.text", "value": "live {{q}}"},
+				},
+			},
+		})
+	}))
+}
+
+func embeddedSnapshot(t *testing.T) fstest.MapFS {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	for _, pv := range []PromptVersion{
+		{Name: "qa", Version: 1, Template: "v1 {{q}}", Aliases: []string{"production"}},
+		{Name: "qa", Version: 2, Template: "v2 {{q}}"},
+	} {
+		data, err := json.Marshal(pv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fsys[fmt.Sprintf("prompts/qa.v%d.json", pv.Version)] = &fstest.MapFile{Data: data}
+	}
+	return fsys
+}
+
+func TestEmbeddedResolver_FallsBackAndRecovers(t *testing.T) {
+	var up atomic.Bool
+	var calls atomic.Int32
+	client := embeddedRegistry(t, &up, &calls)
+
+	now := time.Unix(1700000000, 0)
+	var fallbacks int
+	r, err := NewEmbeddedResolver(embeddedSnapshot(t), client,
+		WithEmbeddedRefresh(0),
+		WithEmbeddedFallbackHook(func(context.Context, string, error) { fallbacks++ }),
+	)
+	if err != nil {
+		t.Fatalf("NewEmbeddedResolver() error = %v", err)
+	}
+	r.opts.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Registry down at startup: the snapshot is served
+	pv, err := r.LoadPrompt(ctx, "qa", WithAlias("production"))
+	if err != nil || pv.Template != "v1 {{q}}" {
+		t.Fatalf("LoadPrompt(production) = %+v, %v, want snapshot v1", pv, err)
+	}
+	pv, err = r.LoadPrompt(ctx, "qa")
+	if err != nil || pv.Version != 2 {
+		t.Fatalf("LoadPrompt(latest) = %+v, %v, want snapshot v2", pv, err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("registry calls = %d, want 1 while retry is pending", calls.Load())
+	}
+	if _, err := r.LoadPrompt(ctx, "qa", WithVersion(7)); err == nil {
+		t.Error("LoadPrompt(v7) error = nil, want unavailable error without a snapshot version")
+	}
+
+	// Registry back: the next call after the retry interval goes live
+	up.Store(true)
+	now = now.Add(31 * time.Second)
+	pv, err = r.LoadPrompt(ctx, "qa", WithAlias("production"))
+	if err != nil || pv.Template != "live {{q}}" {
+		t.Fatalf("LoadPrompt(production) = %+v, %v, want live version", pv, err)
+	}
+	if fallbacks != 2 {
+		t.Errorf("fallbacks = %d, want 2", fallbacks)
+	}
+
+	// Down again: the last live version beats the snapshot
+	up.Store(false)
+	pv, err = r.LoadPrompt(ctx, "qa", WithAlias("production"))
+	if err != nil || pv.Template != "live {{q}}" {
+		t.Errorf("LoadPrompt(production) = %+v, %v, want last live version", pv, err)
+	}
+}
+
+func TestEmbeddedResolver_NotFoundIsNotMasked(t *testing.T) {
+	var up atomic.Bool
+	var calls atomic.Int32
+	up.Store(true)
+	snapshot := embeddedSnapshot(t)
+	data, _ := json.Marshal(PromptVersion{Name: "gone", Version: 1, Template: "old"})
+	snapshot["gone.v1.json"] = &fstest.MapFile{Data: data}
+
+	r, err := NewEmbeddedResolver(snapshot, embeddedRegistry(t, &up, &calls))
+	if err != nil {
+		t.Fatalf("NewEmbeddedResolver() error = %v", err)
+	}
+	if _, err := r.LoadPrompt(context.Background(), "gone"); err == nil {
+		t.Error("LoadPrompt(gone) error = nil, want not found from the registry")
+	}
+}
+
+func TestNewEmbeddedResolver_InvalidSnapshot(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+	fsys := fstest.MapFS{"qa.json": &fstest.MapFile{Data: []byte(`{"name":"qa"}`)}}
+	if _, err := NewEmbeddedResolver(fsys, client); err == nil {
+		t.Error("NewEmbeddedResolver() error = nil for a snapshot without a version")
+	}
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	err := WriteSnapshot(dir,
+		&PromptVersion{Name: "team/qa", Version: 4, Template: "Hi {{q}}", Aliases: []string{"production"}},
+	)
+	if err != nil {
+		t.Fatalf("WriteSnapshot() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "team__qa.v4.json")); err != nil {
+		t.Fatalf("snapshot file: %v", err)
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	r, err := NewEmbeddedResolver(os.DirFS(dir), client)
+	if err != nil {
+		t.Fatalf("NewEmbeddedResolver() error = %v", err)
+	}
+	pv, err := r.LoadPrompt(context.Background(), "team/qa", WithAlias("production"))
+	if err != nil || pv.Version != 4 || pv.Template != "Hi {{q}}" {
+		t.Errorf("LoadPrompt() = %+v, %v, want written snapshot", pv, err)
+	}
+}
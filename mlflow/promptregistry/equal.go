@@ -0,0 +1,100 @@
+package promptregistry
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// equalOptions holds configuration for an Equal comparison.
+type equalOptions struct {
+	ignoreTimestamps bool
+}
+
+// EqualOption configures PromptVersion.Equal.
+type EqualOption func(*equalOptions)
+
+// WithIgnoreTimestamps leaves CreatedAt and UpdatedAt out of the
+// comparison, such as to compare a version built locally with the one the
+// server returned.
+func WithIgnoreTimestamps() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreTimestamps = true
+	}
+}
+
+// Equal reports whether v and other hold the same prompt version. Unlike
+// reflect.DeepEqual, nil and empty tags, aliases, and schemas are equal,
+// aliases are compared in any order, and times are compared with
+// time.Time.Equal. Two nil versions are equal.
+func (v *PromptVersion) Equal(other *PromptVersion, opts ...EqualOption) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	var o equalOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if v.Name != other.Name ||
+		v.Version != other.Version ||
+		v.Template != other.Template ||
+		v.CommitMessage != other.CommitMessage ||
+		v.IsChat() != other.IsChat() ||
+		!slices.Equal(v.Messages, other.Messages) ||
+		!maps.Equal(v.Tags, other.Tags) ||
+		!sameAliases(v.Aliases, other.Aliases) ||
+		!v.InputSchema.equal(other.InputSchema) ||
+		!v.ModelConfig.equal(other.ModelConfig) {
+		return false
+	}
+	if o.ignoreTimestamps {
+		return true
+	}
+	return v.CreatedAt.Equal(other.CreatedAt) && v.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// sameAliases reports whether a and b hold the same aliases in any order.
+func sameAliases(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
+func (s InputSchema) equal(other InputSchema) bool {
+	return maps.EqualFunc(s, other, func(a, b VarSpec) bool {
+		return a.Type == b.Type &&
+			a.Required == b.Required &&
+			a.Default == b.Default &&
+			a.Description == b.Description &&
+			slices.Equal(a.Enum, b.Enum)
+	})
+}
+
+func (c *PromptModelConfig) equal(other *PromptModelConfig) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if len(c.ExtraParams) != len(other.ExtraParams) ||
+		(len(c.ExtraParams) > 0 && !reflect.DeepEqual(c.ExtraParams, other.ExtraParams)) {
+		return false
+	}
+	return c.Provider == other.Provider &&
+		c.ModelName == other.ModelName &&
+		ptrEqual(c.Temperature, other.Temperature) &&
+		ptrEqual(c.MaxTokens, other.MaxTokens) &&
+		ptrEqual(c.TopP, other.TopP) &&
+		ptrEqual(c.TopK, other.TopK) &&
+		ptrEqual(c.FrequencyPenalty, other.FrequencyPenalty) &&
+		ptrEqual(c.PresencePenalty, other.PresencePenalty) &&
+		slices.Equal(c.StopSequences, other.StopSequences)
+}
+
+// ptrEqual reports whether a and b are both nil or point to equal values.
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
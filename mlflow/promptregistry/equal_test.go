@@ -0,0 +1,60 @@
+package promptregistry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPromptVersion_Equal(t *testing.T) {
+	temp := 0.2
+	base := &PromptVersion{
+		Name:        "qa",
+		Version:     2,
+		Template:    "Answer {{question}}",
+		Aliases:     []string{"production", "staging"},
+		ModelConfig: &PromptModelConfig{Provider: "openai", Temperature: &temp},
+		InputSchema: InputSchema{"question": {Required: true, Enum: []string{"a", "b"}}},
+		CreatedAt:   time.UnixMilli(1700000000000),
+	}
+
+	otherTemp := 0.2
+	same := base.Clone()
+	same.Aliases = []string{"staging", "production"}
+	same.Tags = map[string]string{}
+	same.ModelConfig.Temperature = &otherTemp
+	if !base.Equal(same) {
+		t.Error("Equal() = false for reordered aliases, empty tags, and equal pointers")
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*PromptVersion)
+	}{
+		{"template", func(v *PromptVersion) { v.Template = "Hi" }},
+		{"alias", func(v *PromptVersion) { v.Aliases = []string{"production"} }},
+		{"temperature", func(v *PromptVersion) { v.ModelConfig.Temperature = nil }},
+		{"schema enum", func(v *PromptVersion) { v.InputSchema["question"] = VarSpec{Required: true} }},
+		{"chat", func(v *PromptVersion) { v.Messages = []ChatMessage{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := base.Clone()
+			tt.modify(changed)
+			if base.Equal(changed) {
+				t.Error("Equal() = true, want false")
+			}
+		})
+	}
+
+	later := base.Clone()
+	later.UpdatedAt = time.Now()
+	if base.Equal(later) {
+		t.Error("Equal() = true for different UpdatedAt")
+	}
+	if !base.Equal(later, WithIgnoreTimestamps()) {
+		t.Error("Equal(WithIgnoreTimestamps()) = false, want true")
+	}
+	if !(*PromptVersion)(nil).Equal(nil) || base.Equal(nil) {
+		t.Error("Equal() mishandles nil versions")
+	}
+}
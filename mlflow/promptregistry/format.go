@@ -2,32 +2,36 @@ package promptregistry
 
 import (
 	"fmt"
-	"regexp"
-	"strings"
-)
+	"slices"
 
-// varPattern matches {{variable}} placeholders.
-var varPattern = regexp.MustCompile(`\{\{(\w+)\}\}`)
+	"github.com/opendatahub-io/mlflow-go/mlflow/template"
+)
 
 // Format returns a new PromptVersion with all {{variable}} placeholders replaced.
-// Returns an error if any variable in the template is not found in vars.
+// Returns an error if any variable in the template is not found in vars, or
+// if vars do not satisfy the InputSchema. See package template for the
+// placeholder syntax, including escaping literal "{{".
 func (v *PromptVersion) Format(vars map[string]string) (*PromptVersion, error) {
 	if v == nil {
 		return nil, fmt.Errorf("mlflow: cannot format nil PromptVersion")
 	}
+	vars, schemaErr := v.InputSchema.apply(vars)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
 
 	clone := v.Clone()
 
 	if v.IsChat() {
 		for i := range clone.Messages {
-			formatted, err := substituteVars(clone.Messages[i].Content, vars)
+			formatted, err := template.Render(clone.Messages[i].Content, vars)
 			if err != nil {
 				return nil, fmt.Errorf("mlflow: message %d: %w", i, err)
 			}
 			clone.Messages[i].Content = formatted
 		}
 	} else {
-		formatted, err := substituteVars(clone.Template, vars)
+		formatted, err := template.Render(clone.Template, vars)
 		if err != nil {
 			return nil, err
 		}
@@ -38,7 +42,8 @@ func (v *PromptVersion) Format(vars map[string]string) (*PromptVersion, error) {
 }
 
 // FormatAsText formats the prompt and returns the template string.
-// Returns an error if this is a chat prompt or if any variable is not found.
+// Returns an error if this is a chat prompt, if any variable is not found,
+// or if vars do not satisfy the InputSchema.
 func (v *PromptVersion) FormatAsText(vars map[string]string) (string, error) {
 	if v == nil {
 		return "", fmt.Errorf("mlflow: cannot format nil PromptVersion")
@@ -46,12 +51,17 @@ func (v *PromptVersion) FormatAsText(vars map[string]string) (string, error) {
 	if v.IsChat() {
 		return "", fmt.Errorf("mlflow: cannot format chat prompt as text; use FormatAsMessages")
 	}
+	vars, err := v.InputSchema.apply(vars)
+	if err != nil {
+		return "", err
+	}
 
-	return substituteVars(v.Template, vars)
+	return template.Render(v.Template, vars)
 }
 
 // FormatAsMessages formats the prompt and returns the messages.
-// Returns an error if this is a text prompt or if any variable is not found.
+// Returns an error if this is a text prompt, if any variable is not found,
+// or if vars do not satisfy the InputSchema.
 func (v *PromptVersion) FormatAsMessages(vars map[string]string) ([]ChatMessage, error) {
 	if v == nil {
 		return nil, fmt.Errorf("mlflow: cannot format nil PromptVersion")
@@ -59,10 +69,14 @@ func (v *PromptVersion) FormatAsMessages(vars map[string]string) ([]ChatMessage,
 	if !v.IsChat() {
 		return nil, fmt.Errorf("mlflow: cannot format text prompt as messages; use FormatAsText")
 	}
+	vars, schemaErr := v.InputSchema.apply(vars)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
 
 	result := make([]ChatMessage, len(v.Messages))
 	for i, msg := range v.Messages {
-		formatted, err := substituteVars(msg.Content, vars)
+		formatted, err := template.Render(msg.Content, vars)
 		if err != nil {
 			return nil, fmt.Errorf("mlflow: message %d: %w", i, err)
 		}
@@ -75,24 +89,21 @@ func (v *PromptVersion) FormatAsMessages(vars map[string]string) ([]ChatMessage,
 	return result, nil
 }
 
-// substituteVars replaces all {{variable}} placeholders in template with values from vars.
-// Returns an error if any variable is not found in vars.
-func substituteVars(template string, vars map[string]string) (string, error) {
-	var missingVars []string
-
-	result := varPattern.ReplaceAllStringFunc(template, func(match string) string {
-		// Extract variable name from {{name}}
-		name := match[2 : len(match)-2]
-		if value, ok := vars[name]; ok {
-			return value
-		}
-		missingVars = append(missingVars, name)
-		return match
-	})
-
-	if len(missingVars) > 0 {
-		return "", fmt.Errorf("mlflow: missing variables: %s", strings.Join(missingVars, ", "))
+// Variables returns the names of the {{variable}} placeholders Format
+// substitutes, from the template or all messages, sorted and without
+// duplicates. Variables declared only in the InputSchema are not included.
+func (v *PromptVersion) Variables() []string {
+	if v == nil {
+		return nil
 	}
-
-	return result, nil
+	texts := []string{v.Template}
+	for _, msg := range v.Messages {
+		texts = append(texts, msg.Content)
+	}
+	var names []string
+	for _, text := range texts {
+		names = append(names, template.Variables(text)...)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
 }
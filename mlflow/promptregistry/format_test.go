@@ -1,6 +1,8 @@
 package promptregistry
 
 import (
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -177,23 +179,71 @@ func TestPromptVersion_IsChat(t *testing.T) {
 	}
 }
 
-func TestSubstituteVars_MultipleOccurrences(t *testing.T) {
-	template := "{{name}} and {{name}} are the same"
-	result, err := substituteVars(template, map[string]string{"name": "Alice"})
+func TestPromptVersion_Format_EscapedPlaceholder(t *testing.T) {
+	pv := &PromptVersion{Template: `Use \{{name}} to insert {{what}}.`}
+	got, err := pv.FormatAsText(map[string]string{"what": "a name"})
 	if err != nil {
-		t.Fatalf("substituteVars() error = %v", err)
+		t.Fatalf("FormatAsText() error = %v", err)
+	}
+	if want := "Use {{name}} to insert a name."; got != want {
+		t.Errorf("FormatAsText() = %q, want %q", got, want)
 	}
 
-	expected := "Alice and Alice are the same"
-	if result != expected {
-		t.Errorf("result = %q, want %q", result, expected)
+	_, err = pv.FormatAsText(nil)
+	if err == nil || !strings.Contains(err.Error(), "what") || strings.Contains(err.Error(), "name") {
+		t.Errorf("FormatAsText(nil) error = %v, want only what missing", err)
 	}
 }
 
-func TestSubstituteVars_PartialMatch(t *testing.T) {
-	template := "Hello {{name}}, your id is {{id}}"
-	_, err := substituteVars(template, map[string]string{"name": "Alice"})
-	if err == nil {
-		t.Error("expected error for missing id variable")
+func BenchmarkFormatAsText(b *testing.B) {
+	pv := &PromptVersion{
+		Template: "You are a {{role}} assistant for {{company}}. Answer the question about {{topic}} " +
+			"concisely, citing {{source}} where relevant. Question: {{question}}",
+	}
+	vars := map[string]string{
+		"role":     "helpful",
+		"company":  "Acme",
+		"topic":    "billing",
+		"source":   "the knowledge base",
+		"question": "How do I update my payment method?",
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := pv.FormatAsText(vars); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFormat_Chat(b *testing.B) {
+	pv := &PromptVersion{
+		Messages: []ChatMessage{
+			{Role: "system", Content: "You are a {{role}} assistant for {{company}}."},
+			{Role: "user", Content: "Tell me about {{topic}}."},
+		},
+		Tags: map[string]string{"team": "support", "env": "prod"},
+	}
+	vars := map[string]string{"role": "helpful", "company": "Acme", "topic": "billing"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := pv.Format(vars); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestPromptVersion_Variables(t *testing.T) {
+	chat := &PromptVersion{Messages: []ChatMessage{
+		{Role: "system", Content: "You help {{user}} with {{topic}}."},
+		{Role: "user", Content: "{{question}} ({{user}}, {{ not_a_var }})"},
+	}}
+	want := []string{"question", "topic", "user"}
+	if got := chat.Variables(); !slices.Equal(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+	if got := (&PromptVersion{Template: "No variables"}).Variables(); len(got) != 0 {
+		t.Errorf("Variables() = %v, want none", got)
 	}
 }
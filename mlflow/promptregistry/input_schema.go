@@ -0,0 +1,161 @@
+package promptregistry
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+	"github.com/opendatahub-io/mlflow-go/mlflow/template"
+)
+
+// tagInputSchema is the version tag holding the JSON-encoded InputSchema.
+// MLflow has no native equivalent, so other SDKs show it as an ordinary tag.
+const tagInputSchema = mlflowtags.PromptInputSchema
+
+// Variable types for VarSpec.Type.
+const (
+	VarTypeString  = "string"
+	VarTypeInteger = "integer"
+	VarTypeNumber  = "number"
+	VarTypeBoolean = "boolean"
+)
+
+// VarSpec declares one template variable.
+type VarSpec struct {
+	// Type is one of the VarType constants. Empty means VarTypeString.
+	// Values are always passed as strings; Type restricts what they may contain.
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+
+	// Required variables must be passed to Format. Optional variables that
+	// are not passed are replaced by Default.
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+
+	// Default is used for an optional variable that is not passed.
+	Default string `yaml:"default,omitempty" json:"default,omitempty"`
+
+	// Enum, if set, lists the allowed values.
+	Enum []string `yaml:"enum,omitempty" json:"enum,omitempty"`
+
+	// Description documents the variable for prompt users.
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+// InputSchema declares the variables of a prompt, keyed by name.
+type InputSchema map[string]VarSpec
+
+// Names returns the declared variable names in sorted order, e.g. for
+// KnownVariables.
+func (s InputSchema) Names() []string {
+	return slices.Sorted(maps.Keys(s))
+}
+
+// Validate checks that vars satisfy the schema: required variables are
+// present and every declared variable has a value of its type. Variables not
+// in the schema are ignored. All problems are reported in one error.
+func (s InputSchema) Validate(vars map[string]string) error {
+	_, err := s.apply(vars)
+	return err
+}
+
+// validate checks the schema itself.
+func (s InputSchema) validate() error {
+	for _, name := range s.Names() {
+		spec := s[name]
+		if !template.IsVariableName(name) {
+			return fmt.Errorf("mlflow: input schema: %q is not a valid variable name", name)
+		}
+		switch spec.Type {
+		case "", VarTypeString, VarTypeInteger, VarTypeNumber, VarTypeBoolean:
+		default:
+			return fmt.Errorf("mlflow: input schema: variable %q has unknown type %q", name, spec.Type)
+		}
+		for _, value := range spec.Enum {
+			if err := spec.check(value); err != nil {
+				return fmt.Errorf("mlflow: input schema: variable %q enum: %w", name, err)
+			}
+		}
+		if !spec.Required && spec.Default != "" {
+			if err := spec.check(spec.Default); err != nil {
+				return fmt.Errorf("mlflow: input schema: variable %q default: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// apply validates vars and returns them with defaults filled in for
+// optional variables that were not passed. vars is not modified.
+func (s InputSchema) apply(vars map[string]string) (map[string]string, error) {
+	if len(s) == 0 {
+		return vars, nil
+	}
+
+	var problems []string
+	var resolved map[string]string
+
+	for _, name := range s.Names() {
+		spec := s[name]
+		value, ok := vars[name]
+		if !ok {
+			if spec.Required {
+				problems = append(problems, fmt.Sprintf("%s is required", name))
+				continue
+			}
+			if resolved == nil {
+				resolved = maps.Clone(vars)
+				if resolved == nil {
+					resolved = make(map[string]string, len(s))
+				}
+			}
+			resolved[name] = spec.Default
+			continue
+		}
+		if err := spec.check(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("mlflow: invalid variables: %s", strings.Join(problems, "; "))
+	}
+	if resolved == nil {
+		return vars, nil
+	}
+	return resolved, nil
+}
+
+// check reports whether value is valid for the spec's type and enum.
+func (spec VarSpec) check(value string) error {
+	var err error
+	switch spec.Type {
+	case VarTypeInteger:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case VarTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case VarTypeBoolean:
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", value, spec.Type)
+	}
+	if len(spec.Enum) > 0 && !slices.Contains(spec.Enum, value) {
+		return fmt.Errorf("%q is not one of %s", value, strings.Join(spec.Enum, ", "))
+	}
+	return nil
+}
+
+// clone returns a deep copy of s.
+func (s InputSchema) clone() InputSchema {
+	if s == nil {
+		return nil
+	}
+	out := make(InputSchema, len(s))
+	for name, spec := range s {
+		spec.Enum = slices.Clone(spec.Enum)
+		out[name] = spec
+	}
+	return out
+}
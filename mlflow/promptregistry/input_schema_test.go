@@ -0,0 +1,156 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var testSchema = InputSchema{
+	"name":  {Type: VarTypeString, Required: true},
+	"count": {Type: VarTypeInteger, Default: "3"},
+	"tone":  {Enum: []string{"formal", "casual"}, Default: "formal"},
+	"score": {Type: VarTypeNumber},
+	"brief": {Type: VarTypeBoolean, Default: "false"},
+}
+
+func TestInputSchema_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vars    map[string]string
+		wantErr string
+	}{
+		{"required only", map[string]string{"name": "Ada"}, ""},
+		{"all valid", map[string]string{"name": "Ada", "count": "5", "tone": "casual", "score": "0.5", "brief": "true"}, ""},
+		{"extra ignored", map[string]string{"name": "Ada", "other": "x"}, ""},
+		{"missing required", map[string]string{}, "name is required"},
+		{"bad integer", map[string]string{"name": "Ada", "count": "five"}, `count: "five" is not a valid integer`},
+		{"bad number", map[string]string{"name": "Ada", "score": "high"}, `score: "high" is not a valid number`},
+		{"bad boolean", map[string]string{"name": "Ada", "brief": "maybe"}, `brief: "maybe" is not a valid boolean`},
+		{"not in enum", map[string]string{"name": "Ada", "tone": "angry"}, `tone: "angry" is not one of formal, casual`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testSchema.Validate(tt.vars)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInputSchema_ValidateReportsAll(t *testing.T) {
+	err := testSchema.Validate(map[string]string{"count": "x"})
+	if err == nil || !strings.Contains(err.Error(), "count:") || !strings.Contains(err.Error(), "name is required") {
+		t.Errorf("Validate() error = %v, want both problems", err)
+	}
+}
+
+func TestFormat_InputSchema(t *testing.T) {
+	v := &PromptVersion{
+		Template:    "Write {{count}} {{tone}} lines for {{name}}.",
+		InputSchema: testSchema,
+	}
+
+	vars := map[string]string{"name": "Ada"}
+	got, err := v.FormatAsText(vars)
+	if err != nil {
+		t.Fatalf("FormatAsText() error = %v", err)
+	}
+	if want := "Write 3 formal lines for Ada."; got != want {
+		t.Errorf("FormatAsText() = %q, want %q", got, want)
+	}
+	if len(vars) != 1 {
+		t.Errorf("vars modified: %v", vars)
+	}
+
+	if _, err := v.Format(map[string]string{"name": "Ada", "count": "many"}); err == nil {
+		t.Error("Format() expected error for invalid integer")
+	}
+
+	chat := &PromptVersion{
+		Messages:    []ChatMessage{{Role: "user", Content: "Hi {{name}}"}},
+		InputSchema: testSchema,
+	}
+	if _, err := chat.FormatAsMessages(map[string]string{}); err == nil {
+		t.Error("FormatAsMessages() expected error for missing required variable")
+	}
+}
+
+func TestPromptVersion_Clone_InputSchema(t *testing.T) {
+	v := &PromptVersion{InputSchema: InputSchema{"tone": {Enum: []string{"a", "b"}}}}
+	clone := v.Clone()
+	clone.InputSchema["tone"].Enum[0] = "changed"
+	if v.InputSchema["tone"].Enum[0] != "a" {
+		t.Error("Clone() shares InputSchema enum with the original")
+	}
+}
+
+func TestRegisterPrompt_InputSchema(t *testing.T) {
+	var schemaTag string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/2.0/mlflow/model-versions/create" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		var req struct {
+			Tags []map[string]string `json:"tags"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		for _, tag := range req.Tags {
+			if tag["key"] == tagInputSchema {
+				schemaTag = tag["value"]
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{"name": "greet", "version": "1", "tags": req.Tags},
+		})
+	}))
+
+	pv, err := client.RegisterPrompt(context.Background(), "greet", "Hello {{name}}",
+		WithInputSchema(map[string]VarSpec{"name": {Type: "string", Required: true}}))
+	if err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+
+	if want := `{"name":{"type":"string","required":true}}`; schemaTag != want {
+		t.Errorf("schema tag = %s, want %s", schemaTag, want)
+	}
+	if spec, ok := pv.InputSchema["name"]; !ok || !spec.Required {
+		t.Errorf("InputSchema = %+v, want required name", pv.InputSchema)
+	}
+	if _, ok := pv.Tags[tagInputSchema]; ok {
+		t.Error("schema tag exposed in Tags")
+	}
+}
+
+func TestRegisterPrompt_InvalidInputSchema(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	schemas := map[string]map[string]VarSpec{
+		"unknown type": {"n": {Type: "date"}},
+		"bad name":     {"first name": {}},
+		"bad default":  {"n": {Type: VarTypeInteger, Default: "x"}},
+		"bad enum":     {"n": {Type: VarTypeBoolean, Enum: []string{"yes"}}},
+	}
+	for name, schema := range schemas {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.RegisterPrompt(context.Background(), "p", "{{n}}", WithInputSchema(schema))
+			if err == nil || !strings.Contains(err.Error(), "input schema") {
+				t.Errorf("RegisterPrompt() error = %v, want input schema error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package promptregistry
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/template"
+)
+
+// DefaultMaxTemplateLength is the template length, in characters, above which
+// the MaxLength rule in DefaultRules reports a warning.
+const DefaultMaxTemplateLength = 20000
+
+// Severity is how serious a lint finding is.
+type Severity int
+
+const (
+	// SeverityWarning marks a likely mistake that does not stop the
+	// template from formatting.
+	SeverityWarning Severity = iota + 1
+	// SeverityError marks a template that will not format as intended.
+	SeverityError
+)
+
+// String returns "warning" or "error".
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Finding is a problem reported by a lint Rule.
+type Finding struct {
+	// Rule names the rule that reported the finding, e.g. "unbalanced-braces".
+	Rule     string
+	Severity Severity
+	Message  string
+	// Location is "message N" for chat prompts linted at registration, and
+	// empty otherwise.
+	Location string
+	// Offset is the byte offset in the template the finding refers to.
+	Offset int
+}
+
+// String formats the finding as "severity: rule: message (offset N)".
+func (f Finding) String() string {
+	where := fmt.Sprintf("offset %d", f.Offset)
+	if f.Location != "" {
+		where = f.Location + ", " + where
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Severity, f.Rule, f.Message, where)
+}
+
+// Rule checks a template. Implement Rule, or use RuleFunc, to add project
+// specific checks to Lint and WithLint.
+type Rule interface {
+	Check(template string) []Finding
+}
+
+// RuleFunc adapts a function to the Rule interface.
+type RuleFunc func(template string) []Finding
+
+// Check calls f(template).
+func (f RuleFunc) Check(template string) []Finding {
+	return f(template)
+}
+
+// LintError is returned by RegisterPrompt and RegisterChatPrompt under
+// WithLint when a rule reports a finding with SeverityError.
+type LintError struct {
+	Findings []Finding
+}
+
+func (e *LintError) Error() string {
+	parts := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		parts[i] = f.String()
+	}
+	return "mlflow: prompt failed lint: " + strings.Join(parts, "; ")
+}
+
+// DefaultRules returns the rules Lint applies when none are given:
+// UnbalancedBraces and MaxLength(DefaultMaxTemplateLength).
+func DefaultRules() []Rule {
+	return []Rule{UnbalancedBraces(), MaxLength(DefaultMaxTemplateLength)}
+}
+
+// Lint checks template against rules, or DefaultRules if none are given, and
+// returns the findings ordered by offset.
+func Lint(template string, rules ...Rule) []Finding {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+	var findings []Finding
+	for _, rule := range rules {
+		findings = append(findings, rule.Check(template)...)
+	}
+	slices.SortStableFunc(findings, func(a, b Finding) int { return a.Offset - b.Offset })
+	return findings
+}
+
+// UnbalancedBraces reports "{{" without a closing "}}" and "}}" without an
+// opening "{{" (errors), and placeholders whose name is not made of letters,
+// digits and underscores, such as "{{ name }}", which Format leaves as is
+// (warnings).
+func UnbalancedBraces() Rule {
+	return RuleFunc(func(text string) []Finding {
+		const rule = "unbalanced-braces"
+		var findings []Finding
+
+		i := 0
+		for {
+			open := strings.Index(text[i:], "{{")
+			closing := strings.Index(text[i:], "}}")
+			if open < 0 && closing < 0 {
+				break
+			}
+			if open < 0 || (closing >= 0 && closing < open) {
+				findings = append(findings, Finding{Rule: rule, Severity: SeverityError,
+					Message: `"}}" has no matching "{{"`, Offset: i + closing})
+				i += closing + 2
+				continue
+			}
+
+			open += i
+			// Extra braces before a placeholder ("{{{x}}}") are literal
+			for open+2 < len(text) && text[open+2] == '{' {
+				open++
+			}
+			end := strings.Index(text[open+2:], "}}")
+			next := strings.Index(text[open+2:], "{{")
+			if end < 0 || (next >= 0 && next < end) {
+				findings = append(findings, Finding{Rule: rule, Severity: SeverityError,
+					Message: `"{{" is not closed by "}}"`, Offset: open})
+				i = open + 2
+				continue
+			}
+
+			name := text[open+2 : open+2+end]
+			if !template.IsVariableName(name) {
+				findings = append(findings, Finding{Rule: rule, Severity: SeverityWarning,
+					Message: fmt.Sprintf("%q is not a valid variable name and will not be substituted", name), Offset: open})
+			}
+			i = open + 2 + end + 2
+			// Extra braces after a placeholder are literal too
+			for i < len(text) && text[i] == '}' {
+				i++
+			}
+		}
+		return findings
+	})
+}
+
+// KnownVariables reports placeholders for variables not in declared
+// (errors) and declared variables the template never uses (warnings).
+func KnownVariables(declared ...string) Rule {
+	return RuleFunc(func(text string) []Finding {
+		const rule = "unknown-variable"
+		var findings []Finding
+
+		used := make(map[string]bool)
+		for _, p := range template.Placeholders(text) {
+			if !slices.Contains(declared, p.Name) {
+				findings = append(findings, Finding{Rule: rule, Severity: SeverityError,
+					Message: fmt.Sprintf("variable %q is not declared", p.Name), Offset: p.Offset})
+			}
+			used[p.Name] = true
+		}
+		for _, name := range declared {
+			if !used[name] {
+				findings = append(findings, Finding{Rule: "unused-variable", Severity: SeverityWarning,
+					Message: fmt.Sprintf("declared variable %q is not used", name), Offset: 0})
+			}
+		}
+		return findings
+	})
+}
+
+// MaxLength reports templates longer than n characters (a warning), which
+// are often pasted context that belongs in a variable instead.
+func MaxLength(n int) Rule {
+	return RuleFunc(func(template string) []Finding {
+		if length := utf8.RuneCountInString(template); length > n {
+			return []Finding{{Rule: "max-length", Severity: SeverityWarning,
+				Message: fmt.Sprintf("template is %d characters, over the limit of %d", length, n), Offset: 0}}
+		}
+		return nil
+	})
+}
+
+// lintTemplate applies rules to a text prompt template at registration.
+func lintTemplate(rules []Rule, template string) error {
+	return lintError(Lint(template, rules...))
+}
+
+// lintMessages applies rules to each chat message at registration.
+func lintMessages(rules []Rule, messages []ChatMessage) error {
+	var all []Finding
+	for i, msg := range messages {
+		for _, f := range Lint(msg.Content, rules...) {
+			f.Location = fmt.Sprintf("message %d", i)
+			all = append(all, f)
+		}
+	}
+	return lintError(all)
+}
+
+// lintError returns a *LintError with all findings if any is an error.
+func lintError(findings []Finding) error {
+	if slices.ContainsFunc(findings, func(f Finding) bool { return f.Severity == SeverityError }) {
+		return &LintError{Findings: findings}
+	}
+	return nil
+}
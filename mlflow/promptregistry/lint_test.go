@@ -0,0 +1,138 @@
+package promptregistry
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// ruleNames returns "rule/severity" for each finding.
+func ruleNames(findings []Finding) string {
+	parts := make([]string, len(findings))
+	for i, f := range findings {
+		parts[i] = f.Rule + "/" + f.Severity.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestUnbalancedBraces(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"valid", "Hello {{name}}, you are {{age}}.", ""},
+		{"no placeholders", "Plain text", ""},
+		{"literal braces", "JSON: {{{value}}}", ""},
+		{"unclosed", "Hello {{name", "unbalanced-braces/error"},
+		{"unclosed before next", "{{a {{b}}", "unbalanced-braces/error"},
+		{"stray close", "Hello name}}", "unbalanced-braces/error"},
+		{"spaces in name", "Hello {{ name }}", "unbalanced-braces/warning"},
+		{"empty name", "Hello {{}}", "unbalanced-braces/warning"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleNames(UnbalancedBraces().Check(tt.template)); got != tt.want {
+				t.Errorf("findings = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnbalancedBraces_Offset(t *testing.T) {
+	findings := UnbalancedBraces().Check("ok {{a}} then {{b")
+	if len(findings) != 1 || findings[0].Offset != 14 {
+		t.Errorf("findings = %+v, want one at offset 14", findings)
+	}
+}
+
+func TestKnownVariables(t *testing.T) {
+	findings := Lint("Hi {{name}}, re: {{topic}}", KnownVariables("name", "tone"))
+
+	if got := ruleNames(findings); got != "unused-variable/warning,unknown-variable/error" {
+		t.Errorf("findings = %q", got)
+	}
+	if !strings.Contains(findings[1].Message, `"topic"`) || findings[1].Offset != 17 {
+		t.Errorf("unknown finding = %+v, want topic at offset 17", findings[1])
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	if got := MaxLength(5).Check("héllo"); len(got) != 0 {
+		t.Errorf("5 characters: findings = %+v, want none", got)
+	}
+	if got := ruleNames(MaxLength(5).Check("héllo!")); got != "max-length/warning" {
+		t.Errorf("6 characters: findings = %q", got)
+	}
+}
+
+func TestLint_DefaultRules(t *testing.T) {
+	if got := Lint("Hello {{name}}"); len(got) != 0 {
+		t.Errorf("findings = %+v, want none", got)
+	}
+	if got := ruleNames(Lint(strings.Repeat("x", DefaultMaxTemplateLength+1) + "{{")); got != "max-length/warning,unbalanced-braces/error" {
+		t.Errorf("findings = %q", got)
+	}
+}
+
+func TestLint_CustomRule(t *testing.T) {
+	noTodo := RuleFunc(func(template string) []Finding {
+		if i := strings.Index(template, "TODO"); i >= 0 {
+			return []Finding{{Rule: "no-todo", Severity: SeverityError, Message: "remove TODO", Offset: i}}
+		}
+		return nil
+	})
+
+	findings := Lint("Answer {{q}}. TODO: tone", noTodo)
+	if len(findings) != 1 || findings[0].String() != "error: no-todo: remove TODO (offset 14)" {
+		t.Errorf("findings = %v", findings)
+	}
+}
+
+func TestRegisterPrompt_Lint(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"model_version": {"name": "p", "version": "1"}}`))
+	}))
+
+	_, err := client.RegisterPrompt(context.Background(), "p", "Hello {{name", WithLint())
+	var lintErr *LintError
+	if !stderrors.As(err, &lintErr) {
+		t.Fatalf("RegisterPrompt() error = %v, want *LintError", err)
+	}
+	if requests != 0 {
+		t.Errorf("requests = %d, want 0", requests)
+	}
+
+	// Warnings do not block
+	if _, err := client.RegisterPrompt(context.Background(), "p", "Hello {{ name }}", WithLint()); err != nil {
+		t.Errorf("RegisterPrompt() with warning error = %v", err)
+	}
+}
+
+func TestRegisterChatPrompt_Lint(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+	messages := []ChatMessage{
+		{Role: "system", Content: "You answer about {{topic}}."},
+		{Role: "user", Content: "{{question}} about {{subject}}"},
+	}
+
+	_, err := client.RegisterChatPrompt(context.Background(), "p", messages, WithLint(KnownVariables("topic", "question")))
+	var lintErr *LintError
+	if !stderrors.As(err, &lintErr) {
+		t.Fatalf("RegisterChatPrompt() error = %v, want *LintError", err)
+	}
+	var errs []Finding
+	for _, f := range lintErr.Findings {
+		if f.Severity == SeverityError {
+			errs = append(errs, f)
+		}
+	}
+	if len(errs) != 1 || errs[0].Location != "message 1" || !strings.Contains(errs[0].Message, `"subject"`) {
+		t.Errorf("error findings = %+v, want undeclared subject in message 1", errs)
+	}
+}
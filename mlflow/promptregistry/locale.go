@@ -0,0 +1,97 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// LocaleTagKey is the version tag naming the locale of a prompt variant,
+// e.g. "de-DE". Set it at registration with WithTags.
+const LocaleTagKey = mlflowtags.PromptLocale
+
+// Locale returns the locale the version was registered for, or "" if none.
+func (v *PromptVersion) Locale() string {
+	return v.Tags[LocaleTagKey]
+}
+
+// localeCandidates returns the locales to try in order: locale and its
+// parents ("de-DE", then "de"), then each fallback and its parents.
+// Locales are normalized to use "-" and duplicates are dropped.
+func localeCandidates(locale string, fallbacks []string) []string {
+	var out []string
+	for _, l := range append([]string{locale}, fallbacks...) {
+		l = normalizeLocale(l)
+		for l != "" {
+			if !slices.ContainsFunc(out, func(c string) bool { return strings.EqualFold(c, l) }) {
+				out = append(out, l)
+			}
+			i := strings.LastIndexByte(l, '-')
+			if i < 0 {
+				break
+			}
+			l = l[:i]
+		}
+	}
+	return out
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+}
+
+// loadPromptByLocale resolves the localized variant of a prompt. With an
+// alias, it loads the first of the aliases "<alias>-<locale>" that exists
+// for the candidate locales. Otherwise it loads the latest version tagged
+// with the first candidate locale that has one.
+func (c *Client) loadPromptByLocale(ctx context.Context, name string, o *LoadConfig) (*PromptVersion, error) {
+	if o.Version > 0 && o.Alias == "" {
+		return nil, fmt.Errorf("mlflow: WithLocale cannot be combined with WithVersion")
+	}
+	candidates := localeCandidates(o.Locale, o.FallbackLocales)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("mlflow: locale is required")
+	}
+
+	if o.Alias != "" {
+		for _, locale := range candidates {
+			pv, err := c.loadPromptByAlias(ctx, name, o.Alias+"-"+locale)
+			if err == nil {
+				return pv, nil
+			}
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		return nil, localeNotFound(name, candidates)
+	}
+
+	list, err := c.ListPromptVersions(ctx, name, WithVersionsMaxResults(deleteBatchSize))
+	if err != nil {
+		return nil, err
+	}
+	// Versions are listed newest first
+	for _, locale := range candidates {
+		for _, v := range list.Versions {
+			if strings.EqualFold(normalizeLocale(v.Locale()), locale) {
+				return c.loadPromptVersionByNumber(ctx, name, v.Version)
+			}
+		}
+	}
+	return nil, localeNotFound(name, candidates)
+}
+
+// localeNotFound returns a not-found error, so that IsNotFound reports a
+// missing locale like a missing version.
+func localeNotFound(name string, candidates []string) error {
+	return &errors.APIError{
+		StatusCode: http.StatusNotFound,
+		Code:       "RESOURCE_DOES_NOT_EXIST",
+		Message:    fmt.Sprintf("prompt %q has no variant for locales %s", name, strings.Join(candidates, ", ")),
+	}
+}
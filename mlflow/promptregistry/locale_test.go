@@ -0,0 +1,131 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestLocaleCandidates(t *testing.T) {
+	tests := []struct {
+		locale    string
+		fallbacks []string
+		want      string
+	}{
+		{"de-DE", nil, "de-DE,de"},
+		{"de_DE", []string{"en"}, "de-DE,de,en"},
+		{"zh-Hant-TW", []string{"zh", "en-US"}, "zh-Hant-TW,zh-Hant,zh,en-US,en"},
+		{"fr", []string{"FR", "en"}, "fr,en"},
+		{"", []string{"en"}, "en"},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(localeCandidates(tt.locale, tt.fallbacks), ","); got != tt.want {
+			t.Errorf("localeCandidates(%q, %v) = %s, want %s", tt.locale, tt.fallbacks, got, tt.want)
+		}
+	}
+}
+
+// newLocaleTestClient serves versions 1-4 of "greet" tagged en, de, de-DE
+// and en, and the aliases production-de and production-en.
+func newLocaleTestClient(t *testing.T) *Client {
+	t.Helper()
+	locales := map[string]string{"1": "en", "2": "de", "3": "de-DE", "4": "en"}
+	aliases := map[string]string{"production-de": "2", "production-en": "1"}
+
+	version := func(v string) map[string]any {
+		return map[string]any{
+			"name":    "greet",
+			"version": v,
+			"tags": []map[string]string{
+				{"key": "mlflow.prompt.text", "value": "template v" + v},
+				{"key": LocaleTagKey, "value": locales[v]},
+			},
+		}
+	}
+	notFound := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "not found"})
+	}
+
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/search":
+			_ = json.NewEncoder(w).Encode(map[string]any{"model_versions": []any{
+				version("4"), version("3"), version("2"), version("1"),
+			}})
+		case "/api/2.0/mlflow/model-versions/get":
+			_ = json.NewEncoder(w).Encode(map[string]any{"model_version": version(r.URL.Query().Get("version"))})
+		case "/api/2.0/mlflow/registered-models/alias":
+			v, ok := aliases[r.URL.Query().Get("alias")]
+			if !ok {
+				notFound(w)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"model_version": version(v)})
+		default:
+			notFound(w)
+		}
+	}))
+}
+
+func TestLoadPrompt_Locale(t *testing.T) {
+	client := newLocaleTestClient(t)
+
+	tests := []struct {
+		name        string
+		opts        []LoadOption
+		wantVersion int
+	}{
+		{"exact", []LoadOption{WithLocale("de-DE")}, 3},
+		{"case and underscore", []LoadOption{WithLocale("de_de")}, 3},
+		{"parent", []LoadOption{WithLocale("de-AT")}, 2},
+		{"latest of locale", []LoadOption{WithLocale("en-GB")}, 4},
+		{"fallback", []LoadOption{WithLocale("fr-FR"), WithFallbackLocale("es", "en")}, 4},
+		{"alias with parent", []LoadOption{WithAlias("production"), WithLocale("de-CH")}, 2},
+		{"alias with fallback", []LoadOption{WithAlias("production"), WithLocale("fr"), WithFallbackLocale("en")}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pv, err := client.LoadPrompt(context.Background(), "greet", tt.opts...)
+			if err != nil {
+				t.Fatalf("LoadPrompt() error = %v", err)
+			}
+			if pv.Version != tt.wantVersion {
+				t.Errorf("Version = %d, want %d", pv.Version, tt.wantVersion)
+			}
+			if pv.Template == "" {
+				t.Error("Template is empty, want full version loaded")
+			}
+		})
+	}
+}
+
+func TestLoadPrompt_LocaleNotFound(t *testing.T) {
+	client := newLocaleTestClient(t)
+
+	_, err := client.LoadPrompt(context.Background(), "greet", WithLocale("ja-JP"))
+	if !errors.IsNotFound(err) {
+		t.Errorf("LoadPrompt() error = %v, want not found", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "ja-JP, ja") {
+		t.Errorf("error = %v, want it to list the locales tried", err)
+	}
+
+	_, err = client.LoadPrompt(context.Background(), "greet", WithAlias("staging"), WithLocale("de"))
+	if !errors.IsNotFound(err) {
+		t.Errorf("LoadPrompt() with alias error = %v, want not found", err)
+	}
+}
+
+func TestLoadPrompt_LocaleWithVersion(t *testing.T) {
+	client := newLocaleTestClient(t)
+
+	if _, err := client.LoadPrompt(context.Background(), "greet", WithVersion(1), WithLocale("de")); err == nil {
+		t.Error("expected error combining WithVersion and WithLocale")
+	}
+}
@@ -0,0 +1,21 @@
+package promptregistry
+
+import "encoding/json"
+
+// MarshalJSON encodes the version with its times as RFC 3339 in UTC,
+// omitting them for a version not yet registered.
+func (v PromptVersion) MarshalJSON() ([]byte, error) {
+	type plain PromptVersion
+	p := plain(v)
+	p.CreatedAt = p.CreatedAt.UTC()
+	p.UpdatedAt = p.UpdatedAt.UTC()
+	return json.Marshal(p)
+}
+
+// MarshalJSON encodes the prompt with its creation time as RFC 3339 in UTC.
+func (p Prompt) MarshalJSON() ([]byte, error) {
+	type plain Prompt
+	q := plain(p)
+	q.CreationTimestamp = q.CreationTimestamp.UTC()
+	return json.Marshal(q)
+}
@@ -0,0 +1,51 @@
+package promptregistry
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPromptVersion_MarshalJSON(t *testing.T) {
+	created := time.UnixMilli(1700000000000).In(time.FixedZone("CET", 3600))
+	pv := PromptVersion{
+		Name:      "qa",
+		Version:   2,
+		Template:  "Answer {{question}}",
+		Aliases:   []string{"production"},
+		CreatedAt: created,
+	}
+
+	data, err := json.Marshal(pv)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	got := string(data)
+	if !strings.Contains(got, `"created_at":"2023-11-14T22:13:20Z"`) {
+		t.Errorf("Marshal() = %s, want created_at in UTC", got)
+	}
+	if strings.Contains(got, "updated_at") {
+		t.Errorf("Marshal() = %s, want zero updated_at omitted", got)
+	}
+
+	var back PromptVersion
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if back.Name != "qa" || back.Version != 2 || !back.CreatedAt.Equal(created) || back.Aliases[0] != "production" {
+		t.Errorf("Unmarshal() = %+v", back)
+	}
+}
+
+func TestPrompt_MarshalJSON(t *testing.T) {
+	p := Prompt{Name: "qa", LatestVersion: 3}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"name":"qa","description":"","latest_version":3,"tags":null}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
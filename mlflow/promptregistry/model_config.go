@@ -2,14 +2,14 @@ package promptregistry
 
 // PromptModelConfig contains optional model configuration for a prompt.
 type PromptModelConfig struct {
-	Provider         string         `json:"provider,omitempty"`
-	ModelName        string         `json:"model_name,omitempty"`
-	Temperature      *float64       `json:"temperature,omitempty"`
-	MaxTokens        *int           `json:"max_tokens,omitempty"`
-	TopP             *float64       `json:"top_p,omitempty"`
-	TopK             *int           `json:"top_k,omitempty"`
-	FrequencyPenalty *float64       `json:"frequency_penalty,omitempty"`
-	PresencePenalty  *float64       `json:"presence_penalty,omitempty"`
-	StopSequences    []string       `json:"stop_sequences,omitempty"`
-	ExtraParams      map[string]any `json:"extra_params,omitempty"`
+	Provider         string         `yaml:"provider,omitempty" json:"provider,omitempty"`
+	ModelName        string         `yaml:"model_name,omitempty" json:"model_name,omitempty"`
+	Temperature      *float64       `yaml:"temperature,omitempty" json:"temperature,omitempty"`
+	MaxTokens        *int           `yaml:"max_tokens,omitempty" json:"max_tokens,omitempty"`
+	TopP             *float64       `yaml:"top_p,omitempty" json:"top_p,omitempty"`
+	TopK             *int           `yaml:"top_k,omitempty" json:"top_k,omitempty"`
+	FrequencyPenalty *float64       `yaml:"frequency_penalty,omitempty" json:"frequency_penalty,omitempty"`
+	PresencePenalty  *float64       `yaml:"presence_penalty,omitempty" json:"presence_penalty,omitempty"`
+	StopSequences    []string       `yaml:"stop_sequences,omitempty" json:"stop_sequences,omitempty"`
+	ExtraParams      map[string]any `yaml:"extra_params,omitempty" json:"extra_params,omitempty"`
 }
@@ -0,0 +1,108 @@
+package promptregistry
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NameSeparator separates namespace segments in a prompt Name.
+const NameSeparator = "/"
+
+// maxNameLength is the longest registered model name MLflow can store.
+const maxNameLength = 256
+
+// nameSegmentPattern matches a single segment of a prompt name.
+// Mirrors the character set the MLflow Python SDK allows for prompt names.
+var nameSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+
+// Name is a prompt name that may carry a namespace, such as
+// "team/project/greeting". The last segment is the base name and any
+// preceding segments form the namespace.
+//
+// Name is a plain string; the registry stores it verbatim.
+type Name string
+
+// NewName joins a namespace and a base name and validates the result.
+// An empty namespace yields an un-namespaced name.
+func NewName(namespace, base string) (Name, error) {
+	n := Name(base)
+	if namespace != "" {
+		n = Name(strings.TrimSuffix(namespace, NameSeparator) + NameSeparator + base)
+	}
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+// ParseName validates s and returns it as a Name.
+func ParseName(s string) (Name, error) {
+	n := Name(s)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+// Validate reports whether the name is well formed: non-empty, at most 256
+// characters, and made of non-empty segments containing only letters, digits,
+// '_', '.', or '-'.
+func (n Name) Validate() error {
+	if n == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	if len(n) > maxNameLength {
+		return fmt.Errorf("mlflow: prompt name exceeds %d characters", maxNameLength)
+	}
+	for _, seg := range strings.Split(string(n), NameSeparator) {
+		if err := validateNameSegment(seg); err != nil {
+			return fmt.Errorf("mlflow: invalid prompt name %q: %w", string(n), err)
+		}
+	}
+	return nil
+}
+
+// Namespace returns everything before the last separator,
+// or an empty string if the name has no namespace.
+func (n Name) Namespace() string {
+	i := strings.LastIndex(string(n), NameSeparator)
+	if i < 0 {
+		return ""
+	}
+	return string(n[:i])
+}
+
+// Base returns the last segment of the name.
+func (n Name) Base() string {
+	i := strings.LastIndex(string(n), NameSeparator)
+	return string(n[i+1:])
+}
+
+// String returns the full name.
+func (n Name) String() string {
+	return string(n)
+}
+
+// validateNamespace checks a namespace such as "team/project".
+func validateNamespace(ns string) error {
+	if ns == "" {
+		return fmt.Errorf("mlflow: namespace is required")
+	}
+	for _, seg := range strings.Split(ns, NameSeparator) {
+		if err := validateNameSegment(seg); err != nil {
+			return fmt.Errorf("mlflow: invalid namespace %q: %w", ns, err)
+		}
+	}
+	return nil
+}
+
+func validateNameSegment(seg string) error {
+	if seg == "" {
+		return fmt.Errorf("empty segment")
+	}
+	if !nameSegmentPattern.MatchString(seg) {
+		return fmt.Errorf("segment %q may only contain letters, digits, '_', '.', or '-'", seg)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package promptregistry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "plain", input: "greeting"},
+		{name: "namespaced", input: "team/project/greeting"},
+		{name: "allowed punctuation", input: "team-a/v1.2/greet_user"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "empty segment", input: "team//greeting", wantErr: true},
+		{name: "trailing separator", input: "team/", wantErr: true},
+		{name: "leading separator", input: "/greeting", wantErr: true},
+		{name: "space", input: "team/my prompt", wantErr: true},
+		{name: "quote", input: "team/it's", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", 257), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := ParseName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && n.String() != tt.input {
+				t.Errorf("String() = %q, want %q", n.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestNewName(t *testing.T) {
+	n, err := NewName("team/project/", "greeting")
+	if err != nil {
+		t.Fatalf("NewName() error = %v", err)
+	}
+	if n != "team/project/greeting" {
+		t.Errorf("NewName() = %q, want %q", n, "team/project/greeting")
+	}
+
+	n, err = NewName("", "greeting")
+	if err != nil {
+		t.Fatalf("NewName() error = %v", err)
+	}
+	if n != "greeting" {
+		t.Errorf("NewName() = %q, want %q", n, "greeting")
+	}
+
+	if _, err := NewName("team", "bad name"); err == nil {
+		t.Error("expected error for invalid base name")
+	}
+}
+
+func TestName_NamespaceAndBase(t *testing.T) {
+	n := Name("team/project/greeting")
+	if n.Namespace() != "team/project" {
+		t.Errorf("Namespace() = %q, want %q", n.Namespace(), "team/project")
+	}
+	if n.Base() != "greeting" {
+		t.Errorf("Base() = %q, want %q", n.Base(), "greeting")
+	}
+
+	plain := Name("greeting")
+	if plain.Namespace() != "" {
+		t.Errorf("Namespace() = %q, want empty", plain.Namespace())
+	}
+	if plain.Base() != "greeting" {
+		t.Errorf("Base() = %q, want %q", plain.Base(), "greeting")
+	}
+}
@@ -1,27 +1,81 @@
 package promptregistry
 
-// loadOptions holds the configuration for a LoadPrompt call.
-type loadOptions struct {
-	version int
-	alias   string
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// LoadConfig is the configuration a LoadPrompt call resolves its options
+// into. Wrapper libraries can build one with Apply to inspect what a list
+// of options selects, adjust it, and pass it back with WithLoadConfig.
+type LoadConfig struct {
+	// Version is the version to load, set by WithVersion; zero means latest.
+	Version int
+
+	// Alias is the alias to load, set by WithAlias.
+	Alias string
+
+	// Locale and FallbackLocales select a locale variant, set by
+	// WithLocale and WithFallbackLocale.
+	Locale          string
+	FallbackLocales []string
+}
+
+// Apply applies opts to c in order.
+func (c *LoadConfig) Apply(opts ...LoadOption) {
+	for _, opt := range opts {
+		opt(c)
+	}
 }
 
 // LoadOption configures a LoadPrompt call.
-type LoadOption func(*loadOptions)
+type LoadOption func(*LoadConfig)
+
+// WithLoadConfig replaces the whole configuration with cfg. Options after
+// it still apply on top.
+func WithLoadConfig(cfg LoadConfig) LoadOption {
+	return func(o *LoadConfig) {
+		*o = cfg
+		o.FallbackLocales = slices.Clone(cfg.FallbackLocales)
+	}
+}
 
 // WithVersion specifies the version to load.
 // If not set, loads the latest version.
 func WithVersion(version int) LoadOption {
-	return func(o *loadOptions) {
-		o.version = version
+	return func(o *LoadConfig) {
+		o.Version = version
 	}
 }
 
 // WithAlias specifies the alias to load (e.g., "production", "staging").
 // Takes precedence over WithVersion if both are specified.
 func WithAlias(alias string) LoadOption {
-	return func(o *loadOptions) {
-		o.alias = alias
+	return func(o *LoadConfig) {
+		o.Alias = alias
+	}
+}
+
+// WithLocale loads the variant of the prompt for locale, e.g. "de-DE": the
+// latest version tagged with that LocaleTagKey, or, combined with WithAlias,
+// the version behind the alias "<alias>-<locale>" (e.g. "production-de-DE").
+// If there is none, the parent locale ("de") is tried, then the locales
+// given to WithFallbackLocale. Matching ignores case. Cannot be combined
+// with WithVersion.
+func WithLocale(locale string) LoadOption {
+	return func(o *LoadConfig) {
+		o.Locale = locale
+	}
+}
+
+// WithFallbackLocale adds locales to try, in order, when no variant exists
+// for the WithLocale locale or its parents.
+func WithFallbackLocale(locales ...string) LoadOption {
+	return func(o *LoadConfig) {
+		o.FallbackLocales = append(o.FallbackLocales, locales...)
 	}
 }
 
@@ -30,6 +84,10 @@ type registerOptions struct {
 	commitMessage string
 	tags          map[string]string
 	modelConfig   *PromptModelConfig
+	secretPolicy  SecretPolicy
+	lint          bool
+	lintRules     []Rule
+	inputSchema   InputSchema
 }
 
 // RegisterOption configures a RegisterPrompt call.
@@ -56,6 +114,37 @@ func WithModelConfig(config *PromptModelConfig) RegisterOption {
 	}
 }
 
+// WithInputSchema declares the template's variables. The schema is stored
+// with the version and exposed as PromptVersion.InputSchema, and Format
+// validates variables against it and fills in defaults.
+func WithInputSchema(schema map[string]VarSpec) RegisterOption {
+	return func(o *registerOptions) {
+		o.inputSchema = InputSchema(schema).clone()
+	}
+}
+
+// WithSecretScan scans the template, or each chat message, for credentials
+// and personal data before registering, and applies policy to what it finds:
+// SecretWarn logs, SecretRedact masks, and SecretBlock returns a *SecretError
+// without registering anything. See ScanSecrets for what is detected.
+// Detection is pattern based and can miss secrets or flag lookalikes.
+func WithSecretScan(policy SecretPolicy) RegisterOption {
+	return func(o *registerOptions) {
+		o.secretPolicy = policy
+	}
+}
+
+// WithLint lints the template, or each chat message, before registering, and
+// returns a *LintError without registering anything if a rule reports an
+// error. Warnings do not block registration. With no rules, DefaultRules
+// are used. Chat messages are linted one at a time.
+func WithLint(rules ...Rule) RegisterOption {
+	return func(o *registerOptions) {
+		o.lint = true
+		o.lintRules = rules
+	}
+}
+
 // listPromptsOptions holds the configuration for a ListPrompts call.
 type listPromptsOptions struct {
 	maxResults int
@@ -63,6 +152,13 @@ type listPromptsOptions struct {
 	nameFilter string
 	tagFilter  map[string]string
 	orderBy    []string
+	namespace  string
+
+	// aliasSummary fills Prompt.VersionCount and Prompt.Aliases.
+	aliasSummary bool
+
+	// countUpTo fills PromptList.TotalCount when positive.
+	countUpTo int
 }
 
 // ListPromptsOption configures a ListPrompts call.
@@ -107,6 +203,26 @@ func WithOrderBy(fields ...string) ListPromptsOption {
 	}
 }
 
+// WithIncludeAliasSummary fills VersionCount and Aliases of each listed
+// prompt, as a catalog view needs. It costs two extra requests per prompt,
+// made concurrently.
+func WithIncludeAliasSummary() ListPromptsOption {
+	return func(o *listPromptsOptions) {
+		o.aliasSummary = true
+	}
+}
+
+// WithCountUpTo fills PromptList.TotalCount with the number of prompts
+// matching the listing, for pagination controls. The server does not
+// report totals, so they are counted by paging through the results, up to
+// n; TotalCountCapped is set if there are more. Counting from a page other
+// than the first also fetches the pages before it.
+func WithCountUpTo(n int) ListPromptsOption {
+	return func(o *listPromptsOptions) {
+		o.countUpTo = n
+	}
+}
+
 // listVersionsOptions holds the configuration for a ListPromptVersions call.
 type listVersionsOptions struct {
 	maxResults int
@@ -148,3 +264,249 @@ func WithVersionsOrderBy(fields ...string) ListVersionsOption {
 		o.orderBy = fields
 	}
 }
+
+// deleteCompletelyOptions holds the configuration for a DeletePromptCompletely call.
+type deleteCompletelyOptions struct {
+	concurrency int
+	retries     int
+	backoff     time.Duration
+	events      chan<- progress.Event
+	reporter    *progress.Reporter
+	confirm     string
+}
+
+// DeleteCompletelyOption configures a DeletePromptCompletely call.
+type DeleteCompletelyOption func(*deleteCompletelyOptions)
+
+// WithDeleteConfirm confirms that DeletePromptCompletely deletes the prompt
+// name and its versions, as WithConfirm does for DeletePrompt.
+func WithDeleteConfirm(name string) DeleteCompletelyOption {
+	return func(o *deleteCompletelyOptions) {
+		o.confirm = name
+	}
+}
+
+// WithDeleteConcurrency sets how many version deletions may run at once.
+// Default: 4. Values below 1 are treated as 1.
+func WithDeleteConcurrency(n int) DeleteCompletelyOption {
+	return func(o *deleteCompletelyOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithDeleteRetries sets how many times a request failing with 429 or 5xx is
+// retried, and the backoff before the first retry (doubled on each attempt).
+// Default: 3 retries starting at 200ms. Use WithDeleteRetries(0, 0) to disable.
+func WithDeleteRetries(n int, backoff time.Duration) DeleteCompletelyOption {
+	return func(o *deleteCompletelyOptions) {
+		o.retries = n
+		o.backoff = backoff
+	}
+}
+
+// WithEvents sends progress events for the call to ch. See package
+// progress for how events are sent.
+func WithEvents(ch chan<- progress.Event) DeleteCompletelyOption {
+	return func(o *deleteCompletelyOptions) {
+		o.events = ch
+	}
+}
+
+// applyOptions holds the configuration for an Apply call.
+type applyOptions struct {
+	events chan<- progress.Event
+}
+
+// ApplyOption configures an Apply call.
+type ApplyOption func(*applyOptions)
+
+// WithApplyEvents sends progress events for the call to ch. See package
+// progress for how events are sent.
+func WithApplyEvents(ch chan<- progress.Event) ApplyOption {
+	return func(o *applyOptions) {
+		o.events = ch
+	}
+}
+
+// usageReportOptions holds the configuration for a UsageReport call.
+type usageReportOptions struct {
+	experimentIDs []string
+}
+
+// UsageReportOption configures a UsageReport call.
+type UsageReportOption func(*usageReportOptions)
+
+// WithUsageExperiments limits UsageReport to traces and runs in the given
+// experiments. By default every active experiment is scanned.
+func WithUsageExperiments(experimentIDs ...string) UsageReportOption {
+	return func(o *usageReportOptions) {
+		o.experimentIDs = experimentIDs
+	}
+}
+
+// abSelectorOptions holds the configuration for an ABSelector.
+type abSelectorOptions struct {
+	cacheTTL time.Duration
+	recorder func(context.Context, ABSelection)
+}
+
+// ABSelectorOption configures an ABSelector.
+type ABSelectorOption func(*abSelectorOptions)
+
+// WithABCacheTTL sets how long a resolved alias is reused before it is
+// loaded again. Default: 1 minute. Use 0 to resolve on every Select.
+func WithABCacheTTL(d time.Duration) ABSelectorOption {
+	return func(o *abSelectorOptions) {
+		o.cacheTTL = d
+	}
+}
+
+// WithABRecorder sets a function called synchronously with every successful
+// selection, for recording which alias and version served each request,
+// e.g. by setting ABSelection.Tags on the active span or run.
+func WithABRecorder(fn func(context.Context, ABSelection)) ABSelectorOption {
+	return func(o *abSelectorOptions) {
+		o.recorder = fn
+	}
+}
+
+// searchContentOptions holds the configuration for a SearchPromptContent call.
+type searchContentOptions struct {
+	nameFilter   string
+	tagFilter    map[string]string
+	contextLines int
+	concurrency  int
+}
+
+// SearchContentOption configures a SearchPromptContent call.
+type SearchContentOption func(*searchContentOptions)
+
+// WithContentNameFilter limits the search to prompts whose name matches a SQL
+// LIKE pattern, as WithNameFilter.
+func WithContentNameFilter(pattern string) SearchContentOption {
+	return func(o *searchContentOptions) {
+		o.nameFilter = pattern
+	}
+}
+
+// WithContentTagFilter limits the search to prompts with all the given tag
+// values, as WithTagFilter.
+func WithContentTagFilter(tags map[string]string) SearchContentOption {
+	return func(o *searchContentOptions) {
+		o.tagFilter = tags
+	}
+}
+
+// WithContextLines sets how many lines before and after each match are
+// returned with it. Default: 0.
+func WithContextLines(n int) SearchContentOption {
+	return func(o *searchContentOptions) {
+		o.contextLines = n
+	}
+}
+
+// WithContentConcurrency sets how many prompts are loaded at once.
+// Default: 8. Values below 1 are treated as 1.
+func WithContentConcurrency(n int) SearchContentOption {
+	return func(o *searchContentOptions) {
+		o.concurrency = n
+	}
+}
+
+// unreferencedOptions holds the configuration for a FindUnreferencedVersions
+// call.
+type unreferencedOptions struct {
+	experimentIDs []string
+	delete        bool
+	dryRun        bool
+	confirm       string
+}
+
+// UnreferencedOption configures a FindUnreferencedVersions call.
+type UnreferencedOption func(*unreferencedOptions)
+
+// WithUnreferencedExperiments limits the search for linked runs and traces
+// to the given experiments, as WithUsageExperiments. By default every active
+// experiment is scanned.
+func WithUnreferencedExperiments(experimentIDs ...string) UnreferencedOption {
+	return func(o *unreferencedOptions) {
+		o.experimentIDs = experimentIDs
+	}
+}
+
+// WithDeleteUnreferenced deletes the versions found. With dryRun, the
+// deletions are logged and reported to the audit hook but not sent, as with
+// the client's dry-run mode.
+func WithDeleteUnreferenced(dryRun bool) UnreferencedOption {
+	return func(o *unreferencedOptions) {
+		o.delete = true
+		o.dryRun = dryRun
+	}
+}
+
+// WithUnreferencedConfirm confirms that WithDeleteUnreferenced deletes
+// versions of the prompt name, as WithConfirm does for DeletePromptVersion.
+func WithUnreferencedConfirm(name string) UnreferencedOption {
+	return func(o *unreferencedOptions) {
+		o.confirm = name
+	}
+}
+
+// deleteOptions holds the configuration for a DeletePrompt or
+// DeletePromptVersion call.
+type deleteOptions struct {
+	confirm string
+}
+
+// DeleteOption configures a DeletePrompt or DeletePromptVersion call.
+type DeleteOption func(*deleteOptions)
+
+// WithConfirm confirms that the call deletes the prompt name, as a client
+// created with mlflow.WithDeleteProtection requires; without protection it
+// has no effect. It confirms only the call it is passed to. On a namespaced
+// client, name may omit the namespace, but never matches a prompt in
+// another namespace.
+//
+//	err := client.DeletePrompt(ctx, "old-prompt", promptregistry.WithConfirm("old-prompt"))
+func WithConfirm(name string) DeleteOption {
+	return func(o *deleteOptions) {
+		o.confirm = name
+	}
+}
+
+// embeddedOptions holds the configuration for an EmbeddedResolver.
+type embeddedOptions struct {
+	refresh    time.Duration
+	retryAfter time.Duration
+	onFallback func(ctx context.Context, name string, err error)
+	now        func() time.Time
+}
+
+// EmbeddedOption configures an EmbeddedResolver.
+type EmbeddedOption func(*embeddedOptions)
+
+// WithEmbeddedRefresh sets how long a version loaded from the registry is
+// reused before it is loaded again. Default: 1 minute. Use 0 to load on
+// every call while the registry is reachable.
+func WithEmbeddedRefresh(d time.Duration) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.refresh = d
+	}
+}
+
+// WithEmbeddedRetryAfter sets how long fallbacks are served after a failed
+// registry call before the registry is tried again. Default: 30 seconds.
+func WithEmbeddedRetryAfter(d time.Duration) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.retryAfter = d
+	}
+}
+
+// WithEmbeddedFallbackHook sets a function called synchronously whenever a
+// fallback is served instead of a live version, with the error that made
+// the registry unavailable, for logging and metrics.
+func WithEmbeddedFallbackHook(fn func(ctx context.Context, name string, err error)) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.onFallback = fn
+	}
+}
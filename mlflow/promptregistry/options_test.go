@@ -0,0 +1,39 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestLoadConfig_Apply(t *testing.T) {
+	var cfg LoadConfig
+	cfg.Apply(WithAlias("production"), WithLocale("de-DE"), WithFallbackLocale("en", "fr"))
+	want := LoadConfig{Alias: "production", Locale: "de-DE", FallbackLocales: []string{"en", "fr"}}
+	if cfg.Alias != want.Alias || cfg.Locale != want.Locale || len(cfg.FallbackLocales) != 2 {
+		t.Errorf("Apply() = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestWithLoadConfig(t *testing.T) {
+	var gotVersion string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersion = r.URL.Query().Get("version")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{"name": "qa", "version": gotVersion},
+		})
+	}))
+
+	var cfg LoadConfig
+	cfg.Apply(WithVersion(2))
+	cfg.Version++
+	pv, err := client.LoadPrompt(context.Background(), "qa", WithLoadConfig(cfg))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if gotVersion != "3" || pv.Version != 3 {
+		t.Errorf("loaded version %q (%d), want 3", gotVersion, pv.Version)
+	}
+}
@@ -18,14 +18,19 @@
 package promptregistry
 
 import (
+	"fmt"
 	"maps"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
 )
 
 // ChatMessage represents a single message in a chat prompt.
 type ChatMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role    string `yaml:"role" json:"role"`
+	Content string `yaml:"content" json:"content"`
 }
 
 // PromptVersion represents a prompt version from the MLflow Prompt Registry.
@@ -33,41 +38,54 @@ type ChatMessage struct {
 // Modifications do not affect the registry until RegisterPrompt is called.
 type PromptVersion struct {
 	// Name is the prompt identifier in the registry.
-	Name string `json:"name"`
+	Name string `yaml:"name" json:"name"`
 
 	// Version is the version number (1, 2, 3, ...).
 	// Zero if this is a new prompt not yet registered.
-	Version int `json:"version"`
+	Version int `yaml:"version" json:"version"`
 
 	// Template is the prompt template content for text prompts.
 	// May contain {{variable}} placeholders.
 	// Empty for chat prompts (use Messages instead).
-	Template string `json:"template,omitempty"`
+	Template string `yaml:"template,omitempty" json:"template,omitempty"`
 
 	// Messages contains the chat messages for chat prompts.
 	// Each message may contain {{variable}} placeholders in Content.
 	// Nil for text prompts (use Template instead).
-	Messages []ChatMessage `json:"messages,omitempty"`
+	Messages []ChatMessage `yaml:"messages,omitempty" json:"messages,omitempty"`
 
 	// CommitMessage is the version commit message.
-	CommitMessage string `json:"commit_message"`
+	CommitMessage string `yaml:"commit_message" json:"commit_message"`
 
 	// Aliases are the aliases pointing to this version (e.g., "production", "staging").
-	Aliases []string `json:"aliases,omitempty"`
+	Aliases []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
 
 	// ModelConfig contains optional model configuration.
-	ModelConfig *PromptModelConfig `json:"model_config,omitempty"`
+	ModelConfig *PromptModelConfig `yaml:"model_config,omitempty" json:"model_config,omitempty"`
+
+	// InputSchema declares the template variables, if registered with
+	// WithInputSchema. Format validates variables against it.
+	InputSchema InputSchema `yaml:"input_schema,omitempty" json:"input_schema,omitempty"`
 
 	// Tags are key-value metadata pairs.
-	Tags map[string]string `json:"tags"`
+	Tags map[string]string `yaml:"tags" json:"tags"`
+
+	// RawDescription and RawTags are the model version's description field
+	// and all of its tags as stored, including the internal tags the fields
+	// above are decoded from, for tooling that needs both commit message
+	// sources (see DescriptionPrecedence). They are only set on versions
+	// returned by the server, are left out of JSON and YAML, and are ignored
+	// by Equal.
+	RawDescription string            `yaml:"-" json:"-"`
+	RawTags        map[string]string `yaml:"-" json:"-"`
 
 	// CreatedAt is when this version was created.
 	// Zero if not yet registered.
-	CreatedAt time.Time `json:"created_at"`
+	CreatedAt time.Time `yaml:"created_at,omitempty" json:"created_at,omitzero"`
 
 	// UpdatedAt is when this version was last updated.
 	// Zero if not yet registered.
-	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedAt time.Time `yaml:"updated_at,omitempty" json:"updated_at,omitzero"`
 }
 
 // IsChat returns true if this is a chat prompt (has Messages), false for text prompts.
@@ -75,46 +93,89 @@ func (v *PromptVersion) IsChat() bool {
 	return v.Messages != nil
 }
 
+// String returns a one-line summary of the version for logs, such as
+// `prompt "qa" v3 [text] aliases=production,staging tags=2`. The template,
+// messages, and tag values are left out, so it is safe to log.
+func (v PromptVersion) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "prompt %q", v.Name)
+	if v.Version > 0 {
+		fmt.Fprintf(&b, " v%d", v.Version)
+	} else {
+		b.WriteString(" (unregistered)")
+	}
+	if v.IsChat() {
+		fmt.Fprintf(&b, " [chat, %d messages]", len(v.Messages))
+	} else {
+		b.WriteString(" [text]")
+	}
+	if len(v.Aliases) > 0 {
+		aliases := slices.Sorted(slices.Values(v.Aliases))
+		fmt.Fprintf(&b, " aliases=%s", strings.Join(aliases, ","))
+	}
+	fmt.Fprintf(&b, " tags=%d", len(v.Tags))
+	return b.String()
+}
+
 // Prompt represents prompt metadata from a listing operation.
 // Use LoadPrompt to get full PromptVersion with template content.
 type Prompt struct {
 	// Name is the prompt identifier in the registry.
-	Name string `json:"name"`
+	Name string `yaml:"name" json:"name"`
 
 	// Description is the prompt description.
-	Description string `json:"description"`
+	Description string `yaml:"description" json:"description"`
 
 	// LatestVersion is the highest version number, 0 if no versions exist.
-	LatestVersion int `json:"latest_version"`
+	LatestVersion int `yaml:"latest_version" json:"latest_version"`
 
 	// Tags are key-value metadata pairs.
-	Tags map[string]string `json:"tags"`
+	Tags map[string]string `yaml:"tags" json:"tags"`
 
 	// CreationTimestamp is when the prompt was created.
-	CreationTimestamp time.Time `json:"creation_timestamp"`
+	CreationTimestamp time.Time `yaml:"creation_timestamp,omitempty" json:"creation_timestamp,omitzero"`
+
+	// VersionCount is the number of versions, and Aliases maps each alias to
+	// the version it points to. Both are only filled by ListPrompts with
+	// WithIncludeAliasSummary.
+	VersionCount int            `yaml:"version_count,omitempty" json:"version_count,omitempty"`
+	Aliases      map[string]int `yaml:"aliases,omitempty" json:"aliases,omitempty"`
 }
 
 // PromptList contains prompts and a pagination token for the next page.
 type PromptList struct {
 	// Prompts is the list of prompt metadata in this page.
-	Prompts []Prompt `json:"prompts"`
+	Prompts []Prompt `yaml:"prompts" json:"prompts"`
 
 	// NextPageToken is the token to fetch the next page.
 	// Empty if there are no more pages.
-	NextPageToken string `json:"next_page_token"`
+	NextPageToken string `yaml:"next_page_token" json:"next_page_token"`
+
+	// TotalCount is the number of prompts matching the listing, counted
+	// with WithCountUpTo; nil otherwise. If TotalCountCapped is set, more
+	// than TotalCount match.
+	TotalCount       *int `yaml:"total_count,omitempty" json:"total_count,omitempty"`
+	TotalCountCapped bool `yaml:"total_count_capped,omitempty" json:"total_count_capped,omitempty"`
 }
 
 // PromptVersionList contains prompt versions and a pagination token.
 type PromptVersionList struct {
 	// Versions is the list of prompt versions in this page.
 	// Template field will be empty; use LoadPrompt with WithVersion to get full content.
-	Versions []PromptVersion `json:"versions"`
+	Versions []PromptVersion `yaml:"versions" json:"versions"`
 
 	// NextPageToken is the token to fetch the next page.
 	// Empty if there are no more pages.
-	NextPageToken string `json:"next_page_token"`
+	NextPageToken string `yaml:"next_page_token" json:"next_page_token"`
 }
 
+// Page is one page of results from a Cursor.
+type Page[T any] = pagination.Page[T]
+
+// Cursor iterates over pages of list results with Next, All, and Err.
+// It is safe for concurrent use.
+type Cursor[T any] = pagination.Cursor[T]
+
 // Clone returns a deep copy of the PromptVersion.
 // Use this to create a modified version for registration.
 func (v *PromptVersion) Clone() *PromptVersion {
@@ -123,12 +184,13 @@ func (v *PromptVersion) Clone() *PromptVersion {
 	}
 
 	clone := &PromptVersion{
-		Name:          v.Name,
-		Version:       v.Version,
-		Template:      v.Template,
-		CommitMessage: v.CommitMessage,
-		CreatedAt:     v.CreatedAt,
-		UpdatedAt:     v.UpdatedAt,
+		Name:           v.Name,
+		Version:        v.Version,
+		Template:       v.Template,
+		CommitMessage:  v.CommitMessage,
+		RawDescription: v.RawDescription,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
 	}
 
 	if v.ModelConfig != nil {
@@ -144,6 +206,8 @@ func (v *PromptVersion) Clone() *PromptVersion {
 		clone.ModelConfig = &cfg
 	}
 
+	clone.InputSchema = v.InputSchema.clone()
+
 	if v.Messages != nil {
 		clone.Messages = make([]ChatMessage, len(v.Messages))
 		copy(clone.Messages, v.Messages)
@@ -159,6 +223,8 @@ func (v *PromptVersion) Clone() *PromptVersion {
 		maps.Copy(clone.Tags, v.Tags)
 	}
 
+	clone.RawTags = maps.Clone(v.RawTags)
+
 	return clone
 }
 
@@ -221,3 +221,35 @@ func TestPromptVersion_Clone_NilModelConfig(t *testing.T) {
 		t.Error("nil ModelConfig should remain nil after clone")
 	}
 }
+
+func TestPromptVersion_String(t *testing.T) {
+	tests := []struct {
+		name string
+		pv   PromptVersion
+		want string
+	}{
+		{
+			name: "text",
+			pv: PromptVersion{
+				Name:     "qa",
+				Version:  3,
+				Template: "Use key sk-secret",
+				Aliases:  []string{"staging", "production"},
+				Tags:     map[string]string{"a": "1", "b": "2"},
+			},
+			want: `prompt "qa" v3 [text] aliases=production,staging tags=2`,
+		},
+		{
+			name: "unregistered chat",
+			pv:   PromptVersion{Name: "bot", Messages: []ChatMessage{{Role: "system"}, {Role: "user"}}},
+			want: `prompt "bot" (unregistered) [chat, 2 messages] tags=0`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pv.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package promptregistry
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"maps"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// PromptDraft is a prompt version for RegisterPromptTx to register and
+// configure.
+type PromptDraft struct {
+	// Template is the template of a text prompt. Set it or Messages.
+	Template string
+	// Messages are the messages of a chat prompt.
+	Messages []ChatMessage
+	// Tags are set on the version, on top of any WithTags tags.
+	Tags map[string]string
+	// Alias, if set, is pointed at the new version.
+	Alias string
+}
+
+// RegisterPromptTx registers a prompt version and configures it as one
+// unit: it creates the version with the draft's tags, then points the
+// draft's alias at it. If a step after creating the version fails, the
+// version is deleted again, along with the prompt if this call created it,
+// so a partial failure never leaves a half-configured version that callers
+// loading "latest" could pick up. opts apply as for RegisterPrompt.
+//
+// The alias is moved in one request, so it points either at its previous
+// version or at the new one, never at neither. The steps themselves are
+// not atomic: another client may see the new version before its alias is
+// set. The rollback runs even if ctx has ended, and is confirmed
+// implicitly on a client with delete protection, since it only deletes
+// what this call created. If the rollback fails too, both errors are
+// returned.
+func (c *Client) RegisterPromptTx(ctx context.Context, name string, draft PromptDraft, opts ...RegisterOption) (*PromptVersion, error) {
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+	if (draft.Template == "") == (len(draft.Messages) == 0) {
+		return nil, fmt.Errorf("mlflow: prompt draft needs either a template or messages")
+	}
+
+	var o registerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(draft.Tags) > 0 {
+		tags := maps.Clone(o.tags)
+		if tags == nil {
+			tags = make(map[string]string, len(draft.Tags))
+		}
+		maps.Copy(tags, draft.Tags)
+		opts = append(opts, WithTags(tags))
+	}
+
+	qualified, err := c.qualifyForRegistration(name)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.getRegisteredModel(ctx, qualified)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	created := err != nil
+
+	var pv *PromptVersion
+	if draft.Messages != nil {
+		pv, err = c.RegisterChatPrompt(ctx, name, draft.Messages, opts...)
+	} else {
+		pv, err = c.RegisterPrompt(ctx, name, draft.Template, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.Alias != "" {
+		if err = c.SetPromptAlias(ctx, pv.Name, draft.Alias, pv.Version); err != nil {
+			return nil, c.rollbackRegistration(ctx, pv, created, err)
+		}
+		pv.Aliases = append(pv.Aliases, draft.Alias)
+	}
+	return pv, nil
+}
+
+// rollbackRegistration deletes a version RegisterPromptTx created, and its
+// prompt if created, after err, returning err joined with any rollback
+// failure.
+func (c *Client) rollbackRegistration(ctx context.Context, pv *PromptVersion, created bool, err error) error {
+	ctx = transport.WithConfirm(context.WithoutCancel(ctx), pv.Name)
+
+	var rollbackErr error
+	if created {
+		rollbackErr = c.DeletePrompt(ctx, pv.Name)
+	} else {
+		rollbackErr = c.DeletePromptVersion(ctx, pv.Name, pv.Version)
+	}
+	if rollbackErr != nil {
+		return stderrors.Join(err, fmt.Errorf("mlflow: roll back prompt %q version %d: %w", pv.Name, pv.Version, rollbackErr))
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// txServer fakes the registry endpoints RegisterPromptTx uses and records
+// the calls made to it. The prompt exists if exists is set; setting the
+// alias fails with aliasStatus, and deleting fails with deleteStatus, if
+// they are non-zero.
+type txServer struct {
+	t            *testing.T
+	exists       bool
+	aliasStatus  int
+	deleteStatus int
+	calls        []string
+	versionTags  map[string]string
+}
+
+func (s *txServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	s.calls = append(s.calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/2.0/mlflow/"))
+
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/registered-models/get":
+		if !s.exists {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"registered_model": map[string]any{"name": "greeting"}})
+	case "/api/2.0/mlflow/registered-models/create":
+		json.NewEncoder(w).Encode(map[string]any{"registered_model": map[string]any{"name": "greeting"}})
+	case "/api/2.0/mlflow/model-versions/create":
+		var req struct {
+			Tags []struct{ Key, Value string }
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.t.Errorf("decode create request: %v", err)
+		}
+		s.versionTags = make(map[string]string)
+		for _, tag := range req.Tags {
+			s.versionTags[tag.Key] = tag.Value
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{
+				"name":    "greeting",
+				"version": "4",
+				"tags":    []map[string]string{{"key": "mlflow.prompt.text", "value": "Hello, {{name}}!"}},
+			},
+		})
+	case "/api/2.0/mlflow/registered-models/alias":
+		if s.aliasStatus != 0 {
+			w.WriteHeader(s.aliasStatus)
+		}
+		json.NewEncoder(w).Encode(map[string]any{})
+	case "/api/2.0/mlflow/model-versions/delete", "/api/2.0/mlflow/registered-models/delete":
+		if s.deleteStatus != 0 {
+			w.WriteHeader(s.deleteStatus)
+		}
+		json.NewEncoder(w).Encode(map[string]any{})
+	default:
+		s.t.Errorf("unexpected path: %s", r.URL.Path)
+	}
+}
+
+func TestRegisterPromptTx_Success(t *testing.T) {
+	srv := &txServer{t: t, exists: true}
+	client := newTestClient(t, srv)
+
+	pv, err := client.RegisterPromptTx(context.Background(), "greeting", PromptDraft{
+		Template: "Hello, {{name}}!",
+		Tags:     map[string]string{"ticket": "ML-123", "team": "support"},
+		Alias:    "production",
+	}, WithTags(map[string]string{"team": "ml", "env": "prod"}))
+	if err != nil {
+		t.Fatalf("RegisterPromptTx() error = %v", err)
+	}
+	if pv.Version != 4 || !slices.Equal(pv.Aliases, []string{"production"}) {
+		t.Errorf("version = %d, aliases = %v, want 4 with production", pv.Version, pv.Aliases)
+	}
+	// Draft tags are sent with the version and win over WithTags
+	for k, want := range map[string]string{"ticket": "ML-123", "team": "support", "env": "prod"} {
+		if got := srv.versionTags[k]; got != want {
+			t.Errorf("version tag %s = %q, want %q", k, got, want)
+		}
+	}
+	want := []string{
+		"GET registered-models/get",
+		"POST registered-models/create",
+		"POST model-versions/create",
+		"POST registered-models/alias",
+	}
+	if !slices.Equal(srv.calls, want) {
+		t.Errorf("calls = %q, want %q", srv.calls, want)
+	}
+}
+
+func TestRegisterPromptTx_RollsBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		exists bool
+		delete string
+	}{
+		{"existing prompt", true, "DELETE model-versions/delete"},
+		{"new prompt", false, "DELETE registered-models/delete"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &txServer{t: t, exists: tt.exists, aliasStatus: http.StatusBadRequest}
+			// Delete protection must not block the rollback
+			client := newProtectedTestClient(t, srv)
+
+			pv, err := client.RegisterPromptTx(context.Background(), "greeting", PromptDraft{Template: "Hello, {{name}}!", Alias: "production"})
+			if err == nil || !strings.Contains(err.Error(), "failed to set alias") {
+				t.Fatalf("RegisterPromptTx() = %v, %v, want the alias error", pv, err)
+			}
+			if got := srv.calls[len(srv.calls)-1]; got != tt.delete {
+				t.Errorf("last call = %q, want %q", got, tt.delete)
+			}
+		})
+	}
+}
+
+func TestRegisterPromptTx_RollbackFails(t *testing.T) {
+	srv := &txServer{t: t, exists: true, aliasStatus: http.StatusBadRequest, deleteStatus: http.StatusInternalServerError}
+	client := newTestClient(t, srv)
+
+	_, err := client.RegisterPromptTx(context.Background(), "greeting", PromptDraft{Template: "Hello, {{name}}!", Alias: "production"})
+	if err == nil {
+		t.Fatal("RegisterPromptTx() error = nil")
+	}
+	for _, want := range []string{"failed to set alias", "roll back prompt \"greeting\" version 4"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestRegisterPromptTx_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+	for _, draft := range []PromptDraft{
+		{},
+		{Template: "Hi", Messages: []ChatMessage{{Role: "user", Content: "Hi"}}},
+	} {
+		if _, err := client.RegisterPromptTx(ctx, "greeting", draft); err == nil {
+			t.Errorf("RegisterPromptTx(%+v) error = nil", draft)
+		}
+	}
+	if _, err := client.RegisterPromptTx(ctx, "", PromptDraft{Template: "Hi"}); err == nil {
+		t.Error("RegisterPromptTx() without a name error = nil")
+	}
+}
@@ -0,0 +1,78 @@
+package promptregistry
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// Restore endpoints of servers that keep deleted registered models and model
+// versions. They are not part of the MLflow protos.
+const (
+	restorePromptPath        = "/api/2.0/mlflow/registered-models/restore"
+	restorePromptVersionPath = "/api/2.0/mlflow/model-versions/restore"
+)
+
+type restorePromptRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// RestorePrompt restores a deleted prompt, with its versions and aliases, on
+// servers that keep deleted registered models and expose a restore
+// endpoint. MLflow OSS deletes registered models permanently and has no such
+// endpoint; there RestorePrompt returns an error wrapping
+// errors.ErrUnsupportedByServer (mlflow.ErrUnsupportedByServer).
+func (c *Client) RestorePrompt(ctx context.Context, name string) error {
+	if name == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+
+	err := c.transport.Post(ctx, restorePromptPath, &restorePromptRequest{Name: name}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to restore prompt %q: %w", name, unsupportedEndpoint(err))
+	}
+	return nil
+}
+
+// RestorePromptVersion restores a deleted prompt version on servers that
+// keep deleted model versions and expose a restore endpoint. Like
+// RestorePrompt, it returns an error wrapping errors.ErrUnsupportedByServer
+// on servers without one, such as MLflow OSS.
+func (c *Client) RestorePromptVersion(ctx context.Context, name string, version int) error {
+	if name == "" {
+		return fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+	if version <= 0 {
+		return fmt.Errorf("mlflow: version must be positive")
+	}
+
+	req := &restorePromptRequest{Name: name, Version: strconv.Itoa(version)}
+	err := c.transport.Post(ctx, restorePromptVersionPath, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to restore prompt version %s/%d: %w", name, version, unsupportedEndpoint(err))
+	}
+	return nil
+}
+
+// unsupportedEndpoint wraps err with errors.ErrUnsupportedByServer if it
+// shows the server has no such endpoint: a 405 or 501, or a 404 that does
+// not report a missing resource, as routers answer for unknown paths.
+func unsupportedEndpoint(err error) error {
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) {
+		return err
+	}
+	switch {
+	case apiErr.StatusCode == http.StatusMethodNotAllowed, apiErr.StatusCode == http.StatusNotImplemented,
+		apiErr.StatusCode == http.StatusNotFound && apiErr.Code != "RESOURCE_DOES_NOT_EXIST":
+		return fmt.Errorf("%w: %w", errors.ErrUnsupportedByServer, err)
+	}
+	return err
+}
@@ -0,0 +1,341 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/searchfilter"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// rolloutPercentTagKey is the version tag holding the share of traffic, in
+// percent, that a rollout currently sends to the canary alias.
+const rolloutPercentTagKey = mlflowtags.PromptRolloutPercent
+
+// Default aliases for rollouts.
+const (
+	DefaultStableAlias = "production"
+	DefaultCanaryAlias = "canary"
+)
+
+// RolloutConfig configures a canary rollout of a prompt version.
+type RolloutConfig struct {
+	// StableAlias points to the version serving most traffic; it is moved to
+	// the new version when the rollout completes. Default: "production".
+	StableAlias string
+
+	// CanaryAlias points to the new version while it is rolled out. It is
+	// deleted when the rollout completes or rolls back. Default: "canary".
+	CanaryAlias string
+
+	// Steps are the traffic shares the canary is given in turn. Each step is
+	// held for its Hold duration and then checked against MetricGuard.
+	Steps []RolloutStep
+
+	// MetricGuard, if set, rolls the canary back when it regresses.
+	MetricGuard *MetricGuard
+}
+
+// RolloutStep is one stage of a rollout.
+type RolloutStep struct {
+	// Percent is the share of traffic sent to the canary, 1 to 100.
+	Percent int
+
+	// Hold is how long traffic is split this way before the guard is checked.
+	Hold time.Duration
+}
+
+// MetricGuard compares a metric between the runs served by the stable and
+// canary versions. Runs are attributed to versions by the tags recorded by
+// ABSelection.LogToRun.
+type MetricGuard struct {
+	// Tracking is the client used to search runs.
+	Tracking tracking.API
+
+	// ExperimentIDs are the experiments holding the serving runs.
+	ExperimentIDs []string
+
+	// Metric is the key of the metric to compare, using each run's latest value.
+	Metric string
+
+	// HigherIsBetter is true for metrics such as accuracy and false for
+	// metrics such as latency or error rate.
+	HigherIsBetter bool
+
+	// Tolerance is how much worse the canary's mean may be, relative to the
+	// stable mean, before it counts as a regression. 0.05 allows 5%.
+	Tolerance float64
+
+	// MinRuns is how many runs each version needs during a step before the
+	// step is judged. Steps with fewer runs pass. Default: 1.
+	MinRuns int
+}
+
+// RolloutResult reports how a rollout ended.
+type RolloutResult struct {
+	// StableVersion is the version the stable alias pointed to at the start.
+	StableVersion int
+
+	// Promoted is true if the stable alias now points to the new version.
+	Promoted bool
+
+	// RolledBack is true if the canary was removed before promotion, either
+	// because the guard failed or because of an error.
+	RolledBack bool
+
+	// StepsCompleted is how many steps were held and passed the guard.
+	StepsCompleted int
+
+	// Regression describes the failed guard check, if any.
+	Regression string
+}
+
+// withDefaults returns cfg with default aliases filled in.
+func (cfg RolloutConfig) withDefaults() RolloutConfig {
+	if cfg.StableAlias == "" {
+		cfg.StableAlias = DefaultStableAlias
+	}
+	if cfg.CanaryAlias == "" {
+		cfg.CanaryAlias = DefaultCanaryAlias
+	}
+	return cfg
+}
+
+func (cfg RolloutConfig) validate() error {
+	if cfg.StableAlias == cfg.CanaryAlias {
+		return fmt.Errorf("mlflow: stable and canary aliases must differ")
+	}
+	if len(cfg.Steps) == 0 {
+		return fmt.Errorf("mlflow: at least one rollout step is required")
+	}
+	prev := 0
+	for i, step := range cfg.Steps {
+		if step.Percent <= prev || step.Percent > 100 {
+			return fmt.Errorf("mlflow: rollout step %d: percent must increase and be at most 100", i)
+		}
+		prev = step.Percent
+	}
+	if g := cfg.MetricGuard; g != nil {
+		if g.Tracking == nil {
+			return fmt.Errorf("mlflow: metric guard tracking client is required")
+		}
+		if len(g.ExperimentIDs) == 0 {
+			return fmt.Errorf("mlflow: metric guard experiment IDs are required")
+		}
+		if g.Metric == "" {
+			return fmt.Errorf("mlflow: metric guard metric is required")
+		}
+	}
+	return nil
+}
+
+// Rollout gradually shifts traffic from the version behind the stable alias
+// to newVersion. It points the canary alias at newVersion and, for each
+// step, records the step's percentage on newVersion, waits for the step's
+// Hold, and checks the MetricGuard. If every step passes, the stable alias
+// is moved to newVersion; if the guard finds a regression, the canary alias
+// is removed so all traffic returns to the stable version.
+//
+// Traffic is split by selectors created with NewRolloutSelector, which read
+// the percentage from the canary version. Rollout blocks for the sum of the
+// Hold durations. If ctx is canceled or a request fails mid-rollout, the
+// canary is rolled back and the error returned.
+func (c *Client) Rollout(ctx context.Context, name string, newVersion int, cfg RolloutConfig) (*RolloutResult, error) {
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+	if newVersion <= 0 {
+		return nil, fmt.Errorf("mlflow: version must be positive")
+	}
+	cfg = cfg.withDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	stable, err := c.LoadPrompt(ctx, name, WithAlias(cfg.StableAlias))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve stable alias: %w", err)
+	}
+	if stable.Version == newVersion {
+		return nil, fmt.Errorf("mlflow: version %d is already the %s version", newVersion, cfg.StableAlias)
+	}
+
+	result := &RolloutResult{StableVersion: stable.Version}
+	for i, step := range cfg.Steps {
+		if err = c.setCanaryPercent(ctx, name, newVersion, step.Percent); err != nil {
+			break
+		}
+		if i == 0 {
+			// Set the percentage first, so selectors never see the canary
+			// without it
+			if err = c.SetPromptAlias(ctx, name, cfg.CanaryAlias, newVersion); err != nil {
+				break
+			}
+		}
+
+		stepStart := time.Now()
+		if err = sleepContext(ctx, step.Hold); err != nil {
+			break
+		}
+
+		if cfg.MetricGuard != nil {
+			var regression string
+			regression, err = cfg.MetricGuard.check(ctx, stable.Name, stable.Version, newVersion, stepStart)
+			if err != nil {
+				break
+			}
+			if regression != "" {
+				result.Regression = regression
+				break
+			}
+		}
+		result.StepsCompleted++
+	}
+
+	if err != nil || result.Regression != "" {
+		result.RolledBack = true
+		// Roll back even if ctx is done
+		if rbErr := c.rollBack(context.WithoutCancel(ctx), name, newVersion, cfg); rbErr != nil && err == nil {
+			err = rbErr
+		}
+		if err != nil {
+			return result, fmt.Errorf("rollout of version %d rolled back: %w", newVersion, err)
+		}
+		return result, nil
+	}
+
+	if err := c.SetPromptAlias(ctx, name, cfg.StableAlias, newVersion); err != nil {
+		return result, fmt.Errorf("failed to promote version %d: %w", newVersion, err)
+	}
+	result.Promoted = true
+	if err := c.DeletePromptAlias(ctx, name, cfg.CanaryAlias); err != nil {
+		return result, fmt.Errorf("failed to delete canary alias: %w", err)
+	}
+	if err := c.DeletePromptVersionTag(ctx, name, newVersion, rolloutPercentTagKey); err != nil {
+		return result, fmt.Errorf("failed to delete rollout tag: %w", err)
+	}
+	return result, nil
+}
+
+func (c *Client) setCanaryPercent(ctx context.Context, name string, version, percent int) error {
+	return c.SetPromptVersionTag(ctx, name, version, rolloutPercentTagKey, strconv.Itoa(percent))
+}
+
+// rollBack sends all traffic back to the stable alias.
+func (c *Client) rollBack(ctx context.Context, name string, version int, cfg RolloutConfig) error {
+	// Zero the percentage first, for selectors that still have the canary
+	// alias cached
+	if err := c.setCanaryPercent(ctx, name, version, 0); err != nil {
+		return err
+	}
+	if err := c.DeletePromptAlias(ctx, name, cfg.CanaryAlias); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// canaryPercent returns the rollout percentage recorded on v, clamped to
+// 0-100. Versions without one get no traffic.
+func canaryPercent(v *PromptVersion) int {
+	percent, err := strconv.Atoi(v.Tags[rolloutPercentTagKey])
+	if err != nil {
+		return 0
+	}
+	return min(max(percent, 0), 100)
+}
+
+// check compares the guard metric of runs served by the stable and canary
+// versions of prompt since the given time. It returns a description of the
+// regression, or "" if there is none or too little data to tell.
+func (g *MetricGuard) check(ctx context.Context, prompt string, stableVersion, canaryVersion int, since time.Time) (string, error) {
+	minRuns := max(g.MinRuns, 1)
+
+	stableMean, stableRuns, err := g.mean(ctx, prompt, stableVersion, since)
+	if err != nil {
+		return "", err
+	}
+	canaryMean, canaryRuns, err := g.mean(ctx, prompt, canaryVersion, since)
+	if err != nil {
+		return "", err
+	}
+	if stableRuns < minRuns || canaryRuns < minRuns {
+		return "", nil
+	}
+
+	allowed := math.Abs(stableMean) * g.Tolerance
+	regressed := canaryMean < stableMean-allowed
+	if !g.HigherIsBetter {
+		regressed = canaryMean > stableMean+allowed
+	}
+	if !regressed {
+		return "", nil
+	}
+	return fmt.Sprintf("%s: canary v%d mean %g over %d runs vs stable v%d mean %g over %d runs",
+		g.Metric, canaryVersion, canaryMean, canaryRuns, stableVersion, stableMean, stableRuns), nil
+}
+
+// mean returns the mean of the guard metric over runs started since the
+// given time that were served version of prompt.
+func (g *MetricGuard) mean(ctx context.Context, prompt string, version int, since time.Time) (float64, int, error) {
+	filter := fmt.Sprintf("tags.`%s` = '%d' AND attributes.start_time >= %d",
+		searchfilter.Key(abTagPrefix+prompt+".version"), version, since.UnixMilli())
+
+	runs, err := g.Tracking.SearchRunsCursor(g.ExperimentIDs, tracking.WithRunsFilter(filter)).All(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to search runs for version %d: %w", version, err)
+	}
+
+	var sum float64
+	var n int
+	for _, run := range runs {
+		if m, ok := run.LatestMetric(g.Metric); ok {
+			sum += m.Value
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, 0, nil
+	}
+	return sum / float64(n), n, nil
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// NewRolloutSelector returns a selector that splits traffic between the
+// aliases of cfg as a Rollout progresses: the canary alias gets the
+// percentage recorded by the current rollout step, and the stable alias the
+// rest. When no rollout is in progress all traffic goes to the stable alias.
+// Only the aliases in cfg are used.
+//
+// Users move from stable to canary as the percentage grows, never back,
+// so each user sees at most one switch during a rollout.
+func NewRolloutSelector(client API, name string, cfg RolloutConfig, opts ...ABSelectorOption) (*ABSelector, error) {
+	cfg = cfg.withDefaults()
+	if cfg.StableAlias == cfg.CanaryAlias {
+		return nil, fmt.Errorf("mlflow: stable and canary aliases must differ")
+	}
+	s, err := NewABSelector(client, name, map[string]int{cfg.StableAlias: 100, cfg.CanaryAlias: 0}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.rollout = &cfg
+	return s, nil
+}
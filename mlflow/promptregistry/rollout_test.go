@@ -0,0 +1,307 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// fakeAliases keeps aliases and version tags of the prompt "qa" in memory.
+type fakeAliases struct {
+	mu          sync.Mutex
+	aliases     map[string]int
+	versionTags map[int]map[string]string
+	// percents records every canary percentage set, in order
+	percents []string
+}
+
+func newFakeAliases(t *testing.T, aliases map[string]int) (*fakeAliases, *Client) {
+	t.Helper()
+	reg := &fakeAliases{aliases: aliases, versionTags: map[int]map[string]string{}}
+	return reg, newTestClient(t, reg)
+}
+
+func (f *fakeAliases) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var req struct {
+		Alias   string `json:"alias"`
+		Version string `json:"version"`
+		Key     string `json:"key"`
+		Value   string `json:"value"`
+	}
+	if r.Method != http.MethodGet {
+		_ = json.NewDecoder(r.Body).Decode(&req)
+	}
+	version, _ := strconv.Atoi(req.Version)
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method + " " + r.URL.Path {
+	case "GET /api/2.0/mlflow/registered-models/alias":
+		v, ok := f.aliases[r.URL.Query().Get("alias")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "alias not found"})
+			return
+		}
+		tags := []map[string]string{{"key": "mlflow.prompt.text", "value": fmt.Sprintf("v%d", v)}}
+		for k, val := range f.versionTags[v] {
+			tags = append(tags, map[string]string{"key": k, "value": val})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model_version": map[string]any{"name": "qa", "version": strconv.Itoa(v), "tags": tags},
+		})
+		return
+	case "POST /api/2.0/mlflow/registered-models/alias":
+		f.aliases[req.Alias] = version
+	case "DELETE /api/2.0/mlflow/registered-models/alias":
+		delete(f.aliases, req.Alias)
+	case "POST /api/2.0/mlflow/model-versions/set-tag":
+		if f.versionTags[version] == nil {
+			f.versionTags[version] = map[string]string{}
+		}
+		f.versionTags[version][req.Key] = req.Value
+		if req.Key == rolloutPercentTagKey {
+			f.percents = append(f.percents, req.Value)
+		}
+	case "DELETE /api/2.0/mlflow/model-versions/delete-tag":
+		delete(f.versionTags[version], req.Key)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	_, _ = w.Write([]byte("{}"))
+}
+
+func (f *fakeAliases) alias(name string) (int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v, ok := f.aliases[name]
+	return v, ok
+}
+
+// newFakeRuns returns a tracking client whose run searches return three
+// runs per prompt version, each reporting the metric "accuracy" with the
+// version's value.
+func newFakeRuns(t *testing.T, accuracy map[int]float64) *tracking.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Filter string `json:"filter"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+
+		var runs []map[string]any
+		for v, acc := range accuracy {
+			if strings.Contains(req.Filter, fmt.Sprintf("tags.`_mlflow_go.prompt_ab.qa.version` = '%d'", v)) {
+				for range 3 {
+					runs = append(runs, map[string]any{
+						"info": map[string]any{"run_id": "r"},
+						"data": map[string]any{"metrics": []map[string]any{{"key": "accuracy", "value": acc}}},
+					})
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"runs": runs})
+	}))
+	t.Cleanup(server.Close)
+
+	tc, err := transport.New(transport.Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+	return tracking.NewClient(tc)
+}
+
+func TestRollout_Promotes(t *testing.T) {
+	reg, client := newFakeAliases(t, map[string]int{"production": 1})
+
+	result, err := client.Rollout(context.Background(), "qa", 2, RolloutConfig{
+		Steps: []RolloutStep{{Percent: 10}, {Percent: 50}, {Percent: 100}},
+	})
+	if err != nil {
+		t.Fatalf("Rollout() error = %v", err)
+	}
+
+	if !result.Promoted || result.RolledBack || result.StepsCompleted != 3 || result.StableVersion != 1 {
+		t.Errorf("result = %+v, want promoted after 3 steps from v1", result)
+	}
+	if v, _ := reg.alias("production"); v != 2 {
+		t.Errorf("production = v%d, want v2", v)
+	}
+	if _, ok := reg.alias("canary"); ok {
+		t.Error("canary alias still set")
+	}
+	if got := strings.Join(reg.percents, ","); got != "10,50,100" {
+		t.Errorf("percents = %s, want 10,50,100", got)
+	}
+	if _, ok := reg.versionTags[2][rolloutPercentTagKey]; ok {
+		t.Error("rollout tag not deleted")
+	}
+}
+
+func TestRollout_RollsBackOnRegression(t *testing.T) {
+	reg, client := newFakeAliases(t, map[string]int{"production": 1})
+
+	result, err := client.Rollout(context.Background(), "qa", 2, RolloutConfig{
+		Steps: []RolloutStep{{Percent: 10}, {Percent: 100}},
+		MetricGuard: &MetricGuard{
+			Tracking:       newFakeRuns(t, map[int]float64{1: 0.90, 2: 0.80}),
+			ExperimentIDs:  []string{"1"},
+			Metric:         "accuracy",
+			HigherIsBetter: true,
+			Tolerance:      0.05,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Rollout() error = %v", err)
+	}
+
+	if result.Promoted || !result.RolledBack || result.StepsCompleted != 0 {
+		t.Errorf("result = %+v, want rolled back at first step", result)
+	}
+	if !strings.Contains(result.Regression, "accuracy") {
+		t.Errorf("Regression = %q, want it to name the metric", result.Regression)
+	}
+	if v, _ := reg.alias("production"); v != 1 {
+		t.Errorf("production = v%d, want v1", v)
+	}
+	if _, ok := reg.alias("canary"); ok {
+		t.Error("canary alias still set")
+	}
+	if got := strings.Join(reg.percents, ","); got != "10,0" {
+		t.Errorf("percents = %s, want 10,0", got)
+	}
+}
+
+func TestRollout_GuardWithinTolerance(t *testing.T) {
+	reg, client := newFakeAliases(t, map[string]int{"production": 1})
+
+	result, err := client.Rollout(context.Background(), "qa", 2, RolloutConfig{
+		Steps: []RolloutStep{{Percent: 100}},
+		MetricGuard: &MetricGuard{
+			Tracking:       newFakeRuns(t, map[int]float64{1: 0.90, 2: 0.88}),
+			ExperimentIDs:  []string{"1"},
+			Metric:         "accuracy",
+			HigherIsBetter: true,
+			Tolerance:      0.05,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Rollout() error = %v", err)
+	}
+	if !result.Promoted {
+		t.Errorf("result = %+v, want promoted", result)
+	}
+	if v, _ := reg.alias("production"); v != 2 {
+		t.Errorf("production = v%d, want v2", v)
+	}
+}
+
+func TestRollout_CanceledRollsBack(t *testing.T) {
+	reg, client := newFakeAliases(t, map[string]int{"production": 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := client.Rollout(ctx, "qa", 2, RolloutConfig{
+		Steps: []RolloutStep{{Percent: 10, Hold: time.Minute}},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if result == nil || !result.RolledBack {
+		t.Errorf("result = %+v, want rolled back", result)
+	}
+	if _, ok := reg.alias("canary"); ok {
+		t.Error("canary alias still set")
+	}
+	if v, _ := reg.alias("production"); v != 1 {
+		t.Errorf("production = v%d, want v1", v)
+	}
+}
+
+func TestRollout_Validation(t *testing.T) {
+	_, client := newFakeAliases(t, map[string]int{"production": 1})
+	steps := []RolloutStep{{Percent: 100}}
+
+	tests := []struct {
+		name    string
+		prompt  string
+		version int
+		cfg     RolloutConfig
+	}{
+		{"empty name", "", 2, RolloutConfig{Steps: steps}},
+		{"zero version", "qa", 0, RolloutConfig{Steps: steps}},
+		{"no steps", "qa", 2, RolloutConfig{}},
+		{"decreasing steps", "qa", 2, RolloutConfig{Steps: []RolloutStep{{Percent: 50}, {Percent: 10}}}},
+		{"over 100", "qa", 2, RolloutConfig{Steps: []RolloutStep{{Percent: 150}}}},
+		{"same aliases", "qa", 2, RolloutConfig{StableAlias: "a", CanaryAlias: "a", Steps: steps}},
+		{"guard without metric", "qa", 2, RolloutConfig{Steps: steps, MetricGuard: &MetricGuard{Tracking: &tracking.Client{}, ExperimentIDs: []string{"1"}}}},
+		{"already stable", "qa", 1, RolloutConfig{Steps: steps}},
+		{"missing stable alias", "qa", 2, RolloutConfig{StableAlias: "prod", Steps: steps}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.Rollout(context.Background(), tt.prompt, tt.version, tt.cfg); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestRolloutSelector(t *testing.T) {
+	reg, client := newFakeAliases(t, map[string]int{"production": 1})
+	sel, err := NewRolloutSelector(client, "qa", RolloutConfig{}, WithABCacheTTL(0))
+	if err != nil {
+		t.Fatalf("NewRolloutSelector() error = %v", err)
+	}
+
+	servedCanary := func() int {
+		n := 0
+		for i := range 200 {
+			s, err := sel.Select(context.Background(), fmt.Sprintf("user-%d", i))
+			if err != nil {
+				t.Fatalf("Select() error = %v", err)
+			}
+			if s.Alias == "canary" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// No rollout in progress
+	if n := servedCanary(); n != 0 {
+		t.Errorf("canary served %d times without a rollout, want 0", n)
+	}
+
+	reg.mu.Lock()
+	reg.aliases["canary"] = 2
+	reg.versionTags[2] = map[string]string{rolloutPercentTagKey: "50"}
+	reg.mu.Unlock()
+	if n := servedCanary(); n < 70 || n > 130 {
+		t.Errorf("canary served %d of 200 at 50%%, want about 100", n)
+	}
+
+	reg.mu.Lock()
+	reg.versionTags[2][rolloutPercentTagKey] = "100"
+	reg.mu.Unlock()
+	if n := servedCanary(); n != 200 {
+		t.Errorf("canary served %d of 200 at 100%%, want 200", n)
+	}
+}
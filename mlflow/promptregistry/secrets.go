@@ -0,0 +1,180 @@
+package promptregistry
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// SecretPolicy selects what RegisterPrompt does when a template looks like
+// it contains a credential or personal data.
+type SecretPolicy int
+
+const (
+	// SecretWarn registers the prompt unchanged and logs a warning per finding.
+	SecretWarn SecretPolicy = iota + 1
+	// SecretRedact replaces each finding with "[REDACTED:<detector>]" before
+	// registering.
+	SecretRedact
+	// SecretBlock refuses to register the prompt and returns a *SecretError.
+	SecretBlock
+)
+
+// SecretFinding is a possible secret found in a prompt. It never contains
+// the matched text.
+type SecretFinding struct {
+	// Detector names the pattern that matched, e.g. "aws_access_key".
+	Detector string
+	// Location is "template" or "message N" for chat prompts. It is empty
+	// for results of ScanSecrets.
+	Location string
+	// Offset is the byte offset of the match within the location.
+	Offset int
+}
+
+// SecretError is returned by RegisterPrompt and RegisterChatPrompt under
+// SecretBlock when the prompt contains possible secrets.
+type SecretError struct {
+	Findings []SecretFinding
+}
+
+func (e *SecretError) Error() string {
+	parts := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		parts[i] = fmt.Sprintf("%s in %s at offset %d", f.Detector, f.Location, f.Offset)
+	}
+	return "mlflow: prompt contains possible secrets: " + strings.Join(parts, ", ")
+}
+
+// secretDetector is a named pattern for one kind of secret.
+type secretDetector struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// secretDetectors are applied in order; earlier detectors win when matches
+// overlap, so specific patterns come before general ones.
+var secretDetectors = []secretDetector{
+	{"private_key", regexp.MustCompile(`(?s)-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY-----.*?(?:-----END (?:[A-Z0-9]+ )*PRIVATE KEY-----|\z)`)},
+	{"aws_access_key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"github_token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"openai_api_key", regexp.MustCompile(`\bsk-(?:proj-|ant-)?[A-Za-z0-9_-]{20,}`)},
+	{"slack_token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"google_api_key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{"jwt", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{"bearer_token", regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{20,}=*`)},
+	{"email", regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+}
+
+// secretMatch is a finding with its extent, for redaction.
+type secretMatch struct {
+	detector   string
+	start, end int
+}
+
+// ScanSecrets reports possible secrets in text using the same detectors as
+// WithSecretScan: private keys, AWS, GitHub, OpenAI, Slack and Google API
+// keys, JWTs, bearer tokens, and email addresses.
+func ScanSecrets(text string) []SecretFinding {
+	matches := findSecrets(text)
+	if len(matches) == 0 {
+		return nil
+	}
+	findings := make([]SecretFinding, len(matches))
+	for i, m := range matches {
+		findings[i] = SecretFinding{Detector: m.detector, Offset: m.start}
+	}
+	return findings
+}
+
+// findSecrets returns the non-overlapping matches in text, ordered by offset.
+func findSecrets(text string) []secretMatch {
+	var matches []secretMatch
+	for _, d := range secretDetectors {
+		for _, loc := range d.pattern.FindAllStringIndex(text, -1) {
+			if !overlaps(matches, loc[0], loc[1]) {
+				matches = append(matches, secretMatch{detector: d.name, start: loc[0], end: loc[1]})
+			}
+		}
+	}
+	slices.SortFunc(matches, func(a, b secretMatch) int { return a.start - b.start })
+	return matches
+}
+
+func overlaps(matches []secretMatch, start, end int) bool {
+	for _, m := range matches {
+		if start < m.end && m.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// redactSecrets replaces each match in text with a placeholder naming the
+// detector.
+func redactSecrets(text string, matches []secretMatch) string {
+	var b strings.Builder
+	prev := 0
+	for _, m := range matches {
+		b.WriteString(text[prev:m.start])
+		b.WriteString("[REDACTED:" + m.detector + "]")
+		prev = m.end
+	}
+	b.WriteString(text[prev:])
+	return b.String()
+}
+
+// applySecretPolicy scans the text at location and applies policy. It
+// returns the text to register and the findings.
+func applySecretPolicy(policy SecretPolicy, name, location, text string) (string, []SecretFinding) {
+	matches := findSecrets(text)
+	if len(matches) == 0 {
+		return text, nil
+	}
+
+	findings := make([]SecretFinding, len(matches))
+	for i, m := range matches {
+		findings[i] = SecretFinding{Detector: m.detector, Location: location, Offset: m.start}
+	}
+
+	switch policy {
+	case SecretWarn:
+		for _, f := range findings {
+			slog.Warn("prompt contains a possible secret",
+				"prompt", name,
+				"detector", f.Detector,
+				"location", f.Location,
+				"offset", f.Offset)
+		}
+	case SecretRedact:
+		text = redactSecrets(text, matches)
+	}
+	return text, findings
+}
+
+// scanTemplate applies policy to a text prompt template.
+func scanTemplate(policy SecretPolicy, name, template string) (string, error) {
+	template, findings := applySecretPolicy(policy, name, "template", template)
+	if policy == SecretBlock && len(findings) > 0 {
+		return "", &SecretError{Findings: findings}
+	}
+	return template, nil
+}
+
+// scanMessages applies policy to chat prompt messages. The input slice is
+// not modified.
+func scanMessages(policy SecretPolicy, name string, messages []ChatMessage) ([]ChatMessage, error) {
+	var all []SecretFinding
+	out := make([]ChatMessage, len(messages))
+	for i, msg := range messages {
+		content, findings := applySecretPolicy(policy, name, fmt.Sprintf("message %d", i), msg.Content)
+		out[i] = ChatMessage{Role: msg.Role, Content: content}
+		all = append(all, findings...)
+	}
+	if policy == SecretBlock && len(all) > 0 {
+		return nil, &SecretError{Findings: all}
+	}
+	return out, nil
+}
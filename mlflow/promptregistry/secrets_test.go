@@ -0,0 +1,195 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Fake credentials are assembled at run time so the source does not trip
+// secret scanners.
+var (
+	fakeAWSKey    = "AKIA" + "IOSFODNN7EXAMPLE"
+	fakeGitHubPAT = "ghp_" + strings.Repeat("a1B2", 9)
+	fakeOpenAIKey = "sk-" + "proj-" + strings.Repeat("x", 32)
+	fakePEM       = "-----BEGIN " + "RSA PRIVATE KEY-----\nMIIEow\n-----END RSA PRIVATE KEY-----"
+)
+
+func TestScanSecrets(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"clean template", "Summarize {{text}} for {{audience}} in {{n}} words.", nil},
+		{"aws key", "Use key " + fakeAWSKey + " to sign", []string{"aws_access_key"}},
+		{"github token", "token: " + fakeGitHubPAT, []string{"github_token"}},
+		{"openai key", "OPENAI_API_KEY=" + fakeOpenAIKey, []string{"openai_api_key"}},
+		{"slack token", "xoxb-" + "1234567890-abcdefghij", []string{"slack_token"}},
+		{"google key", "AIza" + strings.Repeat("B", 35), []string{"google_api_key"}},
+		{"private key", "key:\n" + fakePEM + "\nend", []string{"private_key"}},
+		{"bearer", "Authorization: Bearer " + strings.Repeat("t", 24), []string{"bearer_token"}},
+		{"email", "Contact jane.doe@example.com for help", []string{"email"}},
+		{"several in order", "mail a@b.io then " + fakeAWSKey, []string{"email", "aws_access_key"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := ScanSecrets(tt.text)
+			var got []string
+			for _, f := range findings {
+				got = append(got, f.Detector)
+				if f.Location != "" {
+					t.Errorf("Location = %q, want empty", f.Location)
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("detectors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanSecrets_Offset(t *testing.T) {
+	text := "key " + fakeAWSKey
+	findings := ScanSecrets(text)
+	if len(findings) != 1 || findings[0].Offset != 4 {
+		t.Errorf("findings = %+v, want one at offset 4", findings)
+	}
+}
+
+// newCaptureRegisterClient returns a client that accepts registrations and
+// records the text or chat template sent, and how many requests were made.
+func newCaptureRegisterClient(t *testing.T, sent *string, requests *int) *Client {
+	t.Helper()
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/2.0/mlflow/model-versions/create" {
+			var req struct {
+				Tags []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"tags"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			for _, tag := range req.Tags {
+				if tag.Key == "mlflow.prompt.text" {
+					*sent = tag.Value
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"model_version": map[string]any{"name": "p", "version": "1"},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"registered_model": map[string]any{"name": "p"}})
+	}))
+}
+
+func TestRegisterPrompt_SecretRedact(t *testing.T) {
+	var sent string
+	var requests int
+	client := newCaptureRegisterClient(t, &sent, &requests)
+
+	_, err := client.RegisterPrompt(context.Background(), "p",
+		"Call the API with "+fakeAWSKey+" for {{user}}", WithSecretScan(SecretRedact))
+	if err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+	if want := "Call the API with [REDACTED:aws_access_key] for {{user}}"; sent != want {
+		t.Errorf("template sent = %q, want %q", sent, want)
+	}
+}
+
+func TestRegisterPrompt_SecretWarn(t *testing.T) {
+	var sent string
+	var requests int
+	client := newCaptureRegisterClient(t, &sent, &requests)
+
+	template := "Escalate to ops@example.com"
+	if _, err := client.RegisterPrompt(context.Background(), "p", template, WithSecretScan(SecretWarn)); err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+	if sent != template {
+		t.Errorf("template sent = %q, want unchanged", sent)
+	}
+}
+
+func TestRegisterPrompt_SecretBlock(t *testing.T) {
+	var sent string
+	var requests int
+	client := newCaptureRegisterClient(t, &sent, &requests)
+
+	_, err := client.RegisterPrompt(context.Background(), "p", "key: "+fakeGitHubPAT, WithSecretScan(SecretBlock))
+
+	var secretErr *SecretError
+	if !stderrors.As(err, &secretErr) {
+		t.Fatalf("RegisterPrompt() error = %v, want *SecretError", err)
+	}
+	if len(secretErr.Findings) != 1 || secretErr.Findings[0].Location != "template" {
+		t.Errorf("Findings = %+v, want one in template", secretErr.Findings)
+	}
+	if strings.Contains(err.Error(), fakeGitHubPAT) {
+		t.Error("error message contains the secret")
+	}
+	if requests != 0 {
+		t.Errorf("requests = %d, want 0", requests)
+	}
+}
+
+func TestRegisterPrompt_NoSecretScanByDefault(t *testing.T) {
+	var sent string
+	var requests int
+	client := newCaptureRegisterClient(t, &sent, &requests)
+
+	template := "key: " + fakeAWSKey
+	if _, err := client.RegisterPrompt(context.Background(), "p", template); err != nil {
+		t.Fatalf("RegisterPrompt() error = %v", err)
+	}
+	if sent != template {
+		t.Errorf("template sent = %q, want unchanged", sent)
+	}
+}
+
+func TestRegisterChatPrompt_SecretScan(t *testing.T) {
+	messages := []ChatMessage{
+		{Role: "system", Content: "You are helpful."},
+		{Role: "user", Content: "My key is " + fakeOpenAIKey},
+	}
+
+	t.Run("block", func(t *testing.T) {
+		var sent string
+		var requests int
+		client := newCaptureRegisterClient(t, &sent, &requests)
+
+		_, err := client.RegisterChatPrompt(context.Background(), "p", messages, WithSecretScan(SecretBlock))
+		var secretErr *SecretError
+		if !stderrors.As(err, &secretErr) {
+			t.Fatalf("RegisterChatPrompt() error = %v, want *SecretError", err)
+		}
+		if len(secretErr.Findings) != 1 || secretErr.Findings[0].Location != "message 1" {
+			t.Errorf("Findings = %+v, want one in message 1", secretErr.Findings)
+		}
+	})
+
+	t.Run("redact", func(t *testing.T) {
+		var sent string
+		var requests int
+		client := newCaptureRegisterClient(t, &sent, &requests)
+
+		if _, err := client.RegisterChatPrompt(context.Background(), "p", messages, WithSecretScan(SecretRedact)); err != nil {
+			t.Fatalf("RegisterChatPrompt() error = %v", err)
+		}
+		if strings.Contains(sent, fakeOpenAIKey) || !strings.Contains(sent, "[REDACTED:openai_api_key]") {
+			t.Errorf("chat template sent = %q, want key redacted", sent)
+		}
+		if messages[1].Content != "My key is "+fakeOpenAIKey {
+			t.Error("caller's messages were modified")
+		}
+	})
+}
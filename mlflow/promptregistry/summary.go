@@ -0,0 +1,42 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// summaryConcurrency bounds the requests summarizePrompts has in flight.
+const summaryConcurrency = 8
+
+// summarizePrompts fills VersionCount and Aliases of prompts, summarizing up
+// to summaryConcurrency prompts at once. It stops at the first failure.
+func (c *Client) summarizePrompts(ctx context.Context, prompts []Prompt) error {
+	return forEachConcurrent(ctx, len(prompts), summaryConcurrency, func(ctx context.Context, i int) error {
+		if err := c.summarizePrompt(ctx, &prompts[i]); err != nil {
+			return fmt.Errorf("failed to summarize prompt %q: %w", prompts[i].Name, err)
+		}
+		return nil
+	})
+}
+
+// summarizePrompt fills VersionCount and Aliases of p.
+func (c *Client) summarizePrompt(ctx context.Context, p *Prompt) error {
+	rm, err := c.getRegisteredModel(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	versions, err := c.ListPromptVersions(ctx, p.Name, WithVersionsMaxResults(deleteBatchSize))
+	if err != nil {
+		return err
+	}
+
+	p.VersionCount = len(versions.Versions)
+	p.Aliases = make(map[string]int, len(rm.GetAliases()))
+	for _, a := range rm.GetAliases() {
+		if v, convErr := strconv.Atoi(a.GetVersion()); convErr == nil {
+			p.Aliases[a.GetAlias()] = v
+		}
+	}
+	return nil
+}
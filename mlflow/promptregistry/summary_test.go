@@ -0,0 +1,96 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestListPrompts_WithIncludeAliasSummary(t *testing.T) {
+	versions := map[string][]string{"a": {"1", "2", "3"}, "b": {"1"}}
+	aliases := map[string][]map[string]string{
+		"a": {{"alias": "production", "version": "2"}, {"alias": "staging", "version": "3"}},
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/registered-models/search":
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "a"}, {"name": "b"}},
+			})
+		case "/api/2.0/mlflow/registered-models/get":
+			name := r.URL.Query().Get("name")
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_model": map[string]any{"name": name, "aliases": aliases[name]},
+			})
+		case "/api/2.0/mlflow/model-versions/search":
+			name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Query().Get("filter"), "name='"), "'")
+			mvs := make([]map[string]string, 0, len(versions[name]))
+			for _, v := range versions[name] {
+				mvs = append(mvs, map[string]string{"name": name, "version": v})
+			}
+			json.NewEncoder(w).Encode(map[string]any{"model_versions": mvs})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	list, err := client.ListPrompts(context.Background(), WithIncludeAliasSummary())
+	if err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if len(list.Prompts) != 2 {
+		t.Fatalf("got %d prompts, want 2", len(list.Prompts))
+	}
+
+	a, b := list.Prompts[0], list.Prompts[1]
+	if a.VersionCount != 3 || a.Aliases["production"] != 2 || a.Aliases["staging"] != 3 || len(a.Aliases) != 2 {
+		t.Errorf("prompt a: VersionCount = %d, Aliases = %v", a.VersionCount, a.Aliases)
+	}
+	if b.VersionCount != 1 || len(b.Aliases) != 0 {
+		t.Errorf("prompt b: VersionCount = %d, Aliases = %v", b.VersionCount, b.Aliases)
+	}
+}
+
+func TestListPrompts_WithoutAliasSummary(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"registered_models": []map[string]any{{"name": "a"}},
+		})
+	}))
+
+	list, err := client.ListPrompts(context.Background())
+	if err != nil {
+		t.Fatalf("ListPrompts() error = %v", err)
+	}
+	if requests.Load() != 1 || list.Prompts[0].Aliases != nil {
+		t.Errorf("made %d requests, Aliases = %v; want 1 request and no summary", requests.Load(), list.Prompts[0].Aliases)
+	}
+}
+
+func TestListPrompts_AliasSummaryError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/2.0/mlflow/registered-models/search" {
+			json.NewEncoder(w).Encode(map[string]any{
+				"registered_models": []map[string]any{{"name": "a"}, {"name": "b"}},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error_code": "INTERNAL_ERROR", "message": "boom"})
+	}))
+
+	_, err := client.ListPrompts(context.Background(), WithIncludeAliasSummary())
+	if err == nil || !strings.Contains(err.Error(), "failed to summarize prompt") {
+		t.Errorf("ListPrompts() error = %v, want summary error", err)
+	}
+}
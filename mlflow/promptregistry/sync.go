@@ -0,0 +1,378 @@
+package promptregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/yaml"
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// SyncAction is what applying a plan does to one prompt.
+type SyncAction string
+
+// Sync actions.
+const (
+	// SyncCreate registers a prompt that does not exist yet.
+	SyncCreate SyncAction = "create"
+	// SyncUpdate registers a new version, or updates the tags and aliases of
+	// the latest version when only those differ.
+	SyncUpdate SyncAction = "update"
+	// SyncNoop leaves a prompt that matches its file unchanged.
+	SyncNoop SyncAction = "no-op"
+)
+
+// PromptFile is a prompt as declared in a local file. In YAML:
+//
+//	name: support-bot           # defaults to the file name
+//	commit_message: Shorter greeting
+//	template: |
+//	  Hello {{name}}, how can I help?
+//	tags:
+//	  team: support
+//	aliases: [production]
+//
+// Chat prompts list messages instead of a template:
+//
+//	messages:
+//	  - role: system
+//	    content: You are a support agent.
+//	  - role: user
+//	    content: "{{question}}"
+//
+// Files ending in .json use the same keys.
+type PromptFile struct {
+	// Path is the file the prompt was read from.
+	Path string `yaml:"-" json:"-"`
+
+	Name          string            `yaml:"name" json:"name"`
+	Template      string            `yaml:"template" json:"template"`
+	Messages      []ChatMessage     `yaml:"messages" json:"messages"`
+	CommitMessage string            `yaml:"commit_message" json:"commit_message"`
+	Tags          map[string]string `yaml:"tags" json:"tags"`
+	Aliases       []string          `yaml:"aliases" json:"aliases"`
+}
+
+// SyncItem is the planned change for one prompt file.
+type SyncItem struct {
+	Action SyncAction
+
+	// Desired is the prompt as declared in its file.
+	Desired PromptFile
+
+	// Current is the latest registered version, or nil for SyncCreate.
+	Current *PromptVersion
+
+	// Changes describe each difference between Desired and Current, such as
+	// "template changed" or "alias production: 3 -> 4". Empty for SyncNoop.
+	Changes []string
+
+	// newVersion is true if the content changed, so Apply registers a new
+	// version instead of updating the latest one.
+	newVersion bool
+}
+
+// Plan is the result of SyncPlan: one item per prompt file, sorted by
+// prompt name.
+type Plan struct {
+	Items []SyncItem
+}
+
+// HasChanges reports whether applying the plan would change the registry,
+// which makes a plan usable as a drift check in CI.
+func (p *Plan) HasChanges() bool {
+	for _, item := range p.Items {
+		if item.Action != SyncNoop {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan one prompt per line, followed by its changes.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, item := range p.Items {
+		fmt.Fprintf(&b, "%s %s\n", item.Action, item.Desired.Name)
+		for _, change := range item.Changes {
+			fmt.Fprintf(&b, "  %s\n", change)
+		}
+	}
+	return b.String()
+}
+
+// SyncPlan compares the prompt files in dir with the registry and returns
+// the changes Apply would make, without making them. Files ending in .yaml,
+// .yml or .json are read recursively; see PromptFile for their format.
+//
+// Each file is compared with the latest registered version of its prompt.
+// A different template or message list (or a switch between text and chat)
+// plans a new version. Tags and aliases are only compared for the keys and
+// names the file lists, so tags and aliases managed elsewhere are left
+// alone. Prompts in the registry without a file are not reported.
+func SyncPlan(ctx context.Context, c API, dir string) (*Plan, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	files, err := ReadPromptFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return PlanPrompts(ctx, c, files)
+}
+
+// PlanPrompts is SyncPlan for prompts declared in memory rather than read
+// from a directory, such as those embedded in a larger manifest.
+func PlanPrompts(ctx context.Context, c API, prompts []PromptFile) (*Plan, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+
+	files := slices.Clone(prompts)
+	sort.SliceStable(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	for i, file := range files {
+		if err := file.validate(); err != nil {
+			return nil, err
+		}
+		if i > 0 && files[i-1].Name == file.Name {
+			return nil, fmt.Errorf("mlflow: prompt %q is declared in both %s and %s", file.Name, files[i-1].source(), file.source())
+		}
+	}
+
+	plan := &Plan{Items: make([]SyncItem, 0, len(files))}
+	for _, file := range files {
+		current, loadErr := c.LoadPrompt(ctx, file.Name)
+		if errors.IsNotFound(loadErr) {
+			plan.Items = append(plan.Items, SyncItem{
+				Action:     SyncCreate,
+				Desired:    file,
+				Changes:    []string{"new prompt"},
+				newVersion: true,
+			})
+			continue
+		}
+		if loadErr != nil {
+			return nil, fmt.Errorf("failed to load prompt %q: %w", file.Name, loadErr)
+		}
+		plan.Items = append(plan.Items, diffPrompt(file, current))
+	}
+	return plan, nil
+}
+
+// source describes where a prompt was declared, for error messages.
+func (f *PromptFile) source() string {
+	if f.Path != "" {
+		return f.Path
+	}
+	return "the manifest"
+}
+
+func (f *PromptFile) validate() error {
+	switch {
+	case f.Name == "":
+		return fmt.Errorf("mlflow: prompt name is required in %s", f.source())
+	case f.Template == "" && f.Messages == nil:
+		return fmt.Errorf("mlflow: prompt %q in %s must set template or messages", f.Name, f.source())
+	case f.Template != "" && f.Messages != nil:
+		return fmt.Errorf("mlflow: prompt %q in %s sets both template and messages", f.Name, f.source())
+	}
+	return nil
+}
+
+// diffPrompt plans the changes that bring current in line with file.
+func diffPrompt(file PromptFile, current *PromptVersion) SyncItem {
+	item := SyncItem{Action: SyncNoop, Desired: file, Current: current}
+
+	switch {
+	case (file.Messages != nil) != current.IsChat():
+		if file.Messages != nil {
+			item.Changes = append(item.Changes, "text prompt becomes chat prompt")
+		} else {
+			item.Changes = append(item.Changes, "chat prompt becomes text prompt")
+		}
+		item.newVersion = true
+	case file.Messages != nil && !slices.Equal(file.Messages, current.Messages):
+		item.Changes = append(item.Changes, "messages changed")
+		item.newVersion = true
+	case file.Messages == nil && file.Template != current.Template:
+		item.Changes = append(item.Changes, "template changed")
+		item.newVersion = true
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(file.Tags)) {
+		if value, ok := current.Tags[key]; !ok {
+			item.Changes = append(item.Changes, fmt.Sprintf("tag %s: set to %q", key, file.Tags[key]))
+		} else if value != file.Tags[key] {
+			item.Changes = append(item.Changes, fmt.Sprintf("tag %s: %q -> %q", key, value, file.Tags[key]))
+		}
+	}
+
+	// A new version gets every alias; otherwise only the aliases that do
+	// not already point to the latest version move
+	for _, alias := range file.Aliases {
+		switch {
+		case item.newVersion:
+			item.Changes = append(item.Changes, fmt.Sprintf("alias %s: -> new version", alias))
+		case !slices.Contains(current.Aliases, alias):
+			item.Changes = append(item.Changes, fmt.Sprintf("alias %s: -> %d", alias, current.Version))
+		}
+	}
+
+	if len(item.Changes) > 0 {
+		item.Action = SyncUpdate
+	}
+	return item
+}
+
+// Apply makes the changes in plan. Prompts with new content get a new
+// version carrying the file's commit message and tags; otherwise the tags of
+// the latest version are updated. Aliases are then pointed at the resulting
+// version.
+//
+// Apply does not re-read the registry, so apply a plan soon after computing
+// it. Every item is attempted even if some fail; the returned error is a
+// *mlflow.MultiError with one entry per prompt that failed.
+//
+// With WithApplyEvents, progress is reported with one item per prompt that
+// changes.
+func Apply(ctx context.Context, c API, plan *Plan, opts ...ApplyOption) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if plan == nil {
+		return fmt.Errorf("mlflow: plan is required")
+	}
+
+	var applyOpts applyOptions
+	for _, opt := range opts {
+		opt(&applyOpts)
+	}
+	reporter := progress.NewReporter(applyOpts.events, "apply prompts")
+	total := 0
+	for _, item := range plan.Items {
+		if item.Action != SyncNoop {
+			total++
+		}
+	}
+	reporter.Start(ctx, total)
+
+	var errs errors.MultiError
+	for i, item := range plan.Items {
+		if item.Action == SyncNoop {
+			continue
+		}
+		resource := "prompt " + item.Desired.Name
+		err := applyItem(ctx, c, item)
+		errs.Add(i, resource, err)
+		reporter.ItemDone(ctx, resource, err)
+	}
+	err := errs.Err()
+	reporter.Finish(ctx, err)
+	return err
+}
+
+func applyItem(ctx context.Context, c API, item SyncItem) error {
+	file := item.Desired
+
+	var version int
+	if item.newVersion {
+		opts := []RegisterOption{WithTags(file.Tags)}
+		if file.CommitMessage != "" {
+			opts = append(opts, WithCommitMessage(file.CommitMessage))
+		}
+		var (
+			pv  *PromptVersion
+			err error
+		)
+		if file.Messages != nil {
+			pv, err = c.RegisterChatPrompt(ctx, file.Name, file.Messages, opts...)
+		} else {
+			pv, err = c.RegisterPrompt(ctx, file.Name, file.Template, opts...)
+		}
+		if err != nil {
+			return err
+		}
+		version = pv.Version
+	} else {
+		version = item.Current.Version
+		for _, key := range slices.Sorted(maps.Keys(file.Tags)) {
+			if value, ok := item.Current.Tags[key]; ok && value == file.Tags[key] {
+				continue
+			}
+			if err := c.SetPromptVersionTag(ctx, file.Name, version, key, file.Tags[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, alias := range file.Aliases {
+		if !item.newVersion && slices.Contains(item.Current.Aliases, alias) {
+			continue
+		}
+		if err := c.SetPromptAlias(ctx, file.Name, alias, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadPromptFiles reads the prompt files under dir, as SyncPlan does:
+// files ending in .yaml, .yml or .json, recursively. See PromptFile for
+// their format.
+func ReadPromptFiles(dir string) ([]PromptFile, error) {
+	var files []PromptFile
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if d.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			return nil
+		}
+
+		file, err := readPromptFile(path, ext)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func readPromptFile(path, ext string) (PromptFile, error) {
+	var file PromptFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+	if ext == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&file)
+	} else {
+		err = yaml.Unmarshal(data, &file)
+	}
+	if err != nil {
+		return file, fmt.Errorf("mlflow: invalid prompt file %s: %w", path, err)
+	}
+
+	file.Path = path
+	if file.Name == "" {
+		file.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return file, nil
+}
@@ -0,0 +1,218 @@
+package promptregistry
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// syncRegistry is an in-memory API holding the latest version of each prompt.
+type syncRegistry struct {
+	API
+	latest map[string]*PromptVersion
+	calls  []string
+}
+
+func (r *syncRegistry) LoadPrompt(_ context.Context, name string, _ ...LoadOption) (*PromptVersion, error) {
+	pv, ok := r.latest[name]
+	if !ok {
+		return nil, &errors.APIError{StatusCode: http.StatusNotFound, Code: "RESOURCE_DOES_NOT_EXIST"}
+	}
+	return pv, nil
+}
+
+func (r *syncRegistry) register(name string, pv *PromptVersion, opts []RegisterOption) *PromptVersion {
+	regOpts := &registerOptions{}
+	for _, opt := range opts {
+		opt(regOpts)
+	}
+	pv.Name, pv.Tags, pv.CommitMessage = name, regOpts.tags, regOpts.commitMessage
+	if prev, ok := r.latest[name]; ok {
+		pv.Version = prev.Version + 1
+	} else {
+		pv.Version = 1
+	}
+	r.latest[name] = pv
+	r.calls = append(r.calls, "register "+name)
+	return pv
+}
+
+func (r *syncRegistry) RegisterPrompt(_ context.Context, name, template string, opts ...RegisterOption) (*PromptVersion, error) {
+	if name == "broken" {
+		return nil, stderrors.New("boom")
+	}
+	return r.register(name, &PromptVersion{Template: template}, opts), nil
+}
+
+func (r *syncRegistry) RegisterChatPrompt(_ context.Context, name string, messages []ChatMessage, opts ...RegisterOption) (*PromptVersion, error) {
+	return r.register(name, &PromptVersion{Messages: messages}, opts), nil
+}
+
+func (r *syncRegistry) SetPromptAlias(_ context.Context, name, alias string, version int) error {
+	r.latest[name].Aliases = append(r.latest[name].Aliases, alias)
+	r.calls = append(r.calls, "alias "+name+" "+alias)
+	return nil
+}
+
+func (r *syncRegistry) SetPromptVersionTag(_ context.Context, name string, _ int, key, value string) error {
+	r.latest[name].Tags[key] = value
+	r.calls = append(r.calls, "tag "+name+" "+key)
+	return nil
+}
+
+func writePromptFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestSyncPlan(t *testing.T) {
+	dir := writePromptFiles(t, map[string]string{
+		"greeting.yaml": "template: |\n  Hello {{name}}\naliases: [production]\n",
+		"nested/qa.yml": "name: qa\ntemplate: Answer {{q}}\ntags:\n  team: search\n",
+		"chat.json":     `{"messages": [{"role": "user", "content": "Hi"}]}`,
+		"new.yaml":      "template: brand new\n",
+		"README.md":     "ignored",
+	})
+	reg := &syncRegistry{latest: map[string]*PromptVersion{
+		"greeting": {Name: "greeting", Version: 2, Template: "Hello {{name}}\n", Aliases: []string{"production"}},
+		"qa":       {Name: "qa", Version: 1, Template: "Answer {{q}}", Tags: map[string]string{"team": "ranking"}},
+		"chat":     {Name: "chat", Version: 4, Messages: []ChatMessage{{Role: "user", Content: "Hello"}}},
+	}}
+
+	plan, err := SyncPlan(context.Background(), reg, dir)
+	if err != nil {
+		t.Fatalf("SyncPlan() error = %v", err)
+	}
+
+	want := map[string]SyncAction{"chat": SyncUpdate, "greeting": SyncNoop, "new": SyncCreate, "qa": SyncUpdate}
+	var names []string
+	for _, item := range plan.Items {
+		names = append(names, item.Desired.Name)
+		if item.Action != want[item.Desired.Name] {
+			t.Errorf("%s: Action = %q, want %q (changes %v)", item.Desired.Name, item.Action, want[item.Desired.Name], item.Changes)
+		}
+	}
+	if !slices.Equal(names, []string{"chat", "greeting", "new", "qa"}) {
+		t.Errorf("items = %v, want sorted by name", names)
+	}
+	if !plan.HasChanges() {
+		t.Error("HasChanges() = false, want true")
+	}
+	if got := plan.Items[3].Changes; !slices.Equal(got, []string{`tag team: "ranking" -> "search"`}) {
+		t.Errorf("qa changes = %v", got)
+	}
+}
+
+func TestSyncPlan_InvalidFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"no content", map[string]string{"a.yaml": "name: a\n"}},
+		{"both contents", map[string]string{"a.yaml": "template: x\nmessages:\n  - role: user\n    content: y\n"}},
+		{"unknown key", map[string]string{"a.yaml": "tempalte: x\n"}},
+		{"duplicate name", map[string]string{"a.yaml": "template: x\n", "b.yaml": "name: a\ntemplate: y\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &syncRegistry{latest: map[string]*PromptVersion{}}
+			if _, err := SyncPlan(context.Background(), reg, writePromptFiles(t, tt.files)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	dir := writePromptFiles(t, map[string]string{
+		"greeting.yaml": "template: Hi {{name}}\ncommit_message: Shorter\naliases: [production]\n",
+		"qa.yaml":       "template: Answer {{q}}\ntags:\n  team: search\naliases: [production]\n",
+		"chat.yaml":     "messages:\n  - role: user\n    content: Hi\n",
+	})
+	reg := &syncRegistry{latest: map[string]*PromptVersion{
+		"greeting": {Name: "greeting", Version: 2, Template: "Hello {{name}}", Aliases: []string{"production"}},
+		"qa":       {Name: "qa", Version: 1, Template: "Answer {{q}}", Tags: map[string]string{}},
+	}}
+
+	plan, err := SyncPlan(context.Background(), reg, dir)
+	if err != nil {
+		t.Fatalf("SyncPlan() error = %v", err)
+	}
+	if err := Apply(context.Background(), reg, plan); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	wantCalls := []string{
+		"register chat",
+		"register greeting",
+		"alias greeting production",
+		"tag qa team",
+		"alias qa production",
+	}
+	if !slices.Equal(reg.calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", reg.calls, wantCalls)
+	}
+	if got := reg.latest["greeting"]; got.Version != 3 || got.CommitMessage != "Shorter" {
+		t.Errorf("greeting = version %d, commit %q", got.Version, got.CommitMessage)
+	}
+
+	// Once applied, the registry matches the files
+	plan, err = SyncPlan(context.Background(), reg, dir)
+	if err != nil {
+		t.Fatalf("SyncPlan() error = %v", err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("plan after Apply has changes:\n%s", plan)
+	}
+}
+
+func TestApply_PartialFailure(t *testing.T) {
+	reg := &syncRegistry{latest: map[string]*PromptVersion{}}
+	plan := &Plan{Items: []SyncItem{
+		{Action: SyncCreate, Desired: PromptFile{Name: "broken", Template: "x"}, newVersion: true},
+		{Action: SyncCreate, Desired: PromptFile{Name: "ok", Template: "y"}, newVersion: true},
+	}}
+
+	events := make(chan progress.Event, 8)
+	err := Apply(context.Background(), reg, plan, WithApplyEvents(events))
+	var multi *errors.MultiError
+	if !stderrors.As(err, &multi) || len(multi.Errors) != 1 || multi.Errors[0].Index != 0 {
+		t.Fatalf("Apply() error = %v, want one failure for item 0", err)
+	}
+	if _, ok := reg.latest["ok"]; !ok {
+		t.Error("later items should still be applied")
+	}
+
+	close(events)
+	var got []string
+	for ev := range events {
+		got = append(got, fmt.Sprintf("%s %s %d/%d %v", ev.Kind, ev.Item, ev.Done, ev.Total, ev.Err != nil))
+	}
+	want := []string{
+		"started  0/2 false",
+		"item done prompt broken 1/2 true",
+		"item done prompt ok 2/2 false",
+		"finished  2/2 true",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+package promptregistry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// FindUnreferencedVersions returns the versions of a prompt that are safe
+// to clean up: created more than olderThan ago, targeted by no alias, and
+// linked to no run or trace within olderThan (see UsageReport for how links
+// are found). The latest version is never returned. Versions are ordered
+// newest first.
+//
+// With WithDeleteUnreferenced, the versions found are also deleted; the
+// returned error is then a *mlflow.MultiError with one entry per version
+// that could not be deleted, indexed into the returned slice. On a client
+// with delete protection, confirm the name with WithUnreferencedConfirm.
+func FindUnreferencedVersions(ctx context.Context, c *Client, name string, olderThan time.Duration, opts ...UnreferencedOption) ([]PromptVersion, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+	if olderThan <= 0 {
+		return nil, fmt.Errorf("mlflow: olderThan must be positive")
+	}
+
+	o := &unreferencedOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	since := time.Now().Add(-olderThan)
+	var usageOpts []UsageReportOption
+	if len(o.experimentIDs) > 0 {
+		usageOpts = append(usageOpts, WithUsageExperiments(o.experimentIDs...))
+	}
+	usage, err := UsageReport(ctx, c, name, since, usageOpts...)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := c.ListPromptVersions(ctx, name, WithVersionsMaxResults(deleteBatchSize))
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[int]bool, len(usage.Versions))
+	for _, u := range usage.Versions {
+		if len(u.Aliases) > 0 || u.Runs > 0 || u.Traces > 0 {
+			referenced[u.Version] = true
+		}
+	}
+	latest := 0
+	for _, v := range versions.Versions {
+		latest = max(latest, v.Version)
+	}
+
+	var unreferenced []PromptVersion
+	for _, v := range versions.Versions {
+		if v.Version == latest || referenced[v.Version] || !v.CreatedAt.Before(since) {
+			continue
+		}
+		unreferenced = append(unreferenced, v)
+	}
+	slices.SortFunc(unreferenced, func(x, y PromptVersion) int { return y.Version - x.Version })
+
+	if !o.delete {
+		return unreferenced, nil
+	}
+	if o.dryRun {
+		ctx = transport.WithDryRun(ctx)
+	}
+	ctx = c.confirmed(ctx, c.qualify(name), o.confirm)
+	var errs errors.MultiError
+	for i, v := range unreferenced {
+		errs.Add(i, versionResource(v.Version), c.DeletePromptVersion(ctx, name, v.Version))
+	}
+	return unreferenced, errs.Err()
+}
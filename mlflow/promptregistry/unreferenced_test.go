@@ -0,0 +1,127 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// unreferencedHandler serves five versions of "qa": v5 is the latest, v4 was
+// created recently, v3 has an alias, v2 is linked to a run, and v1 is unused.
+func unreferencedHandler(t *testing.T, deleted *[]string) http.HandlerFunc {
+	var mu sync.Mutex
+	old := time.Now().Add(-90 * 24 * time.Hour).UnixMilli()
+	recent := time.Now().Add(-time.Hour).UnixMilli()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var resp any
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/search":
+			version := func(v string, created int64) map[string]any {
+				return map[string]any{"name": "qa", "version": v, "creation_timestamp": created}
+			}
+			resp = map[string]any{"model_versions": []map[string]any{
+				version("5", old), version("4", recent), version("3", old), version("2", old), version("1", old),
+			}}
+		case "/api/2.0/mlflow/registered-models/get":
+			resp = map[string]any{"registered_model": map[string]any{
+				"name":    "qa",
+				"aliases": []map[string]any{{"alias": "production", "version": "3"}},
+			}}
+		case "/api/2.0/mlflow/experiments/search":
+			resp = map[string]any{"experiments": []map[string]any{{"experiment_id": "1"}}}
+		case "/api/2.0/mlflow/runs/search":
+			resp = map[string]any{"runs": []map[string]any{{
+				"info": map[string]any{"run_id": "r1"},
+				"data": map[string]any{"tags": []map[string]any{
+					{"key": linkedPromptsTagKey, "value": `[{"name": "qa", "version": "2"}]`},
+				}},
+			}}}
+		case "/api/3.0/mlflow/traces/search":
+			resp = map[string]any{"traces": []map[string]any{}}
+		case "/api/2.0/mlflow/model-versions/delete":
+			var req struct {
+				Version string `json:"version"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			mu.Lock()
+			*deleted = append(*deleted, req.Version)
+			mu.Unlock()
+			resp = map[string]any{}
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}
+}
+
+func TestFindUnreferencedVersions(t *testing.T) {
+	var deleted []string
+	client := newTestClient(t, unreferencedHandler(t, &deleted))
+
+	versions, err := FindUnreferencedVersions(context.Background(), client, "qa", 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("FindUnreferencedVersions() error = %v", err)
+	}
+	if len(versions) != 1 || versions[0].Version != 1 {
+		t.Errorf("versions = %+v, want only v1", versions)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("deleted %v without WithDeleteUnreferenced", deleted)
+	}
+}
+
+func TestFindUnreferencedVersions_Delete(t *testing.T) {
+	var deleted []string
+	client := newTestClient(t, unreferencedHandler(t, &deleted))
+
+	versions, err := FindUnreferencedVersions(context.Background(), client, "qa", 30*24*time.Hour, WithDeleteUnreferenced(false))
+	if err != nil {
+		t.Fatalf("FindUnreferencedVersions() error = %v", err)
+	}
+	if len(versions) != 1 || !slices.Equal(deleted, []string{"1"}) {
+		t.Errorf("versions = %+v, deleted = %v, want v1 deleted", versions, deleted)
+	}
+}
+
+func TestFindUnreferencedVersions_DryRun(t *testing.T) {
+	var deleted []string
+	client := newTestClient(t, unreferencedHandler(t, &deleted))
+
+	versions, err := FindUnreferencedVersions(context.Background(), client, "qa", 30*24*time.Hour, WithDeleteUnreferenced(true))
+	if err != nil {
+		t.Fatalf("FindUnreferencedVersions() error = %v", err)
+	}
+	if len(versions) != 1 || len(deleted) != 0 {
+		t.Errorf("versions = %+v, deleted = %v, want v1 found and nothing deleted", versions, deleted)
+	}
+}
+
+func TestFindUnreferencedVersions_Validation(t *testing.T) {
+	ctx := context.Background()
+	if _, err := FindUnreferencedVersions(ctx, nil, "qa", time.Hour); err == nil {
+		t.Error("expected error for nil client")
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if _, err := FindUnreferencedVersions(ctx, client, "", time.Hour); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if _, err := FindUnreferencedVersions(ctx, client, "qa", 0); err == nil {
+		t.Error("expected error for non-positive olderThan")
+	}
+}
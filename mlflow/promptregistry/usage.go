@@ -0,0 +1,267 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/searchfilter"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// linkedPromptsTagKey is the trace and run tag in which MLflow records the
+// prompt versions loaded while the trace or run was active.
+const linkedPromptsTagKey = mlflowtags.LinkedPrompts
+
+// usageExperimentBatchSize is how many experiments are searched per request.
+const usageExperimentBatchSize = 100
+
+// PromptUsage summarizes how a prompt's versions were used.
+type PromptUsage struct {
+	Name  string
+	Since time.Time
+	// Traces and Runs count the traces and runs linked to any version.
+	Traces int
+	Runs   int
+	// Versions has one entry per registered version, newest first, including
+	// versions with no usage. Versions found only in traces or runs (for
+	// example, since deleted) are included too.
+	Versions []VersionUsage
+}
+
+// VersionUsage summarizes how one prompt version was used.
+type VersionUsage struct {
+	Version int
+	// Aliases are the aliases currently pointing to this version.
+	Aliases []string
+	// Traces counts linked traces; ErrorTraces is how many of them ended
+	// in the ERROR state.
+	Traces      int
+	ErrorTraces int
+	Runs        int
+	// Latency percentiles are computed over the execution duration of
+	// linked traces. They are zero when there are no traces.
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	LatencyP99 time.Duration
+	// LastUsed is the request time of the most recent linked trace or the
+	// start time of the most recent linked run.
+	LastUsed time.Time
+}
+
+// UsageReport summarizes the traces and runs linked to each version of a
+// prompt since the given time, so prompt owners can see which versions are
+// still in use before deleting old ones. A zero since covers all history.
+//
+// MLflow links a prompt version to the active trace or run when the version
+// is loaded by the Python SDK (the mlflow.linkedPrompts tag). Traces are
+// filtered client-side, so the report reads every trace recorded since the
+// given time in the scanned experiments; use WithUsageExperiments to narrow
+// the scan. Trace data requires MLflow 3.x.
+func UsageReport(ctx context.Context, c *Client, name string, since time.Time, opts ...UsageReportOption) (*PromptUsage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: prompt name is required")
+	}
+	name = c.qualify(name)
+
+	usageOpts := &usageReportOptions{}
+	for _, opt := range opts {
+		opt(usageOpts)
+	}
+
+	versions, err := c.ListPromptVersions(ctx, name, WithVersionsMaxResults(deleteBatchSize))
+	if err != nil {
+		return nil, err
+	}
+	rm, err := c.getRegisteredModel(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	trk := tracking.NewClient(c.transport)
+	experimentIDs := usageOpts.experimentIDs
+	if len(experimentIDs) == 0 {
+		experiments, expErr := trk.SearchExperimentsCursor().All(ctx)
+		if expErr != nil {
+			return nil, expErr
+		}
+		for _, exp := range experiments {
+			experimentIDs = append(experimentIDs, exp.ID)
+		}
+	}
+
+	report := newUsageBuilder(name, since, versions.Versions, rm.GetAliases())
+
+	trc := tracing.NewClient(c.transport)
+	for batch := range slices.Chunk(experimentIDs, usageExperimentBatchSize) {
+		runs, runErr := trk.SearchRunsCursor(batch, tracking.WithRunsFilter(linkedRunsFilter(name, since))).All(ctx)
+		if runErr != nil {
+			return nil, runErr
+		}
+		for _, r := range runs {
+			report.addRun(r)
+		}
+
+		var traceOpts []tracing.SearchTracesOption
+		if !since.IsZero() {
+			traceOpts = append(traceOpts, tracing.WithTracesFilter(fmt.Sprintf("trace.timestamp_ms >= %d", since.UnixMilli())))
+		}
+		traces, traceErr := trc.SearchTracesCursor(batch, traceOpts...).All(ctx)
+		if traceErr != nil {
+			return nil, traceErr
+		}
+		for _, t := range traces {
+			report.addTrace(t)
+		}
+	}
+
+	return report.build(), nil
+}
+
+// linkedRunsFilter narrows a run search to runs that may be linked to the
+// prompt. LIKE may over-match; linkedVersions checks the exact name.
+func linkedRunsFilter(name string, since time.Time) string {
+	filters := []string{
+		fmt.Sprintf("tags.`%s` LIKE '%%%s%%'", linkedPromptsTagKey, searchfilter.Value(strconv.Quote(name))),
+	}
+	if !since.IsZero() {
+		filters = append(filters, fmt.Sprintf("attributes.start_time >= %d", since.UnixMilli()))
+	}
+	return searchfilter.And(filters...)
+}
+
+// linkedVersions parses a mlflow.linkedPrompts tag value and returns the
+// versions of the named prompt it references.
+func linkedVersions(tagValue, name string) []int {
+	if tagValue == "" {
+		return nil
+	}
+
+	var links []struct {
+		Name    string          `json:"name"`
+		Version json.RawMessage `json:"version"`
+	}
+	if err := json.Unmarshal([]byte(tagValue), &links); err != nil {
+		return nil
+	}
+
+	var result []int
+	for _, l := range links {
+		if l.Name != name {
+			continue
+		}
+		// The Python SDK writes versions as strings; accept numbers too.
+		v, err := strconv.Atoi(strings.Trim(string(l.Version), `"`))
+		if err != nil || slices.Contains(result, v) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// usageBuilder accumulates usage per version.
+type usageBuilder struct {
+	report    *PromptUsage
+	versions  map[int]*VersionUsage
+	latencies map[int][]time.Duration
+}
+
+func newUsageBuilder(name string, since time.Time, versions []PromptVersion, aliases []*mlflowpb.RegisteredModelAlias) *usageBuilder {
+	b := &usageBuilder{
+		report:    &PromptUsage{Name: name, Since: since},
+		versions:  make(map[int]*VersionUsage, len(versions)),
+		latencies: make(map[int][]time.Duration),
+	}
+	for _, v := range versions {
+		b.version(v.Version)
+	}
+	for _, a := range aliases {
+		if v, err := strconv.Atoi(a.GetVersion()); err == nil {
+			u := b.version(v)
+			u.Aliases = append(u.Aliases, a.GetAlias())
+		}
+	}
+	return b
+}
+
+func (b *usageBuilder) version(v int) *VersionUsage {
+	u, ok := b.versions[v]
+	if !ok {
+		u = &VersionUsage{Version: v}
+		b.versions[v] = u
+	}
+	return u
+}
+
+func (b *usageBuilder) addRun(r tracking.Run) {
+	linked := linkedVersions(r.Data.Tags[linkedPromptsTagKey], b.report.Name)
+	if len(linked) == 0 {
+		return
+	}
+	b.report.Runs++
+	for _, v := range linked {
+		u := b.version(v)
+		u.Runs++
+		if r.Info.StartTime.After(u.LastUsed) {
+			u.LastUsed = r.Info.StartTime
+		}
+	}
+}
+
+func (b *usageBuilder) addTrace(t tracing.TraceInfo) {
+	linked := linkedVersions(t.Tags[linkedPromptsTagKey], b.report.Name)
+	if len(linked) == 0 {
+		return
+	}
+	b.report.Traces++
+	for _, v := range linked {
+		u := b.version(v)
+		u.Traces++
+		if t.State == tracing.TraceStateError {
+			u.ErrorTraces++
+		}
+		if t.RequestTime.After(u.LastUsed) {
+			u.LastUsed = t.RequestTime
+		}
+		b.latencies[v] = append(b.latencies[v], t.ExecutionDuration)
+	}
+}
+
+func (b *usageBuilder) build() *PromptUsage {
+	for v, durations := range b.latencies {
+		slices.Sort(durations)
+		u := b.versions[v]
+		u.LatencyP50 = percentile(durations, 50)
+		u.LatencyP90 = percentile(durations, 90)
+		u.LatencyP99 = percentile(durations, 99)
+	}
+
+	b.report.Versions = make([]VersionUsage, 0, len(b.versions))
+	for _, u := range b.versions {
+		b.report.Versions = append(b.report.Versions, *u)
+	}
+	slices.SortFunc(b.report.Versions, func(x, y VersionUsage) int {
+		return y.Version - x.Version
+	})
+	return b.report
+}
+
+// percentile returns the nearest-rank percentile p of sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	return sorted[max(rank, 1)-1]
+}
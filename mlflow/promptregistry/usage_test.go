@@ -0,0 +1,167 @@
+package promptregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUsageReport(t *testing.T) {
+	since := time.UnixMilli(1_000_000)
+	var runFilter, traceFilter string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Filter string `json:"filter"`
+		}
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+		}
+
+		var resp any
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/model-versions/search":
+			resp = map[string]any{"model_versions": []map[string]any{
+				{"name": "qa", "version": "3"},
+				{"name": "qa", "version": "2"},
+				{"name": "qa", "version": "1"},
+			}}
+		case "/api/2.0/mlflow/registered-models/get":
+			resp = map[string]any{"registered_model": map[string]any{
+				"name": "qa",
+				"aliases": []map[string]any{
+					{"alias": "staging", "version": "3"},
+					{"alias": "production", "version": "2"},
+				},
+			}}
+		case "/api/2.0/mlflow/experiments/search":
+			resp = map[string]any{"experiments": []map[string]any{{"experiment_id": "1"}, {"experiment_id": "2"}}}
+		case "/api/2.0/mlflow/runs/search":
+			runFilter = req.Filter
+			resp = map[string]any{"runs": []map[string]any{
+				{
+					"info": map[string]any{"run_id": "r1", "start_time": 1_500_000},
+					"data": map[string]any{"tags": []map[string]any{
+						{"key": linkedPromptsTagKey, "value": `[{"name": "qa", "version": "2"}, {"name": "other", "version": "1"}]`},
+					}},
+				},
+				{
+					// Matched by LIKE but links a different prompt
+					"info": map[string]any{"run_id": "r2"},
+					"data": map[string]any{"tags": []map[string]any{
+						{"key": linkedPromptsTagKey, "value": `[{"name": "qa-v2", "version": "1"}]`},
+					}},
+				},
+			}}
+		case "/api/3.0/mlflow/traces/search":
+			traceFilter = req.Filter
+			trace := func(id, version, state, duration string) map[string]any {
+				return map[string]any{
+					"trace_id":           id,
+					"state":              state,
+					"request_time":       "2026-01-01T00:00:00Z",
+					"execution_duration": duration,
+					"tags":               map[string]any{linkedPromptsTagKey: `[{"name": "qa", "version": ` + version + `}]`},
+				}
+			}
+			resp = map[string]any{"traces": []map[string]any{
+				trace("t1", `"2"`, "OK", "1s"),
+				trace("t2", `"2"`, "OK", "3s"),
+				trace("t3", `"2"`, "ERROR", "2s"),
+				trace("t4", `4`, "OK", "1s"),
+				{"trace_id": "t5", "tags": map[string]any{}},
+			}}
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+
+	report, err := UsageReport(context.Background(), client, "qa", since)
+	if err != nil {
+		t.Fatalf("UsageReport() error = %v", err)
+	}
+
+	if !strings.Contains(runFilter, "LIKE '%\"qa\"%'") || !strings.Contains(runFilter, "attributes.start_time >= 1000000") {
+		t.Errorf("run filter = %q", runFilter)
+	}
+	if traceFilter != "trace.timestamp_ms >= 1000000" {
+		t.Errorf("trace filter = %q", traceFilter)
+	}
+
+	if report.Traces != 4 || report.Runs != 1 {
+		t.Errorf("Traces = %d, Runs = %d, want 4 and 1", report.Traces, report.Runs)
+	}
+
+	var got []int
+	for _, v := range report.Versions {
+		got = append(got, v.Version)
+	}
+	if len(got) != 4 || got[0] != 4 || got[3] != 1 {
+		t.Fatalf("versions = %v, want [4 3 2 1]", got)
+	}
+
+	v2 := report.Versions[2]
+	if v2.Traces != 3 || v2.ErrorTraces != 1 || v2.Runs != 1 || len(v2.Aliases) != 1 || v2.Aliases[0] != "production" {
+		t.Errorf("v2 = %+v", v2)
+	}
+	if v2.LatencyP50 != 2*time.Second || v2.LatencyP90 != 3*time.Second || v2.LatencyP99 != 3*time.Second {
+		t.Errorf("v2 latency = %v/%v/%v", v2.LatencyP50, v2.LatencyP90, v2.LatencyP99)
+	}
+	if !v2.LastUsed.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("v2 LastUsed = %v", v2.LastUsed)
+	}
+
+	v1 := report.Versions[3]
+	if v1.Traces != 0 || v1.Runs != 0 || !v1.LastUsed.IsZero() || v1.LatencyP50 != 0 {
+		t.Errorf("v1 = %+v, want no usage", v1)
+	}
+}
+
+func TestUsageReport_Validation(t *testing.T) {
+	if _, err := UsageReport(context.Background(), nil, "qa", time.Time{}); err == nil {
+		t.Error("expected error for nil client")
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if _, err := UsageReport(context.Background(), client, "", time.Time{}); err == nil {
+		t.Error("expected error for empty name")
+	}
+}
+
+func TestLinkedVersions(t *testing.T) {
+	tag := `[{"name": "qa", "version": "1"}, {"name": "qa", "version": 2}, {"name": "qa", "version": "1"}, {"name": "x", "version": "3"}]`
+	got := linkedVersions(tag, "qa")
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("linkedVersions() = %v, want [1 2]", got)
+	}
+	if linkedVersions("not json", "qa") != nil {
+		t.Error("expected nil for malformed tag")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	d := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if p := percentile(d, 50); p != 5 {
+		t.Errorf("p50 = %v, want 5", p)
+	}
+	if p := percentile(d, 99); p != 10 {
+		t.Errorf("p99 = %v, want 10", p)
+	}
+	if p := percentile(nil, 50); p != 0 {
+		t.Errorf("empty p50 = %v, want 0", p)
+	}
+}
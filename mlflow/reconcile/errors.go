@@ -0,0 +1,121 @@
+// Package reconcile provides idempotent "ensure" functions for managing MLflow
+// resources from a reconcile loop, such as a Kubernetes controller.
+//
+// Each function compares the desired state with the server, changes only
+// what differs, and returns the observed state after the call, suitable for
+// a custom resource's status. Calling it again with the same input changes
+// nothing. Errors are always an *Error whose Reason is stable across
+// releases, so controllers can set status conditions and decide whether to
+// requeue:
+//
+//	status, err := reconcile.EnsureExperiment(ctx, client.Tracking(), spec)
+//	if err != nil {
+//		if reconcile.IsRetryable(err) {
+//			return ctrl.Result{}, err // requeue with backoff
+//		}
+//		setCondition(obj, "Ready", false, string(reconcile.ReasonOf(err)), err.Error())
+//		return ctrl.Result{}, nil // wait for the spec to change
+//	}
+package reconcile
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// Reason classifies why an ensure function failed. The values are stable and
+// suitable as Kubernetes condition reasons.
+type Reason string
+
+// Reasons.
+const (
+	// ReasonInvalidSpec means the desired state is invalid; fix the spec.
+	ReasonInvalidSpec Reason = "InvalidSpec"
+
+	// ReasonConflict means the server state prevents reaching the desired
+	// state, such as a deleted experiment holding the name.
+	ReasonConflict Reason = "Conflict"
+
+	// ReasonNotFound means a resource the spec refers to does not exist,
+	// such as the prompt version an alias should point to.
+	ReasonNotFound Reason = "NotFound"
+
+	// ReasonUnauthorized means the credentials are missing or invalid.
+	ReasonUnauthorized Reason = "Unauthorized"
+
+	// ReasonPermissionDenied means the credentials lack permission.
+	ReasonPermissionDenied Reason = "PermissionDenied"
+
+	// ReasonTransient means the server or network failed, or the request
+	// timed out; retry with backoff.
+	ReasonTransient Reason = "Transient"
+)
+
+// Error is the error returned by every ensure function.
+type Error struct {
+	Reason Reason
+
+	// Resource identifies what failed, such as `experiment "ranking"`.
+	Resource string
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Resource, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ReasonOf returns the Reason of an *Error in err's chain, or
+// ReasonTransient if there is none.
+func ReasonOf(err error) Reason {
+	var rerr *Error
+	if stderrors.As(err, &rerr) {
+		return rerr.Reason
+	}
+	return ReasonTransient
+}
+
+// IsRetryable reports whether err may go away on its own, so the reconcile
+// should be retried with backoff rather than wait for the spec to change.
+func IsRetryable(err error) bool {
+	return err != nil && ReasonOf(err) == ReasonTransient
+}
+
+// newError wraps err for resource, classifying it by its API status code.
+// Errors without a status code, such as network errors and timeouts, are
+// transient.
+func newError(ctx context.Context, resource string, err error) *Error {
+	reason := ReasonTransient
+	var apiErr *errors.APIError
+	switch {
+	case ctx.Err() != nil:
+	case !stderrors.As(err, &apiErr):
+	case apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500:
+	case apiErr.StatusCode == http.StatusUnauthorized:
+		reason = ReasonUnauthorized
+	case apiErr.StatusCode == http.StatusForbidden:
+		reason = ReasonPermissionDenied
+	case apiErr.StatusCode == http.StatusNotFound:
+		reason = ReasonNotFound
+	case errors.IsAlreadyExists(err) || apiErr.StatusCode == http.StatusConflict:
+		reason = ReasonConflict
+	default:
+		reason = ReasonInvalidSpec
+	}
+	return &Error{Reason: reason, Resource: resource, Err: err}
+}
+
+// invalid returns a ReasonInvalidSpec error for resource.
+func invalid(resource, format string, args ...any) *Error {
+	return &Error{Reason: ReasonInvalidSpec, Resource: resource, Err: fmt.Errorf(format, args...)}
+}
@@ -0,0 +1,59 @@
+package reconcile
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestNewError_Reasons(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Reason
+	}{
+		{"server error", &errors.APIError{StatusCode: http.StatusBadGateway}, ReasonTransient},
+		{"rate limited", &errors.APIError{StatusCode: http.StatusTooManyRequests}, ReasonTransient},
+		{"network error", stderrors.New("connection refused"), ReasonTransient},
+		{"unauthorized", &errors.APIError{StatusCode: http.StatusUnauthorized}, ReasonUnauthorized},
+		{"forbidden", &errors.APIError{StatusCode: http.StatusForbidden}, ReasonPermissionDenied},
+		{"not found", &errors.APIError{StatusCode: http.StatusNotFound}, ReasonNotFound},
+		{"already exists", &errors.APIError{StatusCode: http.StatusBadRequest, Code: "RESOURCE_ALREADY_EXISTS"}, ReasonConflict},
+		{"bad request", &errors.APIError{StatusCode: http.StatusBadRequest}, ReasonInvalidSpec},
+		{"wrapped", fmt.Errorf("failed: %w", &errors.APIError{StatusCode: http.StatusForbidden}), ReasonPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newError(context.Background(), "experiment \"x\"", tt.err)
+			if ReasonOf(err) != tt.want {
+				t.Errorf("ReasonOf() = %q, want %q", ReasonOf(err), tt.want)
+			}
+			if IsRetryable(err) != (tt.want == ReasonTransient) {
+				t.Errorf("IsRetryable() = %v", IsRetryable(err))
+			}
+			if !stderrors.Is(err, tt.err) {
+				t.Error("error does not wrap the cause")
+			}
+		})
+	}
+}
+
+func TestNewError_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := newError(ctx, "experiment \"x\"", &errors.APIError{StatusCode: http.StatusBadRequest})
+	if err.Reason != ReasonTransient {
+		t.Errorf("Reason = %q, want %q", err.Reason, ReasonTransient)
+	}
+}
+
+func TestIsRetryable_Nil(t *testing.T) {
+	if IsRetryable(nil) {
+		t.Error("IsRetryable(nil) = true")
+	}
+}
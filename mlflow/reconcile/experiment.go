@@ -0,0 +1,105 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+// ExperimentSpec is the desired state of an experiment.
+type ExperimentSpec struct {
+	Name string
+
+	// ArtifactLocation is used when the experiment is created. If set, an
+	// existing experiment with another location is a ReasonConflict error,
+	// since MLflow cannot move it.
+	ArtifactLocation string
+
+	// Tags are set on the experiment. Tags not listed are left alone.
+	Tags map[string]string
+}
+
+// ExperimentStatus is the observed state of an experiment.
+type ExperimentStatus struct {
+	ID               string
+	Name             string
+	ArtifactLocation string
+	LifecycleStage   string
+	Tags             map[string]string
+
+	// Created is true if this call created the experiment.
+	Created bool
+
+	// Changed is true if this call created or modified the experiment.
+	Changed bool
+}
+
+// EnsureExperiment creates the experiment named in spec if it does not
+// exist and sets any of its tags that differ. If another caller creates the
+// experiment concurrently, EnsureExperiment updates that one instead.
+func EnsureExperiment(ctx context.Context, c tracking.API, spec ExperimentSpec) (*ExperimentStatus, error) {
+	resource := fmt.Sprintf("experiment %q", spec.Name)
+	if c == nil {
+		return nil, invalid(resource, "mlflow: client is required")
+	}
+	if spec.Name == "" {
+		return nil, invalid(resource, "mlflow: experiment name is required")
+	}
+
+	status := &ExperimentStatus{}
+	exp, err := c.GetExperimentByName(ctx, spec.Name)
+	if errors.IsNotFound(err) {
+		opts := []tracking.CreateExperimentOption{tracking.WithExperimentTags(spec.Tags)}
+		if spec.ArtifactLocation != "" {
+			opts = append(opts, tracking.WithArtifactLocation(spec.ArtifactLocation))
+		}
+		var id string
+		id, err = c.CreateExperiment(ctx, spec.Name, opts...)
+		switch {
+		case err == nil:
+			status.Created, status.Changed = true, true
+			exp, err = c.GetExperiment(ctx, id)
+		case errors.IsAlreadyExists(err):
+			// Lost a race with another reconcile; converge on its experiment
+			exp, err = c.GetExperimentByName(ctx, spec.Name)
+		}
+	}
+	if err != nil {
+		return nil, newError(ctx, resource, err)
+	}
+
+	if exp.LifecycleStage == "deleted" {
+		return nil, &Error{Reason: ReasonConflict, Resource: resource,
+			Err: fmt.Errorf("mlflow: experiment %s is deleted; restore it or choose another name", exp.ID)}
+	}
+	if spec.ArtifactLocation != "" && spec.ArtifactLocation != exp.ArtifactLocation {
+		return nil, &Error{Reason: ReasonConflict, Resource: resource,
+			Err: fmt.Errorf("mlflow: artifact location is %q and cannot be changed to %q", exp.ArtifactLocation, spec.ArtifactLocation)}
+	}
+
+	tags := maps.Clone(exp.Tags)
+	if tags == nil {
+		tags = make(map[string]string, len(spec.Tags))
+	}
+	for _, key := range slices.Sorted(maps.Keys(spec.Tags)) {
+		if value, ok := tags[key]; ok && value == spec.Tags[key] {
+			continue
+		}
+		if err = c.SetExperimentTag(ctx, exp.ID, key, spec.Tags[key]); err != nil {
+			return nil, newError(ctx, resource, err)
+		}
+		tags[key] = spec.Tags[key]
+		status.Changed = true
+	}
+
+	status.ID = exp.ID
+	status.Name = exp.Name
+	status.ArtifactLocation = exp.ArtifactLocation
+	status.LifecycleStage = exp.LifecycleStage
+	status.Tags = tags
+	return status, nil
+}
@@ -0,0 +1,109 @@
+package reconcile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowmock"
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracking"
+)
+
+var errNotFound = &errors.APIError{StatusCode: http.StatusNotFound, Code: "RESOURCE_DOES_NOT_EXIST"}
+
+func TestEnsureExperiment_Creates(t *testing.T) {
+	mock := &mlflowmock.Tracking{
+		GetExperimentByNameFunc: func(context.Context, string) (*tracking.Experiment, error) {
+			return nil, errNotFound
+		},
+		CreateExperimentFunc: func(context.Context, string, ...tracking.CreateExperimentOption) (string, error) {
+			return "7", nil
+		},
+		GetExperimentFunc: func(_ context.Context, id string) (*tracking.Experiment, error) {
+			return &tracking.Experiment{ID: id, Name: "ranking", LifecycleStage: "active", Tags: map[string]string{"team": "search"}}, nil
+		},
+	}
+
+	status, err := EnsureExperiment(context.Background(), mock, ExperimentSpec{Name: "ranking", Tags: map[string]string{"team": "search"}})
+	if err != nil {
+		t.Fatalf("EnsureExperiment() error = %v", err)
+	}
+	if status.ID != "7" || !status.Created || !status.Changed || status.Tags["team"] != "search" {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestEnsureExperiment_UpdatesTags(t *testing.T) {
+	mock := &mlflowmock.Tracking{
+		GetExperimentByNameFunc: func(context.Context, string) (*tracking.Experiment, error) {
+			return &tracking.Experiment{ID: "7", Name: "ranking", LifecycleStage: "active", Tags: map[string]string{"team": "search", "env": "dev"}}, nil
+		},
+		SetExperimentTagFunc: func(context.Context, string, string, string) error { return nil },
+	}
+
+	spec := ExperimentSpec{Name: "ranking", Tags: map[string]string{"team": "search", "env": "prod"}}
+	status, err := EnsureExperiment(context.Background(), mock, spec)
+	if err != nil {
+		t.Fatalf("EnsureExperiment() error = %v", err)
+	}
+	if status.Created || !status.Changed || status.Tags["env"] != "prod" {
+		t.Errorf("status = %+v", status)
+	}
+	if calls := mock.CallsTo("SetExperimentTag"); len(calls) != 1 || calls[0].Args[2] != "env" {
+		t.Errorf("SetExperimentTag calls = %v, want only env", calls)
+	}
+}
+
+func TestEnsureExperiment_LostCreateRace(t *testing.T) {
+	gets := 0
+	mock := &mlflowmock.Tracking{
+		GetExperimentByNameFunc: func(context.Context, string) (*tracking.Experiment, error) {
+			gets++
+			if gets == 1 {
+				return nil, errNotFound
+			}
+			return &tracking.Experiment{ID: "8", Name: "ranking", LifecycleStage: "active"}, nil
+		},
+		CreateExperimentFunc: func(context.Context, string, ...tracking.CreateExperimentOption) (string, error) {
+			return "", &errors.APIError{StatusCode: http.StatusBadRequest, Code: "RESOURCE_ALREADY_EXISTS"}
+		},
+	}
+
+	status, err := EnsureExperiment(context.Background(), mock, ExperimentSpec{Name: "ranking"})
+	if err != nil {
+		t.Fatalf("EnsureExperiment() error = %v", err)
+	}
+	if status.ID != "8" || status.Created || status.Changed {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestEnsureExperiment_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     ExperimentSpec
+		existing *tracking.Experiment
+		getErr   error
+		want     Reason
+	}{
+		{"missing name", ExperimentSpec{}, nil, nil, ReasonInvalidSpec},
+		{"deleted", ExperimentSpec{Name: "x"}, &tracking.Experiment{ID: "1", LifecycleStage: "deleted"}, nil, ReasonConflict},
+		{"artifact location", ExperimentSpec{Name: "x", ArtifactLocation: "s3://b"}, &tracking.Experiment{ID: "1", ArtifactLocation: "s3://a"}, nil, ReasonConflict},
+		{"forbidden", ExperimentSpec{Name: "x"}, nil, &errors.APIError{StatusCode: http.StatusForbidden}, ReasonPermissionDenied},
+		{"unavailable", ExperimentSpec{Name: "x"}, nil, &errors.APIError{StatusCode: http.StatusServiceUnavailable}, ReasonTransient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mlflowmock.Tracking{
+				GetExperimentByNameFunc: func(context.Context, string) (*tracking.Experiment, error) {
+					return tt.existing, tt.getErr
+				},
+			}
+			_, err := EnsureExperiment(context.Background(), mock, tt.spec)
+			if ReasonOf(err) != tt.want || err == nil {
+				t.Errorf("EnsureExperiment() error = %v, reason %q, want %q", err, ReasonOf(err), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+// PromptSpec is the desired content of a prompt's latest version. Set
+// either Template or Messages.
+type PromptSpec struct {
+	Name     string
+	Template string
+	Messages []promptregistry.ChatMessage
+
+	// CommitMessage is used when a new version is registered.
+	CommitMessage string
+
+	// Tags are set on the latest version. Tags not listed are left alone.
+	Tags map[string]string
+}
+
+// PromptStatus is the observed state of a prompt's latest version.
+type PromptStatus struct {
+	Name    string
+	Version int
+	Aliases []string
+
+	// Created is true if this call registered the prompt.
+	Created bool
+
+	// Changed is true if this call registered a version or set tags.
+	Changed bool
+}
+
+// EnsurePrompt registers a new version of the prompt in spec if its latest
+// version has different content, and otherwise sets any tags that differ.
+// It compares content the same way as promptregistry.SyncPlan.
+func EnsurePrompt(ctx context.Context, c promptregistry.API, spec PromptSpec) (*PromptStatus, error) {
+	resource := fmt.Sprintf("prompt %q", spec.Name)
+	switch {
+	case c == nil:
+		return nil, invalid(resource, "mlflow: client is required")
+	case spec.Name == "":
+		return nil, invalid(resource, "mlflow: prompt name is required")
+	case (spec.Template == "") == (spec.Messages == nil):
+		return nil, invalid(resource, "mlflow: set exactly one of template and messages")
+	}
+
+	// With the spec validated, planning only fails to load the prompt
+	plan, err := promptregistry.PlanPrompts(ctx, c, []promptregistry.PromptFile{{
+		Name:          spec.Name,
+		Template:      spec.Template,
+		Messages:      spec.Messages,
+		CommitMessage: spec.CommitMessage,
+		Tags:          spec.Tags,
+	}})
+	if err != nil {
+		return nil, newError(ctx, resource, err)
+	}
+
+	item := plan.Items[0]
+	status := &PromptStatus{
+		Name:    spec.Name,
+		Created: item.Action == promptregistry.SyncCreate,
+		Changed: item.Action != promptregistry.SyncNoop,
+	}
+	current := item.Current
+	if status.Changed {
+		if err = promptregistry.Apply(ctx, c, plan); err != nil {
+			return nil, newError(ctx, resource, err)
+		}
+		if current, err = c.LoadPrompt(ctx, spec.Name); err != nil {
+			return nil, newError(ctx, resource, err)
+		}
+	}
+
+	status.Version = current.Version
+	status.Aliases = current.Aliases
+	return status, nil
+}
+
+// AliasStatus is the observed state of a prompt alias.
+type AliasStatus struct {
+	Name    string
+	Alias   string
+	Version int
+
+	// PreviousVersion is the version the alias pointed to before this call,
+	// or 0 if it did not exist.
+	PreviousVersion int
+
+	// Changed is true if this call moved or created the alias.
+	Changed bool
+}
+
+// EnsureAlias points a prompt alias at version. A version that does not
+// exist is a ReasonNotFound error.
+func EnsureAlias(ctx context.Context, c promptregistry.API, name, alias string, version int) (*AliasStatus, error) {
+	resource := fmt.Sprintf("alias %q of prompt %q", alias, name)
+	switch {
+	case c == nil:
+		return nil, invalid(resource, "mlflow: client is required")
+	case name == "":
+		return nil, invalid(resource, "mlflow: prompt name is required")
+	case alias == "":
+		return nil, invalid(resource, "mlflow: alias is required")
+	case version <= 0:
+		return nil, invalid(resource, "mlflow: version must be positive")
+	}
+
+	status := &AliasStatus{Name: name, Alias: alias, Version: version}
+	current, err := c.LoadPrompt(ctx, name, promptregistry.WithAlias(alias))
+	switch {
+	case err == nil:
+		status.PreviousVersion = current.Version
+		if current.Version == version {
+			return status, nil
+		}
+	case !errors.IsNotFound(err):
+		return nil, newError(ctx, resource, err)
+	}
+
+	if err = c.SetPromptAlias(ctx, name, alias, version); err != nil {
+		return nil, newError(ctx, resource, err)
+	}
+	status.Changed = true
+	return status, nil
+}
@@ -0,0 +1,100 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowmock"
+	"github.com/opendatahub-io/mlflow-go/mlflow/promptregistry"
+)
+
+func TestEnsurePrompt_NoChange(t *testing.T) {
+	mock := &mlflowmock.PromptRegistry{
+		LoadPromptFunc: func(context.Context, string, ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error) {
+			return &promptregistry.PromptVersion{Name: "qa", Version: 3, Template: "Answer {{q}}", Aliases: []string{"production"}}, nil
+		},
+	}
+
+	status, err := EnsurePrompt(context.Background(), mock, PromptSpec{Name: "qa", Template: "Answer {{q}}"})
+	if err != nil {
+		t.Fatalf("EnsurePrompt() error = %v", err)
+	}
+	if status.Version != 3 || status.Changed || len(status.Aliases) != 1 {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestEnsurePrompt_NewVersion(t *testing.T) {
+	latest := &promptregistry.PromptVersion{Name: "qa", Version: 3, Template: "Answer {{q}}"}
+	mock := &mlflowmock.PromptRegistry{
+		LoadPromptFunc: func(context.Context, string, ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error) {
+			return latest, nil
+		},
+		RegisterPromptFunc: func(_ context.Context, name, template string, _ ...promptregistry.RegisterOption) (*promptregistry.PromptVersion, error) {
+			latest = &promptregistry.PromptVersion{Name: name, Version: 4, Template: template}
+			return latest, nil
+		},
+	}
+
+	status, err := EnsurePrompt(context.Background(), mock, PromptSpec{Name: "qa", Template: "Answer briefly: {{q}}"})
+	if err != nil {
+		t.Fatalf("EnsurePrompt() error = %v", err)
+	}
+	if status.Version != 4 || !status.Changed || status.Created {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestEnsurePrompt_InvalidSpec(t *testing.T) {
+	mock := &mlflowmock.PromptRegistry{}
+	_, err := EnsurePrompt(context.Background(), mock, PromptSpec{Name: "qa"})
+	if ReasonOf(err) != ReasonInvalidSpec {
+		t.Errorf("EnsurePrompt() error = %v, want %s", err, ReasonInvalidSpec)
+	}
+}
+
+func TestEnsureAlias(t *testing.T) {
+	aliases := map[string]int{"production": 2}
+	mock := &mlflowmock.PromptRegistry{
+		LoadPromptFunc: func(_ context.Context, name string, _ ...promptregistry.LoadOption) (*promptregistry.PromptVersion, error) {
+			// The mock cannot inspect options, so serve the only alias used
+			v, ok := aliases["production"]
+			if !ok {
+				return nil, errNotFound
+			}
+			return &promptregistry.PromptVersion{Name: name, Version: v}, nil
+		},
+		SetPromptAliasFunc: func(_ context.Context, _, alias string, version int) error {
+			if version > 5 {
+				return errNotFound
+			}
+			aliases[alias] = version
+			return nil
+		},
+	}
+	ctx := context.Background()
+
+	status, err := EnsureAlias(ctx, mock, "qa", "production", 3)
+	if err != nil {
+		t.Fatalf("EnsureAlias() error = %v", err)
+	}
+	if !status.Changed || status.PreviousVersion != 2 || aliases["production"] != 3 {
+		t.Errorf("status = %+v, aliases = %v", status, aliases)
+	}
+
+	status, err = EnsureAlias(ctx, mock, "qa", "production", 3)
+	if err != nil || status.Changed {
+		t.Errorf("second EnsureAlias() = %+v, %v; want unchanged", status, err)
+	}
+	if got := len(mock.CallsTo("SetPromptAlias")); got != 1 {
+		t.Errorf("SetPromptAlias called %d times, want 1", got)
+	}
+
+	delete(aliases, "production")
+	if _, err = EnsureAlias(ctx, mock, "qa", "production", 9); ReasonOf(err) != ReasonNotFound {
+		t.Errorf("EnsureAlias(missing version) error = %v, want %s", err, ReasonNotFound)
+	}
+	if _, err = EnsureAlias(ctx, mock, "qa", "", 1); ReasonOf(err) != ReasonInvalidSpec {
+		t.Errorf("EnsureAlias(no alias) error = %v, want %s", err, ReasonInvalidSpec)
+	}
+}
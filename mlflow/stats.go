@@ -0,0 +1,33 @@
+package mlflow
+
+import (
+	"expvar"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// Stats is a snapshot of a client's request counters: requests in flight,
+// totals, failover retries, deduplication hit rate, and per-endpoint
+// counters with the last error. It marshals to JSON.
+type Stats = transport.Stats
+
+// EndpointStats are the counters of one endpoint in Stats.
+type EndpointStats = transport.EndpointStats
+
+// DedupStats reports how often identical concurrent reads shared one
+// request; see WithSingleflight.
+type DedupStats = transport.DedupStats
+
+// Stats returns a snapshot of the client's request counters since it was
+// created. It is cheap enough to call from a metrics scrape or health
+// endpoint.
+func (c *Client) Stats() Stats {
+	return c.transport.Stats()
+}
+
+// PublishExpvar publishes the client's Stats as an expvar variable, so they
+// appear at /debug/vars when the expvar handler is served. Like
+// expvar.Publish, it panics if name is already published.
+func (c *Client) PublishExpvar(name string) {
+	expvar.Publish(name, expvar.Func(func() any { return c.Stats() }))
+}
@@ -0,0 +1,39 @@
+package mlflow
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Stats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithTrackingURI(server.URL), WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err = client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+
+	if s := client.Stats(); s.Requests != 1 || s.Endpoints["GET /api/2.0/mlflow/experiments/get"].Requests != 1 {
+		t.Errorf("Stats() = %+v", s)
+	}
+
+	client.PublishExpvar("mlflow_test_stats")
+	var exported Stats
+	if err = json.Unmarshal([]byte(expvar.Get("mlflow_test_stats").String()), &exported); err != nil {
+		t.Fatalf("failed to decode expvar: %v", err)
+	}
+	if exported.Requests != 1 {
+		t.Errorf("exported requests = %d, want 1", exported.Requests)
+	}
+}
@@ -0,0 +1,197 @@
+// Package template renders the {{variable}} templates of MLflow prompts.
+//
+// It is the renderer behind promptregistry's Format methods, exported so
+// code that builds prompts without the registry, such as evaluation
+// harnesses, renders them exactly the same way.
+//
+// # Syntax
+//
+// A placeholder is "{{", a variable name of one or more ASCII letters,
+// digits, and underscores, then "}}". Anything else is literal text:
+// "{{ name }}" (with spaces) and "{{a-b}}" are left as written, and in
+// "{{{name}}}" only the inner "{{name}}" is a placeholder.
+//
+// A backslash before "{{" escapes it: `\{{name}}` renders as the literal
+// text "{{name}}". There is no escape for the backslash itself, so a
+// literal backslash cannot directly precede a placeholder.
+package template
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Placeholder is a {{variable}} in a template.
+type Placeholder struct {
+	// Name is the variable name.
+	Name string
+
+	// Offset is the byte offset of the opening "{{".
+	Offset int
+}
+
+// Placeholders returns the placeholders in text, in order. Escaped
+// placeholders are not included.
+func Placeholders(text string) []Placeholder {
+	var found []Placeholder
+	scan(text, func(open, end int) {
+		found = append(found, Placeholder{Name: text[open+2 : end], Offset: open})
+	}, nil)
+	return found
+}
+
+// Variables returns the names of the variables in text, in order of first
+// use and without duplicates.
+func Variables(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, p := range Placeholders(text) {
+		if !seen[p.Name] {
+			seen[p.Name] = true
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// IsVariableName reports whether name can be used in a placeholder.
+func IsVariableName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isWordChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MissingVariablesError is returned by Render when text uses variables
+// that vars does not set.
+type MissingVariablesError struct {
+	// Names lists the missing variables, once per placeholder, in order.
+	Names []string
+}
+
+func (e *MissingVariablesError) Error() string {
+	return fmt.Sprintf("mlflow: missing variables: %s", strings.Join(e.Names, ", "))
+}
+
+// Render replaces every placeholder in text with its value from vars and
+// unescapes escaped placeholders. Values are inserted as is and never
+// rendered themselves. If any variable is missing, Render returns a
+// *MissingVariablesError.
+func Render(text string, vars map[string]string) (string, error) {
+	out, missing := render(text, vars, false)
+	if len(missing) > 0 {
+		return "", &MissingVariablesError{Names: missing}
+	}
+	return out, nil
+}
+
+// RenderPartial replaces the placeholders whose variables vars sets and
+// leaves the others, returning a template to render later with the rest.
+// Inserted values are escaped with Escape, so placeholders in them stay
+// literal when the result is rendered again, and escaped placeholders
+// stay escaped.
+func RenderPartial(text string, vars map[string]string) string {
+	out, _ := render(text, vars, true)
+	return out
+}
+
+// Escape escapes every "{{" in s, so s renders as itself, such as to
+// embed user input in a template.
+func Escape(s string) string {
+	if !strings.Contains(s, "{{") {
+		return s
+	}
+	return strings.ReplaceAll(s, "{{", `\{{`)
+}
+
+// render substitutes the placeholders of text. In partial mode, missing
+// variables are left in place, values are escaped, and escapes are kept.
+// This is the hot path of every Format call, so it scans text once
+// without regexp.
+func render(text string, vars map[string]string, partial bool) (string, []string) {
+	var (
+		b       strings.Builder
+		missing []string
+		copied  int // text[:copied] has been written to b
+	)
+	write := func(upTo int, s string, resume int) {
+		if b.Cap() == 0 {
+			b.Grow(len(text) + len(s))
+		}
+		b.WriteString(text[copied:upTo])
+		b.WriteString(s)
+		copied = resume
+	}
+
+	scan(text, func(open, end int) {
+		value, ok := vars[text[open+2:end]]
+		switch {
+		case !ok && partial:
+		case !ok:
+			missing = append(missing, text[open+2:end])
+		case partial:
+			write(open, Escape(value), end+2)
+		default:
+			write(open, value, end+2)
+		}
+	}, func(backslash int) {
+		if !partial {
+			// Drop the backslash; the "{{" is copied as text
+			write(backslash, "", backslash+1)
+		}
+	})
+
+	if len(missing) > 0 {
+		return "", missing
+	}
+	if copied == 0 {
+		return text, nil
+	}
+	b.WriteString(text[copied:])
+	return b.String(), nil
+}
+
+// scan calls placeholder with the offsets of the opening "{{" and of the
+// closing "}}" of each placeholder in text, and escaped, if not nil, with
+// the offset of the backslash of each escaped "{{".
+func scan(text string, placeholder func(open, end int), escaped func(backslash int)) {
+	for i := 0; ; {
+		open := strings.Index(text[i:], "{{")
+		if open < 0 {
+			return
+		}
+		open += i
+
+		if open > 0 && text[open-1] == '\\' {
+			if escaped != nil {
+				escaped(open - 1)
+			}
+			i = open + 2
+			continue
+		}
+
+		end := open + 2
+		for end < len(text) && isWordChar(text[end]) {
+			end++
+		}
+		if end == open+2 || !strings.HasPrefix(text[end:], "}}") {
+			// Not a placeholder; resume one byte later so "{{{x}}}" still
+			// matches the inner "{{x}}".
+			i = open + 1
+			continue
+		}
+
+		placeholder(open, end)
+		i = end + 2
+	}
+}
+
+// isWordChar reports whether c matches the regexp class \w.
+func isWordChar(c byte) bool {
+	return c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
@@ -0,0 +1,125 @@
+package template
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestRender_MultipleOccurrences(t *testing.T) {
+	got, err := Render("{{name}} and {{name}} are the same", map[string]string{"name": "Alice"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "Alice and Alice are the same"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestRender_MissingVariables(t *testing.T) {
+	_, err := Render("{{x}} {{a}} {{y}} {{x}}", map[string]string{"a": "1"})
+	var missing *MissingVariablesError
+	if !errors.As(err, &missing) {
+		t.Fatalf("Render() error = %v, want *MissingVariablesError", err)
+	}
+	if want := []string{"x", "y", "x"}; !slices.Equal(missing.Names, want) {
+		t.Errorf("Names = %v, want %v", missing.Names, want)
+	}
+	if want := "mlflow: missing variables: x, y, x"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+// TestRender_MatchesRegexp checks the hand-written scanner against the
+// regular expression it replaced, for templates without escapes.
+func TestRender_MatchesRegexp(t *testing.T) {
+	pattern := regexp.MustCompile(`\{\{(\w+)\}\}`)
+	vars := map[string]string{"a": "1", "b_2": "{{a}}", "": "empty"}
+
+	inputs := []string{
+		"", "plain", "{{a}}", "x{{a}}y{{b_2}}z", "{{{a}}}", "{{a}", "{a}}", "{{}}",
+		"{{ a }}", "{{a {{a}}", "{{a}}}}", "{{é}}", "{{a-b}}", "{{a}}{{a}}", "}}{{",
+	}
+	for _, in := range inputs {
+		want := pattern.ReplaceAllStringFunc(in, func(m string) string { return vars[m[2:len(m)-2]] })
+		got, err := Render(in, vars)
+		if err != nil {
+			t.Errorf("Render(%q) error = %v", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Render(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRender_Escapes(t *testing.T) {
+	vars := map[string]string{"a": "1"}
+	tests := []struct {
+		in, want string
+	}{
+		{`\{{a}}`, "{{a}}"},
+		{`\{{b}} {{a}}`, "{{b}} 1"},
+		{`\{{{a}}}`, "{{{a}}}"},
+		{`a\b {{a}}`, `a\b 1`},
+		{`\{a}}`, `\{a}}`},
+	}
+	for _, tt := range tests {
+		got, err := Render(tt.in, vars)
+		if err != nil || got != tt.want {
+			t.Errorf("Render(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestRenderPartial(t *testing.T) {
+	text := `Hi {{user}}, about {{topic}}: \{{literal}}`
+	partial := RenderPartial(text, map[string]string{"user": "{{admin}}"})
+	if want := `Hi \{{admin}}, about {{topic}}: \{{literal}}`; partial != want {
+		t.Fatalf("RenderPartial() = %q, want %q", partial, want)
+	}
+	if got := Variables(partial); !slices.Equal(got, []string{"topic"}) {
+		t.Errorf("Variables(partial) = %v, want [topic]", got)
+	}
+
+	got, err := Render(partial, map[string]string{"topic": "billing"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "Hi {{admin}}, about billing: {{literal}}"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for _, s := range []string{"", "plain", "{{a}}", "{{{a}}} {{", `\`} {
+		got, err := Render(Escape(s), map[string]string{"a": "x"})
+		if err != nil || got != s {
+			t.Errorf("Render(Escape(%q)) = %q, %v, want input back", s, got, err)
+		}
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	got := Placeholders(`{{a}} \{{b}} {{ c }} {{{d}}}`)
+	want := []Placeholder{{Name: "a", Offset: 0}, {Name: "d", Offset: 22}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Placeholders() = %+v, want %+v", got, want)
+	}
+}
+
+func TestVariables(t *testing.T) {
+	got := Variables("{{b}} {{a}} {{b}} {{a-b}}")
+	if want := []string{"b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+}
+
+func TestIsVariableName(t *testing.T) {
+	for name, want := range map[string]bool{"a": true, "user_id2": true, "": false, "a-b": false, " a": false, "é": false} {
+		if got := IsVariableName(name); got != want {
+			t.Errorf("IsVariableName(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package mlflow
+
+import (
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// TimeoutProfile sets request timeouts by class of request.
+// See WithTimeoutProfile.
+type TimeoutProfile = transport.TimeoutProfile
+
+// DefaultTimeoutProfile returns a profile suited to most workloads: API calls
+// time out after 30 seconds, artifact transfers after 30 minutes, and
+// streamed search responses only when the caller's context ends.
+func DefaultTimeoutProfile() TimeoutProfile {
+	return TimeoutProfile{
+		Metadata: 30 * time.Second,
+		Artifact: 30 * time.Minute,
+	}
+}
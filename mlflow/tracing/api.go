@@ -0,0 +1,22 @@
+package tracing
+
+import (
+	"context"
+)
+
+// API is the set of methods implemented by Client. Accept an API instead of
+// a *Client in code you want to unit test without an MLflow server, and pass
+// a mlflowmock.Tracing in tests.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to stay source compatible.
+type API interface {
+	SearchTraces(ctx context.Context, experimentIDs []string, opts ...SearchTracesOption) (*TraceList, error)
+	SearchTracesCursor(experimentIDs []string, opts ...SearchTracesOption) *Cursor[TraceInfo]
+	GetTraceInfo(ctx context.Context, traceID string) (*TraceInfo, error)
+	GetTrace(ctx context.Context, traceID string) (*Trace, error)
+	LogAssessment(ctx context.Context, a Assessment) (*Assessment, error)
+	LogFeedback(ctx context.Context, traceID, name string, value any, rationale string, opts ...LogFeedbackOption) (*Assessment, error)
+}
+
+var _ API = (*Client)(nil)
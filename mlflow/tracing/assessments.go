@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// defaultAssessmentSource is used when no source is given, matching the
+// Python SDK's mlflow.log_feedback default.
+var defaultAssessmentSource = AssessmentSource{Type: AssessmentSourceCode, ID: "default"}
+
+// serializationFormatJSON marks an expectation value stored as a JSON string.
+const serializationFormatJSON = "JSON_FORMAT"
+
+// LogAssessment records feedback or an expectation against a trace and
+// returns the stored assessment. TraceID, Name, and exactly one of Feedback
+// and Expectation are required; AssessmentID, CreateTime, and
+// LastUpdateTime are assigned by the SDK and server. An empty Source
+// defaults to AssessmentSourceCode.
+func (c *Client) LogAssessment(ctx context.Context, a Assessment) (*Assessment, error) {
+	if a.TraceID == "" {
+		return nil, fmt.Errorf("mlflow: trace ID is required")
+	}
+	segment, err := transport.PathSegment(a.TraceID)
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: invalid trace ID: %w", err)
+	}
+	if a.Name == "" {
+		return nil, fmt.Errorf("mlflow: assessment name is required")
+	}
+	if (a.Feedback == nil) == (a.Expectation == nil) {
+		return nil, fmt.Errorf("mlflow: exactly one of feedback and expectation is required")
+	}
+	if a.Source.Type == "" {
+		a.Source = defaultAssessmentSource
+	}
+
+	w, err := assessmentToWire(a)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp wireAssessmentEnvelope
+
+	err = c.transport.Post(ctx, "/api/3.0/mlflow/traces/"+segment+"/assessments", &wireAssessmentEnvelope{Assessment: w}, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log assessment: %w", err)
+	}
+
+	result := assessmentFromWire(resp.Assessment)
+	return &result, nil
+}
+
+// LogFeedback records feedback, such as a thumbs up/down or a score, against
+// a trace. value must be JSON-serializable (typically bool, a number, or a
+// string); rationale may be empty.
+func (c *Client) LogFeedback(ctx context.Context, traceID, name string, value any, rationale string, opts ...LogFeedbackOption) (*Assessment, error) {
+	if value == nil {
+		return nil, fmt.Errorf("mlflow: feedback value is required")
+	}
+
+	o := &logAssessmentOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return c.LogAssessment(ctx, Assessment{
+		Name:      name,
+		TraceID:   traceID,
+		SpanID:    o.spanID,
+		RunID:     o.runID,
+		Source:    o.source,
+		Feedback:  &Feedback{Value: value},
+		Rationale: rationale,
+		Metadata:  o.metadata,
+	})
+}
+
+func assessmentToWire(a Assessment) (wireAssessment, error) {
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	w := wireAssessment{
+		AssessmentName: a.Name,
+		TraceID:        a.TraceID,
+		SpanID:         a.SpanID,
+		RunID:          a.RunID,
+		Source:         &wireAssessmentSource{SourceType: string(a.Source.Type), SourceID: a.Source.ID},
+		CreateTime:     now,
+		LastUpdateTime: now,
+		Rationale:      a.Rationale,
+		Metadata:       a.Metadata,
+	}
+
+	if a.Feedback != nil {
+		w.Feedback = &wireFeedback{}
+		if a.Feedback.Value != nil {
+			raw, err := json.Marshal(a.Feedback.Value)
+			if err != nil {
+				return wireAssessment{}, fmt.Errorf("mlflow: feedback value is not JSON-serializable: %w", err)
+			}
+			w.Feedback.Value = raw
+		}
+		if e := a.Feedback.Error; e != nil {
+			w.Feedback.Error = &wireFeedbackError{ErrorCode: e.Code, ErrorMessage: e.Message}
+		}
+	}
+
+	if a.Expectation != nil {
+		raw, err := json.Marshal(a.Expectation.Value)
+		if err != nil {
+			return wireAssessment{}, fmt.Errorf("mlflow: expectation value is not JSON-serializable: %w", err)
+		}
+		// Like the Python SDK, structured expectations are sent as serialized
+		// JSON; only scalars use the value field.
+		switch a.Expectation.Value.(type) {
+		case string, bool, float32, float64, int, int32, int64, uint, uint32, uint64:
+			w.Expectation = &wireExpectation{Value: raw}
+		default:
+			w.Expectation = &wireExpectation{SerializedValue: &wireSerializedValue{
+				SerializationFormat: serializationFormatJSON,
+				Value:               string(raw),
+			}}
+		}
+	}
+
+	return w, nil
+}
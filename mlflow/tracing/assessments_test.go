@@ -0,0 +1,168 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestLogFeedback_Success(t *testing.T) {
+	var (
+		receivedPath string
+		received     map[string]any
+	)
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		var body struct {
+			Assessment map[string]any `json:"assessment"`
+		}
+		mustDecodeJSON(t, r, &body)
+		received = body.Assessment
+
+		w.Header().Set("Content-Type", "application/json")
+		body.Assessment["assessment_id"] = "a-new"
+		mustEncodeJSON(t, w, body)
+	}))
+
+	got, err := client.LogFeedback(context.Background(), "tr-1", "helpful", true, "answered the question",
+		WithFeedbackSource(AssessmentSourceHuman, "alice"),
+		WithFeedbackSpanID("span-1"),
+	)
+	if err != nil {
+		t.Fatalf("LogFeedback() error = %v", err)
+	}
+
+	if receivedPath != "/api/3.0/mlflow/traces/tr-1/assessments" {
+		t.Errorf("path = %q", receivedPath)
+	}
+	if received["assessment_name"] != "helpful" || received["trace_id"] != "tr-1" || received["span_id"] != "span-1" {
+		t.Errorf("assessment = %v", received)
+	}
+	if fb, _ := received["feedback"].(map[string]any); fb["value"] != true {
+		t.Errorf("feedback = %v", received["feedback"])
+	}
+	if src, _ := received["source"].(map[string]any); src["source_type"] != "HUMAN" || src["source_id"] != "alice" {
+		t.Errorf("source = %v", received["source"])
+	}
+	if received["create_time"] == nil || received["valid"] != nil || received["expectation"] != nil {
+		t.Errorf("assessment = %v", received)
+	}
+
+	if got.AssessmentID != "a-new" || got.Feedback.Value != true || got.Rationale != "answered the question" {
+		t.Errorf("result = %+v", got)
+	}
+}
+
+func TestLogFeedback_DefaultSource(t *testing.T) {
+	var source map[string]any
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Assessment struct {
+				Source map[string]any `json:"source"`
+			} `json:"assessment"`
+		}
+		mustDecodeJSON(t, r, &body)
+		source = body.Assessment.Source
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"assessment": map[string]any{}})
+	}))
+
+	if _, err := client.LogFeedback(context.Background(), "tr-1", "score", 0.5, ""); err != nil {
+		t.Fatalf("LogFeedback() error = %v", err)
+	}
+	if source["source_type"] != "CODE" || source["source_id"] != "default" {
+		t.Errorf("source = %v", source)
+	}
+}
+
+func TestLogAssessment_Expectation(t *testing.T) {
+	var expectations []map[string]any
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Assessment struct {
+				Expectation map[string]any `json:"expectation"`
+			} `json:"assessment"`
+		}
+		mustDecodeJSON(t, r, &body)
+		expectations = append(expectations, body.Assessment.Expectation)
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"assessment": map[string]any{}})
+	}))
+
+	for _, v := range []any{"hello", map[string]any{"answer": "hello"}} {
+		_, err := client.LogAssessment(context.Background(), Assessment{
+			TraceID:     "tr-1",
+			Name:        "expected",
+			Expectation: &Expectation{Value: v},
+		})
+		if err != nil {
+			t.Fatalf("LogAssessment() error = %v", err)
+		}
+	}
+
+	if expectations[0]["value"] != "hello" || expectations[0]["serialized_value"] != nil {
+		t.Errorf("scalar expectation = %v", expectations[0])
+	}
+	sv, _ := expectations[1]["serialized_value"].(map[string]any)
+	if sv["serialization_format"] != "JSON_FORMAT" || sv["value"] != `{"answer":"hello"}` {
+		t.Errorf("structured expectation = %v", expectations[1])
+	}
+}
+
+func TestLogFeedback_EscapesTraceID(t *testing.T) {
+	tests := []struct {
+		traceID string
+		want    string
+	}{
+		{"tr-1%a b", "/api/3.0/mlflow/traces/tr-1%25a%20b/assessments"},
+		{"tr-1/x", "/api/3.0/mlflow/traces/tr-1%2Fx/assessments"},
+		{"../../2.0/mlflow/runs/delete", "/api/3.0/mlflow/traces/..%2F..%2F2.0%2Fmlflow%2Fruns%2Fdelete/assessments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.traceID, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.EscapedPath(); got != tt.want {
+					t.Errorf("escaped path = %q, want %q", got, tt.want)
+				}
+				var body map[string]any
+				mustDecodeJSON(t, r, &body)
+				w.Header().Set("Content-Type", "application/json")
+				mustEncodeJSON(t, w, body)
+			}))
+
+			if _, err := client.LogFeedback(context.Background(), tt.traceID, "helpful", true, ""); err != nil {
+				t.Fatalf("LogFeedback() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestLogAssessment_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+
+	cases := map[string]Assessment{
+		"no trace ID":   {Name: "n", Feedback: &Feedback{Value: 1}},
+		"dot trace ID":  {TraceID: "..", Name: "n", Feedback: &Feedback{Value: 1}},
+		"no name":       {TraceID: "tr-1", Feedback: &Feedback{Value: 1}},
+		"no value":      {TraceID: "tr-1", Name: "n"},
+		"both values":   {TraceID: "tr-1", Name: "n", Feedback: &Feedback{Value: 1}, Expectation: &Expectation{Value: 1}},
+		"unmarshalable": {TraceID: "tr-1", Name: "n", Feedback: &Feedback{Value: func() {}}},
+	}
+	for name, a := range cases {
+		if _, err := client.LogAssessment(ctx, a); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	if _, err := client.LogFeedback(ctx, "tr-1", "n", nil, ""); err == nil {
+		t.Error("expected error for nil feedback value")
+	}
+}
@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+// defaultSearchMaxResults is the default page size for SearchTraces.
+// Matches the MLflow Python SDK default.
+const defaultSearchMaxResults = 100
+
+// Client provides read access to MLflow traces and their assessments.
+// It is safe for concurrent use.
+//
+// The trace APIs were added in MLflow 3.0; older servers return 404.
+type Client struct {
+	transport *transport.Client
+}
+
+// NewClient creates a new Tracing client.
+// This is typically called internally by the root mlflow.Client.
+func NewClient(t *transport.Client) *Client {
+	return &Client{transport: t}
+}
+
+// SearchTraces searches for traces in the given experiments. The returned
+// TraceInfo values include assessments but not spans; use GetTrace to fetch
+// the spans of a trace.
+func (c *Client) SearchTraces(ctx context.Context, experimentIDs []string, opts ...SearchTracesOption) (*TraceList, error) {
+	if len(experimentIDs) == 0 {
+		return nil, fmt.Errorf("mlflow: at least one experiment ID is required")
+	}
+
+	o := &searchTracesOptions{
+		maxResults: defaultSearchMaxResults,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.maxResults <= 0 {
+		return nil, fmt.Errorf("mlflow: max results must be positive")
+	}
+
+	req := &wireSearchTracesRequest{
+		Filter:     o.filter,
+		MaxResults: o.maxResults,
+		OrderBy:    o.orderBy,
+		PageToken:  o.pageToken,
+	}
+	for _, id := range experimentIDs {
+		req.Locations = append(req.Locations, experimentLocation(id))
+	}
+
+	var resp wireSearchTracesResponse
+
+	err := c.transport.Post(ctx, "/api/3.0/mlflow/traces/search", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+
+	result := &TraceList{
+		Traces:        make([]TraceInfo, 0, len(resp.Traces)),
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, t := range resp.Traces {
+		result.Traces = append(result.Traces, traceInfoFromWire(t))
+	}
+
+	return result, nil
+}
+
+// SearchTracesCursor returns a cursor over all pages of SearchTraces results.
+// WithTracesPageToken sets the starting page; later pages are fetched on demand.
+func (c *Client) SearchTracesCursor(experimentIDs []string, opts ...SearchTracesOption) *Cursor[TraceInfo] {
+	o := &searchTracesOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[TraceInfo], error) {
+		pageOpts := append(slices.Clone(opts), WithTracesPageToken(pageToken))
+		list, err := c.SearchTraces(ctx, experimentIDs, pageOpts...)
+		if err != nil {
+			return Page[TraceInfo]{}, err
+		}
+		return Page[TraceInfo]{Items: list.Traces, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, o.pageToken)
+}
+
+// GetTraceInfo retrieves the metadata and assessments of a trace, without
+// its spans.
+func (c *Client) GetTraceInfo(ctx context.Context, traceID string) (*TraceInfo, error) {
+	if traceID == "" {
+		return nil, fmt.Errorf("mlflow: trace ID is required")
+	}
+
+	segment, err := transport.PathSegment(traceID)
+	if err != nil {
+		return nil, fmt.Errorf("mlflow: invalid trace ID: %w", err)
+	}
+
+	var resp wireTraceResponse
+
+	err = c.transport.Get(ctx, "/api/3.0/mlflow/traces/"+segment, nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trace info: %w", err)
+	}
+
+	info := traceInfoFromWire(resp.Trace.TraceInfo)
+	return &info, nil
+}
+
+// GetTrace retrieves a trace with its spans and assessments.
+func (c *Client) GetTrace(ctx context.Context, traceID string) (*Trace, error) {
+	if traceID == "" {
+		return nil, fmt.Errorf("mlflow: trace ID is required")
+	}
+
+	query := url.Values{
+		"trace_id": []string{traceID},
+	}
+
+	var resp wireTraceResponse
+
+	err := c.transport.Get(ctx, "/api/3.0/mlflow/traces/get", query, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trace: %w", err)
+	}
+
+	trace := &Trace{
+		Info:  traceInfoFromWire(resp.Trace.TraceInfo),
+		Spans: make([]Span, 0, len(resp.Trace.Spans)),
+	}
+	for _, s := range resp.Trace.Spans {
+		trace.Spans = append(trace.Spans, spanFromWire(s))
+	}
+
+	return trace, nil
+}
@@ -0,0 +1,355 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	tc, err := transport.New(transport.Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+
+	return NewClient(tc)
+}
+
+func mustDecodeJSON(t *testing.T, r *http.Request, dst any) {
+	t.Helper()
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+}
+
+func mustEncodeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Fatalf("failed to encode response: %v", err)
+	}
+}
+
+// traceInfoJSON is a trace_info payload as returned by MLflow 3.
+func traceInfoJSON(traceID string) map[string]any {
+	return map[string]any{
+		"trace_id":          traceID,
+		"client_request_id": "req-1",
+		"trace_location": map[string]any{
+			"type":              "MLFLOW_EXPERIMENT",
+			"mlflow_experiment": map[string]any{"experiment_id": "7"},
+		},
+		"request_preview":    `{"question": "hi"}`,
+		"response_preview":   `"hello"`,
+		"request_time":       "2025-06-01T10:00:00.250Z",
+		"execution_duration": "1.500s",
+		"state":              "OK",
+		"trace_metadata":     map[string]any{"mlflow.sourceRun": "run-1"},
+		"tags":               map[string]any{"env": "prod"},
+		"assessments": []map[string]any{
+			{
+				"assessment_id":   "a-1",
+				"assessment_name": "relevance",
+				"trace_id":        traceID,
+				"source":          map[string]any{"source_type": "HUMAN", "source_id": "alice"},
+				"create_time":     "2025-06-01T10:05:00Z",
+				"feedback":        map[string]any{"value": 0.8},
+				"rationale":       "mostly on topic",
+			},
+			{
+				"assessment_id":   "a-2",
+				"assessment_name": "expected_answer",
+				"expectation":     map[string]any{"value": map[string]any{"answer": "hello"}},
+				"valid":           false,
+			},
+			{
+				"assessment_id":   "a-3",
+				"assessment_name": "safety",
+				"feedback": map[string]any{
+					"error": map[string]any{"error_code": "TIMEOUT", "error_message": "judge timed out"},
+				},
+			},
+		},
+	}
+}
+
+// --- SearchTraces tests ---
+
+func TestSearchTraces_Success(t *testing.T) {
+	var req struct {
+		Locations []struct {
+			Type             string `json:"type"`
+			MLflowExperiment struct {
+				ExperimentID string `json:"experiment_id"`
+			} `json:"mlflow_experiment"`
+		} `json:"locations"`
+		Filter     string   `json:"filter"`
+		MaxResults int      `json:"max_results"`
+		OrderBy    []string `json:"order_by"`
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/3.0/mlflow/traces/search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		mustDecodeJSON(t, r, &req)
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"traces":          []map[string]any{traceInfoJSON("tr-1")},
+			"next_page_token": "next",
+		})
+	}))
+
+	list, err := client.SearchTraces(context.Background(), []string{"7", "8"},
+		WithTracesFilter("trace.status = 'OK'"),
+		WithTracesOrderBy("timestamp_ms DESC"),
+	)
+	if err != nil {
+		t.Fatalf("SearchTraces() error = %v", err)
+	}
+
+	if len(req.Locations) != 2 || req.Locations[1].Type != "MLFLOW_EXPERIMENT" || req.Locations[1].MLflowExperiment.ExperimentID != "8" {
+		t.Errorf("locations = %+v", req.Locations)
+	}
+	if req.Filter != "trace.status = 'OK'" || req.MaxResults != defaultSearchMaxResults || len(req.OrderBy) != 1 {
+		t.Errorf("request = %+v", req)
+	}
+
+	if list.NextPageToken != "next" || len(list.Traces) != 1 {
+		t.Fatalf("list = %+v", list)
+	}
+	info := list.Traces[0]
+	if info.TraceID != "tr-1" || info.ExperimentID != "7" || info.State != TraceStateOK {
+		t.Errorf("info = %+v", info)
+	}
+	if !info.RequestTime.Equal(time.Date(2025, 6, 1, 10, 0, 0, 250e6, time.UTC)) {
+		t.Errorf("RequestTime = %v", info.RequestTime)
+	}
+	if info.ExecutionDuration != 1500*time.Millisecond {
+		t.Errorf("ExecutionDuration = %v", info.ExecutionDuration)
+	}
+	if info.Metadata["mlflow.sourceRun"] != "run-1" || info.Tags["env"] != "prod" {
+		t.Errorf("metadata = %v, tags = %v", info.Metadata, info.Tags)
+	}
+}
+
+func TestSearchTraces_Assessments(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"traces": []map[string]any{traceInfoJSON("tr-1")}})
+	}))
+
+	list, err := client.SearchTraces(context.Background(), []string{"7"})
+	if err != nil {
+		t.Fatalf("SearchTraces() error = %v", err)
+	}
+
+	got := list.Traces[0].Assessments
+	if len(got) != 3 {
+		t.Fatalf("got %d assessments, want 3", len(got))
+	}
+
+	fb := got[0]
+	if fb.Name != "relevance" || fb.Feedback == nil || fb.Feedback.Value != 0.8 || fb.Expectation != nil {
+		t.Errorf("feedback = %+v", fb)
+	}
+	if fb.Source != (AssessmentSource{Type: AssessmentSourceHuman, ID: "alice"}) || !fb.Valid || fb.Rationale == "" {
+		t.Errorf("feedback = %+v", fb)
+	}
+
+	exp := got[1]
+	value, ok := exp.Expectation.Value.(map[string]any)
+	if !ok || value["answer"] != "hello" || exp.Valid {
+		t.Errorf("expectation = %+v", exp)
+	}
+
+	failed := got[2]
+	if failed.Feedback.Value != nil || failed.Feedback.Error == nil || failed.Feedback.Error.Code != "TIMEOUT" {
+		t.Errorf("failed feedback = %+v", failed.Feedback)
+	}
+}
+
+func TestSearchTraces_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := client.SearchTraces(context.Background(), nil); err == nil {
+		t.Error("expected error for no experiment IDs")
+	}
+	if _, err := client.SearchTraces(context.Background(), []string{"1"}, WithTracesMaxResults(0)); err == nil {
+		t.Error("expected error for non-positive max results")
+	}
+}
+
+func TestSearchTracesCursor_AllPages(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.PageToken == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"traces":          []map[string]any{{"trace_id": "tr-1"}},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{"traces": []map[string]any{{"trace_id": "tr-2"}}})
+	}))
+
+	traces, err := client.SearchTracesCursor([]string{"1"}).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(traces) != 2 || traces[0].TraceID != "tr-1" || traces[1].TraceID != "tr-2" {
+		t.Errorf("traces = %+v", traces)
+	}
+}
+
+// --- GetTrace tests ---
+
+func TestGetTraceInfo_Success(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/3.0/mlflow/traces/tr-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"trace": map[string]any{"trace_info": traceInfoJSON("tr-1")}})
+	}))
+
+	info, err := client.GetTraceInfo(context.Background(), "tr-1")
+	if err != nil {
+		t.Fatalf("GetTraceInfo() error = %v", err)
+	}
+	if info.TraceID != "tr-1" || len(info.Assessments) != 3 {
+		t.Errorf("info = %+v", info)
+	}
+}
+
+func TestGetTraceInfo_EscapesTraceID(t *testing.T) {
+	tests := []struct {
+		traceID string
+		want    string
+	}{
+		{"tr-1%a b", "/api/3.0/mlflow/traces/tr-1%25a%20b"},
+		{"tr-1/x", "/api/3.0/mlflow/traces/tr-1%2Fx"},
+		{"../../2.0/mlflow/experiments/get", "/api/3.0/mlflow/traces/..%2F..%2F2.0%2Fmlflow%2Fexperiments%2Fget"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.traceID, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.EscapedPath(); got != tt.want {
+					t.Errorf("escaped path = %q, want %q", got, tt.want)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				mustEncodeJSON(t, w, map[string]any{"trace": map[string]any{"trace_info": traceInfoJSON(tt.traceID)}})
+			}))
+
+			if _, err := client.GetTraceInfo(context.Background(), tt.traceID); err != nil {
+				t.Fatalf("GetTraceInfo() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestGetTrace_Success(t *testing.T) {
+	var receivedTraceID string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/3.0/mlflow/traces/get" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		receivedTraceID = r.URL.Query().Get("trace_id")
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"trace": map[string]any{
+			"trace_info": traceInfoJSON("tr-1"),
+			"spans": []map[string]any{{
+				"trace_id":             "ASNFZ4mrze8BI0VniavN7w==",
+				"span_id":              "ASNFZ4mrze8=",
+				"name":                 "predict",
+				"start_time_unix_nano": "1748772000000000000",
+				"end_time_unix_nano":   "1748772001500000000",
+				"status":               map[string]any{"code": "STATUS_CODE_OK"},
+				"attributes": []map[string]any{
+					{"key": "mlflow.spanType", "value": map[string]any{"string_value": `"LLM"`}},
+					{"key": "mlflow.spanInputs", "value": map[string]any{"string_value": `{"question": "hi"}`}},
+					{"key": "raw", "value": map[string]any{"string_value": "not json"}},
+					{"key": "tokens", "value": map[string]any{"int_value": "42"}},
+				},
+			}},
+		}})
+	}))
+
+	trace, err := client.GetTrace(context.Background(), "tr-1")
+	if err != nil {
+		t.Fatalf("GetTrace() error = %v", err)
+	}
+	if receivedTraceID != "tr-1" {
+		t.Errorf("trace_id = %q, want %q", receivedTraceID, "tr-1")
+	}
+	if trace.Info.TraceID != "tr-1" || len(trace.Info.Assessments) != 3 {
+		t.Errorf("info = %+v", trace.Info)
+	}
+	if len(trace.Spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(trace.Spans))
+	}
+
+	span := trace.Spans[0]
+	if span.TraceID != "0123456789abcdef0123456789abcdef" || span.SpanID != "0123456789abcdef" || span.ParentSpanID != "" {
+		t.Errorf("span IDs = %q, %q, %q", span.TraceID, span.SpanID, span.ParentSpanID)
+	}
+	if span.EndTime.Sub(span.StartTime) != 1500*time.Millisecond || span.StatusCode != "STATUS_CODE_OK" {
+		t.Errorf("span = %+v", span)
+	}
+	if span.Attributes["mlflow.spanType"] != "LLM" || span.Attributes["raw"] != "not json" || span.Attributes["tokens"] != int64(42) {
+		t.Errorf("attributes = %v", span.Attributes)
+	}
+	if inputs, ok := span.Attributes["mlflow.spanInputs"].(map[string]any); !ok || inputs["question"] != "hi" {
+		t.Errorf("spanInputs = %v", span.Attributes["mlflow.spanInputs"])
+	}
+}
+
+func TestGetTrace_NotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		mustEncodeJSON(t, w, map[string]any{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "Trace not found"})
+	}))
+
+	_, err := client.GetTrace(context.Background(), "tr-missing")
+	if !errors.IsNotFound(err) {
+		t.Errorf("IsNotFound(err) = false, err = %v", err)
+	}
+}
+
+func TestGetTrace_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := client.GetTrace(context.Background(), ""); err == nil {
+		t.Error("expected error for empty trace ID")
+	}
+	if _, err := client.GetTraceInfo(context.Background(), ""); err == nil {
+		t.Error("expected error for empty trace ID")
+	}
+	for _, id := range []string{".", ".."} {
+		if _, err := client.GetTraceInfo(context.Background(), id); err == nil {
+			t.Errorf("expected error for trace ID %q", id)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package tracing
+
+// searchTracesOptions holds configuration for a SearchTraces call.
+type searchTracesOptions struct {
+	filter     string
+	maxResults int
+	pageToken  string
+	orderBy    []string
+}
+
+// SearchTracesOption configures a SearchTraces call.
+type SearchTracesOption func(*searchTracesOptions)
+
+// WithTracesFilter sets the search filter string for traces.
+// Uses MLflow trace filter syntax (e.g., "trace.status = 'OK'" or
+// "metadata.`mlflow.sourceRun` = '<run_id>'").
+func WithTracesFilter(filter string) SearchTracesOption {
+	return func(o *searchTracesOptions) {
+		o.filter = filter
+	}
+}
+
+// WithTracesMaxResults sets the maximum number of traces per page.
+// The server caps this at 500.
+func WithTracesMaxResults(n int) SearchTracesOption {
+	return func(o *searchTracesOptions) {
+		o.maxResults = n
+	}
+}
+
+// WithTracesPageToken sets the pagination token for fetching the next page.
+func WithTracesPageToken(token string) SearchTracesOption {
+	return func(o *searchTracesOptions) {
+		o.pageToken = token
+	}
+}
+
+// WithTracesOrderBy sets the ordering for trace results
+// (e.g., "timestamp_ms DESC").
+func WithTracesOrderBy(fields ...string) SearchTracesOption {
+	return func(o *searchTracesOptions) {
+		o.orderBy = fields
+	}
+}
+
+// logAssessmentOptions holds configuration for a LogFeedback call.
+type logAssessmentOptions struct {
+	source   AssessmentSource
+	spanID   string
+	runID    string
+	metadata map[string]string
+}
+
+// LogFeedbackOption configures a LogFeedback call.
+type LogFeedbackOption func(*logAssessmentOptions)
+
+// WithFeedbackSource sets who produced the feedback. Defaults to
+// AssessmentSourceCode with ID "default", matching the Python SDK.
+func WithFeedbackSource(sourceType AssessmentSourceType, sourceID string) LogFeedbackOption {
+	return func(o *logAssessmentOptions) {
+		o.source = AssessmentSource{Type: sourceType, ID: sourceID}
+	}
+}
+
+// WithFeedbackSpanID attaches the feedback to a span within the trace
+// instead of the trace as a whole.
+func WithFeedbackSpanID(spanID string) LogFeedbackOption {
+	return func(o *logAssessmentOptions) {
+		o.spanID = spanID
+	}
+}
+
+// WithFeedbackRunID links the feedback to a run, such as the evaluation run
+// that produced it.
+func WithFeedbackRunID(runID string) LogFeedbackOption {
+	return func(o *logAssessmentOptions) {
+		o.runID = runID
+	}
+}
+
+// WithFeedbackMetadata sets metadata on the feedback.
+func WithFeedbackMetadata(metadata map[string]string) LogFeedbackOption {
+	return func(o *logAssessmentOptions) {
+		o.metadata = metadata
+	}
+}
@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// W3C Trace Context headers. MLflow tracing is built on OpenTelemetry, so
+// these are the headers the Python SDK reads and writes
+// (mlflow.tracing.get_tracing_context_headers_for_http_request).
+const (
+	HeaderTraceParent = "traceparent"
+	HeaderTraceState  = "tracestate"
+)
+
+// traceIDPrefix is the prefix MLflow adds to OpenTelemetry trace IDs.
+const traceIDPrefix = "tr-"
+
+// flagSampled is the W3C trace-flags bit for a sampled trace.
+const flagSampled = 0x01
+
+// SpanContext identifies a span across service boundaries.
+// IDs are lowercase hex strings as used by OpenTelemetry.
+type SpanContext struct {
+	TraceID string
+	SpanID  string
+	Sampled bool
+	// TraceState is the opaque vendor state from the tracestate header.
+	TraceState string
+}
+
+// IsValid reports whether sc has a well-formed, non-zero trace and span ID.
+func (sc SpanContext) IsValid() bool {
+	return isHexID(sc.TraceID, 32) && isHexID(sc.SpanID, 16)
+}
+
+// MLflowTraceID returns the trace ID in MLflow form ("tr-<hex>"), as
+// accepted by GetTrace and LogFeedback.
+func (sc SpanContext) MLflowTraceID() string {
+	if sc.TraceID == "" {
+		return ""
+	}
+	return traceIDPrefix + sc.TraceID
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a context carrying sc as the current span.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the current span context, if any.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// Inject writes the span context carried by ctx into header as W3C Trace
+// Context headers, so the receiving service can continue the trace. It does
+// nothing if ctx has no valid span context.
+func Inject(ctx context.Context, header http.Header) {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok || !sc.IsValid() {
+		return
+	}
+
+	var flags byte
+	if sc.Sampled {
+		flags |= flagSampled
+	}
+	header.Set(HeaderTraceParent, fmt.Sprintf("00-%s-%s-%02x", sc.TraceID, sc.SpanID, flags))
+	if sc.TraceState != "" {
+		header.Set(HeaderTraceState, sc.TraceState)
+	}
+}
+
+// Extract reads a span context from W3C Trace Context headers. It reports
+// false if the traceparent header is missing or malformed, in which case the
+// receiving service should start a new trace.
+func Extract(header http.Header) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(header.Get(HeaderTraceParent)), "-")
+	if len(parts) < 4 {
+		return SpanContext{}, false
+	}
+
+	version, traceID, spanID, flagsHex := parts[0], parts[1], parts[2], parts[3]
+	// Version 00 has exactly four fields; later versions may append more.
+	if len(version) != 2 || version == "ff" || (version == "00" && len(parts) != 4) {
+		return SpanContext{}, false
+	}
+	if _, err := hex.DecodeString(version); err != nil {
+		return SpanContext{}, false
+	}
+	flags, err := hex.DecodeString(flagsHex)
+	if err != nil || len(flags) != 1 {
+		return SpanContext{}, false
+	}
+
+	sc := SpanContext{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		Sampled:    flags[0]&flagSampled != 0,
+		TraceState: header.Get(HeaderTraceState),
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, false
+	}
+	return sc, true
+}
+
+// isHexID reports whether s is n lowercase hex digits and not all zeros.
+func isHexID(s string, n int) bool {
+	if len(s) != n || strings.Trim(s, "0") == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
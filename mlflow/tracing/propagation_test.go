@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const (
+	testTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testSpanID  = "00f067aa0ba902b7"
+)
+
+func TestInjectExtract_RoundTrip(t *testing.T) {
+	want := SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampled: true, TraceState: "vendor=a"}
+
+	header := http.Header{}
+	Inject(ContextWithSpanContext(context.Background(), want), header)
+
+	if got := header.Get(HeaderTraceParent); got != "00-"+testTraceID+"-"+testSpanID+"-01" {
+		t.Errorf("traceparent = %q", got)
+	}
+
+	got, ok := Extract(header)
+	if !ok {
+		t.Fatal("Extract() reported no span context")
+	}
+	if got != want {
+		t.Errorf("Extract() = %+v, want %+v", got, want)
+	}
+	if got.MLflowTraceID() != "tr-"+testTraceID {
+		t.Errorf("MLflowTraceID() = %q", got.MLflowTraceID())
+	}
+}
+
+func TestInject_NoSpanContext(t *testing.T) {
+	header := http.Header{}
+
+	Inject(context.Background(), header)
+	Inject(ContextWithSpanContext(context.Background(), SpanContext{TraceID: "bad"}), header)
+
+	if len(header) != 0 {
+		t.Errorf("header = %v, want empty", header)
+	}
+}
+
+func TestExtract_Invalid(t *testing.T) {
+	cases := []string{
+		"",
+		"00-" + testTraceID + "-" + testSpanID,
+		"00-" + testTraceID + "-" + testSpanID + "-01-extra",
+		"ff-" + testTraceID + "-" + testSpanID + "-01",
+		"00-00000000000000000000000000000000-" + testSpanID + "-01",
+		"00-" + testTraceID + "-0000000000000000-01",
+		"00-" + "4BF92F3577B34DA6A3CE929D0E0E4736" + "-" + testSpanID + "-01",
+		"00-" + testTraceID + "-" + testSpanID + "-zz",
+	}
+	for _, tp := range cases {
+		header := http.Header{}
+		header.Set(HeaderTraceParent, tp)
+		if sc, ok := Extract(header); ok {
+			t.Errorf("Extract(%q) = %+v, want invalid", tp, sc)
+		}
+	}
+}
+
+func TestExtract_FutureVersion(t *testing.T) {
+	header := http.Header{}
+	header.Set(HeaderTraceParent, "01-"+testTraceID+"-"+testSpanID+"-00-extra")
+
+	sc, ok := Extract(header)
+	if !ok || sc.Sampled {
+		t.Errorf("Extract() = %+v, %v", sc, ok)
+	}
+}
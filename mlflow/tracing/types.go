@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+)
+
+// TraceState is the final state of a trace.
+type TraceState string
+
+// Trace state constants.
+const (
+	TraceStateUnspecified TraceState = "STATE_UNSPECIFIED"
+	TraceStateOK          TraceState = "OK"
+	TraceStateError       TraceState = "ERROR"
+	TraceStateInProgress  TraceState = "IN_PROGRESS"
+)
+
+// AssessmentSourceType identifies who produced an assessment.
+type AssessmentSourceType string
+
+// Assessment source type constants.
+const (
+	AssessmentSourceHuman  AssessmentSourceType = "HUMAN"
+	AssessmentSourceLLM    AssessmentSourceType = "LLM_JUDGE"
+	AssessmentSourceCode   AssessmentSourceType = "CODE"
+	AssessmentSourceUnspec AssessmentSourceType = "SOURCE_TYPE_UNSPECIFIED"
+)
+
+// TraceInfo is the metadata of a trace, without its spans.
+type TraceInfo struct {
+	TraceID         string
+	ClientRequestID string
+	// ExperimentID is the experiment the trace is stored in.
+	ExperimentID      string
+	RequestPreview    string
+	ResponsePreview   string
+	RequestTime       time.Time
+	ExecutionDuration time.Duration
+	State             TraceState
+	// Metadata holds immutable, system-defined values such as the run ID
+	// (mlflow.sourceRun) that produced the trace.
+	Metadata    map[string]string
+	Tags        map[string]string
+	Assessments []Assessment
+}
+
+// Trace is a trace with its spans.
+type Trace struct {
+	Info  TraceInfo
+	Spans []Span
+}
+
+// Span is one operation within a trace.
+// IDs are lowercase hex strings as used by OpenTelemetry.
+type Span struct {
+	TraceID       string
+	SpanID        string
+	ParentSpanID  string
+	Name          string
+	StartTime     time.Time
+	EndTime       time.Time
+	StatusCode    string
+	StatusMessage string
+	// Attributes holds span attributes. MLflow stores attribute values as
+	// JSON; values that parse as JSON are decoded, others are kept as strings.
+	Attributes map[string]any
+}
+
+// AssessmentSource identifies who produced an assessment.
+type AssessmentSource struct {
+	Type AssessmentSourceType
+	// ID identifies the source, such as a user name or judge model.
+	ID string
+}
+
+// Assessment is feedback or an expectation recorded against a trace.
+// Exactly one of Feedback and Expectation is set.
+type Assessment struct {
+	AssessmentID   string
+	Name           string
+	TraceID        string
+	SpanID         string
+	RunID          string
+	Source         AssessmentSource
+	CreateTime     time.Time
+	LastUpdateTime time.Time
+	Feedback       *Feedback
+	Expectation    *Expectation
+	Rationale      string
+	Metadata       map[string]string
+	// Valid is false when the assessment was overridden by a newer one.
+	Valid bool
+}
+
+// Feedback is an evaluation of a trace, such as a thumbs up/down or a score.
+type Feedback struct {
+	// Value is the decoded JSON value: bool, float64, string, []any, or map[string]any.
+	Value any
+	// Error is set instead of Value when producing the feedback failed.
+	Error *FeedbackError
+}
+
+// FeedbackError describes why feedback could not be produced.
+type FeedbackError struct {
+	Code    string
+	Message string
+}
+
+// Expectation is the expected (ground truth) output for a trace.
+type Expectation struct {
+	// Value is the decoded JSON value.
+	Value any
+}
+
+// TraceList contains traces and a pagination token.
+type TraceList struct {
+	Traces        []TraceInfo
+	NextPageToken string
+}
+
+// Page is one page of results from a Cursor.
+type Page[T any] = pagination.Page[T]
+
+// Cursor iterates over paginated results. See pagination.Cursor.
+type Cursor[T any] = pagination.Cursor[T]
@@ -0,0 +1,318 @@
+package tracing
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The MLflow 3 trace APIs are decoded into the structs below rather than
+// generated protobuf types. The assessment and OpenTelemetry span messages
+// are stubs in internal/gen, and the real messages use well-known types
+// (Timestamp, Duration, Value) whose JSON form encoding/json cannot read.
+// See docs/adr/0010-trace-json-wire-types.md.
+
+// wireTraceLocation is the trace_location oneof. Only experiment locations
+// are supported; OSS MLflow stores every trace in an experiment.
+type wireTraceLocation struct {
+	Type             string                   `json:"type,omitempty"`
+	MLflowExperiment *wireExperimentReference `json:"mlflow_experiment,omitempty"`
+}
+
+type wireExperimentReference struct {
+	ExperimentID string `json:"experiment_id,omitempty"`
+}
+
+const traceLocationExperiment = "MLFLOW_EXPERIMENT"
+
+func experimentLocation(experimentID string) wireTraceLocation {
+	return wireTraceLocation{
+		Type:             traceLocationExperiment,
+		MLflowExperiment: &wireExperimentReference{ExperimentID: experimentID},
+	}
+}
+
+type wireSearchTracesRequest struct {
+	Locations  []wireTraceLocation `json:"locations"`
+	Filter     string              `json:"filter,omitempty"`
+	MaxResults int                 `json:"max_results,omitempty"`
+	OrderBy    []string            `json:"order_by,omitempty"`
+	PageToken  string              `json:"page_token,omitempty"`
+}
+
+type wireSearchTracesResponse struct {
+	Traces        []wireTraceInfo `json:"traces"`
+	NextPageToken string          `json:"next_page_token"`
+}
+
+// wireAssessmentEnvelope is the body of assessment requests and responses.
+type wireAssessmentEnvelope struct {
+	Assessment wireAssessment `json:"assessment"`
+}
+
+type wireTraceResponse struct {
+	Trace wireTrace `json:"trace"`
+}
+
+type wireTrace struct {
+	TraceInfo wireTraceInfo `json:"trace_info"`
+	Spans     []wireSpan    `json:"spans"`
+}
+
+type wireTraceInfo struct {
+	TraceID           string            `json:"trace_id"`
+	ClientRequestID   string            `json:"client_request_id"`
+	TraceLocation     wireTraceLocation `json:"trace_location"`
+	RequestPreview    string            `json:"request_preview"`
+	ResponsePreview   string            `json:"response_preview"`
+	RequestTime       string            `json:"request_time"`
+	ExecutionDuration string            `json:"execution_duration"`
+	State             string            `json:"state"`
+	TraceMetadata     map[string]string `json:"trace_metadata"`
+	Tags              map[string]string `json:"tags"`
+	Assessments       []wireAssessment  `json:"assessments"`
+}
+
+// wireAssessment is used for both responses and create requests.
+type wireAssessment struct {
+	AssessmentID   string                `json:"assessment_id,omitempty"`
+	AssessmentName string                `json:"assessment_name,omitempty"`
+	TraceID        string                `json:"trace_id,omitempty"`
+	SpanID         string                `json:"span_id,omitempty"`
+	RunID          string                `json:"run_id,omitempty"`
+	Source         *wireAssessmentSource `json:"source,omitempty"`
+	CreateTime     string                `json:"create_time,omitempty"`
+	LastUpdateTime string                `json:"last_update_time,omitempty"`
+	Feedback       *wireFeedback         `json:"feedback,omitempty"`
+	Expectation    *wireExpectation      `json:"expectation,omitempty"`
+	Rationale      string                `json:"rationale,omitempty"`
+	Metadata       map[string]string     `json:"metadata,omitempty"`
+	Valid          *bool                 `json:"valid,omitempty"`
+}
+
+type wireAssessmentSource struct {
+	SourceType string `json:"source_type,omitempty"`
+	SourceID   string `json:"source_id,omitempty"`
+}
+
+type wireFeedback struct {
+	Value json.RawMessage    `json:"value,omitempty"`
+	Error *wireFeedbackError `json:"error,omitempty"`
+}
+
+type wireFeedbackError struct {
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+type wireExpectation struct {
+	Value           json.RawMessage      `json:"value,omitempty"`
+	SerializedValue *wireSerializedValue `json:"serialized_value,omitempty"`
+}
+
+type wireSerializedValue struct {
+	SerializationFormat string `json:"serialization_format,omitempty"`
+	Value               string `json:"value,omitempty"`
+}
+
+// wireSpan is an OpenTelemetry span in protobuf JSON form: IDs are base64
+// and 64-bit integers are strings.
+type wireSpan struct {
+	TraceID           string          `json:"trace_id"`
+	SpanID            string          `json:"span_id"`
+	ParentSpanID      string          `json:"parent_span_id"`
+	Name              string          `json:"name"`
+	StartTimeUnixNano json.RawMessage `json:"start_time_unix_nano"`
+	EndTimeUnixNano   json.RawMessage `json:"end_time_unix_nano"`
+	Attributes        []wireKeyValue  `json:"attributes"`
+	Status            *wireSpanStatus `json:"status"`
+}
+
+type wireKeyValue struct {
+	Key   string       `json:"key"`
+	Value wireAnyValue `json:"value"`
+}
+
+type wireAnyValue struct {
+	StringValue *string         `json:"string_value"`
+	BoolValue   *bool           `json:"bool_value"`
+	IntValue    json.RawMessage `json:"int_value"`
+	DoubleValue *float64        `json:"double_value"`
+}
+
+type wireSpanStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func traceInfoFromWire(w wireTraceInfo) TraceInfo {
+	info := TraceInfo{
+		TraceID:           w.TraceID,
+		ClientRequestID:   w.ClientRequestID,
+		RequestPreview:    w.RequestPreview,
+		ResponsePreview:   w.ResponsePreview,
+		RequestTime:       parseTimestamp(w.RequestTime),
+		ExecutionDuration: parseDuration(w.ExecutionDuration),
+		State:             TraceState(w.State),
+		Metadata:          w.TraceMetadata,
+		Tags:              w.Tags,
+	}
+	if w.TraceLocation.MLflowExperiment != nil {
+		info.ExperimentID = w.TraceLocation.MLflowExperiment.ExperimentID
+	}
+	if info.Metadata == nil {
+		info.Metadata = make(map[string]string)
+	}
+	if info.Tags == nil {
+		info.Tags = make(map[string]string)
+	}
+	for _, a := range w.Assessments {
+		info.Assessments = append(info.Assessments, assessmentFromWire(a))
+	}
+	return info
+}
+
+func assessmentFromWire(w wireAssessment) Assessment {
+	a := Assessment{
+		AssessmentID:   w.AssessmentID,
+		Name:           w.AssessmentName,
+		TraceID:        w.TraceID,
+		SpanID:         w.SpanID,
+		RunID:          w.RunID,
+		CreateTime:     parseTimestamp(w.CreateTime),
+		LastUpdateTime: parseTimestamp(w.LastUpdateTime),
+		Rationale:      w.Rationale,
+		Metadata:       w.Metadata,
+		// The server omits valid when it is the default (true).
+		Valid: w.Valid == nil || *w.Valid,
+	}
+	if w.Source != nil {
+		a.Source = AssessmentSource{Type: AssessmentSourceType(w.Source.SourceType), ID: w.Source.SourceID}
+	}
+	if w.Feedback != nil {
+		a.Feedback = &Feedback{Value: decodeJSONValue(w.Feedback.Value)}
+		if w.Feedback.Error != nil {
+			a.Feedback.Error = &FeedbackError{Code: w.Feedback.Error.ErrorCode, Message: w.Feedback.Error.ErrorMessage}
+		}
+	}
+	if w.Expectation != nil {
+		a.Expectation = &Expectation{Value: decodeJSONValue(w.Expectation.Value)}
+		if sv := w.Expectation.SerializedValue; sv != nil && w.Expectation.Value == nil {
+			a.Expectation.Value = decodeJSONString(sv.Value)
+		}
+	}
+	return a
+}
+
+func spanFromWire(w wireSpan) Span {
+	s := Span{
+		TraceID:      otelID(w.TraceID),
+		SpanID:       otelID(w.SpanID),
+		ParentSpanID: otelID(w.ParentSpanID),
+		Name:         w.Name,
+		StartTime:    parseUnixNano(w.StartTimeUnixNano),
+		EndTime:      parseUnixNano(w.EndTimeUnixNano),
+		Attributes:   make(map[string]any, len(w.Attributes)),
+	}
+	if w.Status != nil {
+		s.StatusCode = w.Status.Code
+		s.StatusMessage = w.Status.Message
+	}
+	for _, kv := range w.Attributes {
+		s.Attributes[kv.Key] = kv.Value.decode()
+	}
+	return s
+}
+
+// decode returns the attribute value. MLflow writes every attribute as a
+// JSON-encoded string, so string values are decoded as JSON when possible.
+func (v wireAnyValue) decode() any {
+	switch {
+	case v.StringValue != nil:
+		return decodeJSONString(*v.StringValue)
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	case v.IntValue != nil:
+		n, _ := strconv.ParseInt(strings.Trim(string(v.IntValue), `"`), 10, 64)
+		return n
+	default:
+		return nil
+	}
+}
+
+// decodeJSONValue decodes a raw JSON value, returning nil for absent values.
+func decodeJSONValue(raw json.RawMessage) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	return v
+}
+
+// decodeJSONString decodes s as JSON, falling back to s itself when it is
+// not valid JSON.
+func decodeJSONString(s string) any {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+// otelID converts a base64-encoded OpenTelemetry ID to lowercase hex.
+// IDs that are not base64 are returned unchanged.
+func otelID(s string) string {
+	if s == "" {
+		return ""
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return s
+	}
+	return hex.EncodeToString(b)
+}
+
+// parseTimestamp parses a protobuf Timestamp in JSON form (RFC 3339).
+func parseTimestamp(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// parseDuration parses a protobuf Duration in JSON form ("1.5s").
+func parseDuration(s string) time.Duration {
+	if s == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// parseUnixNano parses a 64-bit nanosecond timestamp encoded as a JSON
+// string or number.
+func parseUnixNano(raw json.RawMessage) time.Time {
+	if len(raw) == 0 {
+		return time.Time{}
+	}
+	n, err := strconv.ParseInt(strings.Trim(string(raw), `"`), 10, 64)
+	if err != nil || n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
@@ -0,0 +1,326 @@
+package tracking
+
+import (
+	"cmp"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// Defaults for SearchRunsAcross.
+const (
+	defaultAcrossConcurrency = 4
+	defaultAcrossBatchSize   = 20
+	defaultAcrossOrderBy     = "start_time DESC"
+)
+
+// SearchRunsAcross searches runs in every experiment matching expFilter.
+//
+// It lists the matching experiments, splits their IDs into batches, and runs
+// SearchRuns with runFilter for each batch with bounded concurrency. The
+// merged runs are sorted by WithAcrossOrderBy (start_time DESC by default).
+// An empty expFilter matches every active experiment and an empty runFilter
+// matches every run.
+//
+// Each batch is sorted and truncated by the server before merging, so
+// WithAcrossMaxResults returns the true top runs across all experiments.
+// Supported order_by keys are the run attributes (start_time, end_time,
+// run_name, status, run_id, user_id, experiment_id) and metrics.<key>,
+// params.<key>, and tags.<key>. Runs missing a sort key are ordered last.
+func SearchRunsAcross(ctx context.Context, c *Client, expFilter, runFilter string, opts ...SearchRunsAcrossOption) ([]Run, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+
+	o := &searchRunsAcrossOptions{
+		concurrency: defaultAcrossConcurrency,
+		batchSize:   defaultAcrossBatchSize,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	o.concurrency = max(o.concurrency, 1)
+	o.batchSize = max(o.batchSize, 1)
+	if o.maxResults < 0 {
+		return nil, fmt.Errorf("mlflow: max results must not be negative")
+	}
+	if len(o.orderBy) == 0 {
+		o.orderBy = []string{defaultAcrossOrderBy}
+	}
+
+	order, err := parseRunOrder(o.orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var expOpts []SearchExperimentsOption
+	if expFilter != "" {
+		expOpts = append(expOpts, WithExperimentsFilter(expFilter))
+	}
+	experiments, err := c.SearchExperimentsCursor(expOpts...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(experiments) == 0 {
+		return []Run{}, nil
+	}
+
+	ids := make([]string, 0, len(experiments))
+	for _, exp := range experiments {
+		ids = append(ids, exp.ID)
+	}
+
+	runOpts := []SearchRunsOption{WithRunsOrderBy(o.orderBy...)}
+	if runFilter != "" {
+		runOpts = append(runOpts, WithRunsFilter(runFilter))
+	}
+	if o.viewType != "" {
+		runOpts = append(runOpts, WithRunsViewType(o.viewType))
+	}
+	if o.maxResults > 0 && o.maxResults < defaultSearchMaxResults {
+		runOpts = append(runOpts, WithRunsMaxResults(o.maxResults))
+	}
+
+	runs, err := c.searchRunsBatches(ctx, slices.Collect(slices.Chunk(ids, o.batchSize)), runOpts, o)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(runs, order.compare)
+	if o.maxResults > 0 && len(runs) > o.maxResults {
+		runs = runs[:o.maxResults]
+	}
+	return runs, nil
+}
+
+// searchRunsBatches runs one paginated search per batch of experiment IDs,
+// with at most o.concurrency searches in flight. The first error cancels
+// the remaining searches; the returned *errors.MultiError lists the batches
+// that failed, leaving out those that were only cut short.
+func (c *Client) searchRunsBatches(ctx context.Context, batches [][]string, runOpts []SearchRunsOption, o *searchRunsAcrossOptions) ([]Run, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		runs []Run
+		errs errors.MultiError
+	)
+	sem := make(chan struct{}, o.concurrency)
+
+	for _, batch := range batches {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(experimentIDs []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			batchRuns, err := c.searchRunsBatch(ctx, experimentIDs, runOpts, o.maxResults)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if len(errs.Errors) == 0 || !stderrors.Is(err, context.Canceled) {
+					errs.Add(-1, "experiments "+strings.Join(experimentIDs, ","), err)
+				}
+				cancel()
+				return
+			}
+			runs = append(runs, batchRuns...)
+		}(batch)
+	}
+
+	wg.Wait()
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// searchRunsBatch collects up to limit runs (0 = all) for one batch.
+func (c *Client) searchRunsBatch(ctx context.Context, experimentIDs []string, runOpts []SearchRunsOption, limit int) ([]Run, error) {
+	cursor := c.SearchRunsCursor(experimentIDs, runOpts...)
+
+	var runs []Run
+	for limit == 0 || len(runs) < limit {
+		page, ok := cursor.Next(ctx)
+		if !ok {
+			break
+		}
+		runs = append(runs, page.Items...)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// runOrder is a parsed order_by list that can sort runs client-side.
+type runOrder []runOrderKey
+
+type runOrderKey struct {
+	kind string // "attributes", "metrics", "params", or "tags"
+	name string
+	desc bool
+	now  time.Time // end of unfinished runs for durationOrderKey
+}
+
+// durationOrderKey is a derived attribute key for sorting by RunInfo.Duration.
+// The server cannot sort by it, so parseRunOrder does not accept it; only
+// SortRunsByDuration uses it.
+const durationOrderKey = "duration"
+
+// parseRunOrder parses clauses such as "metrics.rmse ASC" or
+// "attributes.start_time DESC" into a runOrder.
+func parseRunOrder(clauses []string) (runOrder, error) {
+	order := make(runOrder, 0, len(clauses))
+	for _, clause := range clauses {
+		field := strings.TrimSpace(clause)
+		var desc bool
+		// A quoted key may contain spaces, so only a trailing unquoted word is a direction.
+		if i := strings.LastIndexAny(field, " \t"); i >= 0 && !strings.HasSuffix(field, "`") && !strings.HasSuffix(field, `"`) {
+			switch strings.ToUpper(field[i+1:]) {
+			case "ASC":
+			case "DESC":
+				desc = true
+			default:
+				return nil, fmt.Errorf("mlflow: invalid order_by direction in %q", clause)
+			}
+			field = strings.TrimSpace(field[:i])
+		}
+		if field == "" {
+			return nil, fmt.Errorf("mlflow: invalid order_by clause %q", clause)
+		}
+
+		key := runOrderKey{kind: "attributes", name: field, desc: desc}
+		if prefix, name, ok := strings.Cut(field, "."); ok {
+			switch prefix {
+			case "metric", "metrics":
+				key.kind = "metrics"
+			case "param", "params", "parameter", "parameters":
+				key.kind = "params"
+			case "tag", "tags":
+				key.kind = "tags"
+			case "attribute", "attributes", "attr", "run":
+				key.kind = "attributes"
+			default:
+				return nil, fmt.Errorf("mlflow: unsupported order_by key %q", field)
+			}
+			key.name = strings.Trim(name, "`\"")
+		}
+
+		if key.kind == "attributes" && !runOrderAttributes[key.name] {
+			return nil, fmt.Errorf("mlflow: unsupported order_by attribute %q", key.name)
+		}
+		order = append(order, key)
+	}
+	return order, nil
+}
+
+// compare orders a before b. Missing values sort last in either direction
+// and run ID breaks ties so the result is deterministic.
+func (o runOrder) compare(a, b Run) int {
+	for _, key := range o {
+		av, aok := key.value(a)
+		bv, bok := key.value(b)
+		switch {
+		case !aok && !bok:
+			continue
+		case !aok:
+			return 1
+		case !bok:
+			return -1
+		}
+
+		c := av.compare(bv)
+		if key.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(a.Info.RunID, b.Info.RunID)
+}
+
+// sortValue is a numeric or string value extracted from a run.
+type sortValue struct {
+	num     float64
+	str     string
+	numeric bool
+}
+
+func (v sortValue) compare(other sortValue) int {
+	if v.numeric && other.numeric {
+		return cmp.Compare(v.num, other.num)
+	}
+	return cmp.Compare(v.str, other.str)
+}
+
+func (k runOrderKey) value(r Run) (sortValue, bool) {
+	switch k.kind {
+	case "metrics":
+		m, ok := r.LatestMetric(k.name)
+		// NaN is unordered, so treat it like a missing value
+		return sortValue{num: m.Value, numeric: true}, ok && !math.IsNaN(m.Value)
+	case "params":
+		v, ok := r.Param(k.name)
+		return sortValue{str: v}, ok
+	case "tags":
+		v, ok := r.Data.Tags[k.name]
+		return sortValue{str: v}, ok
+	}
+
+	switch k.name {
+	case "start_time":
+		return timeSortValue(r.Info.StartTime.UnixMilli(), !r.Info.StartTime.IsZero())
+	case "end_time":
+		return timeSortValue(r.Info.EndTime.UnixMilli(), !r.Info.EndTime.IsZero())
+	case durationOrderKey:
+		if r.Info.StartTime.IsZero() {
+			return sortValue{}, false
+		}
+		end := r.Info.EndTime
+		if end.IsZero() {
+			end = k.now
+		}
+		return sortValue{num: float64(max(end.Sub(r.Info.StartTime), 0)), numeric: true}, true
+	case "run_name":
+		return sortValue{str: r.Info.RunName}, true
+	case "status":
+		return sortValue{str: string(r.Info.Status)}, true
+	case "user_id":
+		return sortValue{str: r.Info.UserID}, true
+	case "experiment_id":
+		// Experiment IDs are numeric on OSS MLflow; fall back to string order otherwise.
+		if n, err := strconv.ParseFloat(r.Info.ExperimentID, 64); err == nil {
+			return sortValue{num: n, numeric: true}, true
+		}
+		return sortValue{str: r.Info.ExperimentID}, true
+	default: // run_id, run_uuid
+		return sortValue{str: r.Info.RunID}, true
+	}
+}
+
+func timeSortValue(ms int64, ok bool) (sortValue, bool) {
+	return sortValue{num: float64(ms), numeric: true}, ok
+}
@@ -0,0 +1,194 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// acrossServer serves three experiments whose runs carry an "acc" metric.
+func acrossServer(t *testing.T, failExperiment string) http.Handler {
+	runsByExp := map[string][]map[string]any{
+		"1": {runJSON("r1", "1", 0.70), runJSON("r2", "1", 0.95)},
+		"2": {runJSON("r3", "2", 0.80)},
+		"3": {runJSON("r4", "3", 0.90), {"info": map[string]any{"run_id": "r5", "experiment_id": "3"}, "data": map[string]any{}}},
+	}
+
+	var mu sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/experiments/search":
+			var req struct {
+				Filter string `json:"filter"`
+			}
+			mustDecodeJSON(t, r, &req)
+			if req.Filter != "name LIKE 'team-a/%'" {
+				t.Errorf("experiment filter = %q", req.Filter)
+			}
+			mustEncodeJSON(t, w, map[string]any{
+				"experiments": []map[string]any{
+					{"experiment_id": "1"}, {"experiment_id": "2"}, {"experiment_id": "3"},
+				},
+			})
+		case "/api/2.0/mlflow/runs/search":
+			var req struct {
+				ExperimentIDs []string `json:"experiment_ids"`
+				Filter        string   `json:"filter"`
+				OrderBy       []string `json:"order_by"`
+			}
+			mustDecodeJSON(t, r, &req)
+			if req.Filter != "params.model = 'x'" {
+				t.Errorf("run filter = %q", req.Filter)
+			}
+			if len(req.OrderBy) != 1 || req.OrderBy[0] != "metrics.acc DESC" {
+				t.Errorf("order_by = %v", req.OrderBy)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			var runs []map[string]any
+			for _, id := range req.ExperimentIDs {
+				if id == failExperiment {
+					w.WriteHeader(http.StatusInternalServerError)
+					mustEncodeJSON(t, w, map[string]string{"error_code": "INTERNAL_ERROR", "message": "boom"})
+					return
+				}
+				runs = append(runs, runsByExp[id]...)
+			}
+			mustEncodeJSON(t, w, map[string]any{"runs": runs})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func runJSON(runID, expID string, acc float64) map[string]any {
+	return map[string]any{
+		"info": map[string]any{"run_id": runID, "experiment_id": expID},
+		"data": map[string]any{"metrics": []map[string]any{{"key": "acc", "value": acc}}},
+	}
+}
+
+func TestSearchRunsAcross_MergesAndSorts(t *testing.T) {
+	client := newTestClient(t, acrossServer(t, ""))
+
+	runs, err := SearchRunsAcross(context.Background(), client, "name LIKE 'team-a/%'", "params.model = 'x'",
+		WithAcrossOrderBy("metrics.acc DESC"),
+		WithAcrossBatchSize(1),
+		WithAcrossConcurrency(2),
+	)
+	if err != nil {
+		t.Fatalf("SearchRunsAcross() error = %v", err)
+	}
+
+	want := []string{"r2", "r4", "r3", "r1", "r5"}
+	if len(runs) != len(want) {
+		t.Fatalf("got %d runs, want %d", len(runs), len(want))
+	}
+	for i, id := range want {
+		if runs[i].Info.RunID != id {
+			t.Errorf("runs[%d] = %s, want %s", i, runs[i].Info.RunID, id)
+		}
+	}
+}
+
+func TestSearchRunsAcross_MaxResults(t *testing.T) {
+	client := newTestClient(t, acrossServer(t, ""))
+
+	runs, err := SearchRunsAcross(context.Background(), client, "name LIKE 'team-a/%'", "params.model = 'x'",
+		WithAcrossOrderBy("metrics.acc DESC"),
+		WithAcrossMaxResults(2),
+	)
+	if err != nil {
+		t.Fatalf("SearchRunsAcross() error = %v", err)
+	}
+
+	if len(runs) != 2 || runs[0].Info.RunID != "r2" || runs[1].Info.RunID != "r4" {
+		t.Errorf("runs = %v, want [r2 r4]", runIDs(runs))
+	}
+}
+
+func TestSearchRunsAcross_PropagatesError(t *testing.T) {
+	client := newTestClient(t, acrossServer(t, "2"))
+
+	_, err := SearchRunsAcross(context.Background(), client, "name LIKE 'team-a/%'", "params.model = 'x'",
+		WithAcrossOrderBy("metrics.acc DESC"),
+		WithAcrossBatchSize(1),
+	)
+	var multi *errors.MultiError
+	if !stderrors.As(err, &multi) {
+		t.Fatalf("expected *MultiError when one batch fails, got %v", err)
+	}
+	if len(multi.Errors) != 1 || multi.Errors[0].Resource != "experiments 2" {
+		t.Errorf("item errors = %v, want one for experiments 2", multi.Errors)
+	}
+}
+
+func TestSearchRunsAcross_InvalidOrderBy(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	for _, orderBy := range []string{"metrics.acc SIDEWAYS", "datasets.x", "unknown_attr", "a b c"} {
+		if _, err := SearchRunsAcross(context.Background(), client, "", "", WithAcrossOrderBy(orderBy)); err == nil {
+			t.Errorf("expected error for order_by %q", orderBy)
+		}
+	}
+	if _, err := SearchRunsAcross(context.Background(), nil, "", ""); err == nil {
+		t.Error("expected error for nil client")
+	}
+}
+
+func TestRunOrder_Compare(t *testing.T) {
+	early := Run{
+		Info: RunInfo{RunID: "a", StartTime: time.UnixMilli(1000)},
+		Data: RunData{Tags: map[string]string{"team": "x"}, Metrics: []Metric{{Key: "val loss", Value: 0.2}}},
+	}
+	late := Run{Info: RunInfo{RunID: "b", StartTime: time.UnixMilli(2000)}}
+
+	tests := []struct {
+		orderBy string
+		first   Run
+	}{
+		{"start_time DESC", late},
+		{"attributes.start_time ASC", early},
+		{"tags.team DESC", early},  // missing values last
+		{"tags.`team` ASC", early}, // quoted key
+		{"metrics.`val loss` DESC", early},
+		{"metrics.`val loss`", early},
+		{"run_id DESC", late},         // string attribute
+		{"params.missing ASC", early}, // all missing: run ID tiebreak
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			order, err := parseRunOrder([]string{tt.orderBy})
+			if err != nil {
+				t.Fatalf("parseRunOrder() error = %v", err)
+			}
+			got := []Run{early, late}
+			if order.compare(late, early) < 0 {
+				got = []Run{late, early}
+			}
+			if got[0].Info.RunID != tt.first.Info.RunID {
+				t.Errorf("first = %s, want %s", got[0].Info.RunID, tt.first.Info.RunID)
+			}
+		})
+	}
+}
+
+func runIDs(runs []Run) []string {
+	ids := make([]string, 0, len(runs))
+	for _, r := range runs {
+		ids = append(ids, r.Info.RunID)
+	}
+	return ids
+}
@@ -0,0 +1,68 @@
+package tracking
+
+import (
+	"context"
+	"io"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+)
+
+// API is the set of methods implemented by Client. Accept an API instead of
+// a *Client in code you want to unit test without an MLflow server, and pass
+// a mlflowmock.Tracking in tests.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to stay source compatible.
+type API interface {
+	// Experiments
+	CreateExperiment(ctx context.Context, name string, opts ...CreateExperimentOption) (string, error)
+	GetExperiment(ctx context.Context, experimentID string) (*Experiment, error)
+	GetExperimentOrNil(ctx context.Context, experimentID string) (*Experiment, error)
+	GetExperimentByName(ctx context.Context, name string) (*Experiment, error)
+	ExperimentExists(ctx context.Context, name string) (bool, error)
+	UpdateExperiment(ctx context.Context, experimentID, name string) error
+	DeleteExperiment(ctx context.Context, experimentID string, opts ...DeleteOption) error
+	SearchExperiments(ctx context.Context, opts ...SearchExperimentsOption) (*ExperimentList, error)
+	SearchExperimentsCursor(opts ...SearchExperimentsOption) *Cursor[Experiment]
+	SetExperimentTag(ctx context.Context, experimentID, key, value string) error
+	SetExperimentTags(ctx context.Context, experimentID string, tags map[string]string) error
+	UpdateExperimentTags(ctx context.Context, experimentID string, mutate func(cur map[string]string) map[string]string) error
+	ListDeletedExperiments(ctx context.Context) ([]Experiment, error)
+	HardDeleteExperiment(ctx context.Context, experimentID string, opts ...DeleteOption) error
+
+	// Permissions
+	GetExperimentPermission(ctx context.Context, experimentID, username string) (*ExperimentPermission, error)
+	CreateExperimentPermission(ctx context.Context, experimentID, username string, permission Permission) error
+	UpdateExperimentPermission(ctx context.Context, experimentID, username string, permission Permission) error
+	DeleteExperimentPermission(ctx context.Context, experimentID, username string) error
+
+	// Runs
+	CreateRun(ctx context.Context, experimentID string, opts ...CreateRunOption) (*Run, error)
+	GetRun(ctx context.Context, runID string, opts ...GetRunOption) (*Run, error)
+	UpdateRun(ctx context.Context, runID string, opts ...UpdateRunOption) (*RunInfo, error)
+	DeleteRun(ctx context.Context, runID string, opts ...DeleteOption) error
+	SearchRuns(ctx context.Context, experimentIDs []string, opts ...SearchRunsOption) (*RunList, error)
+	SearchRunsCursor(experimentIDs []string, opts ...SearchRunsOption) *Cursor[Run]
+	StreamRuns(ctx context.Context, experimentIDs []string, fn func(Run) error, opts ...SearchRunsOption) error
+	ListDeletedRuns(ctx context.Context, experimentID string) ([]Run, error)
+
+	// Logging
+	LogMetric(ctx context.Context, runID, key string, value float64, opts ...LogMetricOption) error
+	LogParam(ctx context.Context, runID, key, value string) error
+	SetTag(ctx context.Context, runID, key, value string) error
+	DeleteTag(ctx context.Context, runID, key string) error
+	LogBatch(ctx context.Context, runID string, metrics []Metric, params []Param, tags map[string]string, opts ...LogBatchOption) error
+	GetMetricHistory(ctx context.Context, runID, key string) ([]Metric, error)
+	LogAssessment(ctx context.Context, runID string, a tracing.Assessment) (*tracing.Assessment, error)
+
+	// Artifacts
+	LogArtifact(ctx context.Context, runID, localPath, artifactPath string) error
+	LogArtifacts(ctx context.Context, runID, localDir, artifactPath string) error
+	OpenArtifact(ctx context.Context, runID, artifactPath string) (io.ReadCloser, error)
+	CreateArtifact(ctx context.Context, runID, artifactPath string) (io.WriteCloser, error)
+	DownloadArtifact(ctx context.Context, runID, artifactPath, localPath string, opts ...DownloadArtifactOption) (string, error)
+	LogRunRecipe(ctx context.Context, runID string, recipe *RunRecipe) error
+	LoadRunRecipe(ctx context.Context, runID string) (*RunRecipe, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,215 @@
+package tracking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// digestPrefix starts the artifact checksums used by ArtifactCache.
+const digestPrefix = "sha256:"
+
+// ArtifactCache is a local directory of downloaded artifacts, stored once
+// per checksum, so repeated downloads of the same artifact, such as a model
+// pulled by every pod that mounts the directory or by a restarted process,
+// are served from disk. It is safe for concurrent use by several goroutines
+// and processes sharing the directory.
+//
+// The cache has two parts: blobs/sha256/<hex> holds artifact contents by
+// checksum, and refs/ maps each downloaded run artifact to its checksum.
+// Run artifacts are assumed not to change once logged; if one is replaced,
+// remove the cache directory or download it with a new WithDownloadDigest.
+// Nothing is evicted; remove old blobs with an external policy, such as a
+// cron job deleting files by access time.
+type ArtifactCache struct {
+	dir string
+}
+
+// NewArtifactCache returns a cache stored in dir, creating it if needed.
+func NewArtifactCache(dir string) (*ArtifactCache, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("mlflow: cache directory is required")
+	}
+	for _, sub := range []string{"blobs/sha256", "refs", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.FromSlash(sub)), 0o755); err != nil { //nolint:gosec // shared between pods
+			return nil, fmt.Errorf("failed to create artifact cache: %w", err)
+		}
+	}
+	return &ArtifactCache{dir: dir}, nil
+}
+
+// Blob returns the path of the cached contents with the given checksum, as
+// "sha256:<hex>", and whether the cache holds them. The file must not be
+// modified.
+func (c *ArtifactCache) Blob(digest string) (string, bool) {
+	hexDigest, err := parseDigest(digest)
+	if err != nil {
+		return "", false
+	}
+	p := filepath.Join(c.dir, "blobs", "sha256", hexDigest)
+	if _, err := os.Stat(p); err != nil {
+		return "", false
+	}
+	return p, true
+}
+
+// refPath returns the path of the ref for an artifact of a run.
+func (c *ArtifactCache) refPath(runID, artifactPath string) string {
+	sum := sha256.Sum256([]byte(runID + "\x00" + path.Clean(artifactPath)))
+	return filepath.Join(c.dir, "refs", hex.EncodeToString(sum[:]))
+}
+
+// lookup returns the checksum recorded for an artifact of a run, if its
+// blob is still cached.
+func (c *ArtifactCache) lookup(runID, artifactPath string) (string, bool) {
+	data, err := os.ReadFile(c.refPath(runID, artifactPath))
+	if err != nil {
+		return "", false
+	}
+	digest := strings.TrimSpace(string(data))
+	if _, ok := c.Blob(digest); !ok {
+		return "", false
+	}
+	return digest, true
+}
+
+// add moves the file at tmp into the cache as the blob for digest and
+// records it as the contents of the artifact.
+func (c *ArtifactCache) add(tmp, digest, runID, artifactPath string) error {
+	hexDigest, err := parseDigest(digest)
+	if err != nil {
+		return err
+	}
+	// Renames are atomic, so concurrent downloads of the same blob are safe
+	if err := os.Rename(tmp, filepath.Join(c.dir, "blobs", "sha256", hexDigest)); err != nil {
+		return fmt.Errorf("failed to add artifact to cache: %w", err)
+	}
+	ref, err := os.CreateTemp(filepath.Join(c.dir, "tmp"), "ref-")
+	if err != nil {
+		return fmt.Errorf("failed to add artifact to cache: %w", err)
+	}
+	_, err = ref.WriteString(digest + "\n")
+	if closeErr := ref.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(ref.Name(), c.refPath(runID, artifactPath))
+	}
+	if err != nil {
+		_ = os.Remove(ref.Name())
+		return fmt.Errorf("failed to add artifact to cache: %w", err)
+	}
+	return nil
+}
+
+// parseDigest returns the hex part of a "sha256:<hex>" checksum.
+func parseDigest(digest string) (string, error) {
+	hexDigest, ok := strings.CutPrefix(digest, digestPrefix)
+	if !ok || len(hexDigest) != sha256.Size*2 {
+		return "", fmt.Errorf("mlflow: invalid digest %q: want sha256:<64 hex digits>", digest)
+	}
+	if _, err := hex.DecodeString(hexDigest); err != nil {
+		return "", fmt.Errorf("mlflow: invalid digest %q: want sha256:<64 hex digits>", digest)
+	}
+	return strings.ToLower(hexDigest), nil
+}
+
+// DownloadArtifact downloads a run artifact to localPath, replacing any
+// file there, and returns its checksum as "sha256:<hex>". artifactPath is
+// relative to the run's artifact root, such as "model/weights.bin".
+//
+// With WithDownloadCache, an artifact downloaded before is copied from the
+// cache without contacting the server, and a new download is added to it.
+// See LogArtifact for server requirements.
+func (c *Client) DownloadArtifact(ctx context.Context, runID, artifactPath, localPath string, opts ...DownloadArtifactOption) (string, error) {
+	if runID == "" {
+		return "", fmt.Errorf("mlflow: run ID is required")
+	}
+	if artifactPath == "" {
+		return "", fmt.Errorf("mlflow: artifact path is required")
+	}
+	if localPath == "" {
+		return "", fmt.Errorf("mlflow: local path is required")
+	}
+
+	o := &downloadArtifactOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.digest != "" {
+		if _, err := parseDigest(o.digest); err != nil {
+			return "", err
+		}
+	}
+
+	if o.cache != nil {
+		digest := o.digest
+		if digest == "" {
+			digest, _ = o.cache.lookup(runID, artifactPath)
+		}
+		if blob, ok := o.cache.Blob(digest); ok {
+			if err := copyFile(blob, localPath); err != nil {
+				return "", fmt.Errorf("failed to copy cached artifact: %w", err)
+			}
+			return digest, nil
+		}
+	}
+
+	tmpDir := filepath.Dir(localPath)
+	if o.cache != nil {
+		// Download into the cache, so the blob can be moved into place
+		tmpDir = filepath.Join(o.cache.dir, "tmp")
+	}
+	tmp, err := os.CreateTemp(tmpDir, ".download-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create download file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	digest, err := c.downloadTo(ctx, runID, artifactPath, tmp)
+	if closeErr := tmp.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write artifact: %w", closeErr)
+	}
+	if err != nil {
+		return "", err
+	}
+	if o.digest != "" && !strings.EqualFold(digest, o.digest) {
+		return "", fmt.Errorf("mlflow: artifact %q has digest %s, want %s", artifactPath, digest, o.digest)
+	}
+
+	if o.cache == nil {
+		if err := os.Rename(tmp.Name(), localPath); err != nil {
+			return "", fmt.Errorf("failed to write artifact: %w", err)
+		}
+		return digest, nil
+	}
+	if err := o.cache.add(tmp.Name(), digest, runID, artifactPath); err != nil {
+		return "", err
+	}
+	blob, _ := o.cache.Blob(digest)
+	if err := copyFile(blob, localPath); err != nil {
+		return "", fmt.Errorf("failed to copy cached artifact: %w", err)
+	}
+	return digest, nil
+}
+
+// downloadTo streams a run artifact to w and returns its checksum.
+func (c *Client) downloadTo(ctx context.Context, runID, artifactPath string, w io.Writer) (string, error) {
+	r, err := c.OpenArtifact(ctx, runID, artifactPath)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, h), r); err != nil {
+		return "", err
+	}
+	return digestPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,130 @@
+package tracking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// downloads counts the artifact downloads srv has served.
+func (s *artifactServer) downloads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, p := range s.paths {
+		if strings.HasPrefix(p, http.MethodGet+" /api/2.0/mlflow-artifacts/") {
+			n++
+		}
+	}
+	return n
+}
+
+func sha256Digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestDownloadArtifact_Cache(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts",
+		uploads: map[string]string{"1/abc-123/artifacts/model/weights.bin": "weights"}}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	cache, err := NewArtifactCache(filepath.Join(t.TempDir(), "cache"))
+	if err != nil {
+		t.Fatalf("NewArtifactCache() error = %v", err)
+	}
+	out := t.TempDir()
+
+	digest, err := client.DownloadArtifact(ctx, "abc-123", "model/weights.bin", filepath.Join(out, "a.bin"), WithDownloadCache(cache))
+	if err != nil {
+		t.Fatalf("DownloadArtifact() error = %v", err)
+	}
+	if digest != sha256Digest("weights") {
+		t.Errorf("digest = %s, want %s", digest, sha256Digest("weights"))
+	}
+	if got := readFile(t, filepath.Join(out, "a.bin")); got != "weights" {
+		t.Errorf("downloaded %q", got)
+	}
+
+	// Second pull, as from a restarted process: served from the cache
+	before := len(srv.paths)
+	cache, _ = NewArtifactCache(cache.dir)
+	if _, err := client.DownloadArtifact(ctx, "abc-123", "model/weights.bin", filepath.Join(out, "b.bin"), WithDownloadCache(cache)); err != nil {
+		t.Fatalf("DownloadArtifact(cached) error = %v", err)
+	}
+	if len(srv.paths) != before {
+		t.Errorf("cached download made requests: %v", srv.paths[before:])
+	}
+	if got := readFile(t, filepath.Join(out, "b.bin")); got != "weights" {
+		t.Errorf("cached download = %q", got)
+	}
+
+	// Another run's artifact with a known digest: no requests either
+	if _, err := client.DownloadArtifact(ctx, "other-run", "weights.bin", filepath.Join(out, "c.bin"),
+		WithDownloadCache(cache), WithDownloadDigest(digest)); err != nil {
+		t.Fatalf("DownloadArtifact(by digest) error = %v", err)
+	}
+	if srv.downloads() != 1 {
+		t.Errorf("downloads = %d, want 1", srv.downloads())
+	}
+	if p, ok := cache.Blob(digest); !ok || readFile(t, p) != "weights" {
+		t.Errorf("Blob(%s) = %q, %v", digest, p, ok)
+	}
+}
+
+func TestDownloadArtifact_DigestMismatch(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts",
+		uploads: map[string]string{"1/abc-123/artifacts/model.bin": "tampered"}}
+	client := newTestClient(t, srv)
+	cache, err := NewArtifactCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := filepath.Join(t.TempDir(), "model.bin")
+
+	_, err = client.DownloadArtifact(context.Background(), "abc-123", "model.bin", local,
+		WithDownloadCache(cache), WithDownloadDigest(sha256Digest("weights")))
+	if err == nil || !strings.Contains(err.Error(), "has digest") {
+		t.Fatalf("DownloadArtifact() error = %v, want digest mismatch", err)
+	}
+	if _, err := os.Stat(local); !os.IsNotExist(err) {
+		t.Errorf("local file written despite mismatch: %v", err)
+	}
+	if _, ok := cache.Blob(sha256Digest("tampered")); ok {
+		t.Error("mismatched artifact was cached")
+	}
+
+	if _, err := client.DownloadArtifact(context.Background(), "abc-123", "model.bin", local, WithDownloadDigest("md5:abc")); err == nil {
+		t.Error("DownloadArtifact(invalid digest) error = nil")
+	}
+}
+
+func TestDownloadArtifact_NoCache(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts",
+		uploads: map[string]string{"1/abc-123/artifacts/config.json": "{}"}}
+	client := newTestClient(t, srv)
+	local := filepath.Join(t.TempDir(), "config.json")
+
+	digest, err := client.DownloadArtifact(context.Background(), "abc-123", "config.json", local)
+	if err != nil || digest != sha256Digest("{}") {
+		t.Fatalf("DownloadArtifact() = %s, %v", digest, err)
+	}
+	if got := readFile(t, local); got != "{}" {
+		t.Errorf("downloaded %q", got)
+	}
+}
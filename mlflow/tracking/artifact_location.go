@@ -0,0 +1,71 @@
+package tracking
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// artifactLocationSchemes lists the URI schemes of the artifact stores MLflow
+// supports.
+var artifactLocationSchemes = map[string]bool{
+	"s3":               true,
+	"gs":               true,
+	"wasbs":            true,
+	"abfss":            true,
+	"hdfs":             true,
+	"viewfs":           true,
+	"oss":              true,
+	"ftp":              true,
+	"sftp":             true,
+	"dbfs":             true,
+	"file":             true,
+	"http":             true,
+	"https":            true,
+	"mlflow-artifacts": true,
+}
+
+// expandArtifactLocation expands the placeholders of an artifact location
+// template for an experiment and checks that the result is a URI with a
+// supported scheme.
+func expandArtifactLocation(template, name string, tags map[string]string) (string, error) {
+	var b strings.Builder
+	rest := template
+	for {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			b.WriteString(rest)
+			break
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("mlflow: artifact location template %q has an unterminated placeholder", template)
+		}
+		end += open
+
+		b.WriteString(rest[:open])
+		switch placeholder := rest[open+1 : end]; placeholder {
+		case "experiment_name":
+			b.WriteString(name)
+		case "experiment_kind":
+			kind := tags[tagExperimentKind]
+			if kind == "" {
+				return "", fmt.Errorf("mlflow: artifact location template uses {experiment_kind} but no kind is set")
+			}
+			b.WriteString(kind)
+		default:
+			return "", fmt.Errorf("mlflow: unknown placeholder {%s} in artifact location template", placeholder)
+		}
+		rest = rest[end+1:]
+	}
+
+	loc := b.String()
+	u, err := url.Parse(loc)
+	if err != nil {
+		return "", fmt.Errorf("mlflow: invalid artifact location %q: %w", loc, err)
+	}
+	if !artifactLocationSchemes[strings.ToLower(u.Scheme)] {
+		return "", fmt.Errorf("mlflow: artifact location %q has unsupported scheme %q", loc, u.Scheme)
+	}
+	return loc, nil
+}
@@ -0,0 +1,39 @@
+package tracking
+
+import (
+	"testing"
+)
+
+func TestExpandArtifactLocation(t *testing.T) {
+	tags := map[string]string{tagExperimentKind: string(ExperimentKindGenAIDevelopment)}
+
+	tests := []struct {
+		name     string
+		template string
+		tags     map[string]string
+		want     string
+		wantErr  bool
+	}{
+		{"name", "s3://bucket/{experiment_name}", nil, "s3://bucket/churn-model", false},
+		{"name and kind", "gs://bucket/{experiment_kind}/{experiment_name}/artifacts", tags, "gs://bucket/genai_development/churn-model/artifacts", false},
+		{"no placeholders", "file:///mnt/artifacts", nil, "file:///mnt/artifacts", false},
+		{"scheme case", "S3://bucket/{experiment_name}", nil, "S3://bucket/churn-model", false},
+		{"kind not set", "s3://bucket/{experiment_kind}", nil, "", true},
+		{"unknown placeholder", "s3://bucket/{team}", nil, "", true},
+		{"unterminated", "s3://bucket/{experiment_name", nil, "", true},
+		{"no scheme", "/mnt/{experiment_name}", nil, "", true},
+		{"unsupported scheme", "ftps://host/{experiment_name}", nil, "", true},
+		{"scheme from name", "{experiment_name}", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandArtifactLocation(tt.template, "churn-model", tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expandArtifactLocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("expandArtifactLocation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// proxiedArtifactScheme is the artifact URI scheme used when the tracking
+// server proxies artifact storage (`mlflow server --serve-artifacts`, the
+// default since MLflow 2.0).
+const proxiedArtifactScheme = "mlflow-artifacts"
+
+// LogArtifact uploads a local file to the run's artifacts.
+// The file is stored under artifactPath (a directory relative to the run's
+// artifact root; empty means the root) with its base name.
+//
+// Uploads go through the tracking server's proxied artifact API, so the run's
+// artifact URI must use the mlflow-artifacts scheme.
+func (c *Client) LogArtifact(ctx context.Context, runID, localPath, artifactPath string) error {
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+	if localPath == "" {
+		return fmt.Errorf("mlflow: local path is required")
+	}
+
+	root, err := c.artifactRoot(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	return c.uploadArtifact(ctx, localPath, path.Join(root, artifactPath, filepath.Base(localPath)))
+}
+
+// LogArtifacts uploads every file under localDir to the run's artifacts,
+// preserving the directory layout under artifactPath (empty means the root).
+//
+// See LogArtifact for server requirements.
+func (c *Client) LogArtifacts(ctx context.Context, runID, localDir, artifactPath string) error {
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+	if localDir == "" {
+		return fmt.Errorf("mlflow: local directory is required")
+	}
+
+	root, err := c.artifactRoot(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(localDir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, relErr := filepath.Rel(localDir, p)
+		if relErr != nil {
+			return relErr
+		}
+		return c.uploadArtifact(ctx, p, path.Join(root, artifactPath, filepath.ToSlash(rel)))
+	})
+}
+
+// artifactRoot returns the run's artifact root as a path on the proxied artifact API.
+func (c *Client) artifactRoot(ctx context.Context, runID string) (string, error) {
+	run, err := c.GetRun(ctx, runID)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(run.Info.ArtifactURI)
+	if err != nil || u.Scheme != proxiedArtifactScheme {
+		return "", fmt.Errorf("mlflow: artifact URI %q is not served by the tracking server; uploads require an %s: URI",
+			run.Info.ArtifactURI, proxiedArtifactScheme)
+	}
+
+	return strings.TrimPrefix(u.Path, "/"), nil
+}
+
+// artifactsPath returns the escaped request path of the artifact at p on the
+// proxied artifact API.
+func artifactsPath(p string) string {
+	return "/api/2.0/mlflow-artifacts/artifacts/" + (&url.URL{Path: p}).EscapedPath()
+}
+
+// uploadArtifact streams a single local file to dest on the proxied artifact API.
+func (c *Client) uploadArtifact(ctx context.Context, localPath, dest string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to open artifact: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to open artifact: %w", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("mlflow: %q is a directory; use LogArtifacts", localPath)
+	}
+
+	err = c.transport.Upload(ctx, artifactsPath(dest), f, info.Size())
+	if err != nil {
+		return fmt.Errorf("failed to upload artifact %q: %w", dest, err)
+	}
+
+	return nil
+}
+
+// OpenArtifact opens a run artifact for reading, streaming it from the
+// tracking server as it is read, so large files can be piped without a
+// temporary file. artifactPath is relative to the run's artifact root, such
+// as "model/weights.bin". The caller must close the reader; closing it
+// early abandons the download.
+//
+// Errors from the server, such as a missing artifact, are returned by
+// OpenArtifact; errors after the download starts are returned by Read.
+// See LogArtifact for server requirements.
+func (c *Client) OpenArtifact(ctx context.Context, runID, artifactPath string) (io.ReadCloser, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+	if artifactPath == "" {
+		return nil, fmt.Errorf("mlflow: artifact path is required")
+	}
+
+	root, err := c.artifactRoot(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	src := path.Join(root, artifactPath)
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		err := c.transport.DownloadTo(ctx, artifactsPath(src), &startWriter{w: pw, started: started})
+		if err != nil {
+			err = fmt.Errorf("failed to download artifact %q: %w", src, err)
+		}
+		_ = pw.CloseWithError(err)
+		done <- err
+	}()
+
+	// Wait for the response, so a missing artifact fails here
+	select {
+	case <-started:
+	case err := <-done:
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return &artifactReader{PipeReader: pr, cancel: cancel}, nil
+}
+
+// CreateArtifact creates or replaces a run artifact and returns a writer
+// that streams to it, using a chunked upload, so large files can be piped
+// without a temporary file. artifactPath is relative to the run's artifact
+// root, such as "predictions/part-0.jsonl".
+//
+// The upload completes when the writer is closed, and Close returns its
+// error; the caller must close the writer. To abandon an upload, cancel ctx.
+// See LogArtifact for server requirements.
+func (c *Client) CreateArtifact(ctx context.Context, runID, artifactPath string) (io.WriteCloser, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+	if artifactPath == "" {
+		return nil, fmt.Errorf("mlflow: artifact path is required")
+	}
+
+	root, err := c.artifactRoot(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	dest := path.Join(root, artifactPath)
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := c.transport.Upload(ctx, artifactsPath(dest), pr, -1)
+		if err != nil {
+			err = fmt.Errorf("failed to upload artifact %q: %w", dest, err)
+			_ = pr.CloseWithError(err)
+		} else {
+			// Consume anything the request did not, such as in dry-run mode,
+			// so writes do not block
+			_, _ = io.Copy(io.Discard, pr)
+		}
+		done <- err
+	}()
+
+	return &artifactWriter{PipeWriter: pw, done: done}, nil
+}
+
+// startWriter closes started before the first write to w.
+type startWriter struct {
+	w       io.Writer
+	started chan struct{}
+	once    sync.Once
+}
+
+func (s *startWriter) Write(p []byte) (int, error) {
+	s.once.Do(func() { close(s.started) })
+	return s.w.Write(p)
+}
+
+// artifactReader is the reader returned by OpenArtifact.
+type artifactReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+// Close abandons the rest of the download.
+func (r *artifactReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// artifactWriter is the writer returned by CreateArtifact.
+type artifactWriter struct {
+	*io.PipeWriter
+	done chan error
+
+	once sync.Once
+	err  error
+}
+
+// Close finishes the upload and returns its error.
+func (w *artifactWriter) Close() error {
+	w.once.Do(func() {
+		_ = w.PipeWriter.Close()
+		w.err = <-w.done
+	})
+	return w.err
+}
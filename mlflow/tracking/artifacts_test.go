@@ -0,0 +1,226 @@
+package tracking
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// artifactServer serves runs/get with the given artifact URI, records
+// uploads, and serves uploaded files back.
+type artifactServer struct {
+	t           *testing.T
+	artifactURI string
+
+	mu      sync.Mutex
+	uploads map[string]string
+	paths   []string
+}
+
+func (s *artifactServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	s.mu.Lock()
+	s.paths = append(s.paths, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/api/2.0/mlflow/runs/get":
+		mustEncodeJSON(s.t, w, map[string]any{
+			"run": map[string]any{
+				"info": map[string]any{"run_id": "abc-123", "artifact_uri": s.artifactURI},
+			},
+		})
+	case strings.HasPrefix(r.URL.Path, "/api/2.0/mlflow-artifacts/artifacts/") && r.Method == http.MethodGet:
+		s.mu.Lock()
+		data, ok := s.uploads[strings.TrimPrefix(r.URL.Path, "/api/2.0/mlflow-artifacts/artifacts/")]
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			mustEncodeJSON(s.t, w, map[string]any{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "not found"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = io.WriteString(w, data)
+	case strings.HasPrefix(r.URL.Path, "/api/2.0/mlflow-artifacts/artifacts/"):
+		if r.Method != http.MethodPut {
+			s.t.Errorf("upload method = %s, want PUT", r.Method)
+		}
+		data, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		if s.uploads == nil {
+			s.uploads = make(map[string]string)
+		}
+		s.uploads[strings.TrimPrefix(r.URL.Path, "/api/2.0/mlflow-artifacts/artifacts/")] = string(data)
+		s.mu.Unlock()
+		mustEncodeJSON(s.t, w, map[string]any{})
+	case r.URL.Path == "/api/2.0/mlflow/runs/log-batch":
+		mustEncodeJSON(s.t, w, map[string]any{})
+	default:
+		s.t.Errorf("unexpected path: %s", r.URL.Path)
+		http.NotFound(w, r)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogArtifact_Success(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+
+	file := filepath.Join(t.TempDir(), "model.bin")
+	writeFile(t, file, "weights")
+
+	err := client.LogArtifact(context.Background(), "abc-123", file, "models")
+	if err != nil {
+		t.Fatalf("LogArtifact() error = %v", err)
+	}
+
+	if got := srv.uploads["1/abc-123/artifacts/models/model.bin"]; got != "weights" {
+		t.Errorf("uploads = %v", srv.uploads)
+	}
+}
+
+func TestLogArtifacts_PreservesLayout(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts://tracking.example.com/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "config.json"), "{}")
+	writeFile(t, filepath.Join(dir, "shards", "part-0"), "zero")
+
+	err := client.LogArtifacts(context.Background(), "abc-123", dir, "")
+	if err != nil {
+		t.Fatalf("LogArtifacts() error = %v", err)
+	}
+
+	want := map[string]string{
+		"1/abc-123/artifacts/config.json":   "{}",
+		"1/abc-123/artifacts/shards/part-0": "zero",
+	}
+	if len(srv.uploads) != len(want) {
+		t.Fatalf("uploads = %v, want %v", srv.uploads, want)
+	}
+	for k, v := range want {
+		if srv.uploads[k] != v {
+			t.Errorf("uploads[%q] = %q, want %q", k, srv.uploads[k], v)
+		}
+	}
+}
+
+func TestLogArtifacts_UnsupportedArtifactURI(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "s3://bucket/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+
+	err := client.LogArtifacts(context.Background(), "abc-123", t.TempDir(), "")
+	if err == nil || !strings.Contains(err.Error(), "mlflow-artifacts") {
+		t.Fatalf("expected unsupported URI error, got %v", err)
+	}
+	if len(srv.uploads) != 0 {
+		t.Errorf("unexpected uploads: %v", srv.uploads)
+	}
+}
+
+func TestLogArtifact_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if err := client.LogArtifact(context.Background(), "", "file", ""); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if err := client.LogArtifact(context.Background(), "abc-123", "", ""); err == nil {
+		t.Error("expected error for empty local path")
+	}
+	if err := client.LogArtifacts(context.Background(), "abc-123", "", ""); err == nil {
+		t.Error("expected error for empty local directory")
+	}
+}
+
+func TestCreateAndOpenArtifact(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	w, err := client.CreateArtifact(ctx, "abc-123", "predictions/part-0.jsonl")
+	if err != nil {
+		t.Fatalf("CreateArtifact() error = %v", err)
+	}
+	want := strings.Repeat(`{"label": 1}`+"\n", 50000)
+	if _, err := io.Copy(w, strings.NewReader(want)); err != nil {
+		t.Fatalf("write error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+
+	r, err := client.OpenArtifact(ctx, "abc-123", "predictions/part-0.jsonl")
+	if err != nil {
+		t.Fatalf("OpenArtifact() error = %v", err)
+	}
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("read %d bytes, want %d", len(got), len(want))
+	}
+}
+
+func TestOpenArtifact_Errors(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	if _, err := client.OpenArtifact(ctx, "abc-123", "missing.bin"); !errors.IsNotFound(err) {
+		t.Errorf("OpenArtifact(missing) error = %v, want not found", err)
+	}
+	if _, err := client.OpenArtifact(ctx, "abc-123", ""); err == nil {
+		t.Error("OpenArtifact(\"\") error = nil")
+	}
+	if _, err := client.CreateArtifact(ctx, "", "a.txt"); err == nil {
+		t.Error("CreateArtifact(no run) error = nil")
+	}
+}
+
+func TestOpenArtifact_CloseEarly(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts",
+		uploads: map[string]string{"1/abc-123/artifacts/big.bin": strings.Repeat("x", 1<<20)}}
+	client := newTestClient(t, srv)
+
+	r, err := client.OpenArtifact(context.Background(), "abc-123", "big.bin")
+	if err != nil {
+		t.Fatalf("OpenArtifact() error = %v", err)
+	}
+	buf := make([]byte, 10)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if _, err := r.Read(buf); err == nil {
+		t.Error("Read() after Close error = nil")
+	}
+}
@@ -0,0 +1,21 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+)
+
+// LogAssessment records feedback or an expectation against a trace and links
+// it to a run, typically the evaluation run that scored the trace. The
+// assessment's RunID is set to runID; see tracing.Client.LogAssessment for
+// the other requirements. Requires MLflow 3.x.
+func (c *Client) LogAssessment(ctx context.Context, runID string, a tracing.Assessment) (*tracing.Assessment, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+
+	a.RunID = runID
+	return tracing.NewClient(c.transport).LogAssessment(ctx, a)
+}
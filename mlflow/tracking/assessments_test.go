@@ -0,0 +1,43 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/tracing"
+)
+
+func TestLogAssessment_LinksRun(t *testing.T) {
+	var receivedRunID string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.0/mlflow/traces/tr-1/assessments" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		var body struct {
+			Assessment map[string]any `json:"assessment"`
+		}
+		mustDecodeJSON(t, r, &body)
+		receivedRunID, _ = body.Assessment["run_id"].(string)
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, body)
+	}))
+
+	got, err := client.LogAssessment(context.Background(), "run-1", tracing.Assessment{
+		TraceID:  "tr-1",
+		Name:     "correct",
+		Feedback: &tracing.Feedback{Value: false},
+	})
+	if err != nil {
+		t.Fatalf("LogAssessment() error = %v", err)
+	}
+	if receivedRunID != "run-1" || got.RunID != "run-1" {
+		t.Errorf("run ID sent = %q, returned = %q", receivedRunID, got.RunID)
+	}
+
+	if _, err := client.LogAssessment(context.Background(), "", tracing.Assessment{}); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+}
@@ -0,0 +1,84 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// Server limits on a single LogBatch request.
+const (
+	maxBatchEntities = 1000
+	maxBatchParams   = 100
+	maxBatchTags     = 100
+)
+
+// LogBatchChunked logs any number of metrics, params, and tags by splitting
+// them into LogBatch requests within the server's limits (1000 entities per
+// request, at most 100 of them params and 100 tags). Tags are sent in key
+// order.
+//
+// Chunks are sent in order, and a failed chunk does not stop the rest. The
+// returned error is a *mlflow.MultiError with one entry per failed chunk,
+// whose Resource names the slices of the input it carried, such as
+// "metrics[1000:2000]" or "params[0:100] tags[0:40]"; tag ranges refer to
+// the sorted keys. opts apply to every chunk.
+func LogBatchChunked(ctx context.Context, c *Client, runID string, metrics []Metric, params []Param, tags map[string]string, opts ...LogBatchOption) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+
+	tagKeys := slices.Sorted(maps.Keys(tags))
+
+	var errs errors.MultiError
+	var m, p, t int
+	for first := true; first || m < len(metrics) || p < len(params) || t < len(tagKeys); first = false {
+		np := min(maxBatchParams, len(params)-p)
+		nt := min(maxBatchTags, len(tagKeys)-t)
+		nm := min(maxBatchEntities-np-nt, len(metrics)-m)
+
+		chunkTags := make(map[string]string, nt)
+		for _, k := range tagKeys[t : t+nt] {
+			chunkTags[k] = tags[k]
+		}
+
+		err := c.LogBatch(ctx, runID, metrics[m:m+nm], params[p:p+np], chunkTags, opts...)
+		errs.Add(-1, chunkResource(m, nm, p, np, t, nt), err)
+		m, p, t = m+nm, p+np, t+nt
+
+		// Report whatever is left as unsent rather than failing each chunk
+		if ctx.Err() != nil {
+			if m < len(metrics) || p < len(params) || t < len(tagKeys) {
+				errs.Add(-1, chunkResource(m, len(metrics)-m, p, len(params)-p, t, len(tagKeys)-t), ctx.Err())
+			}
+			break
+		}
+	}
+
+	return errs.Err()
+}
+
+// chunkResource describes the input slices carried by one chunk.
+func chunkResource(m, nm, p, np, t, nt int) string {
+	var parts []string
+	if nm > 0 {
+		parts = append(parts, fmt.Sprintf("metrics[%d:%d]", m, m+nm))
+	}
+	if np > 0 {
+		parts = append(parts, fmt.Sprintf("params[%d:%d]", p, p+np))
+	}
+	if nt > 0 {
+		parts = append(parts, fmt.Sprintf("tags[%d:%d]", t, t+nt))
+	}
+	if len(parts) == 0 {
+		return "empty batch"
+	}
+	return strings.Join(parts, " ")
+}
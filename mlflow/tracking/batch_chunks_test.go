@@ -0,0 +1,112 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestLogBatchChunked(t *testing.T) {
+	type batch struct{ metrics, params, tags int }
+	var (
+		mu      sync.Mutex
+		batches []batch
+		tagKeys []string
+	)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Metrics []map[string]any    `json:"metrics"`
+			Params  []map[string]string `json:"params"`
+			Tags    []map[string]string `json:"tags"`
+		}
+		mustDecodeJSON(t, r, &req)
+
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, batch{len(req.Metrics), len(req.Params), len(req.Tags)})
+		for _, tag := range req.Tags {
+			tagKeys = append(tagKeys, tag["key"])
+		}
+
+		// Fail the second chunk
+		if len(batches) == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "INVALID_PARAMETER_VALUE", "message": "bad"})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	metrics := make([]Metric, 2500)
+	params := make([]Param, 150)
+	for i := range params {
+		params[i] = Param{Key: fmt.Sprintf("p%03d", i), Value: "v"}
+	}
+	tags := make(map[string]string)
+	for i := range 120 {
+		tags[fmt.Sprintf("t%03d", i)] = "v"
+	}
+
+	err := LogBatchChunked(context.Background(), client, "run-1", metrics, params, tags)
+
+	want := []batch{{800, 100, 100}, {930, 50, 20}, {770, 0, 0}}
+	if len(batches) != len(want) {
+		t.Fatalf("batches = %v, want %v", batches, want)
+	}
+	for i := range want {
+		if batches[i] != want[i] {
+			t.Errorf("batch %d = %+v, want %+v", i, batches[i], want[i])
+		}
+	}
+	for _, k := range tagKeys[:100] {
+		if k >= "t100" {
+			t.Errorf("first chunk has tag %s, want tags split in key order", k)
+		}
+	}
+
+	var multi *errors.MultiError
+	if !stderrors.As(err, &multi) {
+		t.Fatalf("LogBatchChunked() error = %v, want *MultiError", err)
+	}
+	if len(multi.Errors) != 1 || multi.Errors[0].Resource != "metrics[800:1730] params[100:150] tags[100:120]" {
+		t.Errorf("item errors = %v", multi.Errors)
+	}
+	if !errors.IsInvalidArgument(err) {
+		t.Error("MultiError should match the item's APIError")
+	}
+}
+
+func TestLogBatchChunked_Empty(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	if err := LogBatchChunked(context.Background(), client, "run-1", nil, nil, nil); err != nil {
+		t.Fatalf("LogBatchChunked() error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestLogBatchChunked_Validation(t *testing.T) {
+	if err := LogBatchChunked(context.Background(), nil, "run-1", nil, nil, nil); err == nil {
+		t.Error("expected error for nil client")
+	}
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if err := LogBatchChunked(context.Background(), client, "", nil, nil, nil); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+}
@@ -0,0 +1,117 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// logBatchWithRetries calls send until it succeeds, fails with an error that
+// is neither transient nor an equal-value param conflict, or o.retries
+// additional attempts have been made.
+//
+// The MLflow SQL store writes a batch in one transaction, so after an attempt
+// that may have reached the server, a batch whose params are all already
+// logged with the same values is taken to have landed. Without such an
+// attempt, equal-value params were logged by an earlier call, and the batch
+// is resent without them so its metrics and tags are still written.
+func (c *Client) logBatchWithRetries(ctx context.Context, runID string, params []Param, o *logBatchOptions, send func([]Param) error) error {
+	backoff := o.backoff
+	mayHaveLanded := false
+	for attempt := 0; ; attempt++ {
+		err := send(params)
+		if err == nil {
+			return nil
+		}
+
+		wait := false
+		switch {
+		case isParamConflict(err):
+			remaining, ok := c.unloggedParams(ctx, runID, params)
+			if !ok {
+				return err
+			}
+			if len(remaining) == 0 && mayHaveLanded {
+				return nil
+			}
+			params = remaining
+		case isTransientBatchError(ctx, err):
+			mayHaveLanded = true
+			wait = true
+		default:
+			return err
+		}
+
+		if attempt >= o.retries {
+			return err
+		}
+		if !wait {
+			continue
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// unloggedParams returns the params not yet logged on the run. It reports
+// false if a param is logged with a different value or the run cannot be
+// read, in which case the conflict is real or cannot be verified.
+func (c *Client) unloggedParams(ctx context.Context, runID string, params []Param) ([]Param, bool) {
+	run, err := c.GetRun(ctx, runID)
+	if err != nil {
+		return nil, false
+	}
+
+	logged := make(map[string]string, len(run.Data.Params))
+	for _, p := range run.Data.Params {
+		logged[p.Key] = p.Value
+	}
+
+	var remaining []Param
+	for _, p := range params {
+		value, ok := logged[p.Key]
+		if !ok {
+			remaining = append(remaining, p)
+			continue
+		}
+		if value != p.Value {
+			return nil, false
+		}
+	}
+	return remaining, true
+}
+
+// isParamConflict reports whether err is MLflow's rejection of a param that
+// is already logged for the run.
+func isParamConflict(err error) bool {
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusBadRequest && strings.Contains(apiErr.Message, "already logged")
+}
+
+// isTransientBatchError reports whether a failed attempt is worth retrying:
+// a rate-limit or server-side API error, or a network error or request
+// timeout while the caller's context is still live.
+func isTransientBatchError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *errors.APIError
+	if stderrors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	return true
+}
@@ -0,0 +1,162 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// batchServer is a fake MLflow server that stores logged params and metrics
+// and rejects params that are already logged, like the SQL store.
+type batchServer struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	params  map[string]string
+	metrics int
+	batches int
+	// failAfterWrite makes the first n log-batch calls write the batch and
+	// then fail with 503, as when a response is lost after a commit.
+	failAfterWrite int
+}
+
+func (s *batchServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/runs/get":
+		params := make([]map[string]string, 0, len(s.params))
+		for k, v := range s.params {
+			params = append(params, map[string]string{"key": k, "value": v})
+		}
+		mustEncodeJSON(s.t, w, map[string]any{
+			"run": map[string]any{"info": map[string]any{"run_id": "run-1"}, "data": map[string]any{"params": params}},
+		})
+
+	case "/api/2.0/mlflow/runs/log-batch":
+		s.batches++
+		var req struct {
+			Metrics []map[string]any    `json:"metrics"`
+			Params  []map[string]string `json:"params"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+
+		for _, p := range req.Params {
+			if _, ok := s.params[p["key"]]; ok {
+				w.WriteHeader(http.StatusBadRequest)
+				mustEncodeJSON(s.t, w, map[string]string{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Changing param values is not allowed. Param with key='" + p["key"] + "' was already logged",
+				})
+				return
+			}
+		}
+		for _, p := range req.Params {
+			s.params[p["key"]] = p["value"]
+		}
+		s.metrics += len(req.Metrics)
+
+		if s.failAfterWrite > 0 {
+			s.failAfterWrite--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			mustEncodeJSON(s.t, w, map[string]string{"error_code": "TEMPORARILY_UNAVAILABLE", "message": "try again"})
+			return
+		}
+		mustEncodeJSON(s.t, w, map[string]any{})
+
+	default:
+		s.t.Errorf("unexpected request to %s", r.URL.Path)
+	}
+}
+
+func TestLogBatch_RetryAfterLandedBatch(t *testing.T) {
+	server := &batchServer{t: t, params: map[string]string{}, failAfterWrite: 1}
+	client := newTestClient(t, server)
+
+	err := client.LogBatch(context.Background(), "run-1",
+		[]Metric{{Key: "loss", Value: 0.5}},
+		[]Param{{Key: "lr", Value: "0.01"}},
+		nil,
+		WithBatchRetries(2, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("LogBatch() error = %v", err)
+	}
+	if server.batches != 2 || server.metrics != 1 {
+		t.Errorf("batches = %d, metrics = %d, want 2 and 1 (no duplicate metrics)", server.batches, server.metrics)
+	}
+}
+
+func TestLogBatch_RetryResendsWithoutEqualParams(t *testing.T) {
+	// lr was logged by an earlier call, so the first attempt is rejected
+	// before anything is written
+	server := &batchServer{t: t, params: map[string]string{"lr": "0.01"}}
+	client := newTestClient(t, server)
+
+	err := client.LogBatch(context.Background(), "run-1",
+		[]Metric{{Key: "loss", Value: 0.5}},
+		[]Param{{Key: "lr", Value: "0.01"}, {Key: "epochs", Value: "3"}},
+		nil,
+		WithBatchRetries(1, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("LogBatch() error = %v", err)
+	}
+	if server.metrics != 1 || server.params["epochs"] != "3" {
+		t.Errorf("metrics = %d, params = %v, want the rest of the batch written", server.metrics, server.params)
+	}
+}
+
+func TestLogBatch_RetryDifferentValueFails(t *testing.T) {
+	server := &batchServer{t: t, params: map[string]string{"lr": "0.1"}}
+	client := newTestClient(t, server)
+
+	err := client.LogBatch(context.Background(), "run-1", nil,
+		[]Param{{Key: "lr", Value: "0.01"}},
+		nil,
+		WithBatchRetries(3, time.Millisecond),
+	)
+	if !errors.IsInvalidArgument(err) {
+		t.Errorf("LogBatch() error = %v, want invalid argument", err)
+	}
+	if server.batches != 1 {
+		t.Errorf("batches = %d, want 1", server.batches)
+	}
+}
+
+func TestLogBatch_NoRetriesByDefault(t *testing.T) {
+	server := &batchServer{t: t, params: map[string]string{}, failAfterWrite: 1}
+	client := newTestClient(t, server)
+
+	err := client.LogBatch(context.Background(), "run-1", nil, []Param{{Key: "lr", Value: "0.01"}}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if server.batches != 1 {
+		t.Errorf("batches = %d, want 1", server.batches)
+	}
+}
+
+func TestLogBatch_RetriesExhausted(t *testing.T) {
+	server := &batchServer{t: t, params: map[string]string{}, failAfterWrite: 5}
+	client := newTestClient(t, server)
+
+	err := client.LogBatch(context.Background(), "run-1",
+		[]Metric{{Key: "loss", Value: 0.5}}, nil, nil,
+		WithBatchRetries(2, time.Millisecond),
+	)
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("LogBatch() error = %v, want 503", err)
+	}
+	if server.batches != 3 {
+		t.Errorf("batches = %d, want 3", server.batches)
+	}
+}
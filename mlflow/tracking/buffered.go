@@ -0,0 +1,283 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults for a BufferedLogger.
+const (
+	defaultFlushInterval = 5 * time.Second
+	defaultFlushSize     = maxBatchEntities
+)
+
+// BufferedLogger collects metrics, params, and tags in memory and sends them
+// with LogBatch, every flush interval or as soon as a full batch is pending,
+// so a training loop can log every step without waiting on the network.
+// Create one with NewBufferedLogger and Close it when done.
+//
+// Sends happen in the background, so logging methods do not return errors.
+// A failed flush drops the values it carried; its error is available from
+// Err and is returned by the next Flush or Close.
+//
+// A BufferedLogger is safe for concurrent use.
+type BufferedLogger struct {
+	client *Client
+	runID  string
+	ctx    context.Context
+	opts   bufferedLoggerOptions
+
+	mu      sync.Mutex
+	metrics []Metric
+	params  []Param
+	tags    map[string]string
+	sampler *metricSampler
+	lastErr error
+	closed  bool
+
+	// flushMu keeps batches in order.
+	flushMu sync.Mutex
+
+	full      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewBufferedLogger starts a BufferedLogger for a run. Background flushes
+// use ctx; cancelling it stops them, after which only Flush and Close send.
+func NewBufferedLogger(ctx context.Context, c *Client, runID string, opts ...BufferedLoggerOption) (*BufferedLogger, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+
+	o := bufferedLoggerOptions{
+		flushInterval: defaultFlushInterval,
+		flushSize:     defaultFlushSize,
+		now:           time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.flushInterval <= 0 {
+		return nil, fmt.Errorf("mlflow: flush interval must be positive")
+	}
+	if o.flushSize < 1 {
+		return nil, fmt.Errorf("mlflow: flush size must be at least 1")
+	}
+	if o.sampling != nil && (o.sampling.EveryNSteps < 0 || o.sampling.MaxPerSecond < 0) {
+		return nil, fmt.Errorf("mlflow: metric sampling values must not be negative")
+	}
+
+	l := &BufferedLogger{
+		client: c,
+		runID:  runID,
+		ctx:    ctx,
+		opts:   o,
+		full:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if o.sampling != nil {
+		l.sampler = newMetricSampler(*o.sampling)
+	}
+
+	c.loggersMu.Lock()
+	if c.loggers == nil {
+		c.loggers = make(map[*BufferedLogger]struct{})
+	}
+	c.loggers[l] = struct{}{}
+	c.loggersMu.Unlock()
+
+	go l.loop()
+	return l, nil
+}
+
+func (l *BufferedLogger) loop() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.opts.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.full:
+		case <-l.stop:
+			return
+		case <-l.ctx.Done():
+			return
+		}
+		_ = l.flush(l.ctx, false)
+	}
+}
+
+// LogMetric buffers a metric value at step, timestamped now. With
+// WithMetricSampling, the value may be sampled out.
+func (l *BufferedLogger) LogMetric(key string, value float64, step int64) {
+	m := Metric{Key: key, Value: value, Timestamp: l.opts.now(), Step: step}
+
+	l.mu.Lock()
+	if l.closed || (l.sampler != nil && !l.sampler.keep(m)) {
+		l.mu.Unlock()
+		return
+	}
+	l.metrics = append(l.metrics, m)
+	l.mu.Unlock()
+	l.notifyIfFull()
+}
+
+// LogParam buffers a param.
+func (l *BufferedLogger) LogParam(key, value string) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.params = append(l.params, Param{Key: key, Value: value})
+	l.mu.Unlock()
+	l.notifyIfFull()
+}
+
+// SetTag buffers a tag. A later value for the same key replaces an earlier
+// one not yet sent.
+func (l *BufferedLogger) SetTag(key, value string) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	if l.tags == nil {
+		l.tags = make(map[string]string)
+	}
+	l.tags[key] = value
+	l.mu.Unlock()
+	l.notifyIfFull()
+}
+
+func (l *BufferedLogger) notifyIfFull() {
+	l.mu.Lock()
+	full := len(l.metrics)+len(l.params)+len(l.tags) >= l.opts.flushSize
+	l.mu.Unlock()
+	if full {
+		select {
+		case l.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush sends everything buffered so far, including the latest value of
+// each metric held back by sampling, and returns the error of this or any
+// earlier failed flush.
+func (l *BufferedLogger) Flush(ctx context.Context) error {
+	return l.flush(ctx, true)
+}
+
+// flush sends the buffer. Only explicit flushes report and clear lastErr, so
+// a background failure is not lost before the caller sees it.
+func (l *BufferedLogger) flush(ctx context.Context, explicit bool) error {
+	l.flushMu.Lock()
+	defer l.flushMu.Unlock()
+
+	l.mu.Lock()
+	metrics, params, tags := l.metrics, l.params, l.tags
+	if l.sampler != nil && explicit {
+		metrics = append(metrics, l.sampler.takeHeld()...)
+	}
+	l.metrics, l.params, l.tags = nil, nil, nil
+	l.mu.Unlock()
+
+	var err error
+	if len(metrics) > 0 || len(params) > 0 || len(tags) > 0 {
+		err = LogBatchChunked(ctx, l.client, l.runID, metrics, params, maps.Clone(tags))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err != nil {
+		l.lastErr = err
+	}
+	if !explicit {
+		return err
+	}
+	err, l.lastErr = l.lastErr, nil
+	return err
+}
+
+// Close stops background flushing and sends whatever is still buffered.
+// Values logged after Close are ignored. It is safe to call more than once.
+func (l *BufferedLogger) Close(ctx context.Context) error {
+	l.closeOnce.Do(func() {
+		close(l.stop)
+		l.client.loggersMu.Lock()
+		delete(l.client.loggers, l)
+		l.client.loggersMu.Unlock()
+	})
+	<-l.done
+
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+	return l.Flush(ctx)
+}
+
+// FlushBufferedLoggers flushes every BufferedLogger created for c and not
+// yet closed, and returns their errors joined.
+func (c *Client) FlushBufferedLoggers(ctx context.Context) error {
+	var errs []error
+	for _, l := range c.openLoggers() {
+		if err := l.Flush(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("run %s: %w", l.runID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CloseBufferedLoggers closes every BufferedLogger created for c and not
+// yet closed, sending what they still buffer, and returns their errors
+// joined. Call it on shutdown so trailing values are not lost.
+func (c *Client) CloseBufferedLoggers(ctx context.Context) error {
+	var errs []error
+	for _, l := range c.openLoggers() {
+		if err := l.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("run %s: %w", l.runID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Client) openLoggers() []*BufferedLogger {
+	c.loggersMu.Lock()
+	defer c.loggersMu.Unlock()
+	loggers := slices.Collect(maps.Keys(c.loggers))
+	slices.SortFunc(loggers, func(a, b *BufferedLogger) int { return strings.Compare(a.runID, b.runID) })
+	return loggers
+}
+
+// Err returns the error of the most recent failed flush not yet returned by
+// Flush or Close.
+func (l *BufferedLogger) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastErr
+}
+
+// SampledOut returns how many metric values sampling has discarded.
+func (l *BufferedLogger) SampledOut() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.sampler == nil {
+		return 0
+	}
+	return l.sampler.dropped
+}
@@ -0,0 +1,262 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// batchRecorder records the LogBatch requests a test server receives.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches []recordedBatch
+	fail    bool
+}
+
+type recordedBatch struct {
+	Metrics []struct {
+		Key   string  `json:"key"`
+		Value float64 `json:"value"`
+		Step  int64   `json:"step"`
+	} `json:"metrics"`
+	Params []map[string]string `json:"params"`
+	Tags   []map[string]string `json:"tags"`
+}
+
+func (b *batchRecorder) handler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/runs/log-batch" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req recordedBatch
+		mustDecodeJSON(t, r, &req)
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if b.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "INTERNAL_ERROR", "message": "boom"})
+			return
+		}
+		b.batches = append(b.batches, req)
+		mustEncodeJSON(t, w, map[string]any{})
+	})
+}
+
+func (b *batchRecorder) snapshot() []recordedBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]recordedBatch(nil), b.batches...)
+}
+
+func TestBufferedLogger_FlushOnClose(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+
+	l, err := NewBufferedLogger(context.Background(), client, "run-1", WithFlushInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+	l.LogParam("lr", "0.01")
+	l.SetTag("stage", "warmup")
+	l.SetTag("stage", "train")
+	for step := range int64(3) {
+		l.LogMetric("loss", 1/float64(step+1), step)
+	}
+
+	if got := rec.snapshot(); len(got) != 0 {
+		t.Fatalf("sent %d batches before Close, want 0", len(got))
+	}
+	if err := l.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	got := rec.snapshot()
+	if len(got) != 1 {
+		t.Fatalf("sent %d batches, want 1", len(got))
+	}
+	if len(got[0].Metrics) != 3 || len(got[0].Params) != 1 || len(got[0].Tags) != 1 {
+		t.Errorf("batch = %+v, want 3 metrics, 1 param, 1 tag", got[0])
+	}
+	if got[0].Tags[0]["value"] != "train" {
+		t.Errorf("tag = %v, want the latest value", got[0].Tags[0])
+	}
+
+	// Ignored after Close
+	l.LogMetric("loss", 0, 10)
+	if err := l.Close(context.Background()); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+	if len(rec.snapshot()) != 1 {
+		t.Error("values logged after Close were sent")
+	}
+}
+
+func TestBufferedLogger_FlushWhenFull(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+
+	l, err := NewBufferedLogger(context.Background(), client, "run-1",
+		WithFlushInterval(time.Hour), WithFlushSize(5))
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+	defer l.Close(context.Background())
+
+	for step := range int64(5) {
+		l.LogMetric("loss", 0.5, step)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rec.snapshot()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected a flush once the buffer was full")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := rec.snapshot(); len(got[0].Metrics) != 5 {
+		t.Errorf("batch has %d metrics, want 5", len(got[0].Metrics))
+	}
+}
+
+func TestBufferedLogger_FlushOnInterval(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+
+	l, err := NewBufferedLogger(context.Background(), client, "run-1", WithFlushInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+	defer l.Close(context.Background())
+
+	l.LogMetric("loss", 0.5, 0)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rec.snapshot()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected a flush after the interval")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestBufferedLogger_Sampling(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+
+	l, err := NewBufferedLogger(context.Background(), client, "run-1",
+		WithFlushInterval(time.Hour),
+		WithMetricSampling(MetricSampling{EveryNSteps: 100}),
+	)
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+
+	for step := range int64(250) {
+		l.LogMetric("loss", float64(step), step)
+	}
+	if err := l.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	var steps []int64
+	for _, b := range rec.snapshot() {
+		for _, m := range b.Metrics {
+			steps = append(steps, m.Step)
+		}
+	}
+	// First, every 100th, and the last
+	if want := []int64{0, 100, 200, 249}; !equalSteps(steps, want) {
+		t.Errorf("logged steps = %v, want %v", steps, want)
+	}
+	if l.SampledOut() != 246 {
+		t.Errorf("SampledOut() = %d, want 246", l.SampledOut())
+	}
+}
+
+func TestBufferedLogger_FlushError(t *testing.T) {
+	rec := &batchRecorder{fail: true}
+	client := newTestClient(t, rec.handler(t))
+
+	l, err := NewBufferedLogger(context.Background(), client, "run-1", WithFlushInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("NewBufferedLogger() error = %v", err)
+	}
+	defer l.Close(context.Background())
+
+	l.LogMetric("loss", 0.5, 0)
+	if err := l.Flush(context.Background()); err == nil {
+		t.Fatal("expected Flush to return the batch error")
+	}
+	if l.Err() != nil {
+		t.Error("expected Flush to clear the reported error")
+	}
+	if err := l.Flush(context.Background()); err != nil {
+		t.Errorf("Flush() with nothing buffered error = %v, want nil", err)
+	}
+}
+
+func TestClient_CloseBufferedLoggers(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+	ctx := context.Background()
+
+	var loggers []*BufferedLogger
+	for _, runID := range []string{"run-1", "run-2", "run-3"} {
+		l, err := NewBufferedLogger(ctx, client, runID, WithFlushInterval(time.Hour))
+		if err != nil {
+			t.Fatalf("NewBufferedLogger() error = %v", err)
+		}
+		l.LogMetric("loss", 0.5, 0)
+		loggers = append(loggers, l)
+	}
+	// Closed loggers are no longer tracked
+	if err := loggers[2].Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if err := client.FlushBufferedLoggers(ctx); err != nil {
+		t.Fatalf("FlushBufferedLoggers() error = %v", err)
+	}
+	if got := len(rec.snapshot()); got != 3 {
+		t.Fatalf("batches after flush = %d, want 3", got)
+	}
+
+	loggers[0].LogParam("lr", "0.1")
+	if err := client.CloseBufferedLoggers(ctx); err != nil {
+		t.Fatalf("CloseBufferedLoggers() error = %v", err)
+	}
+	if got := len(rec.snapshot()); got != 4 {
+		t.Errorf("batches after close = %d, want 4", got)
+	}
+	loggers[1].LogMetric("loss", 0.4, 1)
+	if err := loggers[1].Flush(ctx); err != nil || len(rec.snapshot()) != 4 {
+		t.Errorf("values logged after CloseBufferedLoggers were sent")
+	}
+	if len(client.openLoggers()) != 0 {
+		t.Errorf("open loggers = %d, want 0", len(client.openLoggers()))
+	}
+}
+
+func TestNewBufferedLogger_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+
+	if _, err := NewBufferedLogger(ctx, nil, "run-1"); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if _, err := NewBufferedLogger(ctx, client, ""); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if _, err := NewBufferedLogger(ctx, client, "run-1", WithFlushSize(0)); err == nil {
+		t.Error("expected error for zero flush size")
+	}
+	if _, err := NewBufferedLogger(ctx, client, "run-1", WithMetricSampling(MetricSampling{MaxPerSecond: -1})); err == nil {
+		t.Error("expected error for negative sampling rate")
+	}
+}
@@ -0,0 +1,57 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// checkpointArtifactDir is the artifact directory Checkpoint writes under.
+const checkpointArtifactDir = "checkpoints"
+
+// CheckpointArtifactPath returns the artifact path Checkpoint uses for step,
+// e.g. "checkpoints/step-100".
+func CheckpointArtifactPath(step int64) string {
+	return checkpointArtifactDir + "/step-" + strconv.FormatInt(step, 10)
+}
+
+// Checkpoint records a training checkpoint: it uploads the contents of
+// artifactDir to CheckpointArtifactPath(step) in the run's artifacts, then logs
+// metrics at step with a single LogBatch call. An empty artifactDir logs the
+// metrics only.
+//
+// MLflow cannot commit artifacts and metrics together, so Checkpoint orders
+// them: metrics are logged only after every file is uploaded. A metric
+// visible at a step therefore implies a complete checkpoint for that step.
+// If the upload fails no metrics are logged; calling Checkpoint again for
+// the same step overwrites any files already uploaded.
+func Checkpoint(ctx context.Context, c *Client, runID string, step int64, metrics map[string]float64, artifactDir string) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+	if step < 0 {
+		return fmt.Errorf("mlflow: step must not be negative")
+	}
+
+	if artifactDir != "" {
+		if err := c.LogArtifacts(ctx, runID, artifactDir, CheckpointArtifactPath(step)); err != nil {
+			return fmt.Errorf("failed to upload checkpoint: %w", err)
+		}
+	}
+
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	batch := make([]Metric, 0, len(metrics))
+	for _, key := range slices.Sorted(maps.Keys(metrics)) {
+		batch = append(batch, Metric{Key: key, Value: metrics[key], Step: step})
+	}
+
+	return c.LogBatch(ctx, runID, batch, nil, nil)
+}
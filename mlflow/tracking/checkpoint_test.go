@@ -0,0 +1,84 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpointArtifactPath(t *testing.T) {
+	if got := CheckpointArtifactPath(100); got != "checkpoints/step-100" {
+		t.Errorf("CheckpointArtifactPath(100) = %q", got)
+	}
+}
+
+func TestCheckpoint_UploadsThenLogsMetrics(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "model.bin"), "weights")
+
+	err := Checkpoint(context.Background(), client, "abc-123", 7,
+		map[string]float64{"loss": 0.5, "acc": 0.9}, dir)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+
+	if got := srv.uploads["1/abc-123/artifacts/checkpoints/step-7/model.bin"]; got != "weights" {
+		t.Errorf("uploads = %v", srv.uploads)
+	}
+	last := srv.paths[len(srv.paths)-1]
+	if last != "POST /api/2.0/mlflow/runs/log-batch" {
+		t.Errorf("last request = %q, want log-batch after uploads (%v)", last, srv.paths)
+	}
+}
+
+func TestCheckpoint_UploadFailureSkipsMetrics(t *testing.T) {
+	var logged bool
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/runs/get":
+			mustEncodeJSON(t, w, map[string]any{
+				"run": map[string]any{
+					"info": map[string]any{"run_id": "abc-123", "artifact_uri": "mlflow-artifacts:/1/abc-123/artifacts"},
+				},
+			})
+		case "/api/2.0/mlflow/runs/log-batch":
+			logged = true
+			mustEncodeJSON(t, w, map[string]any{})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "INTERNAL_ERROR", "message": "disk full"})
+		}
+	}))
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "model.bin"), "weights")
+
+	err := Checkpoint(context.Background(), client, "abc-123", 1, map[string]float64{"loss": 0.5}, dir)
+	if err == nil {
+		t.Fatal("expected error when upload fails")
+	}
+	if logged {
+		t.Error("metrics should not be logged when the upload fails")
+	}
+}
+
+func TestCheckpoint_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if err := Checkpoint(context.Background(), nil, "abc-123", 1, nil, ""); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if err := Checkpoint(context.Background(), client, "", 1, nil, ""); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if err := Checkpoint(context.Background(), client, "abc-123", -1, nil, ""); err == nil {
+		t.Error("expected error for negative step")
+	}
+}
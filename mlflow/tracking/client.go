@@ -2,14 +2,22 @@ package tracking
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"math"
 	"net/url"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/opendatahub-io/mlflow-go/internal/conv"
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
 	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/internal/searchfilter"
 	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
 )
 
 // defaultSearchMaxResults is the default page size for search operations.
@@ -20,8 +28,30 @@ const defaultSearchMaxResults = 1000
 // It is safe for concurrent use.
 type Client struct {
 	transport *transport.Client
+
+	// runStarts caches run start times for WithRelativeTimestamp.
+	runStarts sync.Map // run ID -> time.Time
+
+	// steps tracks the last step logged per run and key for WithMonotonicSteps.
+	stepsMu sync.Mutex
+	steps   map[metricStepKey]int64
+
+	// loggers holds the BufferedLoggers not yet closed, for
+	// FlushBufferedLoggers and CloseBufferedLoggers.
+	loggersMu sync.Mutex
+	loggers   map[*BufferedLogger]struct{}
 }
 
+// metricStepKey identifies a metric series for WithMonotonicSteps.
+type metricStepKey struct {
+	runID, key string
+}
+
+// DryRunID is the placeholder ID that CreateExperiment and CreateRun return
+// in dry-run mode, where the server assigns no ID. Calls made with it in
+// dry-run mode are validated and logged like any other.
+const DryRunID = "dry-run"
+
 // NewClient creates a new Tracking client.
 // This is typically called internally by the root mlflow.Client.
 func NewClient(t *transport.Client) *Client {
@@ -45,6 +75,16 @@ func (c *Client) CreateExperiment(ctx context.Context, name string, opts ...Crea
 		Name: &name,
 	}
 
+	if o.artifactLocationTemplate != "" {
+		if o.artifactLocation != "" {
+			return "", fmt.Errorf("mlflow: WithArtifactLocation and WithArtifactLocationTemplate are mutually exclusive")
+		}
+		loc, err := expandArtifactLocation(o.artifactLocationTemplate, name, o.tags)
+		if err != nil {
+			return "", err
+		}
+		req.ArtifactLocation = &loc
+	}
 	if o.artifactLocation != "" {
 		req.ArtifactLocation = &o.artifactLocation
 	}
@@ -60,6 +100,12 @@ func (c *Client) CreateExperiment(ctx context.Context, name string, opts ...Crea
 		return "", fmt.Errorf("failed to create experiment: %w", err)
 	}
 
+	// The server always returns the new ID; an empty response means the
+	// request was not sent (dry-run)
+	if resp.ExperimentId == nil {
+		return DryRunID, nil
+	}
+
 	return resp.GetExperimentId(), nil
 }
 
@@ -107,11 +153,34 @@ func (c *Client) GetExperimentByName(ctx context.Context, name string) (*Experim
 	return &exp, nil
 }
 
+// GetExperimentOrNil retrieves an experiment by ID, like GetExperiment, but
+// returns nil and no error if the experiment does not exist.
+func (c *Client) GetExperimentOrNil(ctx context.Context, experimentID string) (*Experiment, error) {
+	exp, err := c.GetExperiment(ctx, experimentID)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return exp, err
+}
+
+// ExperimentExists reports whether an experiment with the given name exists.
+// An experiment marked for deletion still exists, since it keeps its name
+// until `mlflow gc` purges it.
+func (c *Client) ExperimentExists(ctx context.Context, name string) (bool, error) {
+	_, err := c.GetExperimentByName(ctx, name)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // DeleteExperiment marks an experiment for deletion.
-func (c *Client) DeleteExperiment(ctx context.Context, experimentID string) error {
+// On a client with delete protection, confirm the ID with WithConfirm.
+func (c *Client) DeleteExperiment(ctx context.Context, experimentID string, opts ...DeleteOption) error {
 	if experimentID == "" {
 		return fmt.Errorf("mlflow: experiment ID is required")
 	}
+	ctx = confirmDelete(ctx, experimentID, opts)
 
 	req := &mlflowpb.DeleteExperiment{
 		ExperimentId: &experimentID,
@@ -127,6 +196,20 @@ func (c *Client) DeleteExperiment(ctx context.Context, experimentID string) erro
 	return nil
 }
 
+// confirmDelete returns ctx confirming the deletion of id if opts confirm
+// it. The confirmation is scoped to the one call made with the returned
+// context.
+func confirmDelete(ctx context.Context, id string, opts []DeleteOption) context.Context {
+	var o deleteOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.confirm != id {
+		return ctx
+	}
+	return transport.WithConfirm(ctx, id)
+}
+
 // UpdateExperiment renames an experiment.
 func (c *Client) UpdateExperiment(ctx context.Context, experimentID, name string) error {
 	if experimentID == "" {
@@ -166,8 +249,12 @@ func (c *Client) SearchExperiments(ctx context.Context, opts ...SearchExperiment
 
 	req := &mlflowpb.SearchExperiments{}
 
-	if o.filter != "" {
-		req.Filter = &o.filter
+	filter := o.filter
+	if o.kind != "" {
+		filter = searchfilter.And(filter, fmt.Sprintf("tags.`%s` = '%s'", tagExperimentKind, searchfilter.Value(string(o.kind))))
+	}
+	if filter != "" {
+		req.Filter = &filter
 	}
 	maxResults := int64(o.maxResults) // int→int64 widening: always safe
 	req.MaxResults = &maxResults
@@ -201,9 +288,55 @@ func (c *Client) SearchExperiments(ctx context.Context, opts ...SearchExperiment
 		result.Experiments = append(result.Experiments, experimentFromProto(exp))
 	}
 
+	if o.countUpTo > 0 {
+		first := Page[Experiment]{Items: result.Experiments, NextPageToken: result.NextPageToken}
+		fetch := func(ctx context.Context, pageToken string) (Page[Experiment], error) {
+			pageOpts := append(slices.Clone(opts),
+				WithExperimentsPageToken(pageToken),
+				WithExperimentsMaxResults(defaultSearchMaxResults),
+				WithExperimentsCountUpTo(0),
+			)
+			list, err := c.SearchExperiments(ctx, pageOpts...)
+			if err != nil {
+				return Page[Experiment]{}, err
+			}
+			return Page[Experiment]{Items: list.Experiments, NextPageToken: list.NextPageToken}, nil
+		}
+		if o.pageToken != "" {
+			if first, err = fetch(ctx, ""); err != nil {
+				return nil, err
+			}
+		}
+		n, capped, err := pagination.Count(ctx, fetch, first, o.countUpTo)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalCount, result.TotalCountCapped = &n, capped
+	}
+
 	return result, nil
 }
 
+// SearchExperimentsCursor returns a cursor over all pages of SearchExperiments results.
+// WithExperimentsPageToken sets the starting page; later pages are fetched on demand.
+func (c *Client) SearchExperimentsCursor(opts ...SearchExperimentsOption) *Cursor[Experiment] {
+	o := &searchExperimentsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	fetch := func(ctx context.Context, pageToken string) (Page[Experiment], error) {
+		pageOpts := append(slices.Clone(opts), WithExperimentsPageToken(pageToken))
+		list, err := c.SearchExperiments(ctx, pageOpts...)
+		if err != nil {
+			return Page[Experiment]{}, err
+		}
+		return Page[Experiment]{Items: list.Experiments, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, o.pageToken)
+}
+
 // SetExperimentTag sets a tag on an experiment.
 func (c *Client) SetExperimentTag(ctx context.Context, experimentID, key, value string) error {
 	if experimentID == "" {
@@ -232,6 +365,8 @@ func (c *Client) SetExperimentTag(ctx context.Context, experimentID, key, value
 // --- Run operations ---
 
 // CreateRun creates a new run in the specified experiment.
+// Runs created without a name get one from GenerateRunName, unless
+// WithoutGeneratedRunName is set.
 func (c *Client) CreateRun(ctx context.Context, experimentID string, opts ...CreateRunOption) (*Run, error) {
 	if experimentID == "" {
 		return nil, fmt.Errorf("mlflow: experiment ID is required")
@@ -246,15 +381,41 @@ func (c *Client) CreateRun(ctx context.Context, experimentID string, opts ...Cre
 		ExperimentId: &experimentID,
 	}
 
-	if o.runName != "" {
-		req.RunName = &o.runName
+	runName := o.runName
+	if runName == "" {
+		runName = o.tags[mlflowtags.RunName]
+	}
+	if runName == "" && !o.noGeneratedName {
+		runName = GenerateRunName()
+		// Servers that predate the run_name field read the name from this tag
+		req.Tags = append(req.Tags, &mlflowpb.RunTag{Key: conv.Ptr(mlflowtags.RunName), Value: conv.Ptr(runName)})
+	}
+	if runName != "" {
+		req.RunName = &runName
 	}
 	if o.startTime != nil {
 		ms := o.startTime.UnixMilli()
 		req.StartTime = &ms
 	}
 
-	for k, v := range o.tags {
+	tags := o.tags
+	if o.group != "" || o.ttl > 0 {
+		tags = maps.Clone(tags)
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		if o.group != "" {
+			maps.Copy(tags, groupTags(o.group))
+		}
+		if o.ttl > 0 {
+			start := time.Now()
+			if o.startTime != nil {
+				start = *o.startTime
+			}
+			tags[RunExpiresTagKey] = runExpiresAt(start, o.ttl)
+		}
+	}
+	for k, v := range tags {
 		req.Tags = append(req.Tags, &mlflowpb.RunTag{Key: conv.Ptr(k), Value: conv.Ptr(v)})
 	}
 
@@ -265,29 +426,58 @@ func (c *Client) CreateRun(ctx context.Context, experimentID string, opts ...Cre
 		return nil, fmt.Errorf("failed to create run: %w", err)
 	}
 
+	// The server always returns the created run; an empty response means the
+	// request was not sent (dry-run), so describe what would have been created.
+	if resp.Run == nil {
+		startTime := req.StartTime
+		if startTime == nil {
+			startTime = conv.Ptr(time.Now().UnixMilli())
+		}
+		resp.Run = &mlflowpb.Run{
+			Info: &mlflowpb.RunInfo{
+				RunId:        conv.Ptr(DryRunID),
+				ExperimentId: req.ExperimentId,
+				RunName:      req.RunName,
+				Status:       mlflowpb.RunStatus_RUNNING.Enum(),
+				StartTime:    startTime,
+			},
+			Data: &mlflowpb.RunData{Tags: req.Tags},
+		}
+	}
+
 	run := runFromProto(resp.Run)
 
 	return &run, nil
 }
 
 // GetRun retrieves a run by ID.
-func (c *Client) GetRun(ctx context.Context, runID string) (*Run, error) {
+func (c *Client) GetRun(ctx context.Context, runID string, opts ...GetRunOption) (*Run, error) {
 	if runID == "" {
 		return nil, fmt.Errorf("mlflow: run ID is required")
 	}
 
+	o := &getRunOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	query := url.Values{
 		"run_id": []string{runID},
 	}
 
-	var resp mlflowpb.GetRun_Response
+	var resp struct {
+		Run json.RawMessage `json:"run"`
+	}
 
 	err := c.transport.Get(ctx, "/api/2.0/mlflow/runs/get", query, &resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get run: %w", err)
 	}
 
-	run := runFromProto(resp.Run)
+	run, err := decodeRun(resp.Run, o.fields)
+	if err != nil {
+		return nil, err
+	}
 
 	return &run, nil
 }
@@ -330,16 +520,22 @@ func (c *Client) UpdateRun(ctx context.Context, runID string, opts ...UpdateRunO
 		return nil, fmt.Errorf("failed to update run: %w", err)
 	}
 
+	if resp.RunInfo == nil { // dry-run
+		resp.RunInfo = &mlflowpb.RunInfo{RunId: req.RunId, Status: req.Status, EndTime: req.EndTime, RunName: req.RunName}
+	}
+
 	info := runInfoFromProto(resp.RunInfo)
 
 	return &info, nil
 }
 
 // DeleteRun marks a run for deletion.
-func (c *Client) DeleteRun(ctx context.Context, runID string) error {
+// On a client with delete protection, confirm the ID with WithConfirm.
+func (c *Client) DeleteRun(ctx context.Context, runID string, opts ...DeleteOption) error {
 	if runID == "" {
 		return fmt.Errorf("mlflow: run ID is required")
 	}
+	ctx = confirmDelete(ctx, runID, opts)
 
 	req := &mlflowpb.DeleteRun{
 		RunId: &runID,
@@ -361,61 +557,211 @@ func (c *Client) SearchRuns(ctx context.Context, experimentIDs []string, opts ..
 		return nil, fmt.Errorf("mlflow: at least one experiment ID is required")
 	}
 
-	o := &searchRunsOptions{
-		maxResults: defaultSearchMaxResults,
+	o := NewSearchRunsConfig(opts...)
+
+	req, err := newSearchRunsRequest(experimentIDs, &o)
+	if err != nil {
+		return nil, err
 	}
-	for _, opt := range opts {
-		opt(o)
+
+	var resp struct {
+		Runs          []json.RawMessage `json:"runs"`
+		NextPageToken string            `json:"next_page_token"`
 	}
 
-	if o.maxResults <= 0 {
+	err = c.transport.Post(ctx, "/api/2.0/mlflow/runs/search", req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search runs: %w", err)
+	}
+
+	result := &RunList{
+		Runs:          make([]Run, 0, len(resp.Runs)),
+		NextPageToken: resp.NextPageToken,
+	}
+
+	for _, raw := range resp.Runs {
+		run, err := decodeRun(raw, o.Fields)
+		if err != nil {
+			return nil, err
+		}
+		result.Runs = append(result.Runs, run)
+	}
+
+	if o.CountUpTo > 0 {
+		first := Page[Run]{Items: result.Runs, NextPageToken: result.NextPageToken}
+		fetch := func(ctx context.Context, pageToken string) (Page[Run], error) {
+			pageOpts := append(slices.Clone(opts),
+				WithRunsPageToken(pageToken),
+				WithRunsMaxResults(defaultSearchMaxResults),
+				WithRunsCountUpTo(0),
+			)
+			list, err := c.SearchRuns(ctx, experimentIDs, pageOpts...)
+			if err != nil {
+				return Page[Run]{}, err
+			}
+			return Page[Run]{Items: list.Runs, NextPageToken: list.NextPageToken}, nil
+		}
+		if o.PageToken != "" {
+			if first, err = fetch(ctx, ""); err != nil {
+				return nil, err
+			}
+		}
+		n, capped, err := pagination.Count(ctx, fetch, first, o.CountUpTo)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalCount, result.TotalCountCapped = &n, capped
+	}
+
+	return result, nil
+}
+
+// SearchRunsCursor returns a cursor over all pages of SearchRuns results.
+// WithRunsPageToken sets the starting page; later pages are fetched on demand.
+func (c *Client) SearchRunsCursor(experimentIDs []string, opts ...SearchRunsOption) *Cursor[Run] {
+	var o SearchRunsConfig
+	o.Apply(opts...)
+
+	fetch := func(ctx context.Context, pageToken string) (Page[Run], error) {
+		pageOpts := append(slices.Clone(opts), WithRunsPageToken(pageToken))
+		list, err := c.SearchRuns(ctx, experimentIDs, pageOpts...)
+		if err != nil {
+			return Page[Run]{}, err
+		}
+		return Page[Run]{Items: list.Runs, NextPageToken: list.NextPageToken}, nil
+	}
+
+	return pagination.NewAt(fetch, o.PageToken)
+}
+
+// StreamRuns calls fn for every run matching the search, across all pages.
+// Runs are decoded one at a time as each response is read, so memory use
+// stays bounded however many runs match; prefer it to SearchRunsCursor for
+// scans over very large experiments. WithRunsMaxResults sets the page size
+// and WithRunsPageToken the starting page.
+//
+// Iteration stops at the first error, including one returned by fn.
+func (c *Client) StreamRuns(ctx context.Context, experimentIDs []string, fn func(Run) error, opts ...SearchRunsOption) error {
+	if len(experimentIDs) == 0 {
+		return fmt.Errorf("mlflow: at least one experiment ID is required")
+	}
+	if fn == nil {
+		return fmt.Errorf("mlflow: callback is required")
+	}
+
+	o := NewSearchRunsConfig(opts...)
+
+	req, err := newSearchRunsRequest(experimentIDs, &o)
+	if err != nil {
+		return err
+	}
+
+	decodeOne := func(raw json.RawMessage) error {
+		run, decodeErr := decodeRun(raw, o.Fields)
+		if decodeErr != nil {
+			return decodeErr
+		}
+		return fn(run)
+	}
+
+	for {
+		var resp struct {
+			NextPageToken string `json:"next_page_token"`
+		}
+		err = c.transport.PostStream(ctx, "/api/2.0/mlflow/runs/search", req, "runs", decodeOne, &resp)
+		if err != nil {
+			return fmt.Errorf("failed to search runs: %w", err)
+		}
+		if resp.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = &resp.NextPageToken
+	}
+}
+
+// newSearchRunsRequest builds a SearchRuns request from resolved options.
+func newSearchRunsRequest(experimentIDs []string, o *SearchRunsConfig) (*mlflowpb.SearchRuns, error) {
+	if o.MaxResults <= 0 {
 		return nil, fmt.Errorf("mlflow: max results must be positive")
 	}
+	if err := o.Err(); err != nil {
+		return nil, err
+	}
 
 	req := &mlflowpb.SearchRuns{
 		ExperimentIds: experimentIDs,
 	}
 
-	if o.filter != "" {
-		req.Filter = &o.filter
+	filter := o.Filter
+	if o.Group != "" {
+		filter = searchfilter.And(filter, fmt.Sprintf("tags.`%s` = '%s'", searchfilter.Key(RunGroupTagKey), searchfilter.Value(o.Group)))
 	}
-	n := o.maxResults
+	if filter != "" {
+		req.Filter = &filter
+	}
+	n := o.MaxResults
 	if n > math.MaxInt32 {
 		n = math.MaxInt32
 	}
 	maxResults := int32(n) //nolint:gosec // bounds checked above
 	req.MaxResults = &maxResults
-	if o.pageToken != "" {
-		req.PageToken = &o.pageToken
+	if o.PageToken != "" {
+		req.PageToken = &o.PageToken
 	}
-	if len(o.orderBy) > 0 {
-		req.OrderBy = o.orderBy
+	if len(o.OrderBy) > 0 {
+		req.OrderBy = o.OrderBy
 	}
-	if o.viewType != "" {
-		vt, ok := viewTypeToProto[o.viewType]
+	if o.ViewType != "" {
+		vt, ok := viewTypeToProto[o.ViewType]
 		if !ok {
-			return nil, fmt.Errorf("mlflow: invalid view type: %s", o.viewType)
+			return nil, fmt.Errorf("mlflow: invalid view type: %s", o.ViewType)
 		}
 		req.RunViewType = &vt
 	}
 
-	var resp mlflowpb.SearchRuns_Response
+	return req, nil
+}
 
-	err := c.transport.Post(ctx, "/api/2.0/mlflow/runs/search", req, &resp)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search runs: %w", err)
+// GetMetricHistory returns every logged value of a metric for a run,
+// in the order the server returns them.
+func (c *Client) GetMetricHistory(ctx context.Context, runID, key string) ([]Metric, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
 	}
-
-	result := &RunList{
-		Runs:          make([]Run, 0, len(resp.Runs)),
-		NextPageToken: resp.GetNextPageToken(),
+	if key == "" {
+		return nil, fmt.Errorf("mlflow: metric key is required")
 	}
 
-	for _, r := range resp.Runs {
-		result.Runs = append(result.Runs, runFromProto(r))
-	}
+	var metrics []Metric
+	var pageToken string
+	for {
+		query := url.Values{
+			"run_id":     []string{runID},
+			"metric_key": []string{key},
+		}
+		if pageToken != "" {
+			query.Set("page_token", pageToken)
+		}
 
-	return result, nil
+		var resp mlflowpb.GetMetricHistory_Response
+
+		err := c.transport.Get(ctx, "/api/2.0/mlflow/metrics/get-history", query, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metric history: %w", err)
+		}
+
+		if metrics == nil {
+			metrics = make([]Metric, 0, len(resp.Metrics))
+		}
+		for _, m := range resp.Metrics {
+			metrics = append(metrics, metricFromProto(m))
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" {
+			return metrics, nil
+		}
+	}
 }
 
 // --- Logging operations ---
@@ -435,11 +781,28 @@ func (c *Client) LogMetric(ctx context.Context, runID, key string, value float64
 	}
 
 	ts := time.Now()
-	if o.timestamp != nil {
+	switch {
+	case o.timestamp != nil:
 		ts = *o.timestamp
+	case o.sinceRunStart != nil:
+		start, err := c.runStartTime(ctx, runID)
+		if err != nil {
+			return err
+		}
+		ts = start.Add(*o.sinceRunStart)
 	}
 	tsMs := ts.UnixMilli()
 
+	if o.monotonic {
+		var step int64
+		if o.step != nil {
+			step = *o.step
+		}
+		if err := c.checkMonotonicStep(runID, key, step); err != nil {
+			return err
+		}
+	}
+
 	req := &mlflowpb.LogMetric{
 		RunId:     &runID,
 		Key:       &key,
@@ -450,6 +813,13 @@ func (c *Client) LogMetric(ctx context.Context, runID, key string, value float64
 	if o.step != nil {
 		req.Step = o.step
 	}
+	if o.modelID != "" {
+		req.ModelId = &o.modelID
+	}
+	if o.datasetName != "" {
+		req.DatasetName = &o.datasetName
+		req.DatasetDigest = &o.datasetDigest
+	}
 
 	var resp mlflowpb.LogMetric_Response
 
@@ -461,6 +831,39 @@ func (c *Client) LogMetric(ctx context.Context, runID, key string, value float64
 	return nil
 }
 
+// runStartTime returns the start time of a run, fetched once per client.
+func (c *Client) runStartTime(ctx context.Context, runID string) (time.Time, error) {
+	if v, ok := c.runStarts.Load(runID); ok {
+		return v.(time.Time), nil
+	}
+	run, err := c.GetRun(ctx, runID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if run.Info.StartTime.IsZero() {
+		return time.Time{}, fmt.Errorf("mlflow: run %s has no start time", runID)
+	}
+	c.runStarts.Store(runID, run.Info.StartTime)
+	return run.Info.StartTime, nil
+}
+
+// checkMonotonicStep returns an error if step is lower than the last step
+// this client logged for the run's metric, and otherwise records it.
+func (c *Client) checkMonotonicStep(runID, key string, step int64) error {
+	c.stepsMu.Lock()
+	defer c.stepsMu.Unlock()
+
+	k := metricStepKey{runID, key}
+	if last, ok := c.steps[k]; ok && step < last {
+		return fmt.Errorf("mlflow: step %d of metric %q is lower than step %d already logged", step, key, last)
+	}
+	if c.steps == nil {
+		c.steps = make(map[metricStepKey]int64)
+	}
+	c.steps[k] = step
+	return nil
+}
+
 // LogParam logs a parameter for a run.
 func (c *Client) LogParam(ctx context.Context, runID, key, value string) error {
 	if runID == "" {
@@ -536,48 +939,95 @@ func (c *Client) DeleteTag(ctx context.Context, runID, key string) error {
 }
 
 // LogBatch logs a batch of metrics, params, and tags for a run.
-func (c *Client) LogBatch(ctx context.Context, runID string, metrics []Metric, params []Param, tags map[string]string) error {
+// Use WithBatchRetries to retry transient failures safely.
+func (c *Client) LogBatch(ctx context.Context, runID string, metrics []Metric, params []Param, tags map[string]string, opts ...LogBatchOption) error {
 	if runID == "" {
 		return fmt.Errorf("mlflow: run ID is required")
 	}
 
-	req := &mlflowpb.LogBatch{
-		RunId: &runID,
+	o := &logBatchOptions{}
+	for _, opt := range opts {
+		opt(o)
 	}
 
+	// Stamp metrics once so every attempt sends identical timestamps
 	now := time.Now()
-	for _, m := range metrics {
-		ts := now
+	send := func(params []Param) error {
+		req := newLogBatchRequest(runID, metrics, params, tags, now)
+		var resp mlflowpb.LogBatch_Response
+		return c.transport.Post(ctx, "/api/2.0/mlflow/runs/log-batch", req, &resp)
+	}
+
+	var err error
+	if o.retries > 0 {
+		err = c.logBatchWithRetries(ctx, runID, params, o, send)
+	} else {
+		err = send(params)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to log batch: %w", err)
+	}
+
+	return nil
+}
+
+// newLogBatchRequest builds a LogBatch request. Metrics without a timestamp
+// are stamped with now.
+//
+// Large batches are the SDK's hottest encode path, so the proto messages and
+// their scalar fields are allocated in one block per kind rather than one
+// allocation per field. String fields point into the caller's slices, which
+// is safe because the request is encoded before LogBatch returns.
+func newLogBatchRequest(runID string, metrics []Metric, params []Param, tags map[string]string, now time.Time) *mlflowpb.LogBatch {
+	req := &mlflowpb.LogBatch{
+		RunId:   &runID,
+		Metrics: make([]*mlflowpb.Metric, len(metrics)),
+		Params:  make([]*mlflowpb.Param, len(params)),
+		Tags:    make([]*mlflowpb.RunTag, 0, len(tags)),
+	}
+
+	nowMs := now.UnixMilli()
+	pbMetrics := make([]mlflowpb.Metric, len(metrics))
+	timestamps := make([]int64, len(metrics))
+	for i := range metrics {
+		m := &metrics[i]
+		timestamps[i] = nowMs
 		if !m.Timestamp.IsZero() {
-			ts = m.Timestamp
+			timestamps[i] = m.Timestamp.UnixMilli()
 		}
-		tsMs := ts.UnixMilli()
-		pbMetric := &mlflowpb.Metric{
-			Key:       conv.Ptr(m.Key),
-			Value:     conv.Ptr(m.Value),
-			Step:      conv.Ptr(m.Step),
-			Timestamp: &tsMs,
+		pb := &pbMetrics[i]
+		pb.Key = &m.Key
+		pb.Value = &m.Value
+		pb.Step = &m.Step
+		pb.Timestamp = &timestamps[i]
+		if m.ModelID != "" {
+			pb.ModelId = &m.ModelID
 		}
-		req.Metrics = append(req.Metrics, pbMetric)
+		if m.DatasetName != "" {
+			pb.DatasetName = &m.DatasetName
+			pb.DatasetDigest = &m.DatasetDigest
+		}
+		req.Metrics[i] = pb
 	}
 
-	for _, p := range params {
-		req.Params = append(req.Params, &mlflowpb.Param{
-			Key:   conv.Ptr(p.Key),
-			Value: conv.Ptr(p.Value),
-		})
+	pbParams := make([]mlflowpb.Param, len(params))
+	for i := range params {
+		pb := &pbParams[i]
+		pb.Key = &params[i].Key
+		pb.Value = &params[i].Value
+		req.Params[i] = pb
 	}
 
+	pbTags := make([]mlflowpb.RunTag, len(tags))
+	tagStrings := make([]string, 0, 2*len(tags))
 	for k, v := range tags {
-		req.Tags = append(req.Tags, &mlflowpb.RunTag{Key: conv.Ptr(k), Value: conv.Ptr(v)})
+		tagStrings = append(tagStrings, k, v)
+		n := len(tagStrings)
+		pb := &pbTags[len(req.Tags)]
+		pb.Key = &tagStrings[n-2]
+		pb.Value = &tagStrings[n-1]
+		req.Tags = append(req.Tags, pb)
 	}
 
-	var resp mlflowpb.LogBatch_Response
-
-	err := c.transport.Post(ctx, "/api/2.0/mlflow/runs/log-batch", req, &resp)
-	if err != nil {
-		return fmt.Errorf("failed to log batch: %w", err)
-	}
-
-	return nil
+	return req
 }
@@ -3,12 +3,17 @@ package tracking
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/opendatahub-io/mlflow-go/internal/errors"
 	"github.com/opendatahub-io/mlflow-go/internal/transport"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
 )
 
 func newTestClient(t *testing.T, handler http.Handler) *Client {
@@ -120,6 +125,54 @@ func TestCreateExperiment_WithTags(t *testing.T) {
 	}
 }
 
+func TestCreateExperiment_ArtifactLocationTemplate(t *testing.T) {
+	var receivedLocation string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ArtifactLocation string `json:"artifact_location"`
+		}
+		mustDecodeJSON(t, r, &req)
+		receivedLocation = req.ArtifactLocation
+
+		mustEncodeJSON(t, w, map[string]any{
+			"experiment_id": "123",
+		})
+	}))
+
+	_, err := client.CreateExperiment(context.Background(), "churn-model",
+		WithArtifactLocationTemplate("s3://ml-artifacts/{experiment_kind}/{experiment_name}"),
+		WithExperimentKind(ExperimentKindMLDevelopment),
+	)
+	if err != nil {
+		t.Fatalf("CreateExperiment() error = %v", err)
+	}
+
+	if want := "s3://ml-artifacts/custom_model_development/churn-model"; receivedLocation != want {
+		t.Errorf("artifact_location = %q, want %q", receivedLocation, want)
+	}
+}
+
+func TestCreateExperiment_ArtifactLocationTemplateErrors(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+
+	_, err := client.CreateExperiment(ctx, "churn-model", WithArtifactLocationTemplate("bucket/{experiment_name}"))
+	if err == nil {
+		t.Error("expected error for template without a scheme")
+	}
+
+	_, err = client.CreateExperiment(ctx, "churn-model",
+		WithArtifactLocationTemplate("s3://bucket/{experiment_name}"),
+		WithArtifactLocation("s3://bucket/other"),
+	)
+	if err == nil {
+		t.Error("expected error for both WithArtifactLocation and WithArtifactLocationTemplate")
+	}
+}
+
 func TestCreateExperiment_AlreadyExists(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -165,6 +218,7 @@ func TestGetExperiment_Success(t *testing.T) {
 				"last_update_time":  1700000100000,
 				"tags": []map[string]string{
 					{"key": "team", "value": "ml"},
+					{"key": "mlflow.experimentKind", "value": "finetuning"},
 				},
 			},
 		})
@@ -187,6 +241,9 @@ func TestGetExperiment_Success(t *testing.T) {
 	if exp.Tags["team"] != "ml" {
 		t.Errorf("Tags[team] = %q, want %q", exp.Tags["team"], "ml")
 	}
+	if exp.Kind != ExperimentKindFineTuning {
+		t.Errorf("Kind = %q, want %q", exp.Kind, ExperimentKindFineTuning)
+	}
 	if exp.CreationTime.IsZero() {
 		t.Error("CreationTime should not be zero")
 	}
@@ -263,6 +320,43 @@ func TestGetExperimentByName_EmptyName(t *testing.T) {
 	}
 }
 
+// --- Existence check tests ---
+
+func TestExperimentExists(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		name, id := r.URL.Query().Get("experiment_name"), r.URL.Query().Get("experiment_id")
+		switch {
+		case name == "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "INTERNAL_ERROR"})
+		case name == "found" || id == "1":
+			mustEncodeJSON(t, w, map[string]any{"experiment": map[string]any{"experiment_id": "1", "name": "found"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "RESOURCE_DOES_NOT_EXIST"})
+		}
+	}))
+	ctx := context.Background()
+
+	if ok, err := client.ExperimentExists(ctx, "found"); !ok || err != nil {
+		t.Errorf("ExperimentExists(found) = %v, %v; want true, nil", ok, err)
+	}
+	if ok, err := client.ExperimentExists(ctx, "missing"); ok || err != nil {
+		t.Errorf("ExperimentExists(missing) = %v, %v; want false, nil", ok, err)
+	}
+	if _, err := client.ExperimentExists(ctx, "broken"); err == nil {
+		t.Error("ExperimentExists(broken) error = nil, want the server error")
+	}
+
+	if exp, err := client.GetExperimentOrNil(ctx, "1"); err != nil || exp == nil || exp.Name != "found" {
+		t.Errorf("GetExperimentOrNil(1) = %v, %v", exp, err)
+	}
+	if exp, err := client.GetExperimentOrNil(ctx, "2"); exp != nil || err != nil {
+		t.Errorf("GetExperimentOrNil(2) = %v, %v; want nil, nil", exp, err)
+	}
+}
+
 // --- DeleteExperiment tests ---
 
 func TestDeleteExperiment_Success(t *testing.T) {
@@ -420,6 +514,48 @@ func TestSearchExperiments_Empty(t *testing.T) {
 	}
 }
 
+func TestSearchExperiments_KindFilter(t *testing.T) {
+	var receivedFilter string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Filter string `json:"filter"`
+		}
+		mustDecodeJSON(t, r, &req)
+		receivedFilter = req.Filter
+
+		mustEncodeJSON(t, w, map[string]any{
+			"experiments": []map[string]any{
+				{
+					"experiment_id": "1",
+					"name":          "exp-1",
+					"tags": []map[string]string{
+						{"key": "mlflow.experimentKind", "value": "genai_development"},
+					},
+				},
+			},
+		})
+	}))
+
+	result, err := client.SearchExperiments(context.Background(),
+		WithExperimentsFilter("name LIKE 'exp-%'"),
+		WithExperimentsKindFilter(ExperimentKindGenAIDevelopment),
+	)
+	if err != nil {
+		t.Fatalf("SearchExperiments() error = %v", err)
+	}
+
+	wantFilter := "name LIKE 'exp-%' AND tags.`mlflow.experimentKind` = 'genai_development'"
+	if receivedFilter != wantFilter {
+		t.Errorf("filter = %q, want %q", receivedFilter, wantFilter)
+	}
+	if result.Experiments[0].Kind != ExperimentKindGenAIDevelopment {
+		t.Errorf("Kind = %q, want %q", result.Experiments[0].Kind, ExperimentKindGenAIDevelopment)
+	}
+}
+
 func TestSearchExperiments_InvalidViewType(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
@@ -534,6 +670,68 @@ func TestCreateRun_Success(t *testing.T) {
 	}
 }
 
+func TestCreateRun_GeneratesRunName(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []CreateRunOption
+		wantName  string
+		generated bool
+		wantTag   bool
+	}{
+		{"no name", nil, "", true, true},
+		{"explicit name", []CreateRunOption{WithRunName("test-run")}, "test-run", false, false},
+		{"name tag", []CreateRunOption{WithRunTags(map[string]string{mlflowtags.RunName: "tagged"})}, "tagged", false, true},
+		{"generation disabled", []CreateRunOption{WithoutGeneratedRunName()}, "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req struct {
+				RunName *string `json:"run_name"`
+				Tags    []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"tags"`
+			}
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mustDecodeJSON(t, r, &req)
+				mustEncodeJSON(t, w, map[string]any{"run": map[string]any{"info": map[string]any{"run_id": "abc-123"}}})
+			}))
+
+			if _, err := client.CreateRun(context.Background(), "1", tt.opts...); err != nil {
+				t.Fatalf("CreateRun() error = %v", err)
+			}
+
+			var tag string
+			for _, kv := range req.Tags {
+				if kv.Key == mlflowtags.RunName {
+					if tag != "" {
+						t.Errorf("%s tag sent twice", mlflowtags.RunName)
+					}
+					tag = kv.Value
+				}
+			}
+			var runName string
+			if req.RunName != nil {
+				runName = *req.RunName
+			}
+			switch {
+			case tt.generated:
+				if !regexp.MustCompile(`^[a-z]+-[a-z]+-\d+$`).MatchString(runName) {
+					t.Errorf("run_name = %q, want a generated name", runName)
+				}
+			case runName != tt.wantName:
+				t.Errorf("run_name = %q, want %q", runName, tt.wantName)
+			}
+			if tt.wantTag && tag != runName {
+				t.Errorf("%s tag = %q, want %q", mlflowtags.RunName, tag, runName)
+			}
+			if !tt.wantTag && tag != "" {
+				t.Errorf("%s tag = %q, want none", mlflowtags.RunName, tag)
+			}
+		})
+	}
+}
+
 func TestCreateRun_EmptyExperimentID(t *testing.T) {
 	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
@@ -852,6 +1050,64 @@ func TestSearchRuns_InvalidViewType(t *testing.T) {
 	}
 }
 
+// --- GetMetricHistory tests ---
+
+func TestGetMetricHistory_Paginates(t *testing.T) {
+	var tokens []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/api/2.0/mlflow/metrics/get-history" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("run_id") != "run-1" || q.Get("metric_key") != "loss" {
+			t.Errorf("query = %v", q)
+		}
+		tokens = append(tokens, q.Get("page_token"))
+
+		if q.Get("page_token") == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"metrics":         []map[string]any{{"key": "loss", "value": 0.9, "step": 0, "timestamp": 1000}},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"metrics": []map[string]any{{"key": "loss", "value": 0.5, "step": 1, "timestamp": 2000}},
+		})
+	}))
+
+	metrics, err := client.GetMetricHistory(context.Background(), "run-1", "loss")
+	if err != nil {
+		t.Fatalf("GetMetricHistory() error = %v", err)
+	}
+
+	if len(tokens) != 2 || tokens[1] != "p2" {
+		t.Errorf("page tokens = %v, want [\"\" p2]", tokens)
+	}
+	if len(metrics) != 2 {
+		t.Fatalf("got %d metrics, want 2", len(metrics))
+	}
+	if metrics[1].Value != 0.5 || metrics[1].Step != 1 || !metrics[1].Timestamp.Equal(time.UnixMilli(2000)) {
+		t.Errorf("metrics[1] = %+v", metrics[1])
+	}
+}
+
+func TestGetMetricHistory_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := client.GetMetricHistory(context.Background(), "", "loss"); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if _, err := client.GetMetricHistory(context.Background(), "run-1", ""); err == nil {
+		t.Error("expected error for empty metric key")
+	}
+}
+
 // --- LogMetric tests ---
 
 func TestLogMetric_Success(t *testing.T) {
@@ -914,6 +1170,156 @@ func TestLogMetric_EmptyKey(t *testing.T) {
 	}
 }
 
+func TestLogMetric_RelativeTimestamp(t *testing.T) {
+	var gets int
+	var timestamps []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/runs/get":
+			gets++
+			mustEncodeJSON(t, w, map[string]any{
+				"run": map[string]any{"info": map[string]any{"run_id": "abc-123", "start_time": 1_000_000}},
+			})
+		case "/api/2.0/mlflow/runs/log-metric":
+			var req struct {
+				Timestamp json.Number `json:"timestamp"`
+			}
+			mustDecodeJSON(t, r, &req)
+			timestamps = append(timestamps, req.Timestamp.String())
+			mustEncodeJSON(t, w, map[string]any{})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	ctx := context.Background()
+	for _, d := range []time.Duration{1500 * time.Microsecond, time.Minute} {
+		if err := client.LogMetric(ctx, "abc-123", "loss", 0.1, WithRelativeTimestamp(d)); err != nil {
+			t.Fatalf("LogMetric() error = %v", err)
+		}
+	}
+
+	if gets != 1 {
+		t.Errorf("GetRun called %d times, want 1 (cached)", gets)
+	}
+	if want := []string{"1000001", "1060000"}; len(timestamps) != 2 || timestamps[0] != want[0] || timestamps[1] != want[1] {
+		t.Errorf("timestamps = %v, want %v", timestamps, want)
+	}
+}
+
+func TestLogMetric_MonotonicSteps(t *testing.T) {
+	var logged int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		logged++
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+	ctx := context.Background()
+
+	for _, step := range []int64{1, 2, 2, 5} {
+		if err := client.LogMetric(ctx, "run-1", "loss", 0.1, WithStep(step), WithMonotonicSteps()); err != nil {
+			t.Fatalf("LogMetric(step %d) error = %v", step, err)
+		}
+	}
+	if err := client.LogMetric(ctx, "run-1", "loss", 0.1, WithStep(3), WithMonotonicSteps()); err == nil {
+		t.Error("expected error for a step lower than one already logged")
+	}
+
+	// Other keys and runs are tracked separately, and unchecked calls pass
+	if err := client.LogMetric(ctx, "run-1", "acc", 0.1, WithStep(0), WithMonotonicSteps()); err != nil {
+		t.Errorf("LogMetric(other key) error = %v", err)
+	}
+	if err := client.LogMetric(ctx, "run-2", "loss", 0.1, WithStep(0), WithMonotonicSteps()); err != nil {
+		t.Errorf("LogMetric(other run) error = %v", err)
+	}
+	if err := client.LogMetric(ctx, "run-1", "loss", 0.1, WithStep(0)); err != nil {
+		t.Errorf("LogMetric(unchecked) error = %v", err)
+	}
+	if logged != 7 {
+		t.Errorf("logged %d values, want 7 (the rejected one is not sent)", logged)
+	}
+}
+
+func TestLogMetric_ModelAndDataset(t *testing.T) {
+	var req struct {
+		ModelID       string `json:"model_id"`
+		DatasetName   string `json:"dataset_name"`
+		DatasetDigest string `json:"dataset_digest"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mustDecodeJSON(t, r, &req)
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	err := client.LogMetric(context.Background(), "run-1", "accuracy", 0.93,
+		WithModelID("m-123"), WithDataset("eval-set", "abc123"))
+	if err != nil {
+		t.Fatalf("LogMetric() error = %v", err)
+	}
+	if req.ModelID != "m-123" || req.DatasetName != "eval-set" || req.DatasetDigest != "abc123" {
+		t.Errorf("request = %+v, want model and dataset set", req)
+	}
+}
+
+func TestLogBatch_ModelMetrics(t *testing.T) {
+	var req struct {
+		Metrics []map[string]any `json:"metrics"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mustDecodeJSON(t, r, &req)
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	err := client.LogBatch(context.Background(), "run-1", []Metric{
+		{Key: "accuracy", Value: 0.93, ModelID: "m-123", DatasetName: "eval-set", DatasetDigest: "abc123"},
+		{Key: "loss", Value: 0.2},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("LogBatch() error = %v", err)
+	}
+	if len(req.Metrics) != 2 {
+		t.Fatalf("got %d metrics, want 2", len(req.Metrics))
+	}
+	if req.Metrics[0]["model_id"] != "m-123" || req.Metrics[0]["dataset_name"] != "eval-set" {
+		t.Errorf("metrics[0] = %v, want model and dataset", req.Metrics[0])
+	}
+	if _, ok := req.Metrics[1]["model_id"]; ok {
+		t.Errorf("metrics[1] = %v, want no model_id for a run metric", req.Metrics[1])
+	}
+}
+
+func TestGetMetricHistory_ModelMetrics(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"metrics": []map[string]any{{
+				"key": "accuracy", "value": 0.9, "step": 0, "timestamp": 1000,
+				"model_id": "m-123", "dataset_name": "eval-set", "dataset_digest": "abc123",
+			}},
+		})
+	}))
+
+	metrics, err := client.GetMetricHistory(context.Background(), "run-1", "accuracy")
+	if err != nil {
+		t.Fatalf("GetMetricHistory() error = %v", err)
+	}
+	if len(metrics) != 1 || metrics[0].ModelID != "m-123" || metrics[0].DatasetName != "eval-set" || metrics[0].DatasetDigest != "abc123" {
+		t.Errorf("metrics = %+v, want model and dataset parsed", metrics)
+	}
+}
+
+func TestMetric_SinceStart(t *testing.T) {
+	start := time.UnixMilli(1_000_000)
+	m := Metric{Timestamp: time.UnixMilli(1_090_500)}
+	if got := m.SinceStart(start); got != 90500*time.Millisecond {
+		t.Errorf("SinceStart() = %v, want 1m30.5s", got)
+	}
+}
+
 // --- LogParam tests ---
 
 func TestLogParam_Success(t *testing.T) {
@@ -1173,3 +1579,258 @@ func TestLogBatch_EmptyBatch(t *testing.T) {
 		t.Fatalf("LogBatch() with empty batch error = %v", err)
 	}
 }
+
+// --- Cursor tests ---
+
+func TestSearchRunsCursor_Next(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+
+		switch req.PageToken {
+		case "start":
+			mustEncodeJSON(t, w, map[string]any{
+				"runs":            []map[string]any{{"info": map[string]any{"run_id": "r1"}}},
+				"next_page_token": "second",
+			})
+		case "second":
+			mustEncodeJSON(t, w, map[string]any{
+				"runs": []map[string]any{{"info": map[string]any{"run_id": "r2"}}},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			mustEncodeJSON(t, w, map[string]string{"error_code": "INVALID_PARAMETER_VALUE", "message": "bad token"})
+		}
+	}))
+
+	cur := client.SearchRunsCursor([]string{"1"}, WithRunsPageToken("start"))
+
+	var ids []string
+	for {
+		page, ok := cur.Next(context.Background())
+		if !ok {
+			break
+		}
+		for _, r := range page.Items {
+			ids = append(ids, r.Info.RunID)
+		}
+	}
+	if err := cur.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "r1" || ids[1] != "r2" {
+		t.Errorf("run IDs = %v, want [r1 r2]", ids)
+	}
+}
+
+func TestSearchExperimentsCursor_Error(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		mustEncodeJSON(t, w, map[string]string{"error_code": "PERMISSION_DENIED", "message": "no"})
+	}))
+
+	_, err := client.SearchExperimentsCursor().All(context.Background())
+	if !errors.IsPermissionDenied(err) {
+		t.Errorf("expected IsPermissionDenied, got %v", err)
+	}
+}
+
+func BenchmarkLogBatch_Encode(b *testing.B) {
+	// Dry-run stops after the request is encoded, so this measures request
+	// building and JSON encoding without network I/O.
+	tc, err := transport.New(transport.Config{BaseURL: "https://mlflow.example.com", DryRun: true})
+	if err != nil {
+		b.Fatal(err)
+	}
+	client := NewClient(tc)
+
+	metrics := make([]Metric, 1000)
+	for i := range metrics {
+		metrics[i] = Metric{Key: "loss", Value: float64(i) / 1000, Step: int64(i)}
+	}
+	params := make([]Param, 50)
+	for i := range params {
+		params[i] = Param{Key: "param_" + strconv.Itoa(i), Value: strconv.Itoa(i)}
+	}
+	tags := make(map[string]string, 20)
+	for i := range 20 {
+		tags["tag_"+strconv.Itoa(i)] = "value"
+	}
+
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := client.LogBatch(ctx, "run-1", metrics, params, tags); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestStreamRuns(t *testing.T) {
+	var tokens []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			MaxResults int    `json:"max_results"`
+			PageToken  string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+		tokens = append(tokens, req.PageToken)
+		if req.MaxResults != 2 {
+			t.Errorf("max_results = %d, want 2", req.MaxResults)
+		}
+
+		if req.PageToken == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"runs": []map[string]any{
+					{"info": map[string]any{"run_id": "r1", "status": "FINISHED"}},
+					{"info": map[string]any{"run_id": "r2"}, "data": map[string]any{"metrics": []map[string]any{{"key": "loss", "value": 0.5}}}},
+				},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"runs": []map[string]any{{"info": map[string]any{"run_id": "r3"}}},
+		})
+	}))
+
+	var runs []Run
+	err := client.StreamRuns(context.Background(), []string{"1"}, func(r Run) error {
+		runs = append(runs, r)
+		return nil
+	}, WithRunsMaxResults(2))
+	if err != nil {
+		t.Fatalf("StreamRuns() error = %v", err)
+	}
+
+	if len(runs) != 3 || runs[0].Info.RunID != "r1" || runs[2].Info.RunID != "r3" {
+		t.Fatalf("runs = %+v", runs)
+	}
+	if runs[0].Info.Status != RunStatusFinished || len(runs[1].Data.Metrics) != 1 {
+		t.Errorf("runs not fully decoded: %+v", runs[:2])
+	}
+	if len(tokens) != 2 || tokens[1] != "p2" {
+		t.Errorf("page tokens = %v, want [\"\" p2]", tokens)
+	}
+}
+
+func TestStreamRuns_StopsOnCallbackError(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"runs":            []map[string]any{{"info": map[string]any{"run_id": "r1"}}, {"info": map[string]any{"run_id": "r2"}}},
+			"next_page_token": "more",
+		})
+	}))
+
+	stop := stderrors.New("stop")
+	calls := 0
+	err := client.StreamRuns(context.Background(), []string{"1"}, func(Run) error {
+		calls++
+		return stop
+	})
+	if !stderrors.Is(err, stop) {
+		t.Errorf("StreamRuns() error = %v, want %v", err, stop)
+	}
+	if calls != 1 || requests != 1 {
+		t.Errorf("calls = %d, requests = %d, want 1 and 1", calls, requests)
+	}
+}
+
+func TestStreamRuns_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if err := client.StreamRuns(context.Background(), nil, func(Run) error { return nil }); err == nil {
+		t.Error("expected error for no experiment IDs")
+	}
+	if err := client.StreamRuns(context.Background(), []string{"1"}, nil); err == nil {
+		t.Error("expected error for nil callback")
+	}
+	if err := client.StreamRuns(context.Background(), []string{"1"}, func(Run) error { return nil }, WithRunsMaxResults(0)); err == nil {
+		t.Error("expected error for non-positive max results")
+	}
+}
+
+func TestSearchRuns_WithRunsCountUpTo(t *testing.T) {
+	// Three pages of two runs each, keyed by page token
+	pages := map[string]map[string]any{
+		"":   {"runs": []map[string]any{{"info": map[string]any{"run_id": "r1"}}, {"info": map[string]any{"run_id": "r2"}}}, "next_page_token": "p2"},
+		"p2": {"runs": []map[string]any{{"info": map[string]any{"run_id": "r3"}}, {"info": map[string]any{"run_id": "r4"}}}, "next_page_token": "p3"},
+		"p3": {"runs": []map[string]any{{"info": map[string]any{"run_id": "r5"}}, {"info": map[string]any{"run_id": "r6"}}}},
+	}
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req struct {
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, pages[req.PageToken])
+	}))
+
+	ctx := context.Background()
+	list, err := client.SearchRuns(ctx, []string{"1"}, WithRunsMaxResults(2), WithRunsCountUpTo(100))
+	if err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	if len(list.Runs) != 2 || list.TotalCount == nil || *list.TotalCount != 6 || list.TotalCountCapped {
+		t.Errorf("SearchRuns() = %d runs, TotalCount %v, capped %v, want 2, 6, false", len(list.Runs), list.TotalCount, list.TotalCountCapped)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+
+	list, err = client.SearchRuns(ctx, []string{"1"}, WithRunsMaxResults(2), WithRunsPageToken("p3"), WithRunsCountUpTo(3))
+	if err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	if list.Runs[0].Info.RunID != "r5" || list.TotalCount == nil || *list.TotalCount != 3 || !list.TotalCountCapped {
+		t.Errorf("SearchRuns() = %s, TotalCount %v, capped %v, want r5, 3, true", list.Runs[0].Info.RunID, list.TotalCount, list.TotalCountCapped)
+	}
+
+	if list, _ = client.SearchRuns(ctx, []string{"1"}); list.TotalCount != nil {
+		t.Errorf("TotalCount = %d without WithRunsCountUpTo, want nil", *list.TotalCount)
+	}
+}
+
+func TestClient_DryRun_ChainsCreatedIDs(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	ctx := transport.WithDryRun(context.Background())
+
+	expID, err := client.CreateExperiment(ctx, "dry")
+	if err != nil || expID != DryRunID {
+		t.Fatalf("CreateExperiment() = %q, %v, want %q", expID, err, DryRunID)
+	}
+
+	start := time.UnixMilli(1700000000000)
+	run, err := client.CreateRun(ctx, expID, WithRunName("trial"), WithStartTime(start), WithRunTags(map[string]string{"team": "ml"}))
+	if err != nil {
+		t.Fatalf("CreateRun() error = %v", err)
+	}
+	if run.Info.RunID != DryRunID || run.Info.ExperimentID != expID || run.Info.RunName != "trial" ||
+		run.Info.Status != RunStatusRunning || !run.Info.StartTime.Equal(start) || run.Data.Tags["team"] != "ml" {
+		t.Errorf("CreateRun() = %+v, want the submitted run", run)
+	}
+
+	if err := client.LogMetric(ctx, run.Info.RunID, "loss", 0.5); err != nil {
+		t.Errorf("LogMetric() on the dry-run run error = %v", err)
+	}
+	info, err := client.UpdateRun(ctx, run.Info.RunID, WithStatus(RunStatusFinished))
+	if err != nil || info.RunID != DryRunID || info.Status != RunStatusFinished {
+		t.Errorf("UpdateRun() = %+v, %v, want the finished run", info, err)
+	}
+}
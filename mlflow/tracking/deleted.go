@@ -0,0 +1,75 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+// ListDeletedExperiments returns every experiment marked for deletion and
+// not yet purged by `mlflow gc`, fetching all pages.
+func (c *Client) ListDeletedExperiments(ctx context.Context) ([]Experiment, error) {
+	return c.SearchExperimentsCursor(WithExperimentsViewType(ViewTypeDeletedOnly)).All(ctx)
+}
+
+// ListDeletedRuns returns every run of an experiment marked for deletion and
+// not yet purged by `mlflow gc`, fetching all pages.
+func (c *Client) ListDeletedRuns(ctx context.Context, experimentID string) ([]Run, error) {
+	if experimentID == "" {
+		return nil, fmt.Errorf("mlflow: experiment ID is required")
+	}
+	return c.SearchRunsCursor([]string{experimentID}, WithRunsViewType(ViewTypeDeletedOnly)).All(ctx)
+}
+
+// HardDeleteExperiment permanently deletes an experiment with its runs,
+// metrics, params, and tags, as `mlflow gc` does, on servers that expose a
+// hard-delete admin endpoint. It cannot be undone: unlike DeleteExperiment,
+// which marks an experiment for deletion so it can be restored, nothing is
+// left to restore.
+//
+// Following gc semantics, the experiment must already be marked for deletion
+// with DeleteExperiment. Artifacts are not removed from the artifact store.
+// On a client created with WithDeleteProtection, the experiment ID must be
+// confirmed with WithConfirm. If the server has no hard-delete
+// endpoint, as MLflow OSS does not, HardDeleteExperiment returns an error
+// wrapping errors.ErrUnsupportedByServer (mlflow.ErrUnsupportedByServer);
+// run `mlflow gc --experiment-ids` against the backend store instead.
+func (c *Client) HardDeleteExperiment(ctx context.Context, experimentID string, opts ...DeleteOption) error {
+	if experimentID == "" {
+		return fmt.Errorf("mlflow: experiment ID is required")
+	}
+
+	exp, err := c.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return fmt.Errorf("failed to hard delete experiment: %w", err)
+	}
+	if exp.LifecycleStage != lifecycleStageDeleted {
+		return fmt.Errorf("mlflow: experiment %s is %s; delete it with DeleteExperiment before deleting it permanently", experimentID, exp.LifecycleStage)
+	}
+
+	req := &hardDeleteExperimentRequest{ExperimentID: experimentID}
+
+	err = c.transport.Post(confirmDelete(ctx, experimentID, opts), hardDeleteExperimentPath, req, nil)
+	if err != nil {
+		var apiErr *errors.APIError
+		if stderrors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound ||
+			apiErr.StatusCode == http.StatusMethodNotAllowed || apiErr.StatusCode == http.StatusNotImplemented) {
+			// The experiment exists, so a 404 means the endpoint does not
+			return fmt.Errorf("failed to hard delete experiment: %w: %w", errors.ErrUnsupportedByServer, err)
+		}
+		return fmt.Errorf("failed to hard delete experiment: %w", err)
+	}
+
+	return nil
+}
+
+// hardDeleteExperimentPath is the admin endpoint of servers that support
+// permanently deleting experiments. It is not part of the MLflow protos.
+const hardDeleteExperimentPath = "/api/2.0/mlflow/experiments/hard-delete"
+
+type hardDeleteExperimentRequest struct {
+	ExperimentID string `json:"experiment_id"`
+}
@@ -0,0 +1,153 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+func TestListDeletedExperiments(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ViewType  int    `json:"view_type"`
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+		if req.ViewType != int(mlflowpb.ViewType_DELETED_ONLY) {
+			t.Errorf("view_type = %d, want DELETED_ONLY", req.ViewType)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.PageToken == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"experiments":     []map[string]any{{"experiment_id": "1", "lifecycle_stage": "deleted"}},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"experiments": []map[string]any{{"experiment_id": "2", "lifecycle_stage": "deleted"}},
+		})
+	}))
+
+	experiments, err := client.ListDeletedExperiments(context.Background())
+	if err != nil {
+		t.Fatalf("ListDeletedExperiments() error = %v", err)
+	}
+	if len(experiments) != 2 || experiments[0].ID != "1" || experiments[1].ID != "2" {
+		t.Errorf("ListDeletedExperiments() = %+v", experiments)
+	}
+}
+
+func TestListDeletedRuns(t *testing.T) {
+	var pages int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		var req struct {
+			ExperimentIDs []string `json:"experiment_ids"`
+			RunViewType   int      `json:"run_view_type"`
+			PageToken     string   `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+		if req.RunViewType != int(mlflowpb.ViewType_DELETED_ONLY) {
+			t.Errorf("run_view_type = %d, want DELETED_ONLY", req.RunViewType)
+		}
+		if len(req.ExperimentIDs) != 1 || req.ExperimentIDs[0] != "7" {
+			t.Errorf("experiment_ids = %v, want [7]", req.ExperimentIDs)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.PageToken == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"runs":            []map[string]any{{"info": map[string]any{"run_id": "a", "lifecycle_stage": "deleted"}}},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"runs": []map[string]any{{"info": map[string]any{"run_id": "b", "lifecycle_stage": "deleted"}}},
+		})
+	}))
+
+	runs, err := client.ListDeletedRuns(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("ListDeletedRuns() error = %v", err)
+	}
+	if len(runs) != 2 || runs[0].Info.RunID != "a" || runs[1].Info.RunID != "b" || pages != 2 {
+		t.Errorf("ListDeletedRuns() = %+v after %d pages", runs, pages)
+	}
+}
+
+func TestListDeletedRuns_RequiresExperimentID(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	if _, err := client.ListDeletedRuns(context.Background(), ""); err == nil {
+		t.Error("expected error for empty experiment ID")
+	}
+}
+
+func TestHardDeleteExperiment(t *testing.T) {
+	tests := []struct {
+		name            string
+		stage           string
+		endpointStatus  int
+		wantHardDelete  bool
+		wantErr         string
+		wantUnsupported bool
+	}{
+		{name: "deleted", stage: "deleted", endpointStatus: http.StatusOK, wantHardDelete: true},
+		{name: "active", stage: "active", wantErr: "delete it with DeleteExperiment"},
+		{name: "unsupported", stage: "deleted", endpointStatus: http.StatusNotFound, wantHardDelete: true, wantUnsupported: true},
+		{name: "server error", stage: "deleted", endpointStatus: http.StatusInternalServerError, wantHardDelete: true, wantErr: "failed to hard delete experiment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hardDeleted bool
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/2.0/mlflow/experiments/get":
+					mustEncodeJSON(t, w, map[string]any{
+						"experiment": map[string]any{"experiment_id": "7", "lifecycle_stage": tt.stage},
+					})
+				case "/api/2.0/mlflow/experiments/hard-delete":
+					hardDeleted = true
+					var req struct {
+						ExperimentID string `json:"experiment_id"`
+					}
+					mustDecodeJSON(t, r, &req)
+					if req.ExperimentID != "7" {
+						t.Errorf("experiment_id = %q, want 7", req.ExperimentID)
+					}
+					w.WriteHeader(tt.endpointStatus)
+					mustEncodeJSON(t, w, map[string]any{})
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+
+			err := client.HardDeleteExperiment(context.Background(), "7")
+			if hardDeleted != tt.wantHardDelete {
+				t.Errorf("hard-delete called = %v, want %v", hardDeleted, tt.wantHardDelete)
+			}
+			if got := stderrors.Is(err, errors.ErrUnsupportedByServer); got != tt.wantUnsupported {
+				t.Errorf("errors.Is(err, ErrUnsupportedByServer) = %v, want %v (err = %v)", got, tt.wantUnsupported, err)
+			}
+			if tt.wantErr == "" && !tt.wantUnsupported && err != nil {
+				t.Fatalf("HardDeleteExperiment() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("HardDeleteExperiment() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := (&Client{}).HardDeleteExperiment(context.Background(), ""); err == nil {
+		t.Error("HardDeleteExperiment(\"\") error = nil")
+	}
+}
@@ -0,0 +1,132 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// RankMetricKey returns the key DistributedLogger.LogRankMetric logs key
+// under for rank, such as "loss/rank3". MLflow rejects "@" in metric keys;
+// the slash groups the ranks of a metric together in the MLflow UI.
+func RankMetricKey(key string, rank int) string {
+	return key + "/rank" + strconv.Itoa(rank)
+}
+
+// DistributedLogger is a BufferedLogger for one rank of a distributed
+// training job whose ranks all log to one run. The leader rank, rank 0 by
+// default, logs the run's params, tags, and global metrics such as the
+// all-reduced loss; the other ranks ignore them, so every rank can run the
+// same code. Every rank can log its own metrics with LogRankMetric.
+//
+// A DistributedLogger is safe for concurrent use.
+type DistributedLogger struct {
+	*BufferedLogger
+
+	rank      int
+	worldSize int
+	leader    int
+	barrier   func(context.Context) error
+}
+
+// NewDistributedLogger starts a DistributedLogger for rank of worldSize
+// ranks, buffering like NewBufferedLogger with the options given to
+// WithBufferedLoggerOptions.
+func NewDistributedLogger(ctx context.Context, c *Client, runID string, rank, worldSize int, opts ...DistributedLoggerOption) (*DistributedLogger, error) {
+	var o distributedLoggerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if worldSize < 1 {
+		return nil, fmt.Errorf("mlflow: world size must be at least 1")
+	}
+	if rank < 0 || rank >= worldSize {
+		return nil, fmt.Errorf("mlflow: rank %d is out of range for world size %d", rank, worldSize)
+	}
+	if o.leader < 0 || o.leader >= worldSize {
+		return nil, fmt.Errorf("mlflow: leader rank %d is out of range for world size %d", o.leader, worldSize)
+	}
+
+	l, err := NewBufferedLogger(ctx, c, runID, o.buffered...)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributedLogger{
+		BufferedLogger: l,
+		rank:           rank,
+		worldSize:      worldSize,
+		leader:         o.leader,
+		barrier:        o.barrier,
+	}, nil
+}
+
+// Rank returns the logger's rank.
+func (l *DistributedLogger) Rank() int {
+	return l.rank
+}
+
+// WorldSize returns the number of ranks in the job.
+func (l *DistributedLogger) WorldSize() int {
+	return l.worldSize
+}
+
+// IsLeader reports whether the logger's rank logs params, tags, and global
+// metrics.
+func (l *DistributedLogger) IsLeader() bool {
+	return l.rank == l.leader
+}
+
+// LogMetric buffers a global metric value on the leader rank and does
+// nothing on the others.
+func (l *DistributedLogger) LogMetric(key string, value float64, step int64) {
+	if l.IsLeader() {
+		l.BufferedLogger.LogMetric(key, value, step)
+	}
+}
+
+// LogRankMetric buffers a metric value of this rank under
+// RankMetricKey(key, rank), on every rank.
+func (l *DistributedLogger) LogRankMetric(key string, value float64, step int64) {
+	l.BufferedLogger.LogMetric(RankMetricKey(key, l.rank), value, step)
+}
+
+// LogParam buffers a param on the leader rank and does nothing on the
+// others.
+func (l *DistributedLogger) LogParam(key, value string) {
+	if l.IsLeader() {
+		l.BufferedLogger.LogParam(key, value)
+	}
+}
+
+// SetTag buffers a tag on the leader rank and does nothing on the others.
+func (l *DistributedLogger) SetTag(key, value string) {
+	if l.IsLeader() {
+		l.BufferedLogger.SetTag(key, value)
+	}
+}
+
+// Flush sends everything this rank has buffered and then, with WithBarrier,
+// waits at the barrier, so once Flush returns on every rank, every rank's
+// values logged before it are stored. The barrier is entered even if the
+// send failed, so a rank with a failed flush never leaves the others
+// waiting. A rank with nothing buffered makes no request.
+func (l *DistributedLogger) Flush(ctx context.Context) error {
+	return l.withBarrier(ctx, l.BufferedLogger.Flush(ctx))
+}
+
+// Close closes the underlying BufferedLogger and then, with WithBarrier,
+// waits at the barrier like Flush.
+func (l *DistributedLogger) Close(ctx context.Context) error {
+	return l.withBarrier(ctx, l.BufferedLogger.Close(ctx))
+}
+
+func (l *DistributedLogger) withBarrier(ctx context.Context, err error) error {
+	if l.barrier == nil {
+		return err
+	}
+	if barrierErr := l.barrier(ctx); barrierErr != nil {
+		return errors.Join(err, fmt.Errorf("mlflow: barrier: %w", barrierErr))
+	}
+	return err
+}
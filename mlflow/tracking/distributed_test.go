@@ -0,0 +1,126 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testBarrier returns once n goroutines have called it.
+func testBarrier(n int) func(context.Context) error {
+	var (
+		mu      sync.Mutex
+		waiting int
+		release = make(chan struct{})
+	)
+	return func(ctx context.Context) error {
+		mu.Lock()
+		waiting++
+		ch := release
+		if waiting == n {
+			waiting = 0
+			release = make(chan struct{})
+			close(ch)
+		}
+		mu.Unlock()
+		select {
+		case <-ch:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestDistributedLogger(t *testing.T) {
+	rec := &batchRecorder{}
+	client := newTestClient(t, rec.handler(t))
+	ctx := context.Background()
+	barrier := testBarrier(2)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for rank := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l, err := NewDistributedLogger(ctx, client, "run-1", rank, 2, WithBarrier(barrier),
+				WithBufferedLoggerOptions(WithFlushInterval(time.Hour)))
+			if err != nil {
+				errs[rank] = err
+				return
+			}
+			l.LogParam("lr", "0.01")
+			l.SetTag("job", "ddp")
+			l.LogMetric("loss", 0.5, 1)
+			l.LogRankMetric("samples_per_sec", float64(100+rank), 1)
+			errs[rank] = l.Close(ctx)
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("rank error = %v", err)
+	}
+
+	var metrics []string
+	params, tags := 0, 0
+	for _, b := range rec.snapshot() {
+		for _, m := range b.Metrics {
+			metrics = append(metrics, m.Key)
+		}
+		params += len(b.Params)
+		tags += len(b.Tags)
+	}
+	slices.Sort(metrics)
+	if want := []string{"loss", "samples_per_sec/rank0", "samples_per_sec/rank1"}; !slices.Equal(metrics, want) {
+		t.Errorf("metrics = %v, want %v", metrics, want)
+	}
+	if params != 1 || tags != 1 {
+		t.Errorf("params = %d, tags = %d, want 1 each from the leader", params, tags)
+	}
+}
+
+func TestDistributedLogger_BarrierAfterFailedFlush(t *testing.T) {
+	rec := &batchRecorder{fail: true}
+	client := newTestClient(t, rec.handler(t))
+
+	var entered bool
+	l, err := NewDistributedLogger(context.Background(), client, "run-1", 1, 2,
+		WithBarrier(func(context.Context) error { entered = true; return nil }))
+	if err != nil {
+		t.Fatalf("NewDistributedLogger() error = %v", err)
+	}
+	defer l.Close(context.Background())
+
+	if l.IsLeader() || l.Rank() != 1 || l.WorldSize() != 2 {
+		t.Errorf("rank 1 = leader %v, rank %d, world size %d", l.IsLeader(), l.Rank(), l.WorldSize())
+	}
+	l.LogRankMetric("loss", 0.5, 0)
+	if err := l.Flush(context.Background()); err == nil {
+		t.Error("Flush() error = nil, want the failed batch")
+	}
+	if !entered {
+		t.Error("barrier not entered after a failed flush")
+	}
+}
+
+func TestNewDistributedLogger_Validation(t *testing.T) {
+	client := newTestClient(t, (&batchRecorder{}).handler(t))
+	ctx := context.Background()
+	for _, tc := range []struct {
+		rank, worldSize int
+		opts            []DistributedLoggerOption
+	}{
+		{rank: 0, worldSize: 0},
+		{rank: 2, worldSize: 2},
+		{rank: -1, worldSize: 2},
+		{rank: 0, worldSize: 2, opts: []DistributedLoggerOption{WithLeaderRank(2)}},
+	} {
+		if _, err := NewDistributedLogger(ctx, client, "run-1", tc.rank, tc.worldSize, tc.opts...); err == nil {
+			t.Errorf("NewDistributedLogger(rank %d, world size %d) error = nil", tc.rank, tc.worldSize)
+		}
+	}
+}
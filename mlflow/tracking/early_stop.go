@@ -0,0 +1,246 @@
+package tracking
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// EarlyStopTagKey is the run tag an EarlyStopper sets to the reason it
+// stopped, when it observes values through LogMetric or Sync.
+const EarlyStopTagKey = mlflowtags.EarlyStopReason
+
+// EarlyStopMode says which direction of a metric is an improvement.
+type EarlyStopMode string
+
+const (
+	// EarlyStopMin treats a decrease as an improvement, for metrics such as loss.
+	EarlyStopMin EarlyStopMode = "min"
+	// EarlyStopMax treats an increase as an improvement, for metrics such as accuracy.
+	EarlyStopMax EarlyStopMode = "max"
+)
+
+// earlyStopperOptions holds configuration for an EarlyStopper.
+type earlyStopperOptions struct {
+	minDelta float64
+}
+
+// EarlyStopperOption configures an EarlyStopper.
+type EarlyStopperOption func(*earlyStopperOptions)
+
+// WithMinDelta sets how much a value must beat the best so far to count as
+// an improvement. Default: 0, so any improvement resets the patience.
+func WithMinDelta(d float64) EarlyStopperOption {
+	return func(o *earlyStopperOptions) {
+		o.minDelta = math.Abs(d)
+	}
+}
+
+// EarlyStopper signals when a metric has stopped improving, replacing the
+// bookkeeping usually written by hand in training loops:
+//
+//	stopper, err := tracking.NewEarlyStopper("val_loss", 3, tracking.EarlyStopMin)
+//	// ...
+//	for epoch := int64(0); ; epoch++ {
+//		loss := evaluate()
+//		if stop, err := stopper.LogMetric(ctx, client, runID, loss, epoch); err != nil || stop {
+//			break
+//		}
+//	}
+//
+// Values are fed with Observe (local only), LogMetric (logs the value, then
+// observes it), or Sync (observes values another process logged, read with
+// GetMetricHistory). Once patience consecutive values fail to improve on the
+// best, or a value is NaN, the stopper stops: Done is closed, Reason says
+// why, and later values are ignored. LogMetric and Sync also set
+// EarlyStopTagKey on the run when they cause the stop.
+//
+// An EarlyStopper is safe for concurrent use.
+type EarlyStopper struct {
+	metric   string
+	patience int
+	mode     EarlyStopMode
+	opts     earlyStopperOptions
+	done     chan struct{}
+
+	mu       sync.Mutex
+	hasBest  bool
+	best     float64
+	bestStep int64
+	synced   bool  // lastStep is set
+	lastStep int64 // last step read by Sync
+	bad      int
+	reason   string
+}
+
+// NewEarlyStopper returns an EarlyStopper for metric that stops after
+// patience consecutive values without improvement in the given mode.
+func NewEarlyStopper(metric string, patience int, mode EarlyStopMode, opts ...EarlyStopperOption) (*EarlyStopper, error) {
+	if metric == "" {
+		return nil, fmt.Errorf("mlflow: metric is required")
+	}
+	if patience < 1 {
+		return nil, fmt.Errorf("mlflow: early stopping patience must be at least 1")
+	}
+	if mode != EarlyStopMin && mode != EarlyStopMax {
+		return nil, fmt.Errorf("mlflow: invalid early stopping mode %q", mode)
+	}
+
+	s := &EarlyStopper{
+		metric:   metric,
+		patience: patience,
+		mode:     mode,
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(&s.opts)
+	}
+	return s, nil
+}
+
+// Observe records the value of the metric at step and reports whether the
+// stopper has stopped.
+func (s *EarlyStopper) Observe(value float64, step int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stopped, _ := s.observeLocked(value, step)
+	return stopped
+}
+
+// observeLocked is Observe, also reporting whether this value caused the stop.
+func (s *EarlyStopper) observeLocked(value float64, step int64) (stopped, justStopped bool) {
+	if s.reason != "" {
+		return true, false
+	}
+
+	switch {
+	case math.IsNaN(value):
+		s.stopLocked(fmt.Sprintf("%s is NaN at step %d", s.metric, step))
+		return true, true
+	case !s.hasBest || s.improves(value):
+		s.hasBest = true
+		s.best = value
+		s.bestStep = step
+		s.bad = 0
+	default:
+		s.bad++
+		if s.bad >= s.patience {
+			s.stopLocked(fmt.Sprintf("%s did not improve for %d steps (best %g at step %d)",
+				s.metric, s.bad, s.best, s.bestStep))
+			return true, true
+		}
+	}
+	return false, false
+}
+
+// improves reports whether value beats the best so far by more than the
+// minimum delta.
+func (s *EarlyStopper) improves(value float64) bool {
+	if s.mode == EarlyStopMin {
+		return value < s.best-s.opts.minDelta
+	}
+	return value > s.best+s.opts.minDelta
+}
+
+func (s *EarlyStopper) stopLocked(reason string) {
+	s.reason = reason
+	close(s.done)
+}
+
+// LogMetric logs value at step to the run, then observes it. If the value
+// stops the stopper, the run is tagged with EarlyStopTagKey. It reports
+// whether the stopper has stopped; a value that fails to log is not observed.
+func (s *EarlyStopper) LogMetric(ctx context.Context, c API, runID string, value float64, step int64) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("mlflow: client is required")
+	}
+	if err := c.LogMetric(ctx, runID, s.metric, value, WithStep(step)); err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	stopped, justStopped := s.observeLocked(value, step)
+	reason := s.reason
+	s.mu.Unlock()
+
+	if justStopped {
+		if err := c.SetTag(ctx, runID, EarlyStopTagKey, reason); err != nil {
+			return true, err
+		}
+	}
+	return stopped, nil
+}
+
+// Sync reads the run's history of the metric and observes the values logged
+// since the previous Sync, in step order. Use it when the metric is logged by
+// another process, such as a training job watched by a controller. If the
+// values stop the stopper, the run is tagged with EarlyStopTagKey. It
+// reports whether the stopper has stopped.
+//
+// Only values at a step greater than the last one read are observed, so a
+// metric logged more than once per step counts once.
+func (s *EarlyStopper) Sync(ctx context.Context, c API, runID string) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("mlflow: client is required")
+	}
+	history, err := c.GetMetricHistory(ctx, runID, s.metric)
+	if err != nil {
+		return false, err
+	}
+	slices.SortStableFunc(history, func(a, b Metric) int { return cmp.Compare(a.Step, b.Step) })
+
+	s.mu.Lock()
+	var justStopped bool
+	for _, m := range history {
+		if s.reason != "" {
+			break
+		}
+		if s.synced && m.Step <= s.lastStep {
+			continue
+		}
+		s.synced = true
+		s.lastStep = m.Step
+		_, justStopped = s.observeLocked(m.Value, m.Step)
+	}
+	stopped, reason := s.reason != "", s.reason
+	s.mu.Unlock()
+
+	if justStopped {
+		if err := c.SetTag(ctx, runID, EarlyStopTagKey, reason); err != nil {
+			return true, err
+		}
+	}
+	return stopped, nil
+}
+
+// Done returns a channel that is closed when the stopper stops.
+func (s *EarlyStopper) Done() <-chan struct{} {
+	return s.done
+}
+
+// Stopped reports whether the stopper has stopped.
+func (s *EarlyStopper) Stopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reason != ""
+}
+
+// Reason returns why the stopper stopped, or "" if it has not.
+func (s *EarlyStopper) Reason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reason
+}
+
+// Best returns the best value observed and its step. ok is false if no
+// value has been observed.
+func (s *EarlyStopper) Best() (value float64, step int64, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.best, s.bestStep, s.hasBest
+}
@@ -0,0 +1,205 @@
+package tracking
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestEarlyStopper_Observe(t *testing.T) {
+	s, err := NewEarlyStopper("val_loss", 2, EarlyStopMin)
+	if err != nil {
+		t.Fatalf("NewEarlyStopper() error = %v", err)
+	}
+
+	for step, v := range []float64{1.0, 0.8, 0.9, 0.7, 0.75} {
+		if s.Observe(v, int64(step)) {
+			t.Fatalf("stopped early at step %d", step)
+		}
+	}
+	select {
+	case <-s.Done():
+		t.Fatal("Done closed before stopping")
+	default:
+	}
+
+	if !s.Observe(0.71, 5) {
+		t.Fatal("expected stop after 2 values without improvement")
+	}
+	select {
+	case <-s.Done():
+	default:
+		t.Fatal("Done not closed after stopping")
+	}
+	if want := "val_loss did not improve for 2 steps (best 0.7 at step 3)"; s.Reason() != want {
+		t.Errorf("Reason() = %q, want %q", s.Reason(), want)
+	}
+	if best, step, ok := s.Best(); !ok || best != 0.7 || step != 3 {
+		t.Errorf("Best() = %v, %d, %v, want 0.7, 3, true", best, step, ok)
+	}
+
+	// Ignored once stopped
+	if !s.Observe(0.1, 6) {
+		t.Error("expected Observe to keep reporting stopped")
+	}
+	if best, _, _ := s.Best(); best != 0.7 {
+		t.Errorf("Best() = %v after stop, want 0.7", best)
+	}
+}
+
+func TestEarlyStopper_MaxModeAndMinDelta(t *testing.T) {
+	s, err := NewEarlyStopper("acc", 2, EarlyStopMax, WithMinDelta(0.01))
+	if err != nil {
+		t.Fatalf("NewEarlyStopper() error = %v", err)
+	}
+
+	s.Observe(0.80, 0)
+	s.Observe(0.85, 1)  // improvement
+	s.Observe(0.855, 2) // within min delta
+	if !s.Observe(0.859, 3) {
+		t.Error("expected stop: improvements within min delta do not count")
+	}
+	if best, _, _ := s.Best(); best != 0.85 {
+		t.Errorf("Best() = %v, want 0.85", best)
+	}
+}
+
+func TestEarlyStopper_NaN(t *testing.T) {
+	s, _ := NewEarlyStopper("loss", 10, EarlyStopMin)
+	s.Observe(1, 0)
+	if !s.Observe(math.NaN(), 1) {
+		t.Fatal("expected stop on NaN")
+	}
+	if !strings.Contains(s.Reason(), "NaN") {
+		t.Errorf("Reason() = %q", s.Reason())
+	}
+}
+
+func TestEarlyStopper_LogMetric(t *testing.T) {
+	var logged []float64
+	var tags map[string]string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/runs/log-metric":
+			var req struct {
+				Key   string  `json:"key"`
+				Value float64 `json:"value"`
+			}
+			mustDecodeJSON(t, r, &req)
+			if req.Key != "loss" {
+				t.Errorf("key = %q, want loss", req.Key)
+			}
+			logged = append(logged, req.Value)
+		case "/api/2.0/mlflow/runs/set-tag":
+			var req struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			}
+			mustDecodeJSON(t, r, &req)
+			if tags == nil {
+				tags = make(map[string]string)
+			}
+			tags[req.Key] = req.Value
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	s, _ := NewEarlyStopper("loss", 1, EarlyStopMin)
+	ctx := context.Background()
+
+	if stop, err := s.LogMetric(ctx, client, "run-1", 0.5, 0); err != nil || stop {
+		t.Fatalf("LogMetric() = %v, %v, want false, nil", stop, err)
+	}
+	if tags != nil {
+		t.Errorf("tags = %v before stopping, want none", tags)
+	}
+	if stop, err := s.LogMetric(ctx, client, "run-1", 0.6, 1); err != nil || !stop {
+		t.Fatalf("LogMetric() = %v, %v, want true, nil", stop, err)
+	}
+	if len(logged) != 2 {
+		t.Errorf("logged = %v, want 2 values", logged)
+	}
+	if tags[EarlyStopTagKey] != s.Reason() {
+		t.Errorf("tag = %q, want %q", tags[EarlyStopTagKey], s.Reason())
+	}
+}
+
+func TestEarlyStopper_Sync(t *testing.T) {
+	var mu sync.Mutex
+	history := []map[string]any{
+		{"key": "loss", "value": 0.9, "step": 0, "timestamp": 1000},
+		{"key": "loss", "value": 0.5, "step": 1, "timestamp": 2000},
+	}
+	var tagged string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/metrics/get-history":
+			mustEncodeJSON(t, w, map[string]any{"metrics": history})
+		case "/api/2.0/mlflow/runs/set-tag":
+			var req struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			}
+			mustDecodeJSON(t, r, &req)
+			tagged = req.Value
+			mustEncodeJSON(t, w, map[string]any{})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	s, _ := NewEarlyStopper("loss", 2, EarlyStopMin)
+	ctx := context.Background()
+
+	if stop, err := s.Sync(ctx, client, "run-1"); err != nil || stop {
+		t.Fatalf("Sync() = %v, %v, want false, nil", stop, err)
+	}
+
+	// Steps already read are not observed again
+	mu.Lock()
+	history = append(history,
+		map[string]any{"key": "loss", "value": 0.6, "step": 2, "timestamp": 3000},
+	)
+	mu.Unlock()
+	if stop, err := s.Sync(ctx, client, "run-1"); err != nil || stop {
+		t.Fatalf("Sync() = %v, %v, want false, nil", stop, err)
+	}
+
+	mu.Lock()
+	history = append(history,
+		map[string]any{"key": "loss", "value": 0.7, "step": 3, "timestamp": 4000},
+	)
+	mu.Unlock()
+	if stop, err := s.Sync(ctx, client, "run-1"); err != nil || !stop {
+		t.Fatalf("Sync() = %v, %v, want true, nil", stop, err)
+	}
+	if tagged == "" || tagged != s.Reason() {
+		t.Errorf("tagged = %q, want %q", tagged, s.Reason())
+	}
+	if best, step, _ := s.Best(); best != 0.5 || step != 1 {
+		t.Errorf("Best() = %v, %d, want 0.5, 1", best, step)
+	}
+}
+
+func TestNewEarlyStopper_Validation(t *testing.T) {
+	if _, err := NewEarlyStopper("", 1, EarlyStopMin); err == nil {
+		t.Error("expected error for empty metric")
+	}
+	if _, err := NewEarlyStopper("loss", 0, EarlyStopMin); err == nil {
+		t.Error("expected error for zero patience")
+	}
+	if _, err := NewEarlyStopper("loss", 1, "lowest"); err == nil {
+		t.Error("expected error for invalid mode")
+	}
+}
@@ -0,0 +1,175 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"time"
+)
+
+// environmentArtifactDir is the artifact directory LogEnvironment writes to.
+const environmentArtifactDir = "environment"
+
+// defaultEnvironmentVars are the Go runtime settings recorded by
+// LogEnvironment; they change scheduling and memory behavior between runs.
+var defaultEnvironmentVars = []string{"GOMAXPROCS", "GOGC", "GOMEMLIMIT", "GODEBUG"}
+
+// environmentInfo is the schema of environment/environment.json.
+type environmentInfo struct {
+	GoVersion      string            `json:"go_version"`
+	GOOS           string            `json:"goos"`
+	GOARCH         string            `json:"goarch"`
+	NumCPU         int               `json:"num_cpu"`
+	MainModule     string            `json:"main_module,omitempty"`
+	MainVersion    string            `json:"main_version,omitempty"`
+	VCSRevision    string            `json:"vcs_revision,omitempty"`
+	VCSModified    bool              `json:"vcs_modified,omitempty"`
+	ContainerImage string            `json:"container_image,omitempty"`
+	Env            map[string]string `json:"env,omitempty"`
+	CapturedAt     time.Time         `json:"captured_at"`
+}
+
+// LogEnvironment records the environment the process runs in as artifacts
+// under "environment/" for reproducibility audits:
+//
+//   - environment.json: Go version, OS/arch, CPU count, main module and VCS
+//     revision, container image, and allowlisted environment variables
+//   - buildinfo.txt: the dependency list embedded in the binary, in
+//     `go version -m` format
+//   - go.mod and go.sum, when found (see WithModuleDir)
+//
+// Only environment variables named by default or with WithEnvironmentVars
+// are recorded, so secrets in the environment are never uploaded.
+// See LogArtifact for server requirements.
+func LogEnvironment(ctx context.Context, c *Client, runID string, opts ...LogEnvironmentOption) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+
+	o := &logEnvironmentOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	dir, err := os.MkdirTemp("", "mlflow-environment-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	if err = writeEnvironment(dir, o); err != nil {
+		return err
+	}
+
+	if err = c.LogArtifacts(ctx, runID, dir, environmentArtifactDir); err != nil {
+		return fmt.Errorf("failed to upload environment: %w", err)
+	}
+	return nil
+}
+
+// writeEnvironment writes the environment bundle files to dir.
+func writeEnvironment(dir string, o *logEnvironmentOptions) error {
+	info := environmentInfo{
+		GoVersion:      runtime.Version(),
+		GOOS:           runtime.GOOS,
+		GOARCH:         runtime.GOARCH,
+		NumCPU:         runtime.NumCPU(),
+		ContainerImage: o.containerImage,
+		Env:            make(map[string]string),
+		CapturedAt:     time.Now().UTC(),
+	}
+	for _, name := range slices.Concat(defaultEnvironmentVars, o.envVars) {
+		if v, ok := os.LookupEnv(name); ok {
+			info.Env[name] = v
+		}
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.MainModule = bi.Main.Path
+		info.MainVersion = bi.Main.Version
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				info.VCSRevision = s.Value
+			case "vcs.modified":
+				info.VCSModified = s.Value == "true"
+			}
+		}
+		if err := os.WriteFile(filepath.Join(dir, "buildinfo.txt"), []byte(bi.String()), 0o600); err != nil {
+			return fmt.Errorf("failed to write build info: %w", err)
+		}
+	}
+
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode environment: %w", err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "environment.json"), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write environment: %w", err)
+	}
+
+	moduleDir := o.moduleDir
+	if moduleDir == "" {
+		moduleDir = findModuleDir()
+	}
+	if moduleDir == "" {
+		return nil
+	}
+	for _, name := range []string{"go.mod", "go.sum"} {
+		if err = copyFile(filepath.Join(moduleDir, name), filepath.Join(dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to copy %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// findModuleDir returns the nearest directory at or above the working
+// directory that contains go.mod, or "" if there is none.
+func findModuleDir() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		if _, err = os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,62 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestLogEnvironment(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+
+	moduleDir := t.TempDir()
+	writeFile(t, filepath.Join(moduleDir, "go.mod"), "module example.com/trainer\n")
+	t.Setenv("GOGC", "50")
+	t.Setenv("TRAINER_SHARD", "3")
+	t.Setenv("TRAINER_TOKEN", "secret")
+
+	err := LogEnvironment(context.Background(), client, "abc-123",
+		WithModuleDir(moduleDir),
+		WithEnvironmentVars("TRAINER_SHARD", "TRAINER_UNSET"),
+		WithContainerImage("quay.io/org/trainer@sha256:abc"),
+	)
+	if err != nil {
+		t.Fatalf("LogEnvironment() error = %v", err)
+	}
+
+	const prefix = "1/abc-123/artifacts/environment/"
+	if got := srv.uploads[prefix+"go.mod"]; got != "module example.com/trainer\n" {
+		t.Errorf("go.mod = %q", got)
+	}
+	if _, ok := srv.uploads[prefix+"go.sum"]; ok {
+		t.Error("go.sum uploaded although it does not exist")
+	}
+
+	var info environmentInfo
+	if err = json.Unmarshal([]byte(srv.uploads[prefix+"environment.json"]), &info); err != nil {
+		t.Fatalf("environment.json: %v", err)
+	}
+	if info.GoVersion != runtime.Version() || info.GOOS != runtime.GOOS || info.GOARCH != runtime.GOARCH {
+		t.Errorf("runtime info = %+v", info)
+	}
+	if info.ContainerImage != "quay.io/org/trainer@sha256:abc" || info.CapturedAt.IsZero() {
+		t.Errorf("info = %+v", info)
+	}
+	want := map[string]string{"GOGC": "50", "TRAINER_SHARD": "3"}
+	if len(info.Env) != len(want) || info.Env["GOGC"] != "50" || info.Env["TRAINER_SHARD"] != "3" {
+		t.Errorf("Env = %v, want %v", info.Env, want)
+	}
+}
+
+func TestLogEnvironment_Validation(t *testing.T) {
+	if err := LogEnvironment(context.Background(), nil, "abc-123"); err == nil {
+		t.Error("expected error for nil client")
+	}
+	client := newTestClient(t, &artifactServer{t: t})
+	if err := LogEnvironment(context.Background(), client, ""); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+}
@@ -0,0 +1,104 @@
+package tracking
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"time"
+)
+
+// equalOptions holds configuration for an Equal comparison.
+type equalOptions struct {
+	ignoreTimestamps bool
+}
+
+// EqualOption configures Run.Equal and Experiment.Equal.
+type EqualOption func(*equalOptions)
+
+// WithIgnoreTimestamps leaves times out of the comparison: experiment
+// creation and update times, run start and end times, and metric
+// timestamps.
+func WithIgnoreTimestamps() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreTimestamps = true
+	}
+}
+
+func newEqualOptions(opts []EqualOption) equalOptions {
+	var o equalOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// sameTime compares times with time.Time.Equal, or not at all if
+// timestamps are ignored.
+func (o equalOptions) sameTime(a, b time.Time) bool {
+	return o.ignoreTimestamps || a.Equal(b)
+}
+
+// Clone returns a deep copy of the experiment.
+func (e Experiment) Clone() Experiment {
+	e.Tags = maps.Clone(e.Tags)
+	return e
+}
+
+// Equal reports whether e and other hold the same experiment. Unlike
+// reflect.DeepEqual, nil and empty tags are equal and times are compared
+// with time.Time.Equal.
+func (e Experiment) Equal(other Experiment, opts ...EqualOption) bool {
+	o := newEqualOptions(opts)
+	return e.ID == other.ID &&
+		e.Name == other.Name &&
+		e.ArtifactLocation == other.ArtifactLocation &&
+		e.LifecycleStage == other.LifecycleStage &&
+		e.Kind == other.Kind &&
+		maps.Equal(e.Tags, other.Tags) &&
+		o.sameTime(e.CreationTime, other.CreationTime) &&
+		o.sameTime(e.LastUpdateTime, other.LastUpdateTime)
+}
+
+// Clone returns a deep copy of the run, which keeps serving LatestMetric
+// and Param from an index if the original did.
+func (r Run) Clone() Run {
+	r.Data.Metrics = slices.Clone(r.Data.Metrics)
+	r.Data.Params = slices.Clone(r.Data.Params)
+	r.Data.Tags = maps.Clone(r.Data.Tags)
+	if r.index != nil {
+		r.index = newRunIndex(r.Data)
+	}
+	return r
+}
+
+// Equal reports whether r and other hold the same run. Unlike
+// reflect.DeepEqual, nil and empty params, metrics, and tags are equal,
+// times are compared with time.Time.Equal, and NaN metric values equal each
+// other. Metrics and params are compared in order, as the server returns
+// them.
+func (r Run) Equal(other Run, opts ...EqualOption) bool {
+	o := newEqualOptions(opts)
+	a, b := r.Info, other.Info
+	if a.RunID != b.RunID ||
+		a.ExperimentID != b.ExperimentID ||
+		a.RunName != b.RunName ||
+		a.UserID != b.UserID ||
+		a.Status != b.Status ||
+		a.ArtifactURI != b.ArtifactURI ||
+		a.LifecycleStage != b.LifecycleStage ||
+		!o.sameTime(a.StartTime, b.StartTime) ||
+		!o.sameTime(a.EndTime, b.EndTime) {
+		return false
+	}
+	return slices.Equal(r.Data.Params, other.Data.Params) &&
+		maps.Equal(r.Data.Tags, other.Data.Tags) &&
+		slices.EqualFunc(r.Data.Metrics, other.Data.Metrics, func(x, y Metric) bool {
+			return x.Key == y.Key &&
+				(x.Value == y.Value || math.IsNaN(x.Value) && math.IsNaN(y.Value)) &&
+				x.Step == y.Step &&
+				x.ModelID == y.ModelID &&
+				x.DatasetName == y.DatasetName &&
+				x.DatasetDigest == y.DatasetDigest &&
+				o.sameTime(x.Timestamp, y.Timestamp)
+		})
+}
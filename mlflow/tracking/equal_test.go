@@ -0,0 +1,65 @@
+package tracking
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+func TestRun_CloneAndEqual(t *testing.T) {
+	key, val, lr, lrValue := "loss", math.NaN(), "lr", "0.01"
+	ts := int64(1700000000000)
+	run := runFromProto(&mlflowpb.Run{
+		Info: &mlflowpb.RunInfo{RunId: &key, StartTime: &ts},
+		Data: &mlflowpb.RunData{
+			Metrics: []*mlflowpb.Metric{{Key: &key, Value: &val, Timestamp: &ts}},
+			Params:  []*mlflowpb.Param{{Key: &lr, Value: &lrValue}},
+		},
+	})
+
+	clone := run.Clone()
+	if !run.Equal(clone) {
+		t.Fatal("Equal(Clone()) = false, want true")
+	}
+	clone.Data.Params[0].Value = "0.1"
+	clone.Data.Tags["note"] = "changed"
+	if v, _ := run.Param("lr"); v != "0.01" {
+		t.Errorf("original Param(lr) = %q after modifying clone", v)
+	}
+	if len(run.Data.Tags) != 0 {
+		t.Errorf("original tags = %v after modifying clone", run.Data.Tags)
+	}
+	if run.Equal(clone) {
+		t.Error("Equal() = true after modifying clone")
+	}
+
+	moved := run.Clone()
+	moved.Info.StartTime = moved.Info.StartTime.Add(time.Second).UTC()
+	moved.Data.Metrics[0].Timestamp = time.Time{}
+	if run.Equal(moved) {
+		t.Error("Equal() = true for different times")
+	}
+	if !run.Equal(moved, WithIgnoreTimestamps()) {
+		t.Error("Equal(WithIgnoreTimestamps()) = false, want true")
+	}
+}
+
+func TestExperiment_Equal_NilAndEmptyTags(t *testing.T) {
+	created := time.UnixMilli(1700000000000)
+	a := Experiment{ID: "1", Name: "churn", CreationTime: created}
+	b := Experiment{ID: "1", Name: "churn", Tags: map[string]string{}, CreationTime: created.UTC()}
+	if !a.Equal(b) {
+		t.Error("Equal() = false for nil and empty tags")
+	}
+
+	c := b.Clone()
+	c.Tags["owner"] = "ml"
+	if len(b.Tags) != 0 {
+		t.Errorf("original tags = %v after modifying clone", b.Tags)
+	}
+	if a.Equal(c) {
+		t.Error("Equal() = true for different tags")
+	}
+}
@@ -0,0 +1,96 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+// SetExperimentTags sets several tags on an experiment. MLflow has no bulk
+// endpoint, so tags are set one at a time in key order; on failure the tags
+// before the failing key are already set.
+func (c *Client) SetExperimentTags(ctx context.Context, experimentID string, tags map[string]string) error {
+	if experimentID == "" {
+		return fmt.Errorf("mlflow: experiment ID is required")
+	}
+	if _, ok := tags[""]; ok {
+		return fmt.Errorf("mlflow: tag key is required")
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		if err := c.SetExperimentTag(ctx, experimentID, key, tags[key]); err != nil {
+			return fmt.Errorf("tag %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// UpdateExperimentTags reads an experiment's tags, passes a copy to mutate,
+// and writes back only what changed: keys whose value is new or different
+// are set, and keys missing from the returned map are deleted. If mutate
+// returns nil, nothing is written.
+//
+// The read and the writes are not atomic: a tag changed by another client
+// between them may be overwritten.
+//
+//	err := client.UpdateExperimentTags(ctx, expID, func(tags map[string]string) map[string]string {
+//		tags["owner"] = "ml-platform"
+//		delete(tags, "scratch")
+//		return tags
+//	})
+func (c *Client) UpdateExperimentTags(ctx context.Context, experimentID string, mutate func(cur map[string]string) map[string]string) error {
+	if experimentID == "" {
+		return fmt.Errorf("mlflow: experiment ID is required")
+	}
+	if mutate == nil {
+		return fmt.Errorf("mlflow: mutate function is required")
+	}
+
+	exp, err := c.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return err
+	}
+	next := mutate(maps.Clone(exp.Tags))
+	if next == nil {
+		return nil
+	}
+
+	changed := make(map[string]string)
+	for k, v := range next {
+		if cur, ok := exp.Tags[k]; !ok || cur != v {
+			changed[k] = v
+		}
+	}
+	if err := c.SetExperimentTags(ctx, experimentID, changed); err != nil {
+		return err
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(exp.Tags)) {
+		if _, ok := next[key]; ok {
+			continue
+		}
+		if err := c.deleteExperimentTag(ctx, experimentID, key); err != nil {
+			return fmt.Errorf("tag %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) deleteExperimentTag(ctx context.Context, experimentID, key string) error {
+	req := &mlflowpb.DeleteExperimentTag{
+		ExperimentId: &experimentID,
+		Key:          &key,
+	}
+
+	var resp mlflowpb.DeleteExperimentTag_Response
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/experiments/delete-experiment-tag", req, &resp)
+	if err != nil {
+		return fmt.Errorf("failed to delete experiment tag: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package tracking
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// experimentTagsServer serves an experiment with the given tags and records
+// the tag writes made to it.
+func experimentTagsServer(t *testing.T, tags map[string]string, writes *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/experiments/get":
+			var list []map[string]string
+			for k, v := range tags {
+				list = append(list, map[string]string{"key": k, "value": v})
+			}
+			mustEncodeJSON(t, w, map[string]any{"experiment": map[string]any{"experiment_id": "7", "tags": list}})
+		case "/api/2.0/mlflow/experiments/set-experiment-tag":
+			var req map[string]string
+			mustDecodeJSON(t, r, &req)
+			*writes = append(*writes, "set "+req["key"]+"="+req["value"])
+			if req["key"] == "broken" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			mustEncodeJSON(t, w, map[string]any{})
+		case "/api/2.0/mlflow/experiments/delete-experiment-tag":
+			var req map[string]string
+			mustDecodeJSON(t, r, &req)
+			*writes = append(*writes, "delete "+req["key"])
+			mustEncodeJSON(t, w, map[string]any{})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	})
+}
+
+func TestClient_SetExperimentTags(t *testing.T) {
+	var writes []string
+	client := newTestClient(t, experimentTagsServer(t, nil, &writes))
+
+	err := client.SetExperimentTags(context.Background(), "7", map[string]string{"team": "ml", "owner": "alice", "broken": "x", "zone": "eu"})
+	if err == nil {
+		t.Fatal("SetExperimentTags() error = nil, want the failed tag")
+	}
+	// Tags are set in key order up to the failure
+	if want := []string{"set broken=x"}; !slices.Equal(writes, want) {
+		t.Errorf("writes = %q, want %q", writes, want)
+	}
+
+	writes = nil
+	if err := client.SetExperimentTags(context.Background(), "7", map[string]string{"team": "ml", "owner": "alice"}); err != nil {
+		t.Fatalf("SetExperimentTags() error = %v", err)
+	}
+	if want := []string{"set owner=alice", "set team=ml"}; !slices.Equal(writes, want) {
+		t.Errorf("writes = %q, want %q", writes, want)
+	}
+}
+
+func TestClient_UpdateExperimentTags(t *testing.T) {
+	var writes []string
+	current := map[string]string{"owner": "alice", "team": "ml", "scratch": "true"}
+	client := newTestClient(t, experimentTagsServer(t, current, &writes))
+
+	err := client.UpdateExperimentTags(context.Background(), "7", func(tags map[string]string) map[string]string {
+		if !maps.Equal(tags, current) {
+			t.Errorf("mutate got %v, want %v", tags, current)
+		}
+		tags["owner"] = "bob"
+		tags["cost_center"] = "42"
+		delete(tags, "scratch")
+		return tags
+	})
+	if err != nil {
+		t.Fatalf("UpdateExperimentTags() error = %v", err)
+	}
+	// The unchanged team tag is not written
+	if want := []string{"set cost_center=42", "set owner=bob", "delete scratch"}; !slices.Equal(writes, want) {
+		t.Errorf("writes = %q, want %q", writes, want)
+	}
+
+	writes = nil
+	if err := client.UpdateExperimentTags(context.Background(), "7", func(map[string]string) map[string]string { return nil }); err != nil {
+		t.Fatalf("UpdateExperimentTags() error = %v", err)
+	}
+	if len(writes) != 0 {
+		t.Errorf("writes = %q after mutate returned nil, want none", writes)
+	}
+}
+
+func TestClient_ExperimentTags_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+	if err := client.SetExperimentTags(ctx, "", map[string]string{"a": "b"}); err == nil {
+		t.Error("SetExperimentTags() without an experiment error = nil")
+	}
+	if err := client.SetExperimentTags(ctx, "7", map[string]string{"": "b"}); err == nil {
+		t.Error("SetExperimentTags() with an empty key error = nil")
+	}
+	if err := client.UpdateExperimentTags(ctx, "7", nil); err == nil {
+		t.Error("UpdateExperimentTags() without mutate error = nil")
+	}
+}
@@ -0,0 +1,166 @@
+package tracking
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+// Column prefixes used by FlattenRuns, matching the Python SDK's
+// mlflow.search_runs DataFrame.
+const (
+	columnPrefixMetrics = "metrics."
+	columnPrefixParams  = "params."
+	columnPrefixTags    = "tags."
+)
+
+// runAttributeColumns are the leading columns produced by FlattenRuns.
+var runAttributeColumns = []string{
+	"run_id", "experiment_id", "run_name", "status", "user_id",
+	"start_time", "end_time", "artifact_uri", "lifecycle_stage",
+}
+
+// FlattenRuns turns runs into a table with one row per run. The header
+// starts with the run attributes, followed by one column per metric, param,
+// and tag key ("metrics.<key>", "params.<key>", "tags.<key>"), each group
+// sorted by key. Cells for keys a run did not log are empty. Times are
+// formatted as RFC 3339 in UTC.
+//
+// FlattenRuns is the layout ExportRunsCSV writes; use it to feed other
+// tabular formats. The contrib/parquet module writes it as Parquet.
+func FlattenRuns(runs []Run) (header []string, rows [][]string) {
+	metricKeys := make(map[string]bool)
+	paramKeys := make(map[string]bool)
+	tagKeys := make(map[string]bool)
+	for _, r := range runs {
+		for _, m := range r.Data.Metrics {
+			metricKeys[m.Key] = true
+		}
+		for _, p := range r.Data.Params {
+			paramKeys[p.Key] = true
+		}
+		for k := range r.Data.Tags {
+			tagKeys[k] = true
+		}
+	}
+
+	header = slices.Clone(runAttributeColumns)
+	header = appendColumns(header, columnPrefixMetrics, metricKeys)
+	header = appendColumns(header, columnPrefixParams, paramKeys)
+	header = appendColumns(header, columnPrefixTags, tagKeys)
+
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		index[col] = i
+	}
+
+	rows = make([][]string, 0, len(runs))
+	for _, r := range runs {
+		row := make([]string, len(header))
+		copy(row, []string{
+			r.Info.RunID,
+			r.Info.ExperimentID,
+			r.Info.RunName,
+			string(r.Info.Status),
+			r.Info.UserID,
+			formatExportTime(r.Info.StartTime),
+			formatExportTime(r.Info.EndTime),
+			r.Info.ArtifactURI,
+			r.Info.LifecycleStage,
+		})
+		for _, m := range r.Data.Metrics {
+			row[index[columnPrefixMetrics+m.Key]] = strconv.FormatFloat(m.Value, 'g', -1, 64)
+		}
+		for _, p := range r.Data.Params {
+			row[index[columnPrefixParams+p.Key]] = p.Value
+		}
+		for k, v := range r.Data.Tags {
+			row[index[columnPrefixTags+k]] = v
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+// ExportRunsCSV searches runs in the given experiments and writes them to w
+// as CSV in the FlattenRuns layout. An empty filter exports every active run.
+// All matching runs are fetched before anything is written, since the
+// columns depend on the keys every run logged.
+//
+// With WithEvents, progress is reported with one item per page of runs
+// fetched. Total is 0, since the number of pages is not known up front.
+func ExportRunsCSV(ctx context.Context, c *Client, experimentIDs []string, filter string, w io.Writer, opts ...ExportOption) error {
+	if c == nil {
+		return fmt.Errorf("mlflow: client is required")
+	}
+	if w == nil {
+		return fmt.Errorf("mlflow: writer is required")
+	}
+
+	var exportOpts exportOptions
+	for _, opt := range opts {
+		opt(&exportOpts)
+	}
+	reporter := progress.NewReporter(exportOpts.events, "export runs")
+	reporter.Start(ctx, 0)
+	err := exportRunsCSV(ctx, c, experimentIDs, filter, w, reporter)
+	reporter.Finish(ctx, err)
+	return err
+}
+
+func exportRunsCSV(ctx context.Context, c *Client, experimentIDs []string, filter string, w io.Writer, reporter *progress.Reporter) error {
+	var searchOpts []SearchRunsOption
+	if filter != "" {
+		searchOpts = append(searchOpts, WithRunsFilter(filter))
+	}
+	cur := c.SearchRunsCursor(experimentIDs, searchOpts...)
+	var runs []Run
+	for n := 1; ; n++ {
+		page, ok := cur.Next(ctx)
+		if !ok {
+			break
+		}
+		runs = append(runs, page.Items...)
+		reporter.ItemDone(ctx, "page "+strconv.Itoa(n), nil)
+	}
+	if err := cur.Err(); err != nil {
+		return err
+	}
+
+	header, rows := FlattenRuns(runs)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func appendColumns(header []string, prefix string, keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	slices.Sort(sorted)
+	for _, k := range sorted {
+		header = append(header, prefix+k)
+	}
+	return header
+}
+
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
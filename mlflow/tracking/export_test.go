@@ -0,0 +1,135 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
+
+func TestFlattenRuns(t *testing.T) {
+	runs := []Run{
+		{
+			Info: RunInfo{RunID: "r1", ExperimentID: "1", Status: RunStatusFinished, StartTime: time.UnixMilli(0)},
+			Data: RunData{
+				Metrics: []Metric{{Key: "rmse", Value: 0.25}},
+				Params:  []Param{{Key: "lr", Value: "0.01"}},
+				Tags:    map[string]string{"team": "a"},
+			},
+		},
+		{
+			Info: RunInfo{RunID: "r2", ExperimentID: "1"},
+			Data: RunData{Metrics: []Metric{{Key: "acc", Value: 1e-7}}},
+		},
+	}
+
+	header, rows := FlattenRuns(runs)
+
+	wantHeader := append(slices.Clone(runAttributeColumns), "metrics.acc", "metrics.rmse", "params.lr", "tags.team")
+	if !slices.Equal(header, wantHeader) {
+		t.Fatalf("header = %v, want %v", header, wantHeader)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	col := func(name string) int { return slices.Index(header, name) }
+	if rows[0][col("start_time")] != "1970-01-01T00:00:00Z" || rows[0][col("end_time")] != "" {
+		t.Errorf("times = %q, %q", rows[0][col("start_time")], rows[0][col("end_time")])
+	}
+	if rows[0][col("metrics.rmse")] != "0.25" || rows[0][col("metrics.acc")] != "" {
+		t.Errorf("row 0 metrics = %v", rows[0])
+	}
+	if rows[1][col("metrics.acc")] != "1e-07" || rows[1][col("params.lr")] != "" {
+		t.Errorf("row 1 = %v", rows[1])
+	}
+	if rows[0][col("tags.team")] != "a" || rows[0][col("status")] != "FINISHED" {
+		t.Errorf("row 0 = %v", rows[0])
+	}
+}
+
+func TestExportRunsCSV(t *testing.T) {
+	var receivedFilter string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var req struct {
+			Filter    string `json:"filter"`
+			PageToken string `json:"page_token"`
+		}
+		mustDecodeJSON(t, r, &req)
+		receivedFilter = req.Filter
+
+		if req.PageToken == "" {
+			mustEncodeJSON(t, w, map[string]any{
+				"runs": []map[string]any{{
+					"info": map[string]any{"run_id": "r1", "experiment_id": "1"},
+					"data": map[string]any{"params": []map[string]any{{"key": "note", "value": "a, \"quoted\" value"}}},
+				}},
+				"next_page_token": "p2",
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"runs": []map[string]any{{"info": map[string]any{"run_id": "r2", "experiment_id": "1"}}},
+		})
+	}))
+
+	var buf bytes.Buffer
+	events := make(chan progress.Event, 8)
+	err := ExportRunsCSV(context.Background(), client, []string{"1"}, "params.note != ''", &buf, WithEvents(events))
+	if err != nil {
+		t.Fatalf("ExportRunsCSV() error = %v", err)
+	}
+	close(events)
+	var kinds []progress.Kind
+	var items []string
+	for ev := range events {
+		kinds = append(kinds, ev.Kind)
+		items = append(items, ev.Item)
+	}
+	if want := []progress.Kind{progress.Started, progress.ItemDone, progress.ItemDone, progress.Finished}; !slices.Equal(kinds, want) {
+		t.Errorf("event kinds = %v, want %v", kinds, want)
+	}
+	if !slices.Equal(items, []string{"", "page 1", "page 2", ""}) {
+		t.Errorf("event items = %q", items)
+	}
+	if receivedFilter != "params.note != ''" {
+		t.Errorf("filter = %q", receivedFilter)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want header + 2 rows", len(records))
+	}
+	last := len(records[0]) - 1
+	if records[0][last] != "params.note" || records[1][last] != "a, \"quoted\" value" || records[2][last] != "" {
+		t.Errorf("records = %v", records)
+	}
+}
+
+func TestExportRunsCSV_Validation(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportRunsCSV(context.Background(), nil, []string{"1"}, "", &buf); err == nil {
+		t.Error("expected error for nil client")
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if err := ExportRunsCSV(context.Background(), client, []string{"1"}, "", nil); err == nil {
+		t.Error("expected error for nil writer")
+	}
+	if err := ExportRunsCSV(context.Background(), client, nil, "", &buf); err == nil {
+		t.Error("expected error for no experiment IDs")
+	}
+}
@@ -0,0 +1,92 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+	"unicode/utf8"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// Tags Finalize sets on a failed run.
+const (
+	// FailureReasonTagKey holds the error or panic value that failed the run.
+	FailureReasonTagKey = mlflowtags.FailureReason
+
+	// FailureStackTagKey holds the stack trace of the panic that failed the
+	// run. It is not set for runs failed by an error.
+	FailureStackTagKey = mlflowtags.FailureStack
+)
+
+// finalizeTimeout bounds the final status update made by Finalize and
+// Heartbeat.End, which runs even if the caller's context was cancelled.
+const finalizeTimeout = 10 * time.Second
+
+// maxFailureTagLength is the longest tag value MLflow accepts; longer
+// failure tags are truncated.
+const maxFailureTagLength = 8000
+
+// Finalize ends a run according to how the calling function exits. It is
+// meant to be deferred with a pointer to the caller's named error result:
+//
+//	func train(ctx context.Context, runID string) (err error) {
+//		defer tracking.Finalize(ctx, client, runID, &err)
+//		...
+//	}
+//
+// The run is marked FINISHED if the function returns a nil error. If it
+// returns an error, the run is marked FAILED with the error message in the
+// FailureReasonTagKey tag. If it panics, the run is marked FAILED with the
+// panic value and stack trace in the FailureReasonTagKey and
+// FailureStackTagKey tags, and the panic is re-raised.
+//
+// The update is sent even if ctx was cancelled, such as on SIGINT, within
+// a 10 second timeout. If it fails and *errp is nil, its error is stored in
+// *errp.
+func Finalize(ctx context.Context, c *Client, runID string, errp *error) {
+	r := recover()
+	finalizeRun(ctx, c, runID, r, errp)
+	if r != nil {
+		panic(r)
+	}
+}
+
+// finalizeRun marks a run FAILED if r, a recovered panic value, is not nil
+// or *errp is an error, and FINISHED otherwise. It must be called from the
+// deferred function that recovered r, so the stack trace includes the
+// panic.
+func finalizeRun(ctx context.Context, c *Client, runID string, r any, errp *error) {
+	u := UpdateRunBuilder(runID).Status(RunStatusFinished).EndNow()
+	switch {
+	case r != nil:
+		u.Status(RunStatusFailed).
+			Tag(FailureReasonTagKey, truncateTag(fmt.Sprintf("panic: %v", r))).
+			Tag(FailureStackTagKey, truncateTag(string(debug.Stack())))
+	case errp != nil && *errp != nil:
+		u.Status(RunStatusFailed).
+			Tag(FailureReasonTagKey, truncateTag((*errp).Error()))
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalizeTimeout)
+	defer cancel()
+
+	err := u.Apply(ctx, c)
+	if err != nil && errp != nil && *errp == nil {
+		*errp = err
+	}
+}
+
+// truncateTag shortens s to the longest tag value MLflow accepts.
+func truncateTag(s string) string {
+	if len(s) <= maxFailureTagLength {
+		return s
+	}
+	const marker = "\n... (truncated)"
+	n := maxFailureTagLength - len(marker)
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + marker
+}
@@ -0,0 +1,159 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+// finalizeServer records the tags and final status a run is finalized with.
+type finalizeServer struct {
+	t          *testing.T
+	failUpdate bool
+
+	mu     sync.Mutex
+	tags   map[string]string
+	status string
+}
+
+func (s *finalizeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/runs/log-batch":
+		var req struct {
+			Tags []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"tags"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+		s.tags = make(map[string]string)
+		for _, tag := range req.Tags {
+			s.tags[tag.Key] = tag.Value
+		}
+		mustEncodeJSON(s.t, w, map[string]any{})
+	case "/api/2.0/mlflow/runs/update":
+		if s.failUpdate {
+			w.WriteHeader(http.StatusInternalServerError)
+			mustEncodeJSON(s.t, w, map[string]string{"error_code": "INTERNAL_ERROR", "message": "down"})
+			return
+		}
+		var req struct {
+			Status int `json:"status"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+		s.status = string(protoToRunStatus[mlflowpb.RunStatus(req.Status)])
+		mustEncodeJSON(s.t, w, map[string]any{"run_info": map[string]any{"run_id": "abc-123"}})
+	default:
+		s.t.Errorf("unexpected path: %s", r.URL.Path)
+		http.NotFound(w, r)
+	}
+}
+
+func TestFinalize(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus string
+		wantReason string
+	}{
+		{name: "success", wantStatus: "FINISHED"},
+		{name: "error", err: errors.New("loss diverged"), wantStatus: "FAILED", wantReason: "loss diverged"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &finalizeServer{t: t}
+			client := newTestClient(t, srv)
+
+			run := func() (err error) {
+				defer Finalize(context.Background(), client, "abc-123", &err)
+				return tt.err
+			}
+
+			if err := run(); !errors.Is(err, tt.err) {
+				t.Fatalf("run() error = %v, want %v", err, tt.err)
+			}
+			if srv.status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", srv.status, tt.wantStatus)
+			}
+			if srv.tags[FailureReasonTagKey] != tt.wantReason {
+				t.Errorf("reason tag = %q, want %q", srv.tags[FailureReasonTagKey], tt.wantReason)
+			}
+			if _, ok := srv.tags[FailureStackTagKey]; ok {
+				t.Error("stack tag set without a panic")
+			}
+		})
+	}
+}
+
+func TestFinalize_Panic(t *testing.T) {
+	srv := &finalizeServer{t: t}
+	client := newTestClient(t, srv)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want re-raised panic", r)
+		}
+		if srv.status != "FAILED" {
+			t.Errorf("status = %q, want FAILED", srv.status)
+		}
+		if srv.tags[FailureReasonTagKey] != "panic: boom" {
+			t.Errorf("reason tag = %q", srv.tags[FailureReasonTagKey])
+		}
+		if !strings.Contains(srv.tags[FailureStackTagKey], "finalize_test.go") {
+			t.Errorf("stack tag = %q, want the panicking frame", srv.tags[FailureStackTagKey])
+		}
+	}()
+
+	func() {
+		defer Finalize(context.Background(), client, "abc-123", nil)
+		panic("boom")
+	}()
+}
+
+func TestFinalize_CancelledContext(t *testing.T) {
+	srv := &finalizeServer{t: t}
+	client := newTestClient(t, srv)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	runErr := context.Canceled
+	Finalize(ctx, client, "abc-123", &runErr)
+
+	if srv.status != "FAILED" {
+		t.Errorf("status = %q, want FAILED even after cancellation", srv.status)
+	}
+}
+
+func TestFinalize_UpdateError(t *testing.T) {
+	srv := &finalizeServer{t: t, failUpdate: true}
+	client := newTestClient(t, srv)
+
+	var err error
+	Finalize(context.Background(), client, "abc-123", &err)
+	if err == nil {
+		t.Error("err = nil, want the failed status update")
+	}
+}
+
+func TestTruncateTag(t *testing.T) {
+	if got := truncateTag("short"); got != "short" {
+		t.Errorf("truncateTag(short) = %q", got)
+	}
+	long := strings.Repeat("é", maxFailureTagLength)
+	got := truncateTag(long)
+	if len(got) > maxFailureTagLength || !utf8.ValidString(got) || !strings.HasSuffix(got, "(truncated)") {
+		t.Errorf("truncateTag(long) = %d bytes, valid UTF-8 %v", len(got), utf8.ValidString(got))
+	}
+}
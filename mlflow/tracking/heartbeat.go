@@ -0,0 +1,133 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// HeartbeatTagKey is the run tag StartHeartbeat refreshes. Its value is the
+// UTC time of the last beat in RFC 3339 format with millisecond precision,
+// so it sorts lexically and can be compared in search filters.
+const HeartbeatTagKey = mlflowtags.Heartbeat
+
+// Heartbeat periodically marks a run as alive so orchestration systems can
+// detect zombie runs whose process died without finalizing them.
+// Create one with StartHeartbeat.
+type Heartbeat struct {
+	client   *Client
+	runID    string
+	ctx      context.Context
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// StartHeartbeat sets the HeartbeatTagKey tag on a run immediately and then
+// every interval until Stop or End is called or ctx is cancelled.
+//
+// Pair it with a deferred End so the run is finalized however the function exits:
+//
+//	func train(ctx context.Context) (err error) {
+//		hb, err := tracking.StartHeartbeat(ctx, client, runID, 30*time.Second)
+//		if err != nil {
+//			return err
+//		}
+//		defer hb.End(&err)
+//		...
+//	}
+//
+// A process killed by a signal or os.Exit never runs deferred calls; in that
+// case the heartbeat tag simply stops advancing, which is what watchers detect.
+//
+// The first beat is sent synchronously and its error returned. Later beats
+// that fail do not stop the heartbeat; the most recent failure is available
+// from Err.
+func StartHeartbeat(ctx context.Context, c *Client, runID string, interval time.Duration) (*Heartbeat, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("mlflow: heartbeat interval must be positive")
+	}
+
+	h := &Heartbeat{
+		client: c,
+		runID:  runID,
+		ctx:    ctx,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if err := h.beat(); err != nil {
+		return nil, err
+	}
+
+	go h.loop(interval)
+	return h, nil
+}
+
+func (h *Heartbeat) loop(interval time.Duration) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := h.beat()
+			h.mu.Lock()
+			h.lastErr = err
+			h.mu.Unlock()
+		case <-h.stop:
+			return
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+func (h *Heartbeat) beat() error {
+	now := time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00")
+	return h.client.SetTag(h.ctx, h.runID, HeartbeatTagKey, now)
+}
+
+// Err returns the error from the most recent failed beat, or nil if the
+// most recent beat succeeded.
+func (h *Heartbeat) Err() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.lastErr
+}
+
+// Stop stops sending heartbeats and waits for any in-flight beat to finish.
+// It leaves the run status unchanged. Stop is safe to call more than once.
+func (h *Heartbeat) Stop() {
+	h.stopOnce.Do(func() { close(h.stop) })
+	<-h.done
+}
+
+// End stops the heartbeat and finalizes the run as Finalize does. It is
+// meant to be deferred with a pointer to the caller's named error result.
+//
+// The run is marked FAILED, with failure tags, if the caller is panicking
+// or *errp is non-nil, and FINISHED otherwise. A panic is re-raised after
+// the run is updated. If the status update itself fails and *errp is nil,
+// its error is stored in *errp.
+func (h *Heartbeat) End(errp *error) {
+	r := recover()
+	h.Stop()
+	finalizeRun(h.ctx, h.client, h.runID, r, errp)
+	if r != nil {
+		panic(r)
+	}
+}
@@ -0,0 +1,208 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+// heartbeatServer records heartbeat tags, the final run status, and the
+// failure reason tag.
+type heartbeatServer struct {
+	t *testing.T
+
+	mu     sync.Mutex
+	beats  []string
+	status string
+	reason string
+}
+
+func (s *heartbeatServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/api/2.0/mlflow/runs/set-tag":
+		var req struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+		if req.Key != HeartbeatTagKey {
+			s.t.Errorf("tag key = %q, want %q", req.Key, HeartbeatTagKey)
+		}
+		s.mu.Lock()
+		s.beats = append(s.beats, req.Value)
+		s.mu.Unlock()
+		mustEncodeJSON(s.t, w, map[string]any{})
+	case "/api/2.0/mlflow/runs/log-batch":
+		var req struct {
+			Tags []struct{ Key, Value string } `json:"tags"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+		s.mu.Lock()
+		for _, tag := range req.Tags {
+			if tag.Key == FailureReasonTagKey {
+				s.reason = tag.Value
+			}
+		}
+		s.mu.Unlock()
+		mustEncodeJSON(s.t, w, map[string]any{})
+	case "/api/2.0/mlflow/runs/update":
+		var req struct {
+			Status int `json:"status"`
+		}
+		mustDecodeJSON(s.t, r, &req)
+		s.mu.Lock()
+		s.status = string(protoToRunStatus[mlflowpb.RunStatus(req.Status)])
+		s.mu.Unlock()
+		mustEncodeJSON(s.t, w, map[string]any{"run_info": map[string]any{"run_id": "abc-123"}})
+	default:
+		s.t.Errorf("unexpected path: %s", r.URL.Path)
+		http.NotFound(w, r)
+	}
+}
+
+func (s *heartbeatServer) beatCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.beats)
+}
+
+func TestStartHeartbeat_BeatsUntilStopped(t *testing.T) {
+	srv := &heartbeatServer{t: t}
+	client := newTestClient(t, srv)
+
+	hb, err := StartHeartbeat(context.Background(), client, "abc-123", 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+	if srv.beatCount() != 1 {
+		t.Fatalf("beats after start = %d, want 1", srv.beatCount())
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for srv.beatCount() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	hb.Stop()
+	hb.Stop()
+
+	count := srv.beatCount()
+	if count < 3 {
+		t.Fatalf("beats = %d, want at least 3", count)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if srv.beatCount() != count {
+		t.Error("heartbeat continued after Stop")
+	}
+	if srv.status != "" {
+		t.Errorf("Stop changed run status to %q", srv.status)
+	}
+	if _, err := time.Parse(time.RFC3339, srv.beats[0]); err != nil {
+		t.Errorf("beat value %q is not RFC 3339: %v", srv.beats[0], err)
+	}
+}
+
+func TestHeartbeat_End(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus string
+		wantReason string
+	}{
+		{name: "success", err: nil, wantStatus: "FINISHED"},
+		{name: "error", err: errors.New("boom"), wantStatus: "FAILED", wantReason: "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &heartbeatServer{t: t}
+			client := newTestClient(t, srv)
+
+			run := func() (err error) {
+				hb, err := StartHeartbeat(context.Background(), client, "abc-123", time.Hour)
+				if err != nil {
+					return err
+				}
+				defer hb.End(&err)
+				return tt.err
+			}
+
+			if err := run(); !errors.Is(err, tt.err) {
+				t.Fatalf("run() error = %v, want %v", err, tt.err)
+			}
+			if srv.status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", srv.status, tt.wantStatus)
+			}
+			if srv.reason != tt.wantReason {
+				t.Errorf("failure reason = %q, want %q", srv.reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestHeartbeat_End_Panic(t *testing.T) {
+	srv := &heartbeatServer{t: t}
+	client := newTestClient(t, srv)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want re-raised panic", r)
+		}
+		if srv.status != "FAILED" {
+			t.Errorf("status = %q, want FAILED", srv.status)
+		}
+		if srv.reason != "panic: boom" {
+			t.Errorf("failure reason = %q, want %q", srv.reason, "panic: boom")
+		}
+	}()
+
+	func() {
+		hb, err := StartHeartbeat(context.Background(), client, "abc-123", time.Hour)
+		if err != nil {
+			t.Fatalf("StartHeartbeat() error = %v", err)
+		}
+		defer hb.End(nil)
+		panic("boom")
+	}()
+}
+
+func TestHeartbeat_End_CancelledContext(t *testing.T) {
+	srv := &heartbeatServer{t: t}
+	client := newTestClient(t, srv)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hb, err := StartHeartbeat(ctx, client, "abc-123", time.Hour)
+	if err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+	cancel()
+
+	runErr := context.Canceled
+	hb.End(&runErr)
+
+	if srv.status != "FAILED" {
+		t.Errorf("status = %q, want FAILED even after cancellation", srv.status)
+	}
+}
+
+func TestStartHeartbeat_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := StartHeartbeat(context.Background(), nil, "abc-123", time.Second); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if _, err := StartHeartbeat(context.Background(), client, "", time.Second); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if _, err := StartHeartbeat(context.Background(), client, "abc-123", 0); err == nil {
+		t.Error("expected error for non-positive interval")
+	}
+}
@@ -0,0 +1,119 @@
+package tracking
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// The JSON encoding of the public types is stable: snake_case keys as in
+// the MLflow REST API, times as RFC 3339 in UTC (omitted when zero), and
+// statuses as their string names. Decoding the output gives back an equal
+// value, apart from the time zone of times.
+
+// MarshalJSON encodes the experiment with its times in UTC.
+func (e Experiment) MarshalJSON() ([]byte, error) {
+	type plain Experiment
+	p := plain(e)
+	p.CreationTime = p.CreationTime.UTC()
+	p.LastUpdateTime = p.LastUpdateTime.UTC()
+	return json.Marshal(p)
+}
+
+// MarshalJSON encodes the run info with its times in UTC.
+func (i RunInfo) MarshalJSON() ([]byte, error) {
+	type plain RunInfo
+	p := plain(i)
+	p.StartTime = p.StartTime.UTC()
+	p.EndTime = p.EndTime.UTC()
+	return json.Marshal(p)
+}
+
+// metricJSON is the JSON form of a Metric.
+type metricJSON struct {
+	Key           string    `json:"key"`
+	Value         jsonFloat `json:"value"`
+	Timestamp     time.Time `json:"timestamp,omitzero"`
+	Step          int64     `json:"step"`
+	ModelID       string    `json:"model_id,omitempty"`
+	DatasetName   string    `json:"dataset_name,omitempty"`
+	DatasetDigest string    `json:"dataset_digest,omitempty"`
+}
+
+// MarshalJSON encodes the metric with its timestamp in UTC. NaN and
+// infinite values, which plain JSON numbers cannot hold, are encoded as the
+// strings "NaN", "Infinity", and "-Infinity", as MLflow does.
+func (m Metric) MarshalJSON() ([]byte, error) {
+	return json.Marshal(metricJSON{
+		Key:           m.Key,
+		Value:         jsonFloat(m.Value),
+		Timestamp:     m.Timestamp.UTC(),
+		Step:          m.Step,
+		ModelID:       m.ModelID,
+		DatasetName:   m.DatasetName,
+		DatasetDigest: m.DatasetDigest,
+	})
+}
+
+// UnmarshalJSON decodes a metric encoded by MarshalJSON.
+func (m *Metric) UnmarshalJSON(data []byte) error {
+	var j metricJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*m = Metric{
+		Key:           j.Key,
+		Value:         float64(j.Value),
+		Timestamp:     j.Timestamp,
+		Step:          j.Step,
+		ModelID:       j.ModelID,
+		DatasetName:   j.DatasetName,
+		DatasetDigest: j.DatasetDigest,
+	}
+	return nil
+}
+
+// jsonFloat is a float64 whose JSON form may be a string for NaN and the
+// infinities.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Infinity"`), nil
+	}
+	return json.Marshal(v)
+}
+
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		var v float64
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*f = jsonFloat(v)
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid metric value %s", data)
+	}
+	switch s {
+	case "NaN":
+		*f = jsonFloat(math.NaN())
+	case "Infinity":
+		*f = jsonFloat(math.Inf(1))
+	case "-Infinity":
+		*f = jsonFloat(math.Inf(-1))
+	default:
+		return fmt.Errorf("invalid metric value %q", s)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package tracking
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun_MarshalJSON(t *testing.T) {
+	start := time.UnixMilli(1700000000000).In(time.FixedZone("CET", 3600))
+	run := Run{
+		Info: RunInfo{
+			RunID:          "run-1",
+			ExperimentID:   "1",
+			RunName:        "train",
+			Status:         RunStatusFinished,
+			StartTime:      start,
+			LifecycleStage: "active",
+		},
+		Data: RunData{
+			Metrics: []Metric{
+				{Key: "loss", Value: 0.25, Timestamp: start, Step: 3},
+				{Key: "grad", Value: math.NaN(), Step: 4},
+				{Key: "lr", Value: math.Inf(-1)},
+			},
+			Params: []Param{{Key: "lr", Value: "0.01"}},
+			Tags:   map[string]string{"team": "ml"},
+		},
+	}
+
+	data, err := json.Marshal(run)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		`"run_id":"run-1"`,
+		`"status":"FINISHED"`,
+		`"start_time":"2023-11-14T22:13:20Z"`,
+		`{"key":"loss","value":0.25,"timestamp":"2023-11-14T22:13:20Z","step":3}`,
+		`{"key":"grad","value":"NaN","step":4}`,
+		`"value":"-Infinity"`,
+		`"params":[{"key":"lr","value":"0.01"}]`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Marshal() = %s, missing %s", got, want)
+		}
+	}
+	if strings.Contains(got, "end_time") {
+		t.Errorf("Marshal() = %s, want zero end_time omitted", got)
+	}
+
+	var back Run
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !back.Info.StartTime.Equal(start) || back.Info.Status != RunStatusFinished {
+		t.Errorf("Unmarshal() info = %+v", back.Info)
+	}
+	m := back.Data.Metrics
+	if len(m) != 3 || m[0].Value != 0.25 || !math.IsNaN(m[1].Value) || !math.IsInf(m[2].Value, -1) {
+		t.Errorf("Unmarshal() metrics = %+v", m)
+	}
+	if v, ok := back.Param("lr"); !ok || v != "0.01" {
+		t.Errorf("Param(lr) after Unmarshal = %q, %v", v, ok)
+	}
+}
+
+func TestExperiment_MarshalJSON(t *testing.T) {
+	exp := Experiment{
+		ID:           "7",
+		Name:         "churn",
+		Tags:         map[string]string{},
+		CreationTime: time.UnixMilli(1700000000123).In(time.FixedZone("PST", -8*3600)),
+	}
+	data, err := json.Marshal(exp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"experiment_id":"7","name":"churn","artifact_location":"","lifecycle_stage":"","tags":{},"creation_time":"2023-11-14T22:13:20.123Z"}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestMetric_UnmarshalJSON_InvalidValue(t *testing.T) {
+	var m Metric
+	if err := json.Unmarshal([]byte(`{"key":"loss","value":"high"}`), &m); err == nil {
+		t.Error("Unmarshal() error = nil, want invalid metric value")
+	}
+}
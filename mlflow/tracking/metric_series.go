@@ -0,0 +1,251 @@
+package tracking
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// Axis selects the x-axis used to downsample or align metric series.
+type Axis int
+
+const (
+	// AxisStep uses the logged step.
+	AxisStep Axis = iota
+	// AxisWallTime uses the logged timestamp in Unix milliseconds.
+	AxisWallTime
+	// AxisRelativeTime uses milliseconds since the series' first point,
+	// so runs started at different times line up.
+	AxisRelativeTime
+)
+
+// MetricPoint is one logged value of a metric.
+type MetricPoint struct {
+	Step      int64
+	Timestamp time.Time
+	Value     float64
+}
+
+// MetricSeries is the history of one metric in one run, ordered by step and
+// then timestamp. Its methods return new series and never modify the receiver.
+type MetricSeries struct {
+	RunID  string
+	Key    string
+	Points []MetricPoint
+}
+
+// NewMetricSeries builds a series from metric history such as the result of
+// GetMetricHistory. Metrics with a different key are ignored.
+func NewMetricSeries(runID, key string, metrics []Metric) *MetricSeries {
+	s := &MetricSeries{RunID: runID, Key: key, Points: make([]MetricPoint, 0, len(metrics))}
+	for _, m := range metrics {
+		if m.Key != key {
+			continue
+		}
+		s.Points = append(s.Points, MetricPoint{Step: m.Step, Timestamp: m.Timestamp, Value: m.Value})
+	}
+	slices.SortStableFunc(s.Points, func(a, b MetricPoint) int {
+		if c := cmp.Compare(a.Step, b.Step); c != 0 {
+			return c
+		}
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return s
+}
+
+// LoadMetricSeries fetches the full history of a metric and returns it as a series.
+func LoadMetricSeries(ctx context.Context, c *Client, runID, key string) (*MetricSeries, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	metrics, err := c.GetMetricHistory(ctx, runID, key)
+	if err != nil {
+		return nil, err
+	}
+	return NewMetricSeries(runID, key, metrics), nil
+}
+
+// Len returns the number of points in the series.
+func (s *MetricSeries) Len() int {
+	return len(s.Points)
+}
+
+// withPoints returns a copy of the series metadata with the given points.
+func (s *MetricSeries) withPoints(points []MetricPoint) *MetricSeries {
+	return &MetricSeries{RunID: s.RunID, Key: s.Key, Points: points}
+}
+
+// x returns the position of p on axis, relative to the series' first point
+// for AxisRelativeTime.
+func (s *MetricSeries) x(axis Axis, p MetricPoint) float64 {
+	switch axis {
+	case AxisWallTime:
+		return float64(p.Timestamp.UnixMilli())
+	case AxisRelativeTime:
+		return float64(p.Timestamp.Sub(s.Points[0].Timestamp).Milliseconds())
+	default:
+		return float64(p.Step)
+	}
+}
+
+// Downsample reduces the series to at most n points using the
+// Largest-Triangle-Three-Buckets algorithm, which keeps the visual shape of
+// the curve (peaks and dips) better than uniform sampling. The first and
+// last points are always kept. If n is not smaller than Len, or n <= 0,
+// the series is returned unchanged (as a copy).
+func (s *MetricSeries) Downsample(axis Axis, n int) *MetricSeries {
+	points := s.Points
+	if n <= 0 || n >= len(points) {
+		return s.withPoints(slices.Clone(points))
+	}
+	switch n {
+	case 1:
+		return s.withPoints([]MetricPoint{points[0]})
+	case 2:
+		return s.withPoints([]MetricPoint{points[0], points[len(points)-1]})
+	}
+
+	sampled := make([]MetricPoint, 0, n)
+	sampled = append(sampled, points[0])
+
+	// Interior points are split into n-2 buckets; one point is kept per bucket.
+	every := float64(len(points)-2) / float64(n-2)
+	a := 0
+	for i := 0; i < n-2; i++ {
+		// Average of the next bucket (or the last point for the final bucket)
+		avgStart := int(float64(i+1)*every) + 1
+		avgEnd := min(int(float64(i+2)*every)+1, len(points))
+		if avgStart >= avgEnd {
+			avgStart = len(points) - 1
+			avgEnd = len(points)
+		}
+		var avgX, avgY float64
+		for _, p := range points[avgStart:avgEnd] {
+			avgX += s.x(axis, p)
+			avgY += p.Value
+		}
+		count := float64(avgEnd - avgStart)
+		avgX /= count
+		avgY /= count
+
+		// Pick the point in this bucket forming the largest triangle with
+		// the previously kept point and the next bucket's average.
+		start := int(float64(i)*every) + 1
+		end := int(float64(i+1)*every) + 1
+		ax, ay := s.x(axis, points[a]), points[a].Value
+		best, bestArea := start, -1.0
+		for j := start; j < end; j++ {
+			area := math.Abs((ax-avgX)*(points[j].Value-ay) - (ax-s.x(axis, points[j]))*(avgY-ay))
+			if area > bestArea {
+				best, bestArea = j, area
+			}
+		}
+
+		sampled = append(sampled, points[best])
+		a = best
+	}
+
+	sampled = append(sampled, points[len(points)-1])
+	return s.withPoints(sampled)
+}
+
+// EMA smooths the series with an exponential moving average:
+// each value becomes alpha*value + (1-alpha)*previous. alpha must be in
+// (0, 1]; smaller values smooth more and 1 leaves the series unchanged.
+// NaN values are skipped and carry the previous smoothed value forward.
+func (s *MetricSeries) EMA(alpha float64) (*MetricSeries, error) {
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, fmt.Errorf("mlflow: EMA alpha must be in (0, 1], got %v", alpha)
+	}
+
+	smoothed := make([]MetricPoint, len(s.Points))
+	prev := math.NaN()
+	for i, p := range s.Points {
+		switch {
+		case math.IsNaN(p.Value):
+			// keep prev
+		case math.IsNaN(prev):
+			prev = p.Value
+		default:
+			prev = alpha*p.Value + (1-alpha)*prev
+		}
+		smoothed[i] = p
+		smoothed[i].Value = prev
+	}
+	return s.withPoints(smoothed), nil
+}
+
+// AlignedSeries holds several metric series sampled on a shared x-axis,
+// ready to plot as one chart.
+type AlignedSeries struct {
+	Axis Axis
+	// X holds the union of every series' x values, in ascending order.
+	X []float64
+	// Series holds the aligned series in the order they were passed to Align.
+	Series []*MetricSeries
+	// Values[i][j] is the value of Series[i] at X[j]. Between two logged
+	// points the value is linearly interpolated; outside a series' range it
+	// is NaN.
+	Values [][]float64
+}
+
+// Align samples every series at the union of their x values on axis so they
+// can be compared point by point. When a series logs several values at the
+// same x, the last one wins.
+func Align(axis Axis, series ...*MetricSeries) *AlignedSeries {
+	aligned := &AlignedSeries{
+		Axis:   axis,
+		Series: series,
+		Values: make([][]float64, len(series)),
+	}
+
+	// Per-series (x, value) pairs, deduplicated by x
+	type xy struct{ x, y float64 }
+	curves := make([][]xy, len(series))
+	for i, s := range series {
+		curve := make([]xy, 0, len(s.Points))
+		for _, p := range s.Points {
+			curve = append(curve, xy{s.x(axis, p), p.Value})
+		}
+		slices.SortStableFunc(curve, func(a, b xy) int { return cmp.Compare(a.x, b.x) })
+		deduped := curve[:0]
+		for _, pt := range curve {
+			if n := len(deduped); n > 0 && deduped[n-1].x == pt.x {
+				deduped[n-1] = pt
+				continue
+			}
+			deduped = append(deduped, pt)
+		}
+		curves[i] = deduped
+		for _, pt := range deduped {
+			aligned.X = append(aligned.X, pt.x)
+		}
+	}
+	slices.Sort(aligned.X)
+	aligned.X = slices.Compact(aligned.X)
+
+	for i, curve := range curves {
+		values := make([]float64, len(aligned.X))
+		k := 0
+		for j, x := range aligned.X {
+			for k < len(curve) && curve[k].x < x {
+				k++
+			}
+			switch {
+			case k < len(curve) && curve[k].x == x:
+				values[j] = curve[k].y
+			case k == 0 || k == len(curve):
+				values[j] = math.NaN()
+			default:
+				lo, hi := curve[k-1], curve[k]
+				values[j] = lo.y + (hi.y-lo.y)*(x-lo.x)/(hi.x-lo.x)
+			}
+		}
+		aligned.Values[i] = values
+	}
+
+	return aligned
+}
@@ -0,0 +1,167 @@
+package tracking
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func seriesOf(values ...float64) *MetricSeries {
+	s := &MetricSeries{RunID: "r1", Key: "loss"}
+	base := time.UnixMilli(10_000)
+	for i, v := range values {
+		s.Points = append(s.Points, MetricPoint{
+			Step:      int64(i),
+			Timestamp: base.Add(time.Duration(i) * time.Second),
+			Value:     v,
+		})
+	}
+	return s
+}
+
+func TestNewMetricSeries_SortsAndFilters(t *testing.T) {
+	s := NewMetricSeries("r1", "loss", []Metric{
+		{Key: "loss", Value: 3, Step: 2},
+		{Key: "acc", Value: 9, Step: 0},
+		{Key: "loss", Value: 1, Step: 0, Timestamp: time.UnixMilli(2)},
+		{Key: "loss", Value: 0, Step: 0, Timestamp: time.UnixMilli(1)},
+	})
+
+	want := []float64{0, 1, 3}
+	if s.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", s.Len(), len(want))
+	}
+	for i, v := range want {
+		if s.Points[i].Value != v {
+			t.Errorf("Points[%d].Value = %v, want %v", i, s.Points[i].Value, v)
+		}
+	}
+}
+
+func TestMetricSeries_Downsample(t *testing.T) {
+	// A flat line with a single spike: LTTB must keep the spike.
+	values := make([]float64, 100)
+	values[42] = 10
+	s := seriesOf(values...)
+
+	got := s.Downsample(AxisStep, 10)
+	if got.Len() != 10 {
+		t.Fatalf("Len() = %d, want 10", got.Len())
+	}
+	if got.Points[0].Step != 0 || got.Points[9].Step != 99 {
+		t.Errorf("first/last steps = %d/%d, want 0/99", got.Points[0].Step, got.Points[9].Step)
+	}
+	var keptSpike bool
+	for _, p := range got.Points {
+		if p.Value == 10 {
+			keptSpike = true
+		}
+	}
+	if !keptSpike {
+		t.Error("downsampled series lost the spike")
+	}
+	if s.Len() != 100 {
+		t.Error("Downsample modified the receiver")
+	}
+
+	if n := s.Downsample(AxisStep, 0).Len(); n != 100 {
+		t.Errorf("Downsample(0).Len() = %d, want 100", n)
+	}
+	if n := s.Downsample(AxisStep, 500).Len(); n != 100 {
+		t.Errorf("Downsample(500).Len() = %d, want 100", n)
+	}
+	if n := s.Downsample(AxisStep, 2).Len(); n != 2 {
+		t.Errorf("Downsample(2).Len() = %d, want 2", n)
+	}
+}
+
+func TestMetricSeries_EMA(t *testing.T) {
+	s := seriesOf(0, 10, math.NaN(), 10)
+
+	got, err := s.EMA(0.5)
+	if err != nil {
+		t.Fatalf("EMA() error = %v", err)
+	}
+	want := []float64{0, 5, 5, 7.5}
+	for i, v := range want {
+		if got.Points[i].Value != v {
+			t.Errorf("Points[%d].Value = %v, want %v", i, got.Points[i].Value, v)
+		}
+	}
+	if got.Points[1].Step != 1 {
+		t.Error("EMA changed point steps")
+	}
+
+	for _, alpha := range []float64{0, -1, 1.5, math.NaN()} {
+		if _, err := s.EMA(alpha); err == nil {
+			t.Errorf("expected error for alpha %v", alpha)
+		}
+	}
+}
+
+func TestAlign_Step(t *testing.T) {
+	a := &MetricSeries{Points: []MetricPoint{{Step: 0, Value: 0}, {Step: 10, Value: 10}}}
+	b := &MetricSeries{Points: []MetricPoint{{Step: 5, Value: 1}, {Step: 5, Value: 2}, {Step: 20, Value: 4}}}
+
+	got := Align(AxisStep, a, b)
+
+	wantX := []float64{0, 5, 10, 20}
+	if len(got.X) != len(wantX) {
+		t.Fatalf("X = %v, want %v", got.X, wantX)
+	}
+	for i := range wantX {
+		if got.X[i] != wantX[i] {
+			t.Errorf("X[%d] = %v, want %v", i, got.X[i], wantX[i])
+		}
+	}
+
+	// a: interpolated at 5, NaN beyond its last step
+	if got.Values[0][1] != 5 || !math.IsNaN(got.Values[0][3]) {
+		t.Errorf("a values = %v", got.Values[0])
+	}
+	// b: NaN before its first step, last duplicate wins at 5, interpolated at 10
+	if !math.IsNaN(got.Values[1][0]) || got.Values[1][1] != 2 || got.Values[1][2] != 2+2.0/15*5 {
+		t.Errorf("b values = %v", got.Values[1])
+	}
+}
+
+func TestAlign_RelativeTime(t *testing.T) {
+	a := &MetricSeries{Points: []MetricPoint{
+		{Timestamp: time.UnixMilli(1000), Value: 1},
+		{Timestamp: time.UnixMilli(2000), Value: 2},
+	}}
+	b := &MetricSeries{Points: []MetricPoint{
+		{Timestamp: time.UnixMilli(50_000), Value: 3},
+		{Timestamp: time.UnixMilli(51_000), Value: 4},
+	}}
+
+	got := Align(AxisRelativeTime, a, b)
+	if len(got.X) != 2 || got.X[0] != 0 || got.X[1] != 1000 {
+		t.Fatalf("X = %v, want [0 1000]", got.X)
+	}
+	if got.Values[1][0] != 3 || got.Values[1][1] != 4 {
+		t.Errorf("b values = %v", got.Values[1])
+	}
+}
+
+func TestLoadMetricSeries(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"metrics": []map[string]any{
+				{"key": "loss", "value": 0.5, "step": 1},
+				{"key": "loss", "value": 0.9, "step": 0},
+			},
+		})
+	}))
+
+	s, err := LoadMetricSeries(context.Background(), client, "r1", "loss")
+	if err != nil {
+		t.Fatalf("LoadMetricSeries() error = %v", err)
+	}
+	if s.RunID != "r1" || s.Key != "loss" || s.Len() != 2 || s.Points[0].Value != 0.9 {
+		t.Errorf("series = %+v", s)
+	}
+}
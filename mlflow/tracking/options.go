@@ -1,11 +1,18 @@
 package tracking
 
-import "time"
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/progress"
+)
 
 // createExperimentOptions holds configuration for a CreateExperiment call.
 type createExperimentOptions struct {
-	artifactLocation string
-	tags             map[string]string
+	artifactLocation         string
+	artifactLocationTemplate string
+	tags                     map[string]string
 }
 
 // CreateExperimentOption configures a CreateExperiment call.
@@ -18,6 +25,18 @@ func WithArtifactLocation(loc string) CreateExperimentOption {
 	}
 }
 
+// WithArtifactLocationTemplate sets the artifact storage location from a
+// template expanded when the experiment is created, for a standard layout
+// across experiments (e.g., "s3://bucket/{experiment_name}"). Placeholders
+// are {experiment_name} and {experiment_kind}. The result must be a URI with
+// a scheme MLflow supports, such as s3, gs, wasbs, hdfs or file.
+// It cannot be combined with WithArtifactLocation.
+func WithArtifactLocationTemplate(template string) CreateExperimentOption {
+	return func(o *createExperimentOptions) {
+		o.artifactLocationTemplate = template
+	}
+}
+
 // WithExperimentTags sets tags on the experiment. Tags are merged with any
 // previously set tags (e.g., from WithExperimentKind).
 func WithExperimentTags(tags map[string]string) CreateExperimentOption {
@@ -41,7 +60,7 @@ func WithExperimentKind(kind ExperimentKind) CreateExperimentOption {
 		if o.tags == nil {
 			o.tags = make(map[string]string)
 		}
-		o.tags["mlflow.experimentKind"] = string(kind)
+		o.tags[tagExperimentKind] = string(kind)
 	}
 }
 
@@ -50,12 +69,17 @@ type createRunOptions struct {
 	runName   string
 	startTime *time.Time
 	tags      map[string]string
+	group     string
+	ttl       time.Duration
+
+	noGeneratedName bool
 }
 
 // CreateRunOption configures a CreateRun call.
 type CreateRunOption func(*createRunOptions)
 
-// WithRunName sets the name for the run.
+// WithRunName sets the name for the run. If no name is set, CreateRun
+// generates one with GenerateRunName.
 func WithRunName(name string) CreateRunOption {
 	return func(o *createRunOptions) {
 		o.runName = name
@@ -77,13 +101,45 @@ func WithRunTags(tags map[string]string) CreateRunOption {
 	}
 }
 
+// WithRunGroup makes the run a member of the run group with the given ID,
+// as returned by CreateRunGroup: it sets the RunGroupTagKey tag and nests
+// the run under the group's run.
+func WithRunGroup(groupID string) CreateRunOption {
+	return func(o *createRunOptions) {
+		o.group = groupID
+	}
+}
+
+// WithRunTTL marks the run to expire ttl after its start time, by setting
+// RunExpiresTagKey, so ExpireRuns deletes or archives it once ttl has
+// passed. Use it for runs of scratch experiments nobody should have to
+// clean up by hand.
+func WithRunTTL(ttl time.Duration) CreateRunOption {
+	return func(o *createRunOptions) {
+		o.ttl = ttl
+	}
+}
+
+// WithoutGeneratedRunName leaves the run name to the server when none is
+// set, instead of generating one client-side. MLflow 2.x servers generate
+// names themselves; older servers leave the run unnamed.
+func WithoutGeneratedRunName() CreateRunOption {
+	return func(o *createRunOptions) {
+		o.noGeneratedName = true
+	}
+}
+
 // searchExperimentsOptions holds configuration for a SearchExperiments call.
 type searchExperimentsOptions struct {
 	filter     string
+	kind       ExperimentKind
 	maxResults int
 	pageToken  string
 	orderBy    []string
 	viewType   ViewType
+
+	// countUpTo fills ExperimentList.TotalCount when positive.
+	countUpTo int
 }
 
 // SearchExperimentsOption configures a SearchExperiments call.
@@ -96,6 +152,14 @@ func WithExperimentsFilter(filter string) SearchExperimentsOption {
 	}
 }
 
+// WithExperimentsKindFilter restricts results to experiments of the given kind.
+// Combined with WithExperimentsFilter using AND.
+func WithExperimentsKindFilter(kind ExperimentKind) SearchExperimentsOption {
+	return func(o *searchExperimentsOptions) {
+		o.kind = kind
+	}
+}
+
 // WithExperimentsMaxResults sets the maximum number of experiments to return.
 func WithExperimentsMaxResults(n int) SearchExperimentsOption {
 	return func(o *searchExperimentsOptions) {
@@ -118,6 +182,17 @@ func WithExperimentsOrderBy(fields ...string) SearchExperimentsOption {
 	}
 }
 
+// WithExperimentsCountUpTo fills ExperimentList.TotalCount with the number
+// of experiments matching the search, for pagination controls. The server
+// does not report totals, so they are counted by paging through the
+// results, up to n; TotalCountCapped is set if there are more. Counting from
+// a page other than the first also fetches the pages before it.
+func WithExperimentsCountUpTo(n int) SearchExperimentsOption {
+	return func(o *searchExperimentsOptions) {
+		o.countUpTo = n
+	}
+}
+
 // WithExperimentsViewType sets the view type filter for experiments.
 func WithExperimentsViewType(viewType ViewType) SearchExperimentsOption {
 	return func(o *searchExperimentsOptions) {
@@ -125,59 +200,168 @@ func WithExperimentsViewType(viewType ViewType) SearchExperimentsOption {
 	}
 }
 
-// searchRunsOptions holds configuration for a SearchRuns call.
-type searchRunsOptions struct {
-	filter     string
-	maxResults int
-	pageToken  string
-	orderBy    []string
-	viewType   ViewType
+// SearchRunsConfig is the configuration a SearchRuns, SearchRunsCursor,
+// or StreamRuns call resolves its options into. Wrapper libraries can build
+// one with NewSearchRunsConfig to inspect what a list of options sets,
+// adjust it, and pass it back with WithSearchRunsConfig.
+type SearchRunsConfig struct {
+	// Filter is the MLflow filter expression, set by WithRunsFilter.
+	Filter string
+
+	// MaxResults is the page size, set by WithRunsMaxResults.
+	MaxResults int
+
+	// PageToken is the page to start from, set by WithRunsPageToken.
+	PageToken string
+
+	// OrderBy holds order_by clauses, set by WithRunsOrderBy or WithRunsSort.
+	OrderBy []string
+
+	// ViewType filters runs by lifecycle stage, set by WithRunsViewType.
+	ViewType ViewType
+
+	// CountUpTo fills RunList.TotalCount when positive, set by
+	// WithRunsCountUpTo.
+	CountUpTo int
+
+	// Fields selects the parts of each run to decode; zero means all. Set
+	// by WithRunsFields.
+	Fields RunFields
+
+	// Group restricts the search to the members of a run group, set by
+	// WithGroup.
+	Group string
+
+	// orderByErr is the first invalid key given to WithRunsSort.
+	orderByErr error
+}
+
+// NewSearchRunsConfig returns the configuration SearchRuns uses for opts,
+// starting from the defaults.
+func NewSearchRunsConfig(opts ...SearchRunsOption) SearchRunsConfig {
+	c := SearchRunsConfig{MaxResults: defaultSearchMaxResults}
+	c.Apply(opts...)
+	return c
+}
+
+// Apply applies opts to c in order.
+func (c *SearchRunsConfig) Apply(opts ...SearchRunsOption) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// Err returns the error an invalid option recorded, such as an invalid
+// WithRunsSort key, or nil. The search fails with it.
+func (c *SearchRunsConfig) Err() error {
+	return c.orderByErr
 }
 
 // SearchRunsOption configures a SearchRuns call.
-type SearchRunsOption func(*searchRunsOptions)
+type SearchRunsOption func(*SearchRunsConfig)
+
+// WithSearchRunsConfig replaces the whole configuration with cfg, such as
+// one built with NewSearchRunsConfig and adjusted. Options after it still
+// apply on top.
+func WithSearchRunsConfig(cfg SearchRunsConfig) SearchRunsOption {
+	return func(o *SearchRunsConfig) {
+		*o = cfg
+		o.OrderBy = slices.Clone(cfg.OrderBy)
+	}
+}
 
 // WithRunsFilter sets the search filter string for runs.
 // Uses MLflow filter syntax (e.g., "metrics.rmse < 1" or "params.model = 'sklearn'").
 func WithRunsFilter(filter string) SearchRunsOption {
-	return func(o *searchRunsOptions) {
-		o.filter = filter
+	return func(o *SearchRunsConfig) {
+		o.Filter = filter
+	}
+}
+
+// WithGroup restricts the search to the members of the run group with the
+// given ID, in addition to any WithRunsFilter filter.
+func WithGroup(groupID string) SearchRunsOption {
+	return func(o *SearchRunsConfig) {
+		o.Group = groupID
 	}
 }
 
 // WithRunsMaxResults sets the maximum number of runs to return.
 func WithRunsMaxResults(n int) SearchRunsOption {
-	return func(o *searchRunsOptions) {
-		o.maxResults = n
+	return func(o *SearchRunsConfig) {
+		o.MaxResults = n
 	}
 }
 
 // WithRunsPageToken sets the pagination token for runs.
 func WithRunsPageToken(token string) SearchRunsOption {
-	return func(o *searchRunsOptions) {
-		o.pageToken = token
+	return func(o *SearchRunsConfig) {
+		o.PageToken = token
 	}
 }
 
 // WithRunsOrderBy sets the sort order for runs.
 // Examples: "start_time DESC", "metrics.rmse ASC".
+// The strings are sent unchecked; WithRunsSort validates typed keys.
 func WithRunsOrderBy(fields ...string) SearchRunsOption {
-	return func(o *searchRunsOptions) {
-		o.orderBy = fields
+	return func(o *SearchRunsConfig) {
+		o.OrderBy = fields
+		o.orderByErr = nil
+	}
+}
+
+// WithRunsSort sets the sort order for runs from typed keys, such as
+// OrderByMetric("accuracy", Desc). It replaces WithRunsOrderBy; an invalid
+// key fails the search without contacting the server.
+func WithRunsSort(keys ...OrderBy) SearchRunsOption {
+	return func(o *SearchRunsConfig) {
+		o.OrderBy = make([]string, 0, len(keys))
+		o.orderByErr = nil
+		for _, k := range keys {
+			if err := k.Err(); err != nil && o.orderByErr == nil {
+				o.orderByErr = err
+			}
+			o.OrderBy = append(o.OrderBy, k.String())
+		}
+	}
+}
+
+// WithRunsCountUpTo fills RunList.TotalCount with the number of runs
+// matching the search, for pagination controls. The server does not report
+// totals, so they are counted by paging through the results, up to n;
+// TotalCountCapped is set if there are more. Counting from a page other
+// than the first also fetches the pages before it.
+func WithRunsCountUpTo(n int) SearchRunsOption {
+	return func(o *SearchRunsConfig) {
+		o.CountUpTo = n
+	}
+}
+
+// WithRunsFields decodes only the selected parts of each run, such as
+// Info | Tags for a dashboard listing thousands of runs without their
+// metrics; the others are left empty. Default: AllRunFields.
+func WithRunsFields(fields RunFields) SearchRunsOption {
+	return func(o *SearchRunsConfig) {
+		o.Fields = fields
 	}
 }
 
 // WithRunsViewType sets the view type filter for runs.
 func WithRunsViewType(viewType ViewType) SearchRunsOption {
-	return func(o *searchRunsOptions) {
-		o.viewType = viewType
+	return func(o *SearchRunsConfig) {
+		o.ViewType = viewType
 	}
 }
 
 // logMetricOptions holds configuration for a LogMetric call.
 type logMetricOptions struct {
-	step      *int64
-	timestamp *time.Time
+	step          *int64
+	timestamp     *time.Time
+	sinceRunStart *time.Duration
+	monotonic     bool
+	modelID       string
+	datasetName   string
+	datasetDigest string
 }
 
 // LogMetricOption configures a LogMetric call.
@@ -198,6 +382,76 @@ func WithTimestamp(t time.Time) LogMetricOption {
 	}
 }
 
+// WithRelativeTimestamp sets the metric timestamp to the run's start time
+// plus d, for values measured on a clock relative to the start of training
+// rather than the wall clock. The run's start time is fetched with GetRun on
+// first use and cached by the client. WithTimestamp takes precedence.
+//
+// MLflow stores timestamps in milliseconds, so d is truncated to the
+// millisecond; use the step to order values logged within the same
+// millisecond.
+func WithRelativeTimestamp(d time.Duration) LogMetricOption {
+	return func(o *logMetricOptions) {
+		o.sinceRunStart = &d
+	}
+}
+
+// WithMonotonicSteps rejects the call if its step (0 without WithStep) is
+// lower than the last step logged for the same run and key through this
+// client, catching loops that reset or reorder their step counter. Steps
+// logged by other clients, or without this option, are not checked.
+func WithMonotonicSteps() LogMetricOption {
+	return func(o *logMetricOptions) {
+		o.monotonic = true
+	}
+}
+
+// WithModelID attaches the metric to a LoggedModel as well as the run, so
+// evaluation results are stored with the model record and shown on its page.
+// Requires MLflow 3. In LogBatch, set Metric.ModelID instead.
+func WithModelID(modelID string) LogMetricOption {
+	return func(o *logMetricOptions) {
+		o.modelID = modelID
+	}
+}
+
+// WithDataset records the dataset the metric was computed on, by name and
+// digest (such as a content hash). In LogBatch, set Metric.DatasetName and
+// Metric.DatasetDigest instead.
+func WithDataset(name, digest string) LogMetricOption {
+	return func(o *logMetricOptions) {
+		o.datasetName = name
+		o.datasetDigest = digest
+	}
+}
+
+// logBatchOptions holds configuration for a LogBatch call.
+type logBatchOptions struct {
+	retries int
+	backoff time.Duration
+}
+
+// LogBatchOption configures a LogBatch call.
+type LogBatchOption func(*logBatchOptions)
+
+// WithBatchRetries retries a LogBatch call up to n times when it fails with a
+// timeout, network error, 429, or 5xx, waiting backoff before the first retry
+// (doubled on each attempt).
+//
+// A timed-out batch may still have been written, in which case the retry is
+// rejected because its params are "already logged". In this mode LogBatch
+// then reads the run's params: if every param in the batch is already logged
+// with the same value, the earlier attempt landed and the call succeeds.
+// A param logged with a different value is still an error. Batches without
+// params cannot be checked this way, so a retried batch of metrics may be
+// logged twice.
+func WithBatchRetries(n int, backoff time.Duration) LogBatchOption {
+	return func(o *logBatchOptions) {
+		o.retries = n
+		o.backoff = backoff
+	}
+}
+
 // updateRunOptions holds configuration for an UpdateRun call.
 type updateRunOptions struct {
 	status  *RunStatus
@@ -228,3 +482,252 @@ func WithRunNameUpdate(name string) UpdateRunOption {
 		o.runName = name
 	}
 }
+
+// searchRunsAcrossOptions holds configuration for a SearchRunsAcross call.
+type searchRunsAcrossOptions struct {
+	concurrency int
+	batchSize   int
+	maxResults  int
+	orderBy     []string
+	viewType    ViewType
+}
+
+// SearchRunsAcrossOption configures a SearchRunsAcross call.
+type SearchRunsAcrossOption func(*searchRunsAcrossOptions)
+
+// WithAcrossConcurrency sets how many SearchRuns calls may run at once.
+// Default: 4. Values below 1 are treated as 1.
+func WithAcrossConcurrency(n int) SearchRunsAcrossOption {
+	return func(o *searchRunsAcrossOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithAcrossBatchSize sets how many experiment IDs are sent in each SearchRuns call.
+// Default: 20. Values below 1 are treated as 1.
+func WithAcrossBatchSize(n int) SearchRunsAcrossOption {
+	return func(o *searchRunsAcrossOptions) {
+		o.batchSize = n
+	}
+}
+
+// WithAcrossMaxResults caps the number of merged runs returned.
+// Default: 0, which returns every matching run.
+func WithAcrossMaxResults(n int) SearchRunsAcrossOption {
+	return func(o *searchRunsAcrossOptions) {
+		o.maxResults = n
+	}
+}
+
+// WithAcrossOrderBy sets the sort order of the merged runs, using the same
+// syntax as WithRunsOrderBy. Default: "start_time DESC".
+func WithAcrossOrderBy(fields ...string) SearchRunsAcrossOption {
+	return func(o *searchRunsAcrossOptions) {
+		o.orderBy = fields
+	}
+}
+
+// WithAcrossViewType sets the view type for runs.
+// Experiments are always searched with the server default (active only).
+func WithAcrossViewType(viewType ViewType) SearchRunsAcrossOption {
+	return func(o *searchRunsAcrossOptions) {
+		o.viewType = viewType
+	}
+}
+
+// logEnvironmentOptions holds configuration for a LogEnvironment call.
+type logEnvironmentOptions struct {
+	moduleDir      string
+	envVars        []string
+	containerImage string
+}
+
+// LogEnvironmentOption configures a LogEnvironment call.
+type LogEnvironmentOption func(*logEnvironmentOptions)
+
+// WithModuleDir sets the directory containing go.mod and go.sum. By default
+// the current working directory and its parents are searched.
+func WithModuleDir(dir string) LogEnvironmentOption {
+	return func(o *logEnvironmentOptions) {
+		o.moduleDir = dir
+	}
+}
+
+// WithEnvironmentVars adds environment variables to record, in addition to
+// the Go runtime variables recorded by default. Only variable names listed
+// here are ever captured; unset variables are skipped.
+func WithEnvironmentVars(names ...string) LogEnvironmentOption {
+	return func(o *logEnvironmentOptions) {
+		o.envVars = append(o.envVars, names...)
+	}
+}
+
+// WithContainerImage records the container image the process runs in,
+// preferably pinned by digest (e.g. "quay.io/org/trainer@sha256:...").
+// A process cannot discover its own image, so it must be passed in,
+// typically from an environment variable set by the deployment.
+func WithContainerImage(image string) LogEnvironmentOption {
+	return func(o *logEnvironmentOptions) {
+		o.containerImage = image
+	}
+}
+
+// bufferedLoggerOptions holds configuration for a BufferedLogger.
+type bufferedLoggerOptions struct {
+	flushInterval time.Duration
+	flushSize     int
+	sampling      *MetricSampling
+
+	// now returns metric timestamps; replaced in tests.
+	now func() time.Time
+}
+
+// BufferedLoggerOption configures a BufferedLogger.
+type BufferedLoggerOption func(*bufferedLoggerOptions)
+
+// WithFlushInterval sets how often buffered values are sent. Default: 5 seconds.
+func WithFlushInterval(d time.Duration) BufferedLoggerOption {
+	return func(o *bufferedLoggerOptions) {
+		o.flushInterval = d
+	}
+}
+
+// WithFlushSize sets how many buffered values trigger a flush before the
+// interval elapses. Default: 1000, the most one LogBatch request can carry.
+func WithFlushSize(n int) BufferedLoggerOption {
+	return func(o *bufferedLoggerOptions) {
+		o.flushSize = n
+	}
+}
+
+// WithMetricSampling downsamples logged metrics by policy, always keeping the
+// first and latest value of each metric. See MetricSampling.
+func WithMetricSampling(policy MetricSampling) BufferedLoggerOption {
+	return func(o *bufferedLoggerOptions) {
+		o.sampling = &policy
+	}
+}
+
+// downloadArtifactOptions holds configuration for a DownloadArtifact call.
+type downloadArtifactOptions struct {
+	cache  *ArtifactCache
+	digest string
+}
+
+// DownloadArtifactOption configures a DownloadArtifact call.
+type DownloadArtifactOption func(*downloadArtifactOptions)
+
+// WithDownloadCache serves the artifact from cache if it holds it, and adds
+// it to cache after downloading it otherwise.
+func WithDownloadCache(cache *ArtifactCache) DownloadArtifactOption {
+	return func(o *downloadArtifactOptions) {
+		o.cache = cache
+	}
+}
+
+// WithDownloadDigest sets the expected checksum of the artifact, as
+// "sha256:<hex>". The download fails if the content does not match. With
+// WithDownloadCache, any cached artifact with this checksum is used, even
+// one downloaded from another run, without contacting the server.
+func WithDownloadDigest(digest string) DownloadArtifactOption {
+	return func(o *downloadArtifactOptions) {
+		o.digest = digest
+	}
+}
+
+// exportOptions holds configuration for an ExportRunsCSV call.
+type exportOptions struct {
+	events chan<- progress.Event
+}
+
+// ExportOption configures an ExportRunsCSV call.
+type ExportOption func(*exportOptions)
+
+// WithEvents sends progress events for the call to ch. See package
+// progress for how events are sent.
+func WithEvents(ch chan<- progress.Event) ExportOption {
+	return func(o *exportOptions) {
+		o.events = ch
+	}
+}
+
+// distributedLoggerOptions holds configuration for a DistributedLogger.
+type distributedLoggerOptions struct {
+	leader   int
+	barrier  func(context.Context) error
+	buffered []BufferedLoggerOption
+}
+
+// DistributedLoggerOption configures a DistributedLogger.
+type DistributedLoggerOption func(*distributedLoggerOptions)
+
+// WithLeaderRank sets the rank that logs params, tags, and global metrics.
+// Default: 0.
+func WithLeaderRank(rank int) DistributedLoggerOption {
+	return func(o *distributedLoggerOptions) {
+		o.leader = rank
+	}
+}
+
+// WithBarrier sets a function that returns once every rank has called it,
+// such as a wrapper around the job's collective barrier. Flush and Close
+// call it after sending.
+func WithBarrier(barrier func(ctx context.Context) error) DistributedLoggerOption {
+	return func(o *distributedLoggerOptions) {
+		o.barrier = barrier
+	}
+}
+
+// WithBufferedLoggerOptions configures the BufferedLogger each rank
+// buffers with, such as its flush interval.
+func WithBufferedLoggerOptions(opts ...BufferedLoggerOption) DistributedLoggerOption {
+	return func(o *distributedLoggerOptions) {
+		o.buffered = append(o.buffered, opts...)
+	}
+}
+
+// expireRunsOptions holds configuration for an ExpireRuns call.
+type expireRunsOptions struct {
+	action  ExpireAction
+	confirm string
+}
+
+// ExpireRunsOption configures an ExpireRuns call.
+type ExpireRunsOption func(*expireRunsOptions)
+
+// WithExpireAction sets what ExpireRuns does with expired runs.
+// Default: ExpireDelete.
+func WithExpireAction(action ExpireAction) ExpireRunsOption {
+	return func(o *expireRunsOptions) {
+		o.action = action
+	}
+}
+
+// WithExpireConfirm confirms that ExpireRuns deletes the expired runs of
+// experimentID, as a client created with mlflow.WithDeleteProtection
+// requires. Archiving needs no confirmation.
+func WithExpireConfirm(experimentID string) ExpireRunsOption {
+	return func(o *expireRunsOptions) {
+		o.confirm = experimentID
+	}
+}
+
+// deleteOptions holds configuration for a delete call.
+type deleteOptions struct {
+	confirm string
+}
+
+// DeleteOption configures DeleteExperiment, HardDeleteExperiment, and
+// DeleteRun calls.
+type DeleteOption func(*deleteOptions)
+
+// WithConfirm confirms that the call deletes the experiment or run id, as a
+// client created with mlflow.WithDeleteProtection requires; without
+// protection it has no effect. It confirms only the call it is passed to.
+//
+//	err := client.DeleteRun(ctx, runID, tracking.WithConfirm(runID))
+func WithConfirm(id string) DeleteOption {
+	return func(o *deleteOptions) {
+		o.confirm = id
+	}
+}
@@ -0,0 +1,57 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestNewSearchRunsConfig(t *testing.T) {
+	cfg := NewSearchRunsConfig(
+		WithRunsFilter("metrics.rmse < 1"),
+		WithRunsSort(OrderByMetric("rmse", Asc)),
+		WithRunsFields(Info|Tags),
+	)
+	if cfg.MaxResults != defaultSearchMaxResults {
+		t.Errorf("MaxResults = %d, want default %d", cfg.MaxResults, defaultSearchMaxResults)
+	}
+	if cfg.Filter != "metrics.rmse < 1" || cfg.Fields != Info|Tags {
+		t.Errorf("config = %+v", cfg)
+	}
+	if !slices.Equal(cfg.OrderBy, []string{"metrics.rmse ASC"}) {
+		t.Errorf("OrderBy = %v", cfg.OrderBy)
+	}
+	if cfg.Err() != nil {
+		t.Errorf("Err() = %v, want nil", cfg.Err())
+	}
+
+	cfg.Apply(WithRunsSort(OrderByAttribute("accuracy", Desc)))
+	if cfg.Err() == nil {
+		t.Error("Err() = nil after an invalid sort key")
+	}
+}
+
+func TestWithSearchRunsConfig(t *testing.T) {
+	var got map[string]any
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mustDecodeJSON(t, r, &got)
+		mustEncodeJSON(t, w, map[string]any{"runs": []any{}})
+	}))
+
+	cfg := NewSearchRunsConfig(WithRunsFilter("params.model = 'rf'"))
+	cfg.MaxResults = 25
+	_, err := client.SearchRuns(context.Background(), []string{"1"},
+		WithSearchRunsConfig(cfg),
+		WithRunsOrderBy("start_time DESC"),
+	)
+	if err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	if got["filter"] != "params.model = 'rf'" || got["max_results"] != float64(25) {
+		t.Errorf("request = %v, want filter and max_results from config", got)
+	}
+	if orderBy, _ := got["order_by"].([]any); len(orderBy) != 1 || orderBy[0] != "start_time DESC" {
+		t.Errorf("order_by = %v, want option after config applied", got["order_by"])
+	}
+}
@@ -0,0 +1,105 @@
+package tracking
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SortDirection is the direction of an OrderBy key.
+type SortDirection int
+
+const (
+	// Asc sorts from the smallest value to the largest.
+	Asc SortDirection = iota
+	// Desc sorts from the largest value to the smallest.
+	Desc
+)
+
+// String returns "ASC" or "DESC".
+func (d SortDirection) String() string {
+	if d == Desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// runOrderAttributes are the run attributes the server can sort by.
+var runOrderAttributes = map[string]bool{
+	"start_time":    true,
+	"end_time":      true,
+	"run_name":      true,
+	"status":        true,
+	"run_id":        true,
+	"run_uuid":      true,
+	"user_id":       true,
+	"experiment_id": true,
+}
+
+// plainKey matches keys that need no quoting in an order_by clause.
+var plainKey = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// OrderBy is a sort key for run searches, built with OrderByMetric,
+// OrderByParam, OrderByTag, or OrderByAttribute and passed to WithRunsSort.
+// Unlike a raw order_by string, a misspelled attribute or direction is
+// reported before the request is sent.
+type OrderBy struct {
+	kind string // "attributes", "metrics", "params", or "tags"
+	name string
+	dir  SortDirection
+	err  error
+}
+
+// OrderByMetric sorts runs by the latest value of a metric.
+func OrderByMetric(key string, dir SortDirection) OrderBy {
+	return newOrderBy("metrics", key, dir)
+}
+
+// OrderByParam sorts runs by the value of a parameter.
+func OrderByParam(key string, dir SortDirection) OrderBy {
+	return newOrderBy("params", key, dir)
+}
+
+// OrderByTag sorts runs by the value of a tag.
+func OrderByTag(key string, dir SortDirection) OrderBy {
+	return newOrderBy("tags", key, dir)
+}
+
+// OrderByAttribute sorts runs by a run attribute: start_time, end_time,
+// run_name, status, run_id, user_id, or experiment_id.
+func OrderByAttribute(name string, dir SortDirection) OrderBy {
+	o := newOrderBy("attributes", name, dir)
+	if o.err == nil && !runOrderAttributes[name] {
+		o.err = fmt.Errorf("mlflow: unsupported order by attribute %q", name)
+	}
+	return o
+}
+
+func newOrderBy(kind, name string, dir SortDirection) OrderBy {
+	o := OrderBy{kind: kind, name: name, dir: dir}
+	switch {
+	case name == "":
+		o.err = fmt.Errorf("mlflow: order by %s key is required", strings.TrimSuffix(kind, "s"))
+	case strings.Contains(name, "`"):
+		o.err = fmt.Errorf("mlflow: order by key %q must not contain a backtick", name)
+	case dir != Asc && dir != Desc:
+		o.err = fmt.Errorf("mlflow: invalid order by direction %d for %q", int(dir), name)
+	}
+	return o
+}
+
+// String returns the key in order_by syntax, such as
+// "metrics.accuracy DESC". Keys with characters other than letters,
+// digits, and underscores are quoted with backticks.
+func (o OrderBy) String() string {
+	name := o.name
+	if !plainKey.MatchString(name) {
+		name = "`" + name + "`"
+	}
+	return o.kind + "." + name + " " + o.dir.String()
+}
+
+// Err returns the error that makes the key invalid, or nil.
+func (o OrderBy) Err() error {
+	return o.err
+}
@@ -0,0 +1,83 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestOrderBy_String(t *testing.T) {
+	tests := []struct {
+		key  OrderBy
+		want string
+	}{
+		{OrderByMetric("accuracy", Desc), "metrics.accuracy DESC"},
+		{OrderByAttribute("start_time", Asc), "attributes.start_time ASC"},
+		{OrderByParam("learning_rate", Asc), "params.learning_rate ASC"},
+		{OrderByTag("mlflow.runName", Desc), "tags.`mlflow.runName` DESC"},
+		{OrderByMetric("val loss", Asc), "metrics.`val loss` ASC"},
+	}
+	for _, tt := range tests {
+		if err := tt.key.Err(); err != nil {
+			t.Errorf("%s: Err() = %v", tt.want, err)
+		}
+		if got := tt.key.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+		// The wire syntax must parse back for client-side sorting
+		if _, err := parseRunOrder([]string{tt.key.String()}); err != nil {
+			t.Errorf("parseRunOrder(%q) error = %v", tt.key.String(), err)
+		}
+	}
+}
+
+func TestOrderBy_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		key  OrderBy
+	}{
+		{"misspelled attribute", OrderByAttribute("start_tme", Desc)},
+		{"empty metric", OrderByMetric("", Asc)},
+		{"backtick", OrderByTag("a`b", Asc)},
+		{"direction", OrderByMetric("rmse", SortDirection(7))},
+	}
+	for _, tt := range tests {
+		if tt.key.Err() == nil {
+			t.Errorf("%s: Err() = nil, want an error", tt.name)
+		}
+	}
+}
+
+func TestSearchRuns_WithRunsSort(t *testing.T) {
+	var got []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OrderBy []string `json:"order_by"`
+		}
+		mustDecodeJSON(t, r, &req)
+		got = req.OrderBy
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"runs": []any{}})
+	}))
+
+	ctx := context.Background()
+	_, err := client.SearchRuns(ctx, []string{"1"}, WithRunsSort(
+		OrderByMetric("accuracy", Desc),
+		OrderByAttribute("start_time", Asc),
+	))
+	if err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	if want := []string{"metrics.accuracy DESC", "attributes.start_time ASC"}; !slices.Equal(got, want) {
+		t.Errorf("order_by = %q, want %q", got, want)
+	}
+
+	got = nil
+	if _, err := client.SearchRuns(ctx, []string{"1"}, WithRunsSort(OrderByAttribute("start_tme", Desc))); err == nil {
+		t.Error("expected error for misspelled attribute")
+	}
+	if got != nil {
+		t.Error("invalid order by was sent to the server")
+	}
+}
@@ -0,0 +1,134 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Permission is a level of access to an experiment, granted to a user by an
+// MLflow server running with authentication (mlflow server --app-name
+// basic-auth).
+type Permission string
+
+// Permission levels, from least to most access.
+const (
+	PermissionNone   Permission = "NO_PERMISSIONS"
+	PermissionRead   Permission = "READ"
+	PermissionEdit   Permission = "EDIT"
+	PermissionManage Permission = "MANAGE"
+)
+
+// ExperimentPermission is the permission a user has on an experiment.
+type ExperimentPermission struct {
+	ExperimentID string
+	UserID       int64
+	Permission   Permission
+}
+
+// experimentPermissionWire is the JSON form of ExperimentPermission. The auth
+// endpoints are not part of the MLflow protos.
+type experimentPermissionWire struct {
+	ExperimentID string     `json:"experiment_id"`
+	UserID       int64      `json:"user_id"`
+	Permission   Permission `json:"permission"`
+}
+
+type experimentPermissionRequest struct {
+	ExperimentID string     `json:"experiment_id"`
+	Username     string     `json:"username"`
+	Permission   Permission `json:"permission,omitempty"`
+}
+
+func validatePermissionArgs(experimentID, username string) error {
+	if experimentID == "" {
+		return fmt.Errorf("mlflow: experiment ID is required")
+	}
+	if username == "" {
+		return fmt.Errorf("mlflow: username is required")
+	}
+	return nil
+}
+
+// GetExperimentPermission returns the permission username has on an
+// experiment. It returns a not-found error if none was granted.
+func (c *Client) GetExperimentPermission(ctx context.Context, experimentID, username string) (*ExperimentPermission, error) {
+	if err := validatePermissionArgs(experimentID, username); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"experiment_id": []string{experimentID},
+		"username":      []string{username},
+	}
+
+	var resp struct {
+		ExperimentPermission experimentPermissionWire `json:"experiment_permission"`
+	}
+
+	err := c.transport.Get(ctx, "/api/2.0/mlflow/experiments/permissions/get", query, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment permission: %w", err)
+	}
+
+	p := resp.ExperimentPermission
+	return &ExperimentPermission{ExperimentID: p.ExperimentID, UserID: p.UserID, Permission: p.Permission}, nil
+}
+
+// CreateExperimentPermission grants username a permission on an experiment.
+// It fails if the user already has one; use UpdateExperimentPermission to
+// change it.
+func (c *Client) CreateExperimentPermission(ctx context.Context, experimentID, username string, permission Permission) error {
+	if err := validatePermissionArgs(experimentID, username); err != nil {
+		return err
+	}
+	if permission == "" {
+		return fmt.Errorf("mlflow: permission is required")
+	}
+
+	req := &experimentPermissionRequest{ExperimentID: experimentID, Username: username, Permission: permission}
+
+	err := c.transport.Post(ctx, "/api/2.0/mlflow/experiments/permissions/create", req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create experiment permission: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateExperimentPermission changes the permission username has on an
+// experiment.
+func (c *Client) UpdateExperimentPermission(ctx context.Context, experimentID, username string, permission Permission) error {
+	if err := validatePermissionArgs(experimentID, username); err != nil {
+		return err
+	}
+	if permission == "" {
+		return fmt.Errorf("mlflow: permission is required")
+	}
+
+	req := &experimentPermissionRequest{ExperimentID: experimentID, Username: username, Permission: permission}
+
+	err := c.transport.Patch(ctx, "/api/2.0/mlflow/experiments/permissions/update", req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment permission: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExperimentPermission revokes the permission username has on an
+// experiment.
+func (c *Client) DeleteExperimentPermission(ctx context.Context, experimentID, username string) error {
+	if err := validatePermissionArgs(experimentID, username); err != nil {
+		return err
+	}
+
+	req := &experimentPermissionRequest{ExperimentID: experimentID, Username: username}
+
+	err := c.transport.Delete(ctx, "/api/2.0/mlflow/experiments/permissions/delete", req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete experiment permission: %w", err)
+	}
+
+	return nil
+}
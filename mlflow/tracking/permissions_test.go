@@ -0,0 +1,85 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetExperimentPermission(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/2.0/mlflow/experiments/permissions/get" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("experiment_id") != "42" || q.Get("username") != "alice" {
+			t.Errorf("query = %v", q)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"experiment_permission": map[string]any{"experiment_id": "42", "user_id": 7, "permission": "EDIT"},
+		})
+	}))
+
+	got, err := client.GetExperimentPermission(context.Background(), "42", "alice")
+	if err != nil {
+		t.Fatalf("GetExperimentPermission() error = %v", err)
+	}
+	if got.ExperimentID != "42" || got.UserID != 7 || got.Permission != PermissionEdit {
+		t.Errorf("GetExperimentPermission() = %+v", got)
+	}
+
+	if _, err := client.GetExperimentPermission(context.Background(), "42", ""); err == nil {
+		t.Error("expected error for empty username")
+	}
+}
+
+func TestExperimentPermission_Mutations(t *testing.T) {
+	type call struct {
+		method, path string
+		body         map[string]any
+	}
+	var calls []call
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		mustDecodeJSON(t, r, &body)
+		calls = append(calls, call{r.Method, r.URL.Path, body})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+
+	ctx := context.Background()
+	if err := client.CreateExperimentPermission(ctx, "42", "alice", PermissionRead); err != nil {
+		t.Fatalf("CreateExperimentPermission() error = %v", err)
+	}
+	if err := client.UpdateExperimentPermission(ctx, "42", "alice", PermissionManage); err != nil {
+		t.Fatalf("UpdateExperimentPermission() error = %v", err)
+	}
+	if err := client.DeleteExperimentPermission(ctx, "42", "alice"); err != nil {
+		t.Fatalf("DeleteExperimentPermission() error = %v", err)
+	}
+
+	want := []struct{ method, path, permission string }{
+		{http.MethodPost, "/api/2.0/mlflow/experiments/permissions/create", "READ"},
+		{http.MethodPatch, "/api/2.0/mlflow/experiments/permissions/update", "MANAGE"},
+		{http.MethodDelete, "/api/2.0/mlflow/experiments/permissions/delete", ""},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("got %d calls, want %d", len(calls), len(want))
+	}
+	for i, w := range want {
+		got := calls[i]
+		permission, _ := got.body["permission"].(string)
+		if got.method != w.method || got.path != w.path || permission != w.permission {
+			t.Errorf("call %d = %s %s %v", i, got.method, got.path, got.body)
+		}
+		if got.body["experiment_id"] != "42" || got.body["username"] != "alice" {
+			t.Errorf("call %d body = %v", i, got.body)
+		}
+	}
+
+	if err := client.CreateExperimentPermission(ctx, "42", "alice", ""); err == nil {
+		t.Error("expected error for empty permission")
+	}
+}
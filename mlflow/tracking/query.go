@@ -0,0 +1,304 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QueryTarget is the kind of entity a Query selects.
+type QueryTarget string
+
+// Query target constants.
+const (
+	QueryRuns        QueryTarget = "runs"
+	QueryExperiments QueryTarget = "experiments"
+)
+
+// Query is a parsed query. See ParseQuery for the syntax.
+type Query struct {
+	Target QueryTarget
+	// ExperimentFilter selects the experiments whose runs are searched.
+	// Empty means every active experiment. Only set for QueryRuns.
+	ExperimentFilter string
+	// Filter is an MLflow filter expression over the selected entities.
+	Filter  string
+	OrderBy []string
+	// Limit caps the number of results; 0 means no limit.
+	Limit int
+}
+
+// QueryResult holds the entities selected by a query. Only the field
+// matching the query's target is set.
+type QueryResult struct {
+	Runs        []Run
+	Experiments []Experiment
+}
+
+// ParseQuery parses a query in a small SQL-like syntax:
+//
+//	SELECT runs [FROM experiments[(<experiment filter>)]] [WHERE <filter>]
+//	    [ORDER BY <key> [ASC|DESC], ...] [LIMIT <n>]
+//	SELECT experiments [WHERE <filter>] [ORDER BY <key> [ASC|DESC], ...] [LIMIT <n>]
+//
+// Keywords are case-insensitive. Filters and order keys use MLflow search
+// syntax and are passed to the server unchanged, for example:
+//
+//	SELECT runs FROM experiments(name LIKE 'churn-%')
+//	    WHERE metrics.acc > 0.9 AND tags.team = 'search'
+//	    ORDER BY metrics.acc DESC LIMIT 10
+func ParseQuery(q string) (*Query, error) {
+	words, err := queryWords(q)
+	if err != nil {
+		return nil, err
+	}
+
+	// next returns the i-th top-level word, upper-cased, or "" past the end.
+	next := func(i int) string {
+		if i < len(words) {
+			return strings.ToUpper(q[words[i].start:words[i].end])
+		}
+		return ""
+	}
+	// clauseEnd returns the offset at which the clause after word i ends:
+	// the start of the next clause keyword, or the end of the query.
+	clauseEnd := func(i int) int {
+		for j := i + 1; j < len(words); j++ {
+			switch next(j) {
+			case "FROM", "WHERE", "LIMIT":
+				return words[j].start
+			case "ORDER":
+				if next(j+1) == "BY" {
+					return words[j].start
+				}
+			}
+		}
+		return len(q)
+	}
+
+	if next(0) != "SELECT" || strings.TrimSpace(q[:words[0].start]) != "" {
+		return nil, fmt.Errorf("mlflow: query must start with SELECT")
+	}
+	query := &Query{Target: QueryTarget(strings.ToLower(next(1)))}
+	if query.Target != QueryRuns && query.Target != QueryExperiments {
+		return nil, fmt.Errorf("mlflow: query must select runs or experiments")
+	}
+	end := clauseEnd(1)
+	if extra := strings.TrimSpace(q[words[1].end:end]); extra != "" {
+		return nil, fmt.Errorf("mlflow: unexpected %q in query", extra)
+	}
+
+	// Clauses must appear in this order, each at most once
+	clauses := []string{"FROM", "WHERE", "ORDER", "LIMIT"}
+	i := 2
+	for i < len(words) && words[i].start < end {
+		i++
+	}
+	for i < len(words) {
+		keyword := next(i)
+		pos := -1
+		for k, c := range clauses {
+			if c == keyword {
+				pos = k
+				break
+			}
+		}
+		if pos < 0 {
+			return nil, fmt.Errorf("mlflow: unexpected %q in query", q[words[i].start:words[i].end])
+		}
+		clauses = clauses[pos+1:]
+
+		end = clauseEnd(i)
+		switch keyword {
+		case "FROM":
+			if query.Target != QueryRuns {
+				return nil, fmt.Errorf("mlflow: FROM is only supported when selecting runs")
+			}
+			if next(i+1) != "EXPERIMENTS" {
+				return nil, fmt.Errorf("mlflow: FROM must be followed by experiments")
+			}
+			rest := strings.TrimSpace(q[words[i+1].end:end])
+			if rest != "" {
+				if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+					return nil, fmt.Errorf("mlflow: invalid experiment filter %q; use experiments(<filter>)", rest)
+				}
+				query.ExperimentFilter = strings.TrimSpace(rest[1 : len(rest)-1])
+			}
+		case "WHERE":
+			query.Filter = strings.TrimSpace(q[words[i].end:end])
+			if query.Filter == "" {
+				return nil, fmt.Errorf("mlflow: WHERE requires a filter")
+			}
+		case "ORDER":
+			if next(i+1) != "BY" {
+				return nil, fmt.Errorf("mlflow: ORDER must be followed by BY")
+			}
+			for _, key := range splitTopLevel(q[words[i+1].end:end], ',') {
+				key = strings.TrimSpace(key)
+				if key == "" {
+					return nil, fmt.Errorf("mlflow: empty ORDER BY key")
+				}
+				query.OrderBy = append(query.OrderBy, key)
+			}
+		case "LIMIT":
+			n, convErr := strconv.Atoi(strings.TrimSpace(q[words[i].end:end]))
+			if convErr != nil || n <= 0 {
+				return nil, fmt.Errorf("mlflow: LIMIT must be a positive integer")
+			}
+			query.Limit = n
+		}
+
+		// Skip to the word that starts the next clause
+		for i < len(words) && words[i].start < end {
+			i++
+		}
+	}
+
+	return query, nil
+}
+
+// RunQuery parses q with ParseQuery and runs it. Run queries use
+// SearchRunsAcross, so they search every matching experiment with bounded
+// concurrency; experiment queries use SearchExperiments.
+//
+// A query runs in the workspace the client is configured for.
+func RunQuery(ctx context.Context, c *Client, q string) (*QueryResult, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	query, err := ParseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Target == QueryRuns {
+		var opts []SearchRunsAcrossOption
+		if len(query.OrderBy) > 0 {
+			opts = append(opts, WithAcrossOrderBy(query.OrderBy...))
+		}
+		if query.Limit > 0 {
+			opts = append(opts, WithAcrossMaxResults(query.Limit))
+		}
+		var runs []Run
+		if runs, err = SearchRunsAcross(ctx, c, query.ExperimentFilter, query.Filter, opts...); err != nil {
+			return nil, err
+		}
+		return &QueryResult{Runs: runs}, nil
+	}
+
+	var opts []SearchExperimentsOption
+	if query.Filter != "" {
+		opts = append(opts, WithExperimentsFilter(query.Filter))
+	}
+	if len(query.OrderBy) > 0 {
+		opts = append(opts, WithExperimentsOrderBy(query.OrderBy...))
+	}
+	if query.Limit > 0 && query.Limit < defaultSearchMaxResults {
+		opts = append(opts, WithExperimentsMaxResults(query.Limit))
+	}
+
+	cursor := c.SearchExperimentsCursor(opts...)
+	experiments := []Experiment{}
+	for query.Limit == 0 || len(experiments) < query.Limit {
+		page, ok := cursor.Next(ctx)
+		if !ok {
+			break
+		}
+		experiments = append(experiments, page.Items...)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+	if query.Limit > 0 && len(experiments) > query.Limit {
+		experiments = experiments[:query.Limit]
+	}
+	return &QueryResult{Experiments: experiments}, nil
+}
+
+// queryWord is the position of a word in a query.
+type queryWord struct {
+	start, end int
+}
+
+// queryWords returns the words of q outside quotes and parentheses, which
+// are the candidates for keywords. A word is a run of letters, digits, '_'
+// and '.', so keys such as params.order are never mistaken for keywords.
+func queryWords(q string) ([]queryWord, error) {
+	var (
+		words []queryWord
+		quote byte
+		depth int
+		start = -1
+	)
+	isWord := func(c byte) bool {
+		return c == '_' || c == '.' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+	}
+	for i := 0; i <= len(q); i++ {
+		var c byte
+		if i < len(q) {
+			c = q[i]
+		}
+		if start >= 0 && !isWord(c) {
+			words = append(words, queryWord{start, i})
+			start = -1
+		}
+		if i == len(q) {
+			break
+		}
+
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("mlflow: unbalanced parentheses in query")
+			}
+			depth--
+		case depth == 0 && start < 0 && isWord(c):
+			start = i
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("mlflow: unterminated quote in query")
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("mlflow: unbalanced parentheses in query")
+	}
+	return words, nil
+}
+
+// splitTopLevel splits s at each sep outside quotes and parentheses.
+func splitTopLevel(s string, sep byte) []string {
+	var (
+		parts []string
+		quote byte
+		depth int
+		last  int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+	return append(parts, s[last:])
+}
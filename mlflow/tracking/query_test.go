@@ -0,0 +1,141 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  Query
+	}{
+		{
+			name:  "all runs",
+			query: "SELECT runs",
+			want:  Query{Target: QueryRuns},
+		},
+		{
+			name:  "full run query",
+			query: "select runs from experiments(name LIKE 'churn-%') where metrics.acc > 0.9 AND tags.team='x' order by metrics.acc DESC, start_time limit 10",
+			want: Query{
+				Target:           QueryRuns,
+				ExperimentFilter: "name LIKE 'churn-%'",
+				Filter:           "metrics.acc > 0.9 AND tags.team='x'",
+				OrderBy:          []string{"metrics.acc DESC", "start_time"},
+				Limit:            10,
+			},
+		},
+		{
+			name:  "keywords inside quotes and keys",
+			query: "SELECT runs WHERE tags.note = 'order by limit' AND params.order = '1' ORDER BY tags.`where, limit`",
+			want: Query{
+				Target:  QueryRuns,
+				Filter:  "tags.note = 'order by limit' AND params.order = '1'",
+				OrderBy: []string{"tags.`where, limit`"},
+			},
+		},
+		{
+			name:  "experiments",
+			query: "SELECT experiments WHERE name LIKE 'team-a/%' ORDER BY name LIMIT 5",
+			want: Query{
+				Target:  QueryExperiments,
+				Filter:  "name LIKE 'team-a/%'",
+				OrderBy: []string{"name"},
+				Limit:   5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery() error = %v", err)
+			}
+			if got.Target != tt.want.Target || got.ExperimentFilter != tt.want.ExperimentFilter ||
+				got.Filter != tt.want.Filter || !slices.Equal(got.OrderBy, tt.want.OrderBy) || got.Limit != tt.want.Limit {
+				t.Errorf("ParseQuery() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	queries := []string{
+		"",
+		"runs WHERE metrics.acc > 0.9",
+		"SELECT models",
+		"SELECT runs extra",
+		"SELECT runs WHERE",
+		"SELECT runs LIMIT 10 WHERE metrics.acc > 0.9",
+		"SELECT runs WHERE a = 1 WHERE b = 2",
+		"SELECT runs LIMIT ten",
+		"SELECT runs LIMIT 0",
+		"SELECT runs ORDER metrics.acc",
+		"SELECT runs FROM runs",
+		"SELECT runs FROM experiments name = 'x'",
+		"SELECT experiments FROM experiments",
+		"SELECT runs WHERE tags.team = 'x",
+		"SELECT runs FROM experiments(name = 'x'",
+	}
+	for _, q := range queries {
+		if _, err := ParseQuery(q); err == nil {
+			t.Errorf("ParseQuery(%q) succeeded, want error", q)
+		}
+	}
+}
+
+func TestRunQuery_Runs(t *testing.T) {
+	client := newTestClient(t, acrossServer(t, ""))
+
+	result, err := RunQuery(context.Background(), client,
+		"SELECT runs FROM experiments(name LIKE 'team-a/%') WHERE params.model = 'x' ORDER BY metrics.acc DESC LIMIT 2")
+	if err != nil {
+		t.Fatalf("RunQuery() error = %v", err)
+	}
+
+	var ids []string
+	for _, r := range result.Runs {
+		ids = append(ids, r.Info.RunID)
+	}
+	if !slices.Equal(ids, []string{"r2", "r4"}) {
+		t.Errorf("runs = %v, want [r2 r4]", ids)
+	}
+	if result.Experiments != nil {
+		t.Errorf("Experiments = %v, want nil", result.Experiments)
+	}
+}
+
+func TestRunQuery_Experiments(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req struct {
+			Filter     string   `json:"filter"`
+			OrderBy    []string `json:"order_by"`
+			MaxResults int      `json:"max_results"`
+		}
+		mustDecodeJSON(t, r, &req)
+		if req.Filter != "name LIKE 'team-a/%'" || !slices.Equal(req.OrderBy, []string{"name ASC"}) || req.MaxResults != 2 {
+			t.Errorf("request = %+v", req)
+		}
+		mustEncodeJSON(t, w, map[string]any{
+			"experiments":     []map[string]any{{"experiment_id": "1"}, {"experiment_id": "2"}},
+			"next_page_token": "more",
+		})
+	}))
+
+	result, err := RunQuery(context.Background(), client, "SELECT experiments WHERE name LIKE 'team-a/%' ORDER BY name ASC LIMIT 2")
+	if err != nil {
+		t.Fatalf("RunQuery() error = %v", err)
+	}
+	if len(result.Experiments) != 2 {
+		t.Errorf("experiments = %v, want 2", result.Experiments)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
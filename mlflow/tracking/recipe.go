@@ -0,0 +1,103 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Run recipe artifact layout.
+const (
+	// RunRecipeArtifactPath is where LogRunRecipe stores the recipe,
+	// relative to the run's artifact root.
+	RunRecipeArtifactPath = "recipe.json"
+
+	// RunRecipeSchemaVersion is the recipe schema version LogRunRecipe writes.
+	// LoadRunRecipe rejects recipes with a newer version.
+	RunRecipeSchemaVersion = 1
+)
+
+// RunRecipe describes how to reproduce a run, similar to an MLproject entry
+// point. It is stored as JSON with stable field names so other tools can
+// read it.
+type RunRecipe struct {
+	// SchemaVersion is set by LogRunRecipe.
+	SchemaVersion int `json:"schema_version"`
+	// Command is the program and arguments to run, e.g.
+	// ["go", "run", "./cmd/train", "-epochs", "10"].
+	Command []string `json:"command"`
+	// WorkingDir is the directory Command runs in, relative to the source root.
+	WorkingDir string `json:"working_dir,omitempty"`
+	// DockerImage is the image to run Command in, preferably pinned by digest.
+	DockerImage string `json:"docker_image,omitempty"`
+	// SourceRepo and SourceCommit identify the source tree.
+	SourceRepo   string `json:"source_repo,omitempty"`
+	SourceCommit string `json:"source_commit,omitempty"`
+	// Parameters are the run's hyperparameters.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Env holds environment variables Command needs. Do not put secrets here.
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// LogRunRecipe stores recipe as the run's RunRecipeArtifactPath artifact,
+// replacing any existing recipe. See LogArtifact for server requirements.
+func (c *Client) LogRunRecipe(ctx context.Context, runID string, recipe *RunRecipe) error {
+	if runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+	if recipe == nil || len(recipe.Command) == 0 {
+		return fmt.Errorf("mlflow: recipe command is required")
+	}
+
+	stored := *recipe
+	stored.SchemaVersion = RunRecipeSchemaVersion
+	data, err := json.MarshalIndent(&stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode recipe: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "mlflow-recipe-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	file := filepath.Join(dir, RunRecipeArtifactPath)
+	if err = os.WriteFile(file, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write recipe: %w", err)
+	}
+
+	return c.LogArtifact(ctx, runID, file, "")
+}
+
+// LoadRunRecipe reads the recipe stored by LogRunRecipe. It returns a
+// not-found error if the run has no recipe.
+func (c *Client) LoadRunRecipe(ctx context.Context, runID string) (*RunRecipe, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("mlflow: run ID is required")
+	}
+
+	root, err := c.artifactRoot(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.transport.Download(ctx, artifactsPath(path.Join(root, RunRecipeArtifactPath)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download recipe: %w", err)
+	}
+
+	var recipe RunRecipe
+	if err = json.Unmarshal(data, &recipe); err != nil {
+		return nil, fmt.Errorf("failed to decode recipe: %w", err)
+	}
+	if recipe.SchemaVersion > RunRecipeSchemaVersion {
+		return nil, fmt.Errorf("mlflow: recipe schema version %d is newer than supported version %d",
+			recipe.SchemaVersion, RunRecipeSchemaVersion)
+	}
+
+	return &recipe, nil
+}
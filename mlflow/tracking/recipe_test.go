@@ -0,0 +1,73 @@
+package tracking
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+)
+
+func TestRunRecipe_RoundTrip(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	recipe := &RunRecipe{
+		Command:      []string{"go", "run", "./cmd/train", "-epochs", "10"},
+		DockerImage:  "quay.io/org/trainer@sha256:abc",
+		SourceCommit: "0123abc",
+		Parameters:   map[string]string{"lr": "0.01"},
+	}
+	if err := client.LogRunRecipe(ctx, "abc-123", recipe); err != nil {
+		t.Fatalf("LogRunRecipe() error = %v", err)
+	}
+	if recipe.SchemaVersion != 0 {
+		t.Error("LogRunRecipe modified the caller's recipe")
+	}
+
+	stored := srv.uploads["1/abc-123/artifacts/recipe.json"]
+	if !strings.Contains(stored, `"schema_version": 1`) || !strings.Contains(stored, `"docker_image"`) {
+		t.Errorf("stored recipe = %s", stored)
+	}
+
+	got, err := client.LoadRunRecipe(ctx, "abc-123")
+	if err != nil {
+		t.Fatalf("LoadRunRecipe() error = %v", err)
+	}
+	if got.SchemaVersion != RunRecipeSchemaVersion || !slices.Equal(got.Command, recipe.Command) ||
+		got.DockerImage != recipe.DockerImage || got.Parameters["lr"] != "0.01" {
+		t.Errorf("LoadRunRecipe() = %+v", got)
+	}
+}
+
+func TestLoadRunRecipe_Errors(t *testing.T) {
+	srv := &artifactServer{t: t, artifactURI: "mlflow-artifacts:/1/abc-123/artifacts"}
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	if _, err := client.LoadRunRecipe(ctx, "abc-123"); !errors.IsNotFound(err) {
+		t.Errorf("missing recipe: IsNotFound(err) = false, err = %v", err)
+	}
+
+	srv.uploads = map[string]string{"1/abc-123/artifacts/recipe.json": `{"schema_version": 2, "command": ["x"]}`}
+	if _, err := client.LoadRunRecipe(ctx, "abc-123"); err == nil {
+		t.Error("expected error for newer schema version")
+	}
+}
+
+func TestLogRunRecipe_Validation(t *testing.T) {
+	client := newTestClient(t, &artifactServer{t: t})
+	ctx := context.Background()
+
+	if err := client.LogRunRecipe(ctx, "", &RunRecipe{Command: []string{"x"}}); err == nil {
+		t.Error("expected error for empty run ID")
+	}
+	if err := client.LogRunRecipe(ctx, "abc-123", &RunRecipe{}); err == nil {
+		t.Error("expected error for empty command")
+	}
+	if err := client.LogRunRecipe(ctx, "abc-123", nil); err == nil {
+		t.Error("expected error for nil recipe")
+	}
+}
@@ -0,0 +1,493 @@
+package tracking
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"html/template"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultReportTopK is how many runs a report lists when TopKByMetric is 0.
+const defaultReportTopK = 5
+
+// ReportOptions configures GenerateReport.
+type ReportOptions struct {
+	// Title is the report heading. Default: "Experiment report".
+	Title string
+
+	// Metric ranks the runs. Required.
+	Metric string
+
+	// LowerIsBetter ranks lower values of Metric first, for metrics such as
+	// loss or latency.
+	LowerIsBetter bool
+
+	// TopKByMetric is how many of the best runs are listed. Default: 5.
+	TopKByMetric int
+
+	// IncludeParams adds the params of the best runs and a parameter
+	// importance section to the report.
+	IncludeParams bool
+}
+
+// Report summarizes the runs of one or more experiments. Render it with
+// Markdown or HTML.
+type Report struct {
+	Title         string
+	ExperimentIDs []string
+	GeneratedAt   time.Time
+
+	Metric        string
+	LowerIsBetter bool
+
+	// Runs counts the active runs scanned; RunsWithMetric those that logged
+	// Metric.
+	Runs           int
+	RunsWithMetric int
+
+	// TopRuns are the best runs by Metric, best first.
+	TopRuns []ReportRun
+
+	// Metrics summarizes every metric logged by the runs, sorted by key.
+	Metrics []MetricTrend
+
+	// ParamImportance ranks numeric params by the strength of their
+	// correlation with Metric. Nil unless IncludeParams is set.
+	ParamImportance []ParamImportance
+}
+
+// ReportRun is one of a report's best runs.
+type ReportRun struct {
+	RunID        string
+	RunName      string
+	ExperimentID string
+	StartTime    time.Time
+	// Value is the run's latest value of the report metric.
+	Value float64
+	// Params is set only with IncludeParams.
+	Params map[string]string
+}
+
+// MetricTrend summarizes the latest values of one metric across runs.
+type MetricTrend struct {
+	Key  string
+	Runs int
+	Min  float64
+	Max  float64
+	Mean float64
+	// Latest is the value in the most recently started run.
+	Latest float64
+	// Trend is the Pearson correlation between the value and the run start
+	// time, from -1 to 1: positive if the metric has been going up. It is 0
+	// with fewer than three runs.
+	Trend float64
+}
+
+// ParamImportance is the correlation between a numeric param and the report
+// metric. It is a simple screening signal, not a causal measure: it ignores
+// interactions between params and misses non-monotonic effects.
+type ParamImportance struct {
+	Key  string
+	Runs int
+	// Correlation is the Pearson correlation, from -1 to 1.
+	Correlation float64
+}
+
+// reportSample holds what a report needs from one run.
+type reportSample struct {
+	run     RunInfo
+	metrics map[string]float64
+	params  map[string]string
+}
+
+// GenerateReport scans the active runs of the experiments and summarizes
+// them: the best runs by a metric, the range and trend over time of every
+// metric, and, with IncludeParams, which numeric params correlate with the
+// metric. The result renders as Markdown or HTML, for pull requests and
+// release notes.
+//
+// Runs are compared by the latest value of each metric, as returned by
+// search. Every matching run is held in memory while the report is built.
+func GenerateReport(ctx context.Context, c *Client, experimentIDs []string, opts ReportOptions) (*Report, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if len(experimentIDs) == 0 {
+		return nil, fmt.Errorf("mlflow: at least one experiment ID is required")
+	}
+	if opts.Metric == "" {
+		return nil, fmt.Errorf("mlflow: report metric is required")
+	}
+
+	var samples []reportSample
+	err := c.StreamRuns(ctx, experimentIDs, func(run Run) error {
+		s := reportSample{run: run.Info, metrics: make(map[string]float64, len(run.Data.Metrics))}
+		for _, m := range run.Data.Metrics {
+			s.metrics[m.Key] = m.Value
+		}
+		if opts.IncludeParams {
+			s.params = make(map[string]string, len(run.Data.Params))
+			for _, p := range run.Data.Params {
+				s.params[p.Key] = p.Value
+			}
+		}
+		samples = append(samples, s)
+		return nil
+	}, WithRunsMaxResults(statsPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildReport(samples, experimentIDs, opts, time.Now()), nil
+}
+
+func buildReport(samples []reportSample, experimentIDs []string, opts ReportOptions, now time.Time) *Report {
+	r := &Report{
+		Title:         cmp.Or(opts.Title, "Experiment report"),
+		ExperimentIDs: slices.Clone(experimentIDs),
+		GeneratedAt:   now,
+		Metric:        opts.Metric,
+		LowerIsBetter: opts.LowerIsBetter,
+		Runs:          len(samples),
+	}
+
+	// Oldest first, for trends and "latest"
+	slices.SortStableFunc(samples, func(a, b reportSample) int {
+		return a.run.StartTime.Compare(b.run.StartTime)
+	})
+
+	var ranked []reportSample
+	for _, s := range samples {
+		if _, ok := s.metrics[opts.Metric]; ok {
+			ranked = append(ranked, s)
+		}
+	}
+	r.RunsWithMetric = len(ranked)
+
+	slices.SortStableFunc(ranked, func(a, b reportSample) int {
+		if opts.LowerIsBetter {
+			return cmp.Compare(a.metrics[opts.Metric], b.metrics[opts.Metric])
+		}
+		return cmp.Compare(b.metrics[opts.Metric], a.metrics[opts.Metric])
+	})
+	topK := opts.TopKByMetric
+	if topK <= 0 {
+		topK = defaultReportTopK
+	}
+	for _, s := range ranked[:min(topK, len(ranked))] {
+		r.TopRuns = append(r.TopRuns, ReportRun{
+			RunID:        s.run.RunID,
+			RunName:      s.run.RunName,
+			ExperimentID: s.run.ExperimentID,
+			StartTime:    s.run.StartTime,
+			Value:        s.metrics[opts.Metric],
+			Params:       s.params,
+		})
+	}
+
+	r.Metrics = metricTrends(samples)
+	if opts.IncludeParams {
+		r.ParamImportance = paramImportance(samples, opts.Metric)
+	}
+	return r
+}
+
+// metricTrends summarizes each metric over samples, which are ordered by
+// start time.
+func metricTrends(samples []reportSample) []MetricTrend {
+	type series struct{ times, values []float64 }
+	byKey := make(map[string]*series)
+	for _, s := range samples {
+		for key, value := range s.metrics {
+			if byKey[key] == nil {
+				byKey[key] = &series{}
+			}
+			byKey[key].times = append(byKey[key].times, float64(s.run.StartTime.UnixMilli()))
+			byKey[key].values = append(byKey[key].values, value)
+		}
+	}
+
+	trends := make([]MetricTrend, 0, len(byKey))
+	for key, s := range byKey {
+		t := MetricTrend{
+			Key:    key,
+			Runs:   len(s.values),
+			Min:    slices.Min(s.values),
+			Max:    slices.Max(s.values),
+			Latest: s.values[len(s.values)-1],
+			Trend:  pearson(s.times, s.values),
+		}
+		var sum float64
+		for _, v := range s.values {
+			sum += v
+		}
+		t.Mean = sum / float64(len(s.values))
+		trends = append(trends, t)
+	}
+	slices.SortFunc(trends, func(a, b MetricTrend) int { return strings.Compare(a.Key, b.Key) })
+	return trends
+}
+
+// paramImportance correlates each numeric param with metric. Params with a
+// non-numeric value in any run, or with fewer than three runs, are skipped.
+func paramImportance(samples []reportSample, metric string) []ParamImportance {
+	type pairs struct {
+		xs, ys  []float64
+		numeric bool
+	}
+	byKey := make(map[string]*pairs)
+	for _, s := range samples {
+		y, ok := s.metrics[metric]
+		if !ok {
+			continue
+		}
+		for key, raw := range s.params {
+			p := byKey[key]
+			if p == nil {
+				p = &pairs{numeric: true}
+				byKey[key] = p
+			}
+			x, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				p.numeric = false
+				continue
+			}
+			p.xs = append(p.xs, x)
+			p.ys = append(p.ys, y)
+		}
+	}
+
+	result := []ParamImportance{}
+	for key, p := range byKey {
+		if !p.numeric || len(p.xs) < 3 {
+			continue
+		}
+		result = append(result, ParamImportance{Key: key, Runs: len(p.xs), Correlation: pearson(p.xs, p.ys)})
+	}
+	slices.SortFunc(result, func(a, b ParamImportance) int {
+		return cmp.Or(
+			cmp.Compare(math.Abs(b.Correlation), math.Abs(a.Correlation)),
+			strings.Compare(a.Key, b.Key),
+		)
+	})
+	return result
+}
+
+// pearson returns the Pearson correlation of xs and ys, or 0 if there are
+// fewer than three points or either has no variance.
+func pearson(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	if len(xs) < 3 {
+		return 0
+	}
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// paramColumns returns the param keys of the top runs, sorted.
+func (r *Report) paramColumns() []string {
+	seen := make(map[string]bool)
+	for _, run := range r.TopRuns {
+		for k := range run.Params {
+			seen[k] = true
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (r *Report) direction() string {
+	if r.LowerIsBetter {
+		return "lower is better"
+	}
+	return "higher is better"
+}
+
+// Markdown renders the report as GitHub-flavored Markdown.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", mdEscape(r.Title))
+	fmt.Fprintf(&b, "Experiments: %s · Runs: %d (%d with `%s`) · Generated %s\n",
+		mdEscape(strings.Join(r.ExperimentIDs, ", ")), r.Runs, r.RunsWithMetric, r.Metric,
+		r.GeneratedAt.UTC().Format(time.RFC3339))
+
+	fmt.Fprintf(&b, "\n## Top %d runs by %s (%s)\n\n", len(r.TopRuns), mdEscape(r.Metric), r.direction())
+	if len(r.TopRuns) == 0 {
+		fmt.Fprintf(&b, "No runs logged `%s`.\n", r.Metric)
+	} else {
+		params := r.paramColumns()
+		header := append([]string{"#", "Run", "Experiment", r.Metric}, params...)
+		writeMDRow(&b, header)
+		writeMDRow(&b, slices.Repeat([]string{"---"}, len(header)))
+		for i, run := range r.TopRuns {
+			row := []string{strconv.Itoa(i + 1), runLabel(run), run.ExperimentID, formatValue(run.Value)}
+			for _, p := range params {
+				row = append(row, run.Params[p])
+			}
+			writeMDRow(&b, row)
+		}
+	}
+
+	b.WriteString("\n## Metric trends\n\n")
+	if len(r.Metrics) == 0 {
+		b.WriteString("No metrics logged.\n")
+	} else {
+		writeMDRow(&b, []string{"Metric", "Runs", "Min", "Max", "Mean", "Latest", "Trend"})
+		writeMDRow(&b, slices.Repeat([]string{"---"}, 7))
+		for _, m := range r.Metrics {
+			writeMDRow(&b, []string{m.Key, strconv.Itoa(m.Runs), formatValue(m.Min), formatValue(m.Max),
+				formatValue(m.Mean), formatValue(m.Latest), formatTrend(m.Trend)})
+		}
+		b.WriteString("\nTrend is the correlation between the metric and run start time (-1 to 1).\n")
+	}
+
+	if r.ParamImportance != nil {
+		b.WriteString("\n## Parameter importance\n\n")
+		if len(r.ParamImportance) == 0 {
+			b.WriteString("No numeric params logged in three or more runs.\n")
+		} else {
+			writeMDRow(&b, []string{"Param", "Runs", "Correlation with " + r.Metric})
+			writeMDRow(&b, []string{"---", "---", "---"})
+			for _, p := range r.ParamImportance {
+				writeMDRow(&b, []string{p.Key, strconv.Itoa(p.Runs), fmt.Sprintf("%+.2f", p.Correlation)})
+			}
+			b.WriteString("\nCorrelation is a screening signal, not a causal effect.\n")
+		}
+	}
+
+	return b.String()
+}
+
+func writeMDRow(b *strings.Builder, cells []string) {
+	b.WriteString("|")
+	for _, c := range cells {
+		b.WriteString(" " + mdEscape(c) + " |")
+	}
+	b.WriteString("\n")
+}
+
+// mdEscape makes s safe inside a Markdown table cell or heading.
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ", "\r", "").Replace(s)
+}
+
+func runLabel(run ReportRun) string {
+	if run.RunName == "" {
+		return run.RunID
+	}
+	return run.RunName + " (" + run.RunID + ")"
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
+
+// formatTrend renders a correlation with an arrow for its direction.
+func formatTrend(t float64) string {
+	arrow := "→"
+	switch {
+	case t >= 0.3:
+		arrow = "↑"
+	case t <= -0.3:
+		arrow = "↓"
+	}
+	return fmt.Sprintf("%s %+.2f", arrow, t)
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"value": formatValue,
+	"trend": formatTrend,
+	"label": runLabel,
+	"inc":   func(i int) int { return i + 1 },
+	"param": func(run ReportRun, key string) string { return run.Params[key] },
+	"utc":   func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+	"join":  strings.Join,
+	"corr":  func(c float64) string { return fmt.Sprintf("%+.2f", c) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Experiments: {{join .ExperimentIDs ", "}} · Runs: {{.Runs}} ({{.RunsWithMetric}} with <code>{{.Metric}}</code>) · Generated {{utc .GeneratedAt}}</p>
+<h2>Top {{len .TopRuns}} runs by {{.Metric}} ({{.Direction}})</h2>
+{{- if .TopRuns}}
+<table>
+<tr><th>#</th><th>Run</th><th>Experiment</th><th>{{.Metric}}</th>{{range .Params}}<th>{{.}}</th>{{end}}</tr>
+{{- range $i, $run := .TopRuns}}
+<tr><td>{{inc $i}}</td><td>{{label $run}}</td><td>{{$run.ExperimentID}}</td><td>{{value $run.Value}}</td>{{range $.Params}}<td>{{param $run .}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- else}}
+<p>No runs logged <code>{{.Metric}}</code>.</p>
+{{- end}}
+<h2>Metric trends</h2>
+{{- if .Metrics}}
+<table>
+<tr><th>Metric</th><th>Runs</th><th>Min</th><th>Max</th><th>Mean</th><th>Latest</th><th>Trend</th></tr>
+{{- range .Metrics}}
+<tr><td>{{.Key}}</td><td>{{.Runs}}</td><td>{{value .Min}}</td><td>{{value .Max}}</td><td>{{value .Mean}}</td><td>{{value .Latest}}</td><td>{{trend .Trend}}</td></tr>
+{{- end}}
+</table>
+<p>Trend is the correlation between the metric and run start time (-1 to 1).</p>
+{{- else}}
+<p>No metrics logged.</p>
+{{- end}}
+{{- if .ShowParams}}
+<h2>Parameter importance</h2>
+{{- if .ParamImportance}}
+<table>
+<tr><th>Param</th><th>Runs</th><th>Correlation with {{.Metric}}</th></tr>
+{{- range .ParamImportance}}
+<tr><td>{{.Key}}</td><td>{{.Runs}}</td><td>{{corr .Correlation}}</td></tr>
+{{- end}}
+</table>
+<p>Correlation is a screening signal, not a causal effect.</p>
+{{- else}}
+<p>No numeric params logged in three or more runs.</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML renders the report as a standalone HTML page. All values are escaped.
+func (r *Report) HTML() (string, error) {
+	var b bytes.Buffer
+	err := reportTemplate.Execute(&b, struct {
+		*Report
+		Direction  string
+		Params     []string
+		ShowParams bool
+	}{r, r.direction(), r.paramColumns(), r.ParamImportance != nil})
+	if err != nil {
+		return "", fmt.Errorf("failed to render report: %w", err)
+	}
+	return b.String(), nil
+}
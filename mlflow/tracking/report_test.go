@@ -0,0 +1,215 @@
+package tracking
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func reportRun(id string, start int64, acc float64, lr string) map[string]any {
+	return map[string]any{
+		"info": map[string]any{"run_id": id, "run_name": "run-" + id, "experiment_id": "1", "start_time": start},
+		"data": map[string]any{
+			"metrics": []map[string]any{{"key": "acc", "value": acc}, {"key": "loss", "value": 1 - acc}},
+			"params":  []map[string]any{{"key": "lr", "value": lr}, {"key": "optimizer", "value": "adam"}},
+		},
+	}
+}
+
+func newReportTestClient(t *testing.T) *Client {
+	t.Helper()
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/2.0/mlflow/runs/search" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{
+			"runs": []map[string]any{
+				reportRun("r1", 1000, 0.70, "0.1"),
+				reportRun("r2", 2000, 0.80, "0.01"),
+				reportRun("r3", 3000, 0.90, "0.001"),
+				reportRun("r4", 4000, 0.85, "0.005"),
+				{
+					"info": map[string]any{"run_id": "r5", "experiment_id": "1", "start_time": 5000},
+					"data": map[string]any{},
+				},
+			},
+		})
+	}))
+}
+
+func TestGenerateReport_Success(t *testing.T) {
+	client := newReportTestClient(t)
+
+	report, err := GenerateReport(context.Background(), client, []string{"1"}, ReportOptions{
+		Metric:        "acc",
+		TopKByMetric:  2,
+		IncludeParams: true,
+	})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	if report.Runs != 5 || report.RunsWithMetric != 4 {
+		t.Errorf("Runs, RunsWithMetric = %d, %d, want 5, 4", report.Runs, report.RunsWithMetric)
+	}
+	if len(report.TopRuns) != 2 || report.TopRuns[0].RunID != "r3" || report.TopRuns[1].RunID != "r4" {
+		t.Fatalf("TopRuns = %+v, want r3, r4", report.TopRuns)
+	}
+	if report.TopRuns[0].Params["lr"] != "0.001" {
+		t.Errorf("TopRuns[0].Params = %v, want lr 0.001", report.TopRuns[0].Params)
+	}
+	if !report.TopRuns[0].StartTime.Equal(time.UnixMilli(3000)) {
+		t.Errorf("TopRuns[0].StartTime = %v", report.TopRuns[0].StartTime)
+	}
+
+	if len(report.Metrics) != 2 || report.Metrics[0].Key != "acc" || report.Metrics[1].Key != "loss" {
+		t.Fatalf("Metrics = %+v, want acc, loss", report.Metrics)
+	}
+	acc := report.Metrics[0]
+	if acc.Runs != 4 || acc.Min != 0.70 || acc.Max != 0.90 || acc.Latest != 0.85 {
+		t.Errorf("acc = %+v", acc)
+	}
+	if math.Abs(acc.Mean-0.8125) > 1e-9 {
+		t.Errorf("acc.Mean = %v, want 0.8125", acc.Mean)
+	}
+	if acc.Trend <= 0 || report.Metrics[1].Trend >= 0 {
+		t.Errorf("Trend acc, loss = %v, %v, want positive, negative", acc.Trend, report.Metrics[1].Trend)
+	}
+
+	// optimizer is not numeric; lr falls as acc rises
+	if len(report.ParamImportance) != 1 || report.ParamImportance[0].Key != "lr" {
+		t.Fatalf("ParamImportance = %+v, want lr only", report.ParamImportance)
+	}
+	if report.ParamImportance[0].Correlation >= 0 || report.ParamImportance[0].Runs != 4 {
+		t.Errorf("lr importance = %+v, want negative over 4 runs", report.ParamImportance[0])
+	}
+}
+
+func TestGenerateReport_LowerIsBetter(t *testing.T) {
+	client := newReportTestClient(t)
+
+	report, err := GenerateReport(context.Background(), client, []string{"1"}, ReportOptions{
+		Metric:        "loss",
+		LowerIsBetter: true,
+	})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	if len(report.TopRuns) != 4 || report.TopRuns[0].RunID != "r3" || report.TopRuns[3].RunID != "r1" {
+		t.Errorf("TopRuns = %+v, want r3 first and r1 last", report.TopRuns)
+	}
+	if report.TopRuns[0].Params != nil || report.ParamImportance != nil {
+		t.Error("expected no params without IncludeParams")
+	}
+}
+
+func TestReport_Markdown(t *testing.T) {
+	client := newReportTestClient(t)
+
+	report, err := GenerateReport(context.Background(), client, []string{"1"}, ReportOptions{
+		Title:         "Release | 1.2",
+		Metric:        "acc",
+		IncludeParams: true,
+	})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	md := report.Markdown()
+	for _, want := range []string{
+		`# Release \| 1.2`,
+		"## Top 4 runs by acc (higher is better)",
+		"| # | Run | Experiment | acc | lr | optimizer |",
+		"| 1 | run-r3 (r3) | 1 | 0.9 | 0.001 | adam |",
+		"## Metric trends",
+		"| acc | 4 | 0.7 | 0.9 | 0.8125 | 0.85 | ↑",
+		"## Parameter importance",
+		"| lr | 4 | -",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown() missing %q\n%s", want, md)
+		}
+	}
+}
+
+func TestReport_HTML(t *testing.T) {
+	client := newReportTestClient(t)
+
+	report, err := GenerateReport(context.Background(), client, []string{"1"}, ReportOptions{
+		Title:  "<b>Release</b>",
+		Metric: "acc",
+	})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	html, err := report.HTML()
+	if err != nil {
+		t.Fatalf("HTML() error = %v", err)
+	}
+	if strings.Contains(html, "<b>Release</b>") || !strings.Contains(html, "&lt;b&gt;Release&lt;/b&gt;") {
+		t.Error("expected title to be escaped")
+	}
+	if !strings.Contains(html, "<td>run-r3 (r3)</td>") {
+		t.Errorf("HTML() missing best run\n%s", html)
+	}
+	if strings.Contains(html, "Parameter importance") {
+		t.Error("expected no parameter importance section without IncludeParams")
+	}
+}
+
+func TestGenerateReport_NoRuns(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	report, err := GenerateReport(context.Background(), client, []string{"1"}, ReportOptions{Metric: "acc"})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	if len(report.TopRuns) != 0 || len(report.Metrics) != 0 {
+		t.Errorf("report = %+v, want empty", report)
+	}
+	if md := report.Markdown(); !strings.Contains(md, "No runs logged `acc`.") {
+		t.Errorf("Markdown() = %s", md)
+	}
+}
+
+func TestGenerateReport_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	ctx := context.Background()
+
+	if _, err := GenerateReport(ctx, nil, []string{"1"}, ReportOptions{Metric: "acc"}); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if _, err := GenerateReport(ctx, client, nil, ReportOptions{Metric: "acc"}); err == nil {
+		t.Error("expected error for no experiment IDs")
+	}
+	if _, err := GenerateReport(ctx, client, []string{"1"}, ReportOptions{}); err == nil {
+		t.Error("expected error for missing metric")
+	}
+}
+
+func TestPearson(t *testing.T) {
+	if got := pearson([]float64{1, 2, 3}, []float64{2, 4, 6}); math.Abs(got-1) > 1e-9 {
+		t.Errorf("pearson(perfect) = %v, want 1", got)
+	}
+	if got := pearson([]float64{1, 2, 3}, []float64{3, 2, 1}); math.Abs(got+1) > 1e-9 {
+		t.Errorf("pearson(inverse) = %v, want -1", got)
+	}
+	if got := pearson([]float64{1, 2}, []float64{1, 2}); got != 0 {
+		t.Errorf("pearson(two points) = %v, want 0", got)
+	}
+	if got := pearson([]float64{1, 1, 1}, []float64{1, 2, 3}); got != 0 {
+		t.Errorf("pearson(no variance) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,94 @@
+package tracking
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+// RunFields selects the parts of a run that GetRun and SearchRuns decode.
+// Combine them with |, as in Info | Params. The server always sends the
+// whole run; skipping the parts a caller does not need, usually metrics,
+// saves the memory and time of decoding them.
+type RunFields uint8
+
+const (
+	// Info selects Run.Info.
+	Info RunFields = 1 << iota
+	// Params selects Run.Data.Params.
+	Params
+	// Metrics selects Run.Data.Metrics.
+	Metrics
+	// Tags selects Run.Data.Tags.
+	Tags
+
+	// AllRunFields selects the whole run, as when no fields are given.
+	AllRunFields = Info | Params | Metrics | Tags
+)
+
+// getRunOptions holds configuration for a GetRun call.
+type getRunOptions struct {
+	fields RunFields
+}
+
+// GetRunOption configures a GetRun call.
+type GetRunOption func(*getRunOptions)
+
+// WithRunFields decodes only the selected parts of the run; the others are
+// left empty. Default: AllRunFields.
+func WithRunFields(fields RunFields) GetRunOption {
+	return func(o *getRunOptions) {
+		o.fields = fields
+	}
+}
+
+// partialRun is a run as the server sends it, with each part kept
+// undecoded until the selected fields are known.
+type partialRun struct {
+	Info json.RawMessage `json:"info"`
+	Data struct {
+		Metrics json.RawMessage `json:"metrics"`
+		Params  json.RawMessage `json:"params"`
+		Tags    json.RawMessage `json:"tags"`
+	} `json:"data"`
+}
+
+// decodeRun decodes the fields of raw, a run as the server sends it.
+func decodeRun(raw json.RawMessage, fields RunFields) (Run, error) {
+	if len(raw) == 0 {
+		return Run{}, nil
+	}
+	if fields == 0 || fields == AllRunFields {
+		var r mlflowpb.Run
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return Run{}, fmt.Errorf("failed to decode run: %w", err)
+		}
+		return runFromProto(&r), nil
+	}
+
+	var p partialRun
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Run{}, fmt.Errorf("failed to decode run: %w", err)
+	}
+	r := &mlflowpb.Run{Data: &mlflowpb.RunData{}}
+	parts := []struct {
+		field RunFields
+		raw   json.RawMessage
+		dst   any
+	}{
+		{Info, p.Info, &r.Info},
+		{Params, p.Data.Params, &r.Data.Params},
+		{Metrics, p.Data.Metrics, &r.Data.Metrics},
+		{Tags, p.Data.Tags, &r.Data.Tags},
+	}
+	for _, part := range parts {
+		if fields&part.field == 0 || len(part.raw) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(part.raw, part.dst); err != nil {
+			return Run{}, fmt.Errorf("failed to decode run: %w", err)
+		}
+	}
+	return runFromProto(r), nil
+}
@@ -0,0 +1,72 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+var fieldsTestRun = map[string]any{
+	"info": map[string]any{"run_id": "r1", "status": "FINISHED"},
+	"data": map[string]any{
+		"metrics": []map[string]any{{"key": "loss", "value": 0.5, "timestamp": 1000, "step": 1}},
+		"params":  []map[string]any{{"key": "lr", "value": "0.01"}},
+		"tags":    []map[string]any{{"key": "team", "value": "search"}},
+	},
+}
+
+func TestGetRun_WithRunFields(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"run": fieldsTestRun})
+	}))
+	ctx := context.Background()
+
+	run, err := client.GetRun(ctx, "r1", WithRunFields(Info|Params))
+	if err != nil {
+		t.Fatalf("GetRun() error = %v", err)
+	}
+	if run.Info.RunID != "r1" || run.Info.Status != RunStatusFinished {
+		t.Errorf("Info = %+v", run.Info)
+	}
+	if len(run.Data.Params) != 1 || len(run.Data.Metrics) != 0 || len(run.Data.Tags) != 0 {
+		t.Errorf("Data = %+v, want params only", run.Data)
+	}
+
+	run, err = client.GetRun(ctx, "r1")
+	if err != nil {
+		t.Fatalf("GetRun() error = %v", err)
+	}
+	if run.Info.RunID != "r1" || len(run.Data.Metrics) != 1 || run.Data.Metrics[0].Step != 1 || run.Data.Tags["team"] != "search" {
+		t.Errorf("GetRun() = %+v, want the whole run", run)
+	}
+}
+
+func TestSearchRuns_WithRunsFields(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"runs": []any{fieldsTestRun, fieldsTestRun}})
+	}))
+
+	list, err := client.SearchRuns(context.Background(), []string{"1"}, WithRunsFields(Tags))
+	if err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	for _, run := range list.Runs {
+		if run.Info.RunID != "" || len(run.Data.Metrics) != 0 || run.Data.Tags["team"] != "search" {
+			t.Errorf("run = %+v, want tags only", run)
+		}
+	}
+
+	var streamed int
+	err = client.StreamRuns(context.Background(), []string{"1"}, func(run Run) error {
+		streamed++
+		if len(run.Data.Metrics) != 1 || len(run.Data.Params) != 0 {
+			t.Errorf("streamed run = %+v, want metrics only", run)
+		}
+		return nil
+	}, WithRunsFields(Metrics))
+	if err != nil || streamed != 2 {
+		t.Errorf("StreamRuns() error = %v, streamed %d runs, want 2", err, streamed)
+	}
+}
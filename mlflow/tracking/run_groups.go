@@ -0,0 +1,112 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// RunGroupTagKey is the run tag holding the ID of the run group a run
+// belongs to. WithRunGroup sets it and WithGroup searches by it.
+const RunGroupTagKey = mlflowtags.RunGroup
+
+// RunGroup is a logical run made of several runs, such as the workers of a
+// distributed training job. The group is itself a run, which its members
+// are nested under in the MLflow UI; its ID is that run's ID.
+type RunGroup struct {
+	ID           string
+	ExperimentID string
+	Name         string
+}
+
+// CreateRunGroup creates the run of a new run group in an experiment. Create
+// its members with WithRunGroup:
+//
+//	group, err := tracking.CreateRunGroup(ctx, client, expID, "resnet-ddp")
+//	worker, err := client.CreateRun(ctx, expID, tracking.WithRunGroup(group.ID))
+//
+// opts apply to the group's run; WithRunName is replaced by name.
+func CreateRunGroup(ctx context.Context, c *Client, experimentID, name string, opts ...CreateRunOption) (*RunGroup, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("mlflow: run group name is required")
+	}
+
+	run, err := c.CreateRun(ctx, experimentID, append(slices.Clone(opts), WithRunName(name))...)
+	if err != nil {
+		return nil, err
+	}
+	return &RunGroup{
+		ID:           run.Info.RunID,
+		ExperimentID: run.Info.ExperimentID,
+		Name:         name,
+	}, nil
+}
+
+// GroupMetric summarizes the latest values of one metric across the runs
+// of a group.
+type GroupMetric struct {
+	Key  string
+	Runs int
+	Min  float64
+	Max  float64
+	Mean float64
+	// Sum suits metrics that add up across workers, such as throughput.
+	Sum float64
+}
+
+// GroupMetrics summarizes the latest value of each metric across the active
+// runs of a group, sorted by key. NaN values are skipped.
+func GroupMetrics(ctx context.Context, c *Client, group RunGroup) ([]GroupMetric, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if group.ID == "" || group.ExperimentID == "" {
+		return nil, fmt.Errorf("mlflow: run group ID and experiment ID are required")
+	}
+
+	runs, err := c.SearchRunsCursor([]string{group.ExperimentID}, WithGroup(group.ID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*GroupMetric)
+	for _, run := range runs {
+		for _, m := range run.Data.Metrics {
+			if math.IsNaN(m.Value) {
+				continue
+			}
+			g := byKey[m.Key]
+			if g == nil {
+				g = &GroupMetric{Key: m.Key, Min: m.Value, Max: m.Value}
+				byKey[m.Key] = g
+			}
+			g.Runs++
+			g.Min = min(g.Min, m.Value)
+			g.Max = max(g.Max, m.Value)
+			g.Sum += m.Value
+		}
+	}
+
+	metrics := make([]GroupMetric, 0, len(byKey))
+	for _, g := range byKey {
+		g.Mean = g.Sum / float64(g.Runs)
+		metrics = append(metrics, *g)
+	}
+	slices.SortFunc(metrics, func(a, b GroupMetric) int { return strings.Compare(a.Key, b.Key) })
+	return metrics, nil
+}
+
+// groupTags returns the tags that make a run a member of the group.
+func groupTags(groupID string) map[string]string {
+	return map[string]string{
+		RunGroupTagKey:         groupID,
+		mlflowtags.ParentRunID: groupID,
+	}
+}
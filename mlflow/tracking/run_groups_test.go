@@ -0,0 +1,119 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+func TestRunGroups(t *testing.T) {
+	var (
+		created []map[string]string
+		filters []string
+	)
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/runs/create":
+			var req struct {
+				RunName string              `json:"run_name"`
+				Tags    []map[string]string `json:"tags"`
+			}
+			mustDecodeJSON(t, r, &req)
+			tags := map[string]string{"run_name": req.RunName}
+			for _, tag := range req.Tags {
+				tags[tag["key"]] = tag["value"]
+			}
+			created = append(created, tags)
+			mustEncodeJSON(t, w, map[string]any{"run": map[string]any{
+				"info": map[string]any{"run_id": []string{"group-1", "worker-1"}[len(created)-1], "experiment_id": "7"},
+			}})
+		case "/api/2.0/mlflow/runs/search":
+			var req struct {
+				Filter string `json:"filter"`
+			}
+			mustDecodeJSON(t, r, &req)
+			filters = append(filters, req.Filter)
+			worker := func(id string, loss, throughput float64) map[string]any {
+				return map[string]any{
+					"info": map[string]any{"run_id": id, "experiment_id": "7"},
+					"data": map[string]any{"metrics": []map[string]any{
+						{"key": "loss", "value": loss},
+						{"key": "samples_per_sec", "value": throughput},
+					}},
+				}
+			}
+			mustEncodeJSON(t, w, map[string]any{"runs": []map[string]any{
+				worker("worker-1", 0.5, 100),
+				worker("worker-2", 0.3, 120),
+			}})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	ctx := context.Background()
+
+	group, err := CreateRunGroup(ctx, client, "7", "resnet-ddp")
+	if err != nil {
+		t.Fatalf("CreateRunGroup() error = %v", err)
+	}
+	if *group != (RunGroup{ID: "group-1", ExperimentID: "7", Name: "resnet-ddp"}) {
+		t.Errorf("group = %+v", group)
+	}
+	if created[0]["run_name"] != "resnet-ddp" || created[0][RunGroupTagKey] != "" {
+		t.Errorf("group run = %v", created[0])
+	}
+
+	_, err = client.CreateRun(ctx, "7", WithRunGroup(group.ID), WithRunTags(map[string]string{"rank": "0"}))
+	if err != nil {
+		t.Fatalf("CreateRun() error = %v", err)
+	}
+	if w := created[1]; w[RunGroupTagKey] != "group-1" || w[mlflowtags.ParentRunID] != "group-1" || w["rank"] != "0" {
+		t.Errorf("worker tags = %v", w)
+	}
+
+	if _, err = client.SearchRuns(ctx, []string{"7"}, WithGroup("it's"), WithRunsFilter("metrics.loss < 1")); err != nil {
+		t.Fatalf("SearchRuns() error = %v", err)
+	}
+	if want := "metrics.loss < 1 AND tags.`_mlflow_go.run_group` = 'it''s'"; filters[0] != want {
+		t.Errorf("filter = %q, want %q", filters[0], want)
+	}
+
+	metrics, err := GroupMetrics(ctx, client, *group)
+	if err != nil {
+		t.Fatalf("GroupMetrics() error = %v", err)
+	}
+	want := []GroupMetric{
+		{Key: "loss", Runs: 2, Min: 0.3, Max: 0.5, Mean: 0.4, Sum: 0.8},
+		{Key: "samples_per_sec", Runs: 2, Min: 100, Max: 120, Mean: 110, Sum: 220},
+	}
+	if !slices.EqualFunc(metrics, want, func(a, b GroupMetric) bool {
+		return a.Key == b.Key && a.Runs == b.Runs && a.Min == b.Min && a.Max == b.Max &&
+			approxEqual(a.Mean, b.Mean) && approxEqual(a.Sum, b.Sum)
+	}) {
+		t.Errorf("GroupMetrics() = %+v, want %+v", metrics, want)
+	}
+	if filters[1] != "tags.`_mlflow_go.run_group` = 'group-1'" {
+		t.Errorf("GroupMetrics filter = %q", filters[1])
+	}
+}
+
+func TestRunGroups_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if _, err := CreateRunGroup(context.Background(), client, "7", ""); err == nil {
+		t.Error("CreateRunGroup() without a name error = nil")
+	}
+	if _, err := GroupMetrics(context.Background(), client, RunGroup{ID: "g"}); err == nil {
+		t.Error("GroupMetrics() without an experiment error = nil")
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	d := a - b
+	return d < 1e-9 && d > -1e-9
+}
@@ -0,0 +1,83 @@
+package tracking
+
+// runIndex locates the metrics and params of a run by key. It is built when
+// the run is decoded, so lookups do not scan Data.
+type runIndex struct {
+	metrics  map[string]int // key → index of the latest value in Data.Metrics
+	params   map[string]int // key → index in Data.Params
+	nMetrics int
+	nParams  int
+}
+
+func newRunIndex(d RunData) *runIndex {
+	idx := &runIndex{
+		metrics:  make(map[string]int, len(d.Metrics)),
+		params:   make(map[string]int, len(d.Params)),
+		nMetrics: len(d.Metrics),
+		nParams:  len(d.Params),
+	}
+	for i, m := range d.Metrics {
+		if j, ok := idx.metrics[m.Key]; !ok || newerMetric(m, d.Metrics[j]) {
+			idx.metrics[m.Key] = i
+		}
+	}
+	for i, p := range d.Params {
+		idx.params[p.Key] = i
+	}
+	return idx
+}
+
+// newerMetric reports whether a is a later value than b: a higher step, or
+// the same step and a later timestamp.
+func newerMetric(a, b Metric) bool {
+	if a.Step != b.Step {
+		return a.Step > b.Step
+	}
+	return a.Timestamp.After(b.Timestamp)
+}
+
+// LatestMetric returns the latest value of the metric key in the run's data:
+// the value with the highest step, and the latest timestamp among those.
+// Runs returned by the client carry the latest value of each metric; use
+// GetMetricHistory for the others.
+func (r Run) LatestMetric(key string) (Metric, bool) {
+	// The index is trusted only while Data matches what it was built from
+	if idx := r.index; idx != nil && idx.nMetrics == len(r.Data.Metrics) {
+		i, ok := idx.metrics[key]
+		if !ok {
+			return Metric{}, false
+		}
+		if r.Data.Metrics[i].Key == key {
+			return r.Data.Metrics[i], true
+		}
+	}
+
+	var latest Metric
+	var found bool
+	for _, m := range r.Data.Metrics {
+		if m.Key == key && (!found || newerMetric(m, latest)) {
+			latest, found = m, true
+		}
+	}
+	return latest, found
+}
+
+// Param returns the value of the parameter key.
+func (r Run) Param(key string) (string, bool) {
+	if idx := r.index; idx != nil && idx.nParams == len(r.Data.Params) {
+		i, ok := idx.params[key]
+		if !ok {
+			return "", false
+		}
+		if r.Data.Params[i].Key == key {
+			return r.Data.Params[i].Value, true
+		}
+	}
+
+	for _, p := range r.Data.Params {
+		if p.Key == key {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,63 @@
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+)
+
+func TestRun_LatestMetric(t *testing.T) {
+	key, lr, lrValue := "loss", "lr", "0.01"
+	step1, step2 := int64(1), int64(2)
+	ts1, ts2 := int64(1000), int64(2000)
+	v1, v2, v3 := 0.9, 0.5, 0.4
+	run := runFromProto(&mlflowpb.Run{Data: &mlflowpb.RunData{
+		Metrics: []*mlflowpb.Metric{
+			{Key: &key, Value: &v2, Step: &step2, Timestamp: &ts1},
+			{Key: &key, Value: &v1, Step: &step1, Timestamp: &ts2},
+			{Key: &key, Value: &v3, Step: &step2, Timestamp: &ts2},
+		},
+		Params: []*mlflowpb.Param{{Key: &lr, Value: &lrValue}},
+	}})
+
+	m, ok := run.LatestMetric("loss")
+	if !ok || m.Value != 0.4 || m.Step != 2 || !m.Timestamp.Equal(time.UnixMilli(2000)) {
+		t.Errorf("LatestMetric(loss) = %+v, %v, want 0.4 at step 2", m, ok)
+	}
+	if _, ok := run.LatestMetric("acc"); ok {
+		t.Error("LatestMetric(acc) found a missing metric")
+	}
+	if v, ok := run.Param("lr"); !ok || v != "0.01" {
+		t.Errorf("Param(lr) = %q, %v, want 0.01", v, ok)
+	}
+	if _, ok := run.Param("batch"); ok {
+		t.Error("Param(batch) found a missing param")
+	}
+}
+
+func TestRun_LatestMetric_WithoutIndex(t *testing.T) {
+	run := Run{Data: RunData{
+		Metrics: []Metric{{Key: "acc", Value: 0.7, Step: 1}, {Key: "acc", Value: 0.8, Step: 3}},
+		Params:  []Param{{Key: "lr", Value: "0.1"}},
+	}}
+	if m, ok := run.LatestMetric("acc"); !ok || m.Value != 0.8 {
+		t.Errorf("LatestMetric(acc) = %+v, %v, want 0.8", m, ok)
+	}
+	if v, ok := run.Param("lr"); !ok || v != "0.1" {
+		t.Errorf("Param(lr) = %q, %v, want 0.1", v, ok)
+	}
+}
+
+func TestRun_LatestMetric_DataChangedAfterDecode(t *testing.T) {
+	key := "acc"
+	v := 0.5
+	run := runFromProto(&mlflowpb.Run{Data: &mlflowpb.RunData{
+		Metrics: []*mlflowpb.Metric{{Key: &key, Value: &v}},
+	}})
+	run.Data.Metrics = append(run.Data.Metrics, Metric{Key: "f1", Value: 0.6})
+
+	if m, ok := run.LatestMetric("f1"); !ok || m.Value != 0.6 {
+		t.Errorf("LatestMetric(f1) = %+v, %v, want the appended metric", m, ok)
+	}
+}
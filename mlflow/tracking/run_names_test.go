@@ -0,0 +1,21 @@
+package tracking
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestGenerateRunName(t *testing.T) {
+	pattern := regexp.MustCompile(`^[a-z]+-[a-z]+-\d{1,3}$`)
+	seen := make(map[string]bool)
+	for range 100 {
+		name := GenerateRunName()
+		if !pattern.MatchString(name) {
+			t.Fatalf("GenerateRunName() = %q, want adjective-noun-number", name)
+		}
+		seen[name] = true
+	}
+	if len(seen) < 90 {
+		t.Errorf("GenerateRunName() returned only %d distinct names in 100 calls", len(seen))
+	}
+}
@@ -0,0 +1,126 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/searchfilter"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
+)
+
+// RunExpiresTagKey is the run tag holding the time a run expires, in RFC
+// 3339 UTC. WithRunTTL sets it and ExpireRuns acts on the runs past it.
+const RunExpiresTagKey = mlflowtags.ExpiresAt
+
+// RunArchivedTagKey is the run tag ExpireRuns sets to the time it archived
+// a run with ExpireArchive. Find archived runs with the filter
+// "tags.`_mlflow_go.archived_at` LIKE '%'".
+const RunArchivedTagKey = mlflowtags.ArchivedAt
+
+// ExpireAction says what ExpireRuns does with an expired run.
+type ExpireAction string
+
+const (
+	// ExpireDelete marks expired runs for deletion with DeleteRun. They can
+	// be restored until `mlflow gc` purges them.
+	ExpireDelete ExpireAction = "delete"
+	// ExpireArchive keeps expired runs, tagging them with RunArchivedTagKey
+	// and removing RunExpiresTagKey so later sweeps skip them.
+	ExpireArchive ExpireAction = "archive"
+)
+
+// RunExpiry returns the time a run expires, and false if it has no valid
+// RunExpiresTagKey tag.
+func RunExpiry(run Run) (time.Time, bool) {
+	v, ok := run.Data.Tags[RunExpiresTagKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ExpireRuns sweeps the active runs of an experiment created with WithRunTTL
+// and deletes those past their expiry, or archives them with
+// WithExpireAction(ExpireArchive). Runs without a valid RunExpiresTagKey tag
+// are never touched. It returns the expired runs, oldest expiry first:
+//
+//	expired, err := tracking.ExpireRuns(ctx, client, scratchExpID)
+//
+// Pass a context from mlflow.ContextWithDryRun to list the runs a sweep
+// would expire without changing them. On a client created with
+// WithDeleteProtection, deleting requires the experiment ID to be confirmed
+// with WithExpireConfirm; archiving does not. If some runs cannot be expired,
+// the returned error is a *mlflow.MultiError with one entry per run,
+// indexed into the returned slice.
+func ExpireRuns(ctx context.Context, c *Client, experimentID string, opts ...ExpireRunsOption) ([]Run, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if experimentID == "" {
+		return nil, fmt.Errorf("mlflow: experiment ID is required")
+	}
+
+	o := &expireRunsOptions{action: ExpireDelete}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.action != ExpireDelete && o.action != ExpireArchive {
+		return nil, fmt.Errorf("mlflow: unknown expire action %q", o.action)
+	}
+
+	runs, err := c.SearchRunsCursor([]string{experimentID},
+		WithRunsFilter("tags.`"+searchfilter.Key(RunExpiresTagKey)+"` LIKE '%'")).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var expired []Run
+	for _, run := range runs {
+		if at, ok := RunExpiry(run); ok && !now.Before(at) {
+			expired = append(expired, run)
+		}
+	}
+	sortByExpiry(expired)
+
+	var errs errors.MultiError
+	for i, run := range expired {
+		errs.Add(i, "run "+run.Info.RunID, c.expireRun(ctx, run.Info.RunID, o.action, o.confirm == experimentID, now))
+	}
+	return expired, errs.Err()
+}
+
+func (c *Client) expireRun(ctx context.Context, runID string, action ExpireAction, confirmed bool, now time.Time) error {
+	if action == ExpireDelete {
+		if confirmed {
+			return c.DeleteRun(ctx, runID, WithConfirm(runID))
+		}
+		return c.DeleteRun(ctx, runID)
+	}
+	if err := c.SetTag(ctx, runID, RunArchivedTagKey, now.UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return c.DeleteTag(ctx, runID, RunExpiresTagKey)
+}
+
+// sortByExpiry sorts runs with valid expiry tags by expiry, oldest first.
+func sortByExpiry(runs []Run) {
+	slices.SortStableFunc(runs, func(a, b Run) int {
+		x, _ := RunExpiry(a)
+		y, _ := RunExpiry(b)
+		return x.Compare(y)
+	})
+}
+
+// runExpiresAt returns the RunExpiresTagKey value of a run started at start
+// with the given TTL.
+func runExpiresAt(start time.Time, ttl time.Duration) string {
+	return start.Add(ttl).UTC().Format(time.RFC3339)
+}
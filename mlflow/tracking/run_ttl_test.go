@@ -0,0 +1,157 @@
+package tracking
+
+import (
+	"context"
+	stderrors "errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/internal/errors"
+	"github.com/opendatahub-io/mlflow-go/internal/transport"
+)
+
+func TestWithRunTTL(t *testing.T) {
+	var tags map[string]string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Tags []map[string]string `json:"tags"`
+		}
+		mustDecodeJSON(t, r, &req)
+		tags = make(map[string]string)
+		for _, tag := range req.Tags {
+			tags[tag["key"]] = tag["value"]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustEncodeJSON(t, w, map[string]any{"run": map[string]any{"info": map[string]any{"run_id": "run-1"}}})
+	}))
+
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	_, err := client.CreateRun(context.Background(), "7", WithRunName("scratch"),
+		WithStartTime(start), WithRunTTL(72*time.Hour))
+	if err != nil {
+		t.Fatalf("CreateRun() error = %v", err)
+	}
+	if got := tags[RunExpiresTagKey]; got != "2026-03-04T12:00:00Z" {
+		t.Errorf("%s = %q", RunExpiresTagKey, got)
+	}
+}
+
+// ttlServer serves a search returning runs with the given expiry tags and
+// records the mutating requests made after it.
+func ttlServer(t *testing.T, expiries map[string]string, calls *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/2.0/mlflow/runs/search" {
+			var req struct {
+				Filter string `json:"filter"`
+			}
+			mustDecodeJSON(t, r, &req)
+			if req.Filter != "tags.`_mlflow_go.expires_at` LIKE '%'" {
+				t.Errorf("filter = %q", req.Filter)
+			}
+			var runs []map[string]any
+			for _, id := range slices.Sorted(maps.Keys(expiries)) {
+				runs = append(runs, map[string]any{
+					"info": map[string]any{"run_id": id},
+					"data": map[string]any{"tags": []map[string]string{{"key": RunExpiresTagKey, "value": expiries[id]}}},
+				})
+			}
+			mustEncodeJSON(t, w, map[string]any{"runs": runs})
+			return
+		}
+		var req map[string]string
+		mustDecodeJSON(t, r, &req)
+		*calls = append(*calls, r.URL.Path+" "+req["run_id"]+" "+req["key"])
+		if req["run_id"] == "broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	})
+}
+
+func TestExpireRuns(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	older := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	expiries := map[string]string{"a": past, "b": older, "fresh": future, "bad": "soon"}
+
+	var calls []string
+	client := newTestClient(t, ttlServer(t, expiries, &calls))
+	expired, err := ExpireRuns(context.Background(), client, "7")
+	if err != nil {
+		t.Fatalf("ExpireRuns() error = %v", err)
+	}
+	var ids []string
+	for _, run := range expired {
+		ids = append(ids, run.Info.RunID)
+	}
+	if !slices.Equal(ids, []string{"b", "a"}) {
+		t.Errorf("expired = %v, want oldest expiry first", ids)
+	}
+	want := []string{"/api/2.0/mlflow/runs/delete b ", "/api/2.0/mlflow/runs/delete a "}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
+
+func TestExpireRuns_Archive(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	var calls []string
+	client := newTestClient(t, ttlServer(t, map[string]string{"a": past, "broken": past}, &calls))
+
+	expired, err := ExpireRuns(context.Background(), client, "7", WithExpireAction(ExpireArchive))
+	if len(expired) != 2 {
+		t.Fatalf("expired = %d runs, want 2", len(expired))
+	}
+	var multi *errors.MultiError
+	if !stderrors.As(err, &multi) || len(multi.Errors) != 1 {
+		t.Fatalf("ExpireRuns() error = %v, want one failed run", err)
+	}
+	if !slices.Contains(calls, "/api/2.0/mlflow/runs/set-tag a _mlflow_go.archived_at") ||
+		!slices.Contains(calls, "/api/2.0/mlflow/runs/delete-tag a _mlflow_go.expires_at") {
+		t.Errorf("calls = %q", calls)
+	}
+}
+
+func TestExpireRuns_DeleteProtection(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	var calls []string
+	server := httptest.NewServer(ttlServer(t, map[string]string{"a": past}, &calls))
+	t.Cleanup(server.Close)
+	tc, err := transport.New(transport.Config{BaseURL: server.URL, DeleteProtection: true})
+	if err != nil {
+		t.Fatalf("transport.New() error = %v", err)
+	}
+	client := NewClient(tc)
+
+	_, err = ExpireRuns(context.Background(), client, "7", WithExpireConfirm("8"))
+	if !stderrors.Is(err, errors.ErrDeleteNotConfirmed) {
+		t.Errorf("ExpireRuns() confirming another experiment error = %v, want ErrDeleteNotConfirmed", err)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("calls = %q, want none", calls)
+	}
+
+	if _, err := ExpireRuns(context.Background(), client, "7", WithExpireConfirm("7")); err != nil {
+		t.Fatalf("ExpireRuns() error = %v", err)
+	}
+	if want := []string{"/api/2.0/mlflow/runs/delete a "}; !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
+
+func TestExpireRuns_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+	if _, err := ExpireRuns(context.Background(), client, ""); err == nil {
+		t.Error("ExpireRuns() without an experiment error = nil")
+	}
+	if _, err := ExpireRuns(context.Background(), client, "7", WithExpireAction("purge")); err == nil {
+		t.Error("ExpireRuns() with an unknown action error = nil")
+	}
+}
@@ -0,0 +1,110 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RunUpdate accumulates changes to a run's metadata and applies them with as
+// few API calls as possible. Create one with UpdateRunBuilder.
+//
+//	err := tracking.UpdateRunBuilder(runID).
+//		Status(tracking.RunStatusFinished).
+//		EndNow().
+//		Tag("outcome", "converged").
+//		Apply(ctx, client)
+//
+// A RunUpdate is not safe for concurrent use.
+type RunUpdate struct {
+	runID   string
+	runName string
+	status  *RunStatus
+	endTime *time.Time
+	tags    map[string]string
+}
+
+// UpdateRunBuilder starts a new metadata update for the given run.
+func UpdateRunBuilder(runID string) *RunUpdate {
+	return &RunUpdate{runID: runID}
+}
+
+// Name sets a new name for the run.
+func (u *RunUpdate) Name(name string) *RunUpdate {
+	u.runName = name
+	return u
+}
+
+// Status sets the run status.
+func (u *RunUpdate) Status(status RunStatus) *RunUpdate {
+	u.status = &status
+	return u
+}
+
+// EndTime sets the end time for the run.
+func (u *RunUpdate) EndTime(t time.Time) *RunUpdate {
+	u.endTime = &t
+	return u
+}
+
+// EndNow sets the end time for the run to the current time.
+func (u *RunUpdate) EndNow() *RunUpdate {
+	return u.EndTime(time.Now())
+}
+
+// Tag adds or replaces a tag to be set on the run.
+func (u *RunUpdate) Tag(key, value string) *RunUpdate {
+	if u.tags == nil {
+		u.tags = make(map[string]string)
+	}
+	u.tags[key] = value
+	return u
+}
+
+// Apply sends the accumulated changes to the server.
+// Tags are written in a single LogBatch call before the run info is updated,
+// so a run is never marked terminal without its finalization tags.
+// Apply makes no API calls if nothing was set.
+func (u *RunUpdate) Apply(ctx context.Context, c *Client) error {
+	if u.runID == "" {
+		return fmt.Errorf("mlflow: run ID is required")
+	}
+	if c == nil {
+		return fmt.Errorf("mlflow: tracking client is required")
+	}
+	if u.status != nil {
+		if _, ok := runStatusToProto[*u.status]; !ok {
+			return fmt.Errorf("mlflow: invalid run status: %s", *u.status)
+		}
+	}
+	for k := range u.tags {
+		if k == "" {
+			return fmt.Errorf("mlflow: tag key is required")
+		}
+	}
+
+	if len(u.tags) > 0 {
+		if err := c.LogBatch(ctx, u.runID, nil, nil, u.tags); err != nil {
+			return err
+		}
+	}
+
+	var opts []UpdateRunOption
+	if u.status != nil {
+		opts = append(opts, WithStatus(*u.status))
+	}
+	if u.endTime != nil {
+		opts = append(opts, WithEndTime(*u.endTime))
+	}
+	if u.runName != "" {
+		opts = append(opts, WithRunNameUpdate(u.runName))
+	}
+
+	if len(opts) > 0 {
+		if _, err := c.UpdateRun(ctx, u.runID, opts...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRunUpdate_Apply(t *testing.T) {
+	var paths []string
+	var receivedTags []map[string]any
+	var receivedName string
+	var receivedStatus int
+	var receivedEndTime int64
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		paths = append(paths, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/runs/log-batch":
+			var req struct {
+				Tags []map[string]any `json:"tags"`
+			}
+			mustDecodeJSON(t, r, &req)
+			receivedTags = req.Tags
+			mustEncodeJSON(t, w, map[string]any{})
+		case "/api/2.0/mlflow/runs/update":
+			var req struct {
+				RunName string `json:"run_name"`
+				Status  int    `json:"status"`
+				EndTime int64  `json:"end_time"`
+			}
+			mustDecodeJSON(t, r, &req)
+			receivedName = req.RunName
+			receivedStatus = req.Status
+			receivedEndTime = req.EndTime
+			mustEncodeJSON(t, w, map[string]any{
+				"run_info": map[string]any{"run_id": "abc-123"},
+			})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	err := UpdateRunBuilder("abc-123").
+		Name("final").
+		Status(RunStatusFinished).
+		EndNow().
+		Tag("a", "1").
+		Tag("b", "2").
+		Apply(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("request count = %d, want 2 (%v)", len(paths), paths)
+	}
+	if paths[0] != "/api/2.0/mlflow/runs/log-batch" {
+		t.Errorf("first request = %q, want log-batch", paths[0])
+	}
+	if len(receivedTags) != 2 {
+		t.Errorf("tags count = %d, want 2", len(receivedTags))
+	}
+	if receivedName != "final" {
+		t.Errorf("run_name = %q, want %q", receivedName, "final")
+	}
+	// RunStatus_FINISHED = 3 in protobuf enum
+	if receivedStatus != 3 {
+		t.Errorf("status = %d, want 3 (FINISHED)", receivedStatus)
+	}
+	if receivedEndTime == 0 {
+		t.Error("expected end_time to be set")
+	}
+}
+
+func TestRunUpdate_Apply_TagsOnly(t *testing.T) {
+	var paths []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		paths = append(paths, r.URL.Path)
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	err := UpdateRunBuilder("abc-123").Tag("k", "v").Apply(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if len(paths) != 1 || paths[0] != "/api/2.0/mlflow/runs/log-batch" {
+		t.Errorf("requests = %v, want single log-batch", paths)
+	}
+}
+
+func TestRunUpdate_Apply_NoChanges(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	if err := UpdateRunBuilder("abc-123").Apply(context.Background(), client); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+}
+
+func TestRunUpdate_Apply_EmptyRunID(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	err := UpdateRunBuilder("").Status(RunStatusFinished).Apply(context.Background(), client)
+	if err == nil {
+		t.Error("expected error for empty run ID")
+	}
+}
+
+func TestRunUpdate_Apply_InvalidStatus(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+
+	err := UpdateRunBuilder("abc-123").
+		Tag("k", "v").
+		Status(RunStatus("INVALID")).
+		Apply(context.Background(), client)
+	if err == nil {
+		t.Error("expected error for invalid status")
+	}
+}
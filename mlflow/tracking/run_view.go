@@ -0,0 +1,114 @@
+package tracking
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// IsTerminal reports whether the status is final: FINISHED, FAILED, or
+// KILLED.
+func (s RunStatus) IsTerminal() bool {
+	return s == RunStatusFinished || s == RunStatusFailed || s == RunStatusKilled
+}
+
+// Emoji returns a symbol for the status for terminal and chat output, such
+// as "✅" for FINISHED, or "❔" for an unknown status.
+func (s RunStatus) Emoji() string {
+	switch s {
+	case RunStatusRunning:
+		return "🏃"
+	case RunStatusScheduled:
+		return "🕒"
+	case RunStatusFinished:
+		return "✅"
+	case RunStatusFailed:
+		return "❌"
+	case RunStatusKilled:
+		return "🛑"
+	default:
+		return "❔"
+	}
+}
+
+// IsTerminal reports whether the run has reached a final status.
+func (ri RunInfo) IsTerminal() bool {
+	return ri.Status.IsTerminal()
+}
+
+// StatusEmoji returns the symbol for the run's status; see RunStatus.Emoji.
+func (ri RunInfo) StatusEmoji() string {
+	return ri.Status.Emoji()
+}
+
+// Duration returns how long the run ran: from its start time to its end
+// time, or to now if it has not ended. It is 0 for a run without a start
+// time, or with an end time before its start.
+func (ri RunInfo) Duration() time.Duration {
+	if ri.StartTime.IsZero() {
+		return 0
+	}
+	end := ri.EndTime
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return max(end.Sub(ri.StartTime), 0)
+}
+
+// FormatDuration formats d for people, with at most two units, such as
+// "850ms", "42s", "3m 5s", "2h 10m", or "3d 4h". Durations of a second or
+// more are rounded down to the second.
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + FormatDuration(-d)
+	}
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	s := int64(d / time.Second)
+	days, hours, minutes, seconds := s/86400, s/3600%24, s/60%60, s%60
+	switch {
+	case days > 0:
+		return twoUnits(days, "d", hours, "h")
+	case hours > 0:
+		return twoUnits(hours, "h", minutes, "m")
+	case minutes > 0:
+		return twoUnits(minutes, "m", seconds, "s")
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+func twoUnits(a int64, aUnit string, b int64, bUnit string) string {
+	if b == 0 {
+		return fmt.Sprintf("%d%s", a, aUnit)
+	}
+	return fmt.Sprintf("%d%s %d%s", a, aUnit, b, bUnit)
+}
+
+// SortRunsByStartTime sorts runs by start time in place, in the order
+// SearchRunsAcross uses for OrderByAttribute("start_time", dir). Runs
+// without a start time come last in either direction; run ID breaks ties.
+func SortRunsByStartTime(runs []Run, dir SortDirection) {
+	sortRunsBy(runs, runOrderKey{kind: "attributes", name: "start_time", desc: dir == Desc})
+}
+
+// SortRunsByDuration sorts runs by RunInfo.Duration in place, so runs still
+// going are measured up to now. Runs without a start time come last in
+// either direction; run ID breaks ties.
+func SortRunsByDuration(runs []Run, dir SortDirection) {
+	sortRunsBy(runs, runOrderKey{kind: "attributes", name: durationOrderKey, desc: dir == Desc, now: time.Now()})
+}
+
+// SortRunsByMetric sorts runs by the latest value of a metric in place, in
+// the order SearchRunsAcross uses for OrderByMetric(key, dir). Runs without
+// the metric, or with a NaN value, come last in either direction; run ID
+// breaks ties.
+func SortRunsByMetric(runs []Run, key string, dir SortDirection) {
+	sortRunsBy(runs, runOrderKey{kind: "metrics", name: key, desc: dir == Desc})
+}
+
+// sortRunsBy sorts runs in place by a single runOrder key.
+func sortRunsBy(runs []Run, key runOrderKey) {
+	slices.SortStableFunc(runs, runOrder{key}.compare)
+}
@@ -0,0 +1,112 @@
+package tracking
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRunInfo_View(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	finished := RunInfo{Status: RunStatusFinished, StartTime: start, EndTime: start.Add(90 * time.Minute)}
+	if d := finished.Duration(); d != 90*time.Minute {
+		t.Errorf("Duration() = %v, want 1h30m", d)
+	}
+	if !finished.IsTerminal() || finished.StatusEmoji() != "✅" {
+		t.Errorf("finished run: IsTerminal() = %v, StatusEmoji() = %q", finished.IsTerminal(), finished.StatusEmoji())
+	}
+
+	running := RunInfo{Status: RunStatusRunning, StartTime: time.Now().Add(-time.Minute)}
+	if d := running.Duration(); d < time.Minute || d > 2*time.Minute {
+		t.Errorf("running Duration() = %v, want about a minute", d)
+	}
+	if running.IsTerminal() {
+		t.Error("running run IsTerminal() = true")
+	}
+	if d := (RunInfo{EndTime: start}).Duration(); d != 0 {
+		t.Errorf("Duration() without a start time = %v, want 0", d)
+	}
+	if RunStatus("PAUSED").Emoji() != "❔" || RunStatus("PAUSED").IsTerminal() {
+		t.Error("unknown status treated as known")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for _, tc := range []struct {
+		d    time.Duration
+		want string
+	}{
+		{850 * time.Millisecond, "850ms"},
+		{0, "0s"},
+		{42*time.Second + 900*time.Millisecond, "42s"},
+		{3*time.Minute + 5*time.Second, "3m 5s"},
+		{2 * time.Hour, "2h"},
+		{2*time.Hour + 10*time.Minute + 30*time.Second, "2h 10m"},
+		{76 * time.Hour, "3d 4h"},
+		{-90 * time.Second, "-1m 30s"},
+	} {
+		if got := FormatDuration(tc.d); got != tc.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestSortRuns(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	run := func(id string, startOffset, length time.Duration, loss float64) Run {
+		r := Run{Info: RunInfo{RunID: id, StartTime: start.Add(startOffset), EndTime: start.Add(startOffset + length)}}
+		if !math.IsInf(loss, 0) {
+			r.Data.Metrics = []Metric{{Key: "loss", Value: loss}}
+		}
+		return r
+	}
+	runs := []Run{
+		run("a", time.Hour, time.Minute, 0.3),
+		run("b", 0, time.Hour, math.Inf(1)), // no loss
+		run("c", 2*time.Hour, 10*time.Minute, math.NaN()),
+		run("d", 30*time.Minute, 5*time.Minute, 0.1),
+		{Info: RunInfo{RunID: "e"}},
+	}
+	ids := func() []string {
+		var out []string
+		for _, r := range runs {
+			out = append(out, r.Info.RunID)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		name string
+		sort func()
+		want []string
+	}{
+		{"start asc", func() { SortRunsByStartTime(runs, Asc) }, []string{"b", "d", "a", "c", "e"}},
+		{"start desc", func() { SortRunsByStartTime(runs, Desc) }, []string{"c", "a", "d", "b", "e"}},
+		{"duration desc", func() { SortRunsByDuration(runs, Desc) }, []string{"b", "c", "d", "a", "e"}},
+		{"metric asc", func() { SortRunsByMetric(runs, "loss", Asc) }, []string{"d", "a", "b", "c", "e"}},
+	} {
+		tc.sort()
+		if got := ids(); !slices.Equal(got, tc.want) {
+			t.Errorf("%s = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestSortRuns_TiesByRunID(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	runs := []Run{
+		{Info: RunInfo{RunID: "z", StartTime: start}},
+		{Info: RunInfo{RunID: "x", StartTime: start}},
+		{Info: RunInfo{RunID: "y", StartTime: start.Add(-time.Minute)}},
+	}
+
+	SortRunsByStartTime(runs, Desc)
+	var got []string
+	for _, r := range runs {
+		got = append(got, r.Info.RunID)
+	}
+	if want := []string{"x", "z", "y"}; !slices.Equal(got, want) {
+		t.Errorf("SortRunsByStartTime() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package tracking
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// MetricSampling downsamples metrics logged through a BufferedLogger, for
+// training loops that log far more often than anyone will chart. Set one
+// field; if both are set, a value must satisfy both to be kept.
+//
+// Whatever the policy, the first value of each metric is kept, and so is the
+// latest: the most recent value sampled out is held back and sent by the next
+// Flush or Close unless a newer value is kept first, so charts end at the
+// final value.
+type MetricSampling struct {
+	// EveryNSteps keeps only values whose step is a multiple of n.
+	EveryNSteps int64
+
+	// MaxPerSecond keeps at most this many values per second for each
+	// metric key, measured by the metric timestamps.
+	MaxPerSecond float64
+}
+
+// metricSampler applies a MetricSampling policy per metric key.
+type metricSampler struct {
+	policy  MetricSampling
+	minGap  time.Duration
+	keys    map[string]*sampledKey
+	dropped int64
+}
+
+// sampledKey is the sampling state of one metric key.
+type sampledKey struct {
+	lastKept time.Time
+	held     *Metric // latest value sampled out since the last kept one
+}
+
+func newMetricSampler(policy MetricSampling) *metricSampler {
+	s := &metricSampler{policy: policy, keys: make(map[string]*sampledKey)}
+	if policy.MaxPerSecond > 0 {
+		s.minGap = time.Duration(float64(time.Second) / policy.MaxPerSecond)
+	}
+	return s
+}
+
+// keep reports whether m should be logged now. A value that is not kept is
+// held as the key's latest value.
+func (s *metricSampler) keep(m Metric) bool {
+	k := s.keys[m.Key]
+	if k == nil {
+		s.keys[m.Key] = &sampledKey{lastKept: m.Timestamp}
+		return true
+	}
+
+	ok := true
+	if n := s.policy.EveryNSteps; n > 1 && m.Step%n != 0 {
+		ok = false
+	}
+	if s.minGap > 0 && m.Timestamp.Sub(k.lastKept) < s.minGap {
+		ok = false
+	}
+
+	if k.held != nil {
+		// Superseded by m either way
+		s.dropped++
+		k.held = nil
+	}
+	if ok {
+		k.lastKept = m.Timestamp
+		return true
+	}
+	held := m
+	k.held = &held
+	return false
+}
+
+// takeHeld returns the held latest values, which were sampled out but must
+// not be lost, and clears them.
+func (s *metricSampler) takeHeld() []Metric {
+	var held []Metric
+	for _, k := range s.keys {
+		if k.held != nil {
+			held = append(held, *k.held)
+			k.lastKept = k.held.Timestamp
+			k.held = nil
+		}
+	}
+	slices.SortFunc(held, func(a, b Metric) int { return cmp.Compare(a.Key, b.Key) })
+	return held
+}
@@ -0,0 +1,79 @@
+package tracking
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetricSampler_EveryNSteps(t *testing.T) {
+	s := newMetricSampler(MetricSampling{EveryNSteps: 10})
+	start := time.UnixMilli(0)
+
+	var kept []int64
+	for step := int64(3); step <= 25; step++ {
+		if s.keep(Metric{Key: "loss", Step: step, Timestamp: start}) {
+			kept = append(kept, step)
+		}
+	}
+	// The first value, then multiples of 10
+	if want := []int64{3, 10, 20}; !equalSteps(kept, want) {
+		t.Errorf("kept steps = %v, want %v", kept, want)
+	}
+
+	held := s.takeHeld()
+	if len(held) != 1 || held[0].Step != 25 {
+		t.Errorf("takeHeld() = %+v, want the latest value (step 25)", held)
+	}
+	if s.takeHeld() != nil {
+		t.Error("expected held values to be cleared")
+	}
+	// Steps 4-9, 11-19, and 21-24 were superseded
+	if s.dropped != 19 {
+		t.Errorf("dropped = %d, want 19", s.dropped)
+	}
+}
+
+func TestMetricSampler_MaxPerSecond(t *testing.T) {
+	s := newMetricSampler(MetricSampling{MaxPerSecond: 2})
+	start := time.UnixMilli(0)
+
+	var kept []int64
+	for step := range int64(20) {
+		ts := start.Add(time.Duration(step) * 100 * time.Millisecond)
+		if s.keep(Metric{Key: "loss", Step: step, Timestamp: ts}) {
+			kept = append(kept, step)
+		}
+	}
+	// One value per 500ms
+	if want := []int64{0, 5, 10, 15}; !equalSteps(kept, want) {
+		t.Errorf("kept steps = %v, want %v", kept, want)
+	}
+}
+
+func TestMetricSampler_KeysIndependent(t *testing.T) {
+	s := newMetricSampler(MetricSampling{EveryNSteps: 100})
+	ts := time.UnixMilli(0)
+
+	if !s.keep(Metric{Key: "a", Step: 1, Timestamp: ts}) || !s.keep(Metric{Key: "b", Step: 1, Timestamp: ts}) {
+		t.Error("expected the first value of each key to be kept")
+	}
+	s.keep(Metric{Key: "b", Step: 2, Timestamp: ts})
+	s.keep(Metric{Key: "a", Step: 2, Timestamp: ts})
+
+	held := s.takeHeld()
+	if len(held) != 2 || held[0].Key != "a" || held[1].Key != "b" {
+		t.Errorf("takeHeld() = %+v, want one value per key sorted by key", held)
+	}
+}
+
+func equalSteps(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
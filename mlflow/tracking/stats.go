@@ -0,0 +1,94 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// statsPageSize is the page size ExperimentStats uses when scanning runs.
+const statsPageSize = 1000
+
+// lifecycleStageDeleted is the lifecycle stage of soft-deleted runs.
+const lifecycleStageDeleted = "deleted"
+
+// ExperimentUsage summarizes the runs in an experiment. See ExperimentStats.
+type ExperimentUsage struct {
+	ExperimentID string
+
+	// Runs counts every run, including deleted ones.
+	Runs int
+	// DeletedRuns counts runs in the deleted lifecycle stage.
+	DeletedRuns int
+	// RunsByStatus counts runs by status, including deleted runs.
+	RunsByStatus map[RunStatus]int
+
+	// Metrics counts metric keys across runs. Only the latest value of each
+	// key is returned by search, so metric history is not included.
+	Metrics int
+	// Params counts logged parameters across runs.
+	Params int
+	// Tags counts run tags across runs, including system tags.
+	Tags int
+
+	// LastActivity is the latest of the experiment's last update time and
+	// every run's start and end time. Zero if none are known.
+	LastActivity time.Time
+}
+
+// ExperimentStats scans every run in an experiment and reports run counts by
+// status, metric and parameter volume, and the time of the last activity.
+// It is intended for finding stale or runaway experiments.
+//
+// The scan issues one SearchRuns call per 1000 runs, so it can be slow on
+// very large experiments. Runs are streamed, so memory use does not grow
+// with the experiment size.
+func ExperimentStats(ctx context.Context, c *Client, experimentID string) (*ExperimentUsage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mlflow: client is required")
+	}
+	if experimentID == "" {
+		return nil, fmt.Errorf("mlflow: experiment ID is required")
+	}
+
+	exp, err := c.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &ExperimentUsage{
+		ExperimentID: experimentID,
+		RunsByStatus: make(map[RunStatus]int),
+		LastActivity: exp.LastUpdateTime,
+	}
+
+	err = c.StreamRuns(ctx, []string{experimentID}, func(run Run) error {
+		usage.add(run)
+		return nil
+	}, WithRunsViewType(ViewTypeAll), WithRunsMaxResults(statsPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func (u *ExperimentUsage) add(run Run) {
+	u.Runs++
+	if run.Info.LifecycleStage == lifecycleStageDeleted {
+		u.DeletedRuns++
+	}
+	if run.Info.Status != "" {
+		u.RunsByStatus[run.Info.Status]++
+	}
+
+	u.Metrics += len(run.Data.Metrics)
+	u.Params += len(run.Data.Params)
+	u.Tags += len(run.Data.Tags)
+
+	for _, t := range []time.Time{run.Info.StartTime, run.Info.EndTime} {
+		if t.After(u.LastActivity) {
+			u.LastActivity = t
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package tracking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestExperimentStats_Success(t *testing.T) {
+	var searches int
+	var receivedViewType any
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/2.0/mlflow/experiments/get":
+			mustEncodeJSON(t, w, map[string]any{
+				"experiment": map[string]any{
+					"experiment_id":    "1",
+					"name":             "exp",
+					"last_update_time": 1000,
+				},
+			})
+		case "/api/2.0/mlflow/runs/search":
+			var req struct {
+				PageToken   string `json:"page_token"`
+				RunViewType any    `json:"run_view_type"`
+			}
+			mustDecodeJSON(t, r, &req)
+			receivedViewType = req.RunViewType
+			searches++
+
+			if req.PageToken == "" {
+				mustEncodeJSON(t, w, map[string]any{
+					"runs": []map[string]any{
+						{
+							"info": map[string]any{"run_id": "r1", "status": "FINISHED", "start_time": 2000, "end_time": 5000},
+							"data": map[string]any{
+								"metrics": []map[string]any{{"key": "loss", "value": 0.1}, {"key": "acc", "value": 0.9}},
+								"params":  []map[string]any{{"key": "lr", "value": "0.01"}},
+								"tags":    []map[string]any{{"key": "team", "value": "a"}},
+							},
+						},
+						{
+							"info": map[string]any{"run_id": "r2", "status": "FAILED", "start_time": 3000, "lifecycle_stage": "deleted"},
+							"data": map[string]any{},
+						},
+					},
+					"next_page_token": "p2",
+				})
+				return
+			}
+			mustEncodeJSON(t, w, map[string]any{
+				"runs": []map[string]any{
+					{
+						"info": map[string]any{"run_id": "r3", "status": "RUNNING", "start_time": 4000},
+						"data": map[string]any{"metrics": []map[string]any{{"key": "loss", "value": 0.5}}},
+					},
+				},
+			})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	usage, err := ExperimentStats(context.Background(), client, "1")
+	if err != nil {
+		t.Fatalf("ExperimentStats() error = %v", err)
+	}
+
+	if searches != 2 {
+		t.Errorf("searches = %d, want 2", searches)
+	}
+	if receivedViewType == nil {
+		t.Error("expected run_view_type to be set so deleted runs are counted")
+	}
+	if usage.Runs != 3 || usage.DeletedRuns != 1 {
+		t.Errorf("Runs = %d, DeletedRuns = %d, want 3, 1", usage.Runs, usage.DeletedRuns)
+	}
+	wantStatus := map[RunStatus]int{RunStatusFinished: 1, RunStatusFailed: 1, RunStatusRunning: 1}
+	for status, n := range wantStatus {
+		if usage.RunsByStatus[status] != n {
+			t.Errorf("RunsByStatus[%s] = %d, want %d", status, usage.RunsByStatus[status], n)
+		}
+	}
+	if usage.Metrics != 3 || usage.Params != 1 || usage.Tags != 1 {
+		t.Errorf("Metrics, Params, Tags = %d, %d, %d, want 3, 1, 1", usage.Metrics, usage.Params, usage.Tags)
+	}
+	if !usage.LastActivity.Equal(time.UnixMilli(5000)) {
+		t.Errorf("LastActivity = %v, want %v", usage.LastActivity, time.UnixMilli(5000))
+	}
+}
+
+func TestExperimentStats_NoRuns(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/2.0/mlflow/experiments/get" {
+			mustEncodeJSON(t, w, map[string]any{
+				"experiment": map[string]any{"experiment_id": "1", "last_update_time": 1000},
+			})
+			return
+		}
+		mustEncodeJSON(t, w, map[string]any{})
+	}))
+
+	usage, err := ExperimentStats(context.Background(), client, "1")
+	if err != nil {
+		t.Fatalf("ExperimentStats() error = %v", err)
+	}
+	if usage.Runs != 0 {
+		t.Errorf("Runs = %d, want 0", usage.Runs)
+	}
+	if !usage.LastActivity.Equal(time.UnixMilli(1000)) {
+		t.Errorf("LastActivity = %v, want experiment last update time", usage.LastActivity)
+	}
+}
+
+func TestExperimentStats_Validation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	if _, err := ExperimentStats(context.Background(), nil, "1"); err == nil {
+		t.Error("expected error for nil client")
+	}
+	if _, err := ExperimentStats(context.Background(), client, ""); err == nil {
+		t.Error("expected error for empty experiment ID")
+	}
+}
@@ -6,9 +6,13 @@
 package tracking
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/opendatahub-io/mlflow-go/internal/gen/mlflowpb"
+	"github.com/opendatahub-io/mlflow-go/internal/pagination"
+	"github.com/opendatahub-io/mlflow-go/mlflow/mlflowtags"
 )
 
 // RunStatus represents the status of a run.
@@ -45,6 +49,9 @@ var protoToRunStatus = map[mlflowpb.RunStatus]RunStatus{
 // Set via WithExperimentKind when creating an experiment.
 type ExperimentKind string
 
+// tagExperimentKind is the experiment tag MLflow uses to store the experiment kind.
+const tagExperimentKind = mlflowtags.ExperimentKind
+
 const (
 	ExperimentKindMLDevelopment    ExperimentKind = "custom_model_development"
 	ExperimentKindGenAIDevelopment ExperimentKind = "genai_development"
@@ -72,68 +79,148 @@ var viewTypeToProto = map[ViewType]mlflowpb.ViewType{
 }
 
 // Experiment represents an MLflow experiment.
+// Kind is read from the mlflow.experimentKind tag and is empty if the
+// experiment was created without one; the tag also remains in Tags.
 type Experiment struct {
-	ID               string
-	Name             string
-	ArtifactLocation string
-	LifecycleStage   string
-	Tags             map[string]string
-	CreationTime     time.Time
-	LastUpdateTime   time.Time
+	ID               string            `yaml:"experiment_id" json:"experiment_id"`
+	Name             string            `yaml:"name" json:"name"`
+	ArtifactLocation string            `yaml:"artifact_location" json:"artifact_location"`
+	LifecycleStage   string            `yaml:"lifecycle_stage" json:"lifecycle_stage"`
+	Kind             ExperimentKind    `yaml:"kind,omitempty" json:"kind,omitempty"`
+	Tags             map[string]string `yaml:"tags" json:"tags"`
+	CreationTime     time.Time         `yaml:"creation_time,omitempty" json:"creation_time,omitzero"`
+	LastUpdateTime   time.Time         `yaml:"last_update_time,omitempty" json:"last_update_time,omitzero"`
+}
+
+// String returns a one-line summary of the experiment for logs, such as
+// `experiment 7 "churn" [active] kind=automl tags=3`. Tag values are left
+// out, so it is safe to log.
+func (e Experiment) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "experiment %s %q", e.ID, e.Name)
+	if e.LifecycleStage != "" {
+		fmt.Fprintf(&b, " [%s]", e.LifecycleStage)
+	}
+	if e.Kind != "" {
+		fmt.Fprintf(&b, " kind=%s", e.Kind)
+	}
+	fmt.Fprintf(&b, " tags=%d", len(e.Tags))
+	return b.String()
 }
 
 // ExperimentList contains experiments and a pagination token.
 type ExperimentList struct {
-	Experiments   []Experiment
-	NextPageToken string
+	Experiments   []Experiment `yaml:"experiments" json:"experiments"`
+	NextPageToken string       `yaml:"next_page_token" json:"next_page_token"`
+
+	// TotalCount is the number of experiments matching the search, counted
+	// with WithExperimentsCountUpTo; nil otherwise. If TotalCountCapped is
+	// set, more than TotalCount match.
+	TotalCount       *int `yaml:"total_count,omitempty" json:"total_count,omitempty"`
+	TotalCountCapped bool `yaml:"total_count_capped,omitempty" json:"total_count_capped,omitempty"`
 }
 
 // Run represents an MLflow run with its info and data.
 type Run struct {
-	Info RunInfo
-	Data RunData
+	Info RunInfo `yaml:"info" json:"info"`
+	Data RunData `yaml:"data" json:"data"`
+
+	// index serves LatestMetric and Param; nil for runs not decoded by
+	// the client.
+	index *runIndex
+}
+
+// String returns a one-line summary of the run for logs, such as
+// `run 4f2a "train" [FINISHED] experiment=1 metrics=5 params=3 tags=7`.
+// Values of metrics, params, and tags are left out, so it is safe to log.
+func (r Run) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "run %s", r.Info.RunID)
+	if r.Info.RunName != "" {
+		fmt.Fprintf(&b, " %q", r.Info.RunName)
+	}
+	if r.Info.Status != "" {
+		fmt.Fprintf(&b, " [%s]", r.Info.Status)
+	}
+	if r.Info.ExperimentID != "" {
+		fmt.Fprintf(&b, " experiment=%s", r.Info.ExperimentID)
+	}
+	fmt.Fprintf(&b, " metrics=%d params=%d tags=%d",
+		len(r.Data.Metrics), len(r.Data.Params), len(r.Data.Tags))
+	return b.String()
 }
 
 // RunInfo contains metadata about a run.
 type RunInfo struct {
-	RunID          string
-	ExperimentID   string
-	RunName        string
-	UserID         string
-	Status         RunStatus
-	StartTime      time.Time
-	EndTime        time.Time
-	ArtifactURI    string
-	LifecycleStage string
+	RunID          string    `yaml:"run_id" json:"run_id"`
+	ExperimentID   string    `yaml:"experiment_id" json:"experiment_id"`
+	RunName        string    `yaml:"run_name" json:"run_name"`
+	UserID         string    `yaml:"user_id" json:"user_id"`
+	Status         RunStatus `yaml:"status" json:"status"`
+	StartTime      time.Time `yaml:"start_time,omitempty" json:"start_time,omitzero"`
+	EndTime        time.Time `yaml:"end_time,omitempty" json:"end_time,omitzero"`
+	ArtifactURI    string    `yaml:"artifact_uri" json:"artifact_uri"`
+	LifecycleStage string    `yaml:"lifecycle_stage" json:"lifecycle_stage"`
 }
 
 // RunData contains the metrics, params, and tags for a run.
 type RunData struct {
-	Metrics []Metric
-	Params  []Param
-	Tags    map[string]string
+	Metrics []Metric          `yaml:"metrics" json:"metrics"`
+	Params  []Param           `yaml:"params" json:"params"`
+	Tags    map[string]string `yaml:"tags" json:"tags"`
 }
 
 // Metric represents a metric logged to a run.
 type Metric struct {
-	Key       string
-	Value     float64
-	Timestamp time.Time
-	Step      int64
+	Key   string  `yaml:"key" json:"key"`
+	Value float64 `yaml:"value" json:"value"`
+	// Timestamp is the wall-clock time of the value, with millisecond
+	// precision as stored by MLflow.
+	Timestamp time.Time `yaml:"timestamp,omitempty" json:"timestamp,omitzero"`
+	Step      int64     `yaml:"step" json:"step"`
+
+	// ModelID is the LoggedModel the value belongs to, such as a model
+	// evaluated in the run. Empty for run metrics. Requires MLflow 3.
+	ModelID string `yaml:"model_id,omitempty" json:"model_id,omitempty"`
+
+	// DatasetName and DatasetDigest identify the dataset the value was
+	// computed on, if any.
+	DatasetName   string `yaml:"dataset_name,omitempty" json:"dataset_name,omitempty"`
+	DatasetDigest string `yaml:"dataset_digest,omitempty" json:"dataset_digest,omitempty"`
+}
+
+// SinceStart returns the time between the start of a run and the metric's
+// timestamp, for plotting values against training time rather than wall
+// clock. Pass the run's RunInfo.StartTime.
+func (m Metric) SinceStart(runStart time.Time) time.Duration {
+	return m.Timestamp.Sub(runStart)
 }
 
 // Param represents a parameter logged to a run.
 type Param struct {
-	Key   string
-	Value string
+	Key   string `yaml:"key" json:"key"`
+	Value string `yaml:"value" json:"value"`
 }
 
 // RunList contains runs and a pagination token.
 type RunList struct {
-	Runs          []Run
-	NextPageToken string
+	Runs          []Run  `yaml:"runs" json:"runs"`
+	NextPageToken string `yaml:"next_page_token" json:"next_page_token"`
+
+	// TotalCount is the number of runs matching the search, counted with
+	// WithRunsCountUpTo; nil otherwise. If TotalCountCapped is set, more
+	// than TotalCount match.
+	TotalCount       *int `yaml:"total_count,omitempty" json:"total_count,omitempty"`
+	TotalCountCapped bool `yaml:"total_count_capped,omitempty" json:"total_count_capped,omitempty"`
 }
 
+// Page is one page of results from a Cursor.
+type Page[T any] = pagination.Page[T]
+
+// Cursor iterates over pages of search results with Next, All, and Err.
+// It is safe for concurrent use.
+type Cursor[T any] = pagination.Cursor[T]
+
 // experimentFromProto converts a protobuf Experiment to a domain Experiment.
 func experimentFromProto(exp *mlflowpb.Experiment) Experiment {
 	if exp == nil {
@@ -158,6 +245,7 @@ func experimentFromProto(exp *mlflowpb.Experiment) Experiment {
 	for _, tag := range exp.Tags {
 		e.Tags[tag.GetKey()] = tag.GetValue()
 	}
+	e.Kind = ExperimentKind(e.Tags[tagExperimentKind])
 
 	return e
 }
@@ -168,9 +256,11 @@ func runFromProto(r *mlflowpb.Run) Run {
 		return Run{}
 	}
 
+	data := runDataFromProto(r.Data)
 	return Run{
-		Info: runInfoFromProto(r.Info),
-		Data: runDataFromProto(r.Data),
+		Info:  runInfoFromProto(r.Info),
+		Data:  data,
+		index: newRunIndex(data),
 	}
 }
 
@@ -205,6 +295,22 @@ func runInfoFromProto(ri *mlflowpb.RunInfo) RunInfo {
 	return info
 }
 
+// metricFromProto converts a protobuf Metric to a domain Metric.
+func metricFromProto(m *mlflowpb.Metric) Metric {
+	metric := Metric{
+		Key:           m.GetKey(),
+		Value:         m.GetValue(),
+		Step:          m.GetStep(),
+		ModelID:       m.GetModelId(),
+		DatasetName:   m.GetDatasetName(),
+		DatasetDigest: m.GetDatasetDigest(),
+	}
+	if m.Timestamp != nil {
+		metric.Timestamp = time.UnixMilli(*m.Timestamp)
+	}
+	return metric
+}
+
 // runDataFromProto converts a protobuf RunData to a domain RunData.
 func runDataFromProto(rd *mlflowpb.RunData) RunData {
 	if rd == nil {
@@ -218,15 +324,7 @@ func runDataFromProto(rd *mlflowpb.RunData) RunData {
 	}
 
 	for _, m := range rd.Metrics {
-		metric := Metric{
-			Key:   m.GetKey(),
-			Value: m.GetValue(),
-			Step:  m.GetStep(),
-		}
-		if m.Timestamp != nil {
-			metric.Timestamp = time.UnixMilli(*m.Timestamp)
-		}
-		data.Metrics = append(data.Metrics, metric)
+		data.Metrics = append(data.Metrics, metricFromProto(m))
 	}
 
 	for _, p := range rd.Params {
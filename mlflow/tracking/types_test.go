@@ -0,0 +1,35 @@
+package tracking
+
+import "testing"
+
+func TestRun_String(t *testing.T) {
+	run := Run{
+		Info: RunInfo{RunID: "4f2a", RunName: "train", Status: RunStatusFinished, ExperimentID: "1"},
+		Data: RunData{
+			Metrics: []Metric{{Key: "loss"}, {Key: "loss"}},
+			Params:  []Param{{Key: "api_key", Value: "sk-secret"}},
+			Tags:    map[string]string{"token": "hunter2"},
+		},
+	}
+	want := `run 4f2a "train" [FINISHED] experiment=1 metrics=2 params=1 tags=1`
+	if got := run.String(); got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+	if got := (Run{Info: RunInfo{RunID: "4f2a"}}).String(); got != "run 4f2a metrics=0 params=0 tags=0" {
+		t.Errorf("String() of bare run = %s", got)
+	}
+}
+
+func TestExperiment_String(t *testing.T) {
+	exp := Experiment{
+		ID:             "7",
+		Name:           "churn\nmodel",
+		LifecycleStage: "active",
+		Kind:           ExperimentKindAutoML,
+		Tags:           map[string]string{"owner": "ml"},
+	}
+	want := `experiment 7 "churn\nmodel" [active] kind=automl tags=1`
+	if got := exp.String(); got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+}
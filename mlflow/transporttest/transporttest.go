@@ -0,0 +1,149 @@
+// Package transporttest injects network faults into HTTP handlers, for
+// testing how code that uses the SDK handles a misbehaving MLflow server.
+//
+// Wrap the handler of a fake server with the faults to simulate:
+//
+//	srv := transporttest.NewServer(t, fakeMLflow,
+//		transporttest.Times(2, transporttest.DropConnection()),
+//		transporttest.OnPath("/api/2.0/mlflow/runs/search", transporttest.Latency(time.Second)),
+//	)
+//	client, _ := mlflow.NewClient(mlflow.WithTrackingURI(srv.URL), mlflow.WithInsecure())
+//
+// Faults act on a real connection, so the client sees the same errors it
+// would in production: an unexpected EOF, a context deadline, a response
+// that fails to decode.
+package transporttest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Fault wraps a handler so that it misbehaves.
+type Fault func(next http.Handler) http.Handler
+
+// Handler returns h wrapped in faults. The first fault sees each request
+// first.
+func Handler(h http.Handler, faults ...Fault) http.Handler {
+	for i := len(faults) - 1; i >= 0; i-- {
+		h = faults[i](h)
+	}
+	return h
+}
+
+// NewServer starts an httptest.Server serving h wrapped in faults, and
+// closes it when the test ends.
+func NewServer(tb testing.TB, h http.Handler, faults ...Fault) *httptest.Server {
+	tb.Helper()
+	srv := httptest.NewServer(Handler(h, faults...))
+	tb.Cleanup(srv.Close)
+	return srv
+}
+
+// Latency delays each request by d before passing it on. The delay ends
+// early if the client cancels the request.
+func Latency(d time.Duration) Fault {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+				next.ServeHTTP(w, r)
+			case <-r.Context().Done():
+			}
+		})
+	}
+}
+
+// DropConnection closes the connection without sending a response.
+func DropConnection() Fault {
+	return func(_ http.Handler) http.Handler {
+		return http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			panic(http.ErrAbortHandler)
+		})
+	}
+}
+
+// MalformedJSON answers 200 OK with a body that is not valid JSON.
+func MalformedJSON() Fault {
+	return func(_ http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"runs": [{"info": `))
+		})
+	}
+}
+
+// PartialWrite passes the request on but sends only the first n bytes of
+// the response body, with a Content-Length for the whole body, and then
+// closes the connection.
+func PartialWrite(n int) Fault {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := httptest.NewRecorder()
+			next.ServeHTTP(rec, r)
+
+			body := rec.Body.Bytes()
+			for k, v := range rec.Header() {
+				w.Header()[k] = v
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			w.WriteHeader(rec.Code)
+			_, _ = w.Write(body[:min(n, len(body))])
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			panic(http.ErrAbortHandler)
+		})
+	}
+}
+
+// ErrorResponse answers with status and an MLflow error body carrying code
+// (such as "RESOURCE_DOES_NOT_EXIST") and message.
+func ErrorResponse(status int, code, message string) Fault {
+	return func(_ http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = fmt.Fprintf(w, `{"error_code": %q, "message": %q}`, code, message)
+		})
+	}
+}
+
+// Times applies f to the first n requests it sees and passes later ones on
+// unchanged, for testing retries.
+func Times(n int, f Fault) Fault {
+	return func(next http.Handler) http.Handler {
+		faulty := f(next)
+		var seen atomic.Int64
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if seen.Add(1) <= int64(n) {
+				faulty.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// OnPath applies f only to requests whose URL path ends with path, so a
+// fault can target one endpoint behind any base path prefix.
+func OnPath(path string, f Fault) Fault {
+	return func(next http.Handler) http.Handler {
+		faulty := f(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, path) {
+				faulty.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
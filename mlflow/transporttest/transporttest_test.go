@@ -0,0 +1,152 @@
+package transporttest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/mlflow-go/mlflow"
+	"github.com/opendatahub-io/mlflow-go/mlflow/transporttest"
+)
+
+// fakeRun serves a run for every request.
+var fakeRun = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"run": {"info": {"run_id": "run-1", "status": "FINISHED"}, "data": {}}}`))
+})
+
+func newClient(t *testing.T, faults ...transporttest.Fault) *mlflow.Client {
+	t.Helper()
+	srv := transporttest.NewServer(t, fakeRun, faults...)
+	client, err := mlflow.NewClient(mlflow.WithTrackingURI(srv.URL), mlflow.WithInsecure())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestLatency_ContextDeadline(t *testing.T) {
+	client := newClient(t, transporttest.Latency(10*time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Tracking().GetRun(ctx, "run-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetRun() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("GetRun() took %v, want it to return at the deadline", elapsed)
+	}
+}
+
+func TestLatency_CancelInFlight(t *testing.T) {
+	client := newClient(t, transporttest.Latency(10*time.Second))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := client.Tracking().GetRun(ctx, "run-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("GetRun() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestLatency_Completes(t *testing.T) {
+	client := newClient(t, transporttest.Latency(10*time.Millisecond))
+
+	run, err := client.Tracking().GetRun(context.Background(), "run-1")
+	if err != nil {
+		t.Fatalf("GetRun() error = %v", err)
+	}
+	if run.Info.RunID != "run-1" {
+		t.Errorf("RunID = %q, want run-1", run.Info.RunID)
+	}
+}
+
+func TestDropConnection(t *testing.T) {
+	client := newClient(t, transporttest.DropConnection())
+
+	_, err := client.Tracking().GetRun(context.Background(), "run-1")
+	if err == nil {
+		t.Fatal("expected error for dropped connection")
+	}
+	var apiErr *mlflow.APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("GetRun() error = %v, want a transport error, not an API error", err)
+	}
+}
+
+func TestMalformedJSON(t *testing.T) {
+	client := newClient(t, transporttest.MalformedJSON())
+
+	_, err := client.Tracking().GetRun(context.Background(), "run-1")
+	if err == nil || !strings.Contains(err.Error(), "failed to decode response") {
+		t.Fatalf("GetRun() error = %v, want decode error", err)
+	}
+}
+
+func TestPartialWrite(t *testing.T) {
+	client := newClient(t, transporttest.PartialWrite(10))
+
+	_, err := client.Tracking().GetRun(context.Background(), "run-1")
+	if err == nil {
+		t.Fatal("expected error for truncated response")
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	client := newClient(t, transporttest.ErrorResponse(http.StatusNotFound, "RESOURCE_DOES_NOT_EXIST", "Run 'run-1' not found"))
+
+	_, err := client.Tracking().GetRun(context.Background(), "run-1")
+	if !mlflow.IsNotFound(err) {
+		t.Fatalf("GetRun() error = %v, want not found", err)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	client := newClient(t, transporttest.Times(1, transporttest.ErrorResponse(http.StatusServiceUnavailable, "", "unavailable")))
+	ctx := context.Background()
+
+	if _, err := client.Tracking().GetRun(ctx, "run-1"); err == nil {
+		t.Fatal("expected first request to fail")
+	}
+	if _, err := client.Tracking().GetRun(ctx, "run-1"); err != nil {
+		t.Fatalf("second GetRun() error = %v, want success", err)
+	}
+}
+
+func TestOnPath(t *testing.T) {
+	client := newClient(t, transporttest.OnPath("/runs/search", transporttest.MalformedJSON()))
+	ctx := context.Background()
+
+	if _, err := client.Tracking().GetRun(ctx, "run-1"); err != nil {
+		t.Fatalf("GetRun() error = %v, want other paths unaffected", err)
+	}
+	if _, err := client.Tracking().SearchRuns(ctx, []string{"1"}); err == nil {
+		t.Fatal("expected SearchRuns to fail")
+	}
+}
+
+func TestHandler_Order(t *testing.T) {
+	// The first fault sees the request first, so the error wins over latency
+	client := newClient(t,
+		transporttest.ErrorResponse(http.StatusInternalServerError, "INTERNAL_ERROR", "boom"),
+		transporttest.Latency(10*time.Second),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.Tracking().GetRun(ctx, "run-1")
+	var apiErr *mlflow.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("GetRun() error = %v, want 500", err)
+	}
+}
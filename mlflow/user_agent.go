@@ -0,0 +1,54 @@
+package mlflow
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// modulePath is the SDK's module path, used to find its version in the
+// build info of the binary it is linked into.
+const modulePath = "github.com/opendatahub-io/mlflow-go"
+
+// sdkVersion returns the SDK's module version, or "devel" when it is not
+// known, such as in the SDK's own tests.
+func sdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	version := ""
+	if info.Main.Path == modulePath {
+		version = info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			version = dep.Version
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				version = dep.Replace.Version
+			}
+		}
+	}
+	if version == "" || version == "(devel)" {
+		return "devel"
+	}
+	return version
+}
+
+// userAgent builds the User-Agent header: the SDK name and version, the Go
+// version and platform, then any suffixes, such as
+// "mlflow-go/v0.4.0 go/1.24.2 (linux/amd64) my-service/1.2.3".
+func userAgent(suffixes []string) string {
+	parts := []string{
+		"mlflow-go/" + sdkVersion(),
+		"go/" + strings.TrimPrefix(runtime.Version(), "go"),
+		"(" + runtime.GOOS + "/" + runtime.GOARCH + ")",
+	}
+	return strings.Join(append(parts, suffixes...), " ")
+}
+
+// UserAgent returns the User-Agent header the client sends. A User-Agent
+// set with WithHeaders takes precedence.
+func (c *Client) UserAgent() string {
+	return c.userAgent
+}
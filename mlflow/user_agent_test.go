@@ -0,0 +1,74 @@
+package mlflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestClient_UserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"experiment": {"experiment_id": "1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithUserAgentSuffix("my-service/1.2.3"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ua := client.UserAgent()
+	if !strings.HasPrefix(ua, "mlflow-go/") || !strings.HasSuffix(ua, " my-service/1.2.3") {
+		t.Errorf("UserAgent() = %q", ua)
+	}
+	if !strings.Contains(ua, "go/"+strings.TrimPrefix(runtime.Version(), "go")) {
+		t.Errorf("UserAgent() = %q, want Go version", ua)
+	}
+
+	if _, err = client.Tracking().GetExperiment(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExperiment() error = %v", err)
+	}
+	if got != ua {
+		t.Errorf("User-Agent sent = %q, want %q", got, ua)
+	}
+}
+
+func TestClient_UserAgent_HeaderOverride(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithTrackingURI(server.URL),
+		WithInsecure(),
+		WithHeaders(map[string]string{"User-Agent": "custom"}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	_, _ = client.Tracking().GetExperiment(context.Background(), "1")
+	if got != "custom" {
+		t.Errorf("User-Agent sent = %q, want custom", got)
+	}
+}
+
+func TestNewClient_InvalidUserAgentSuffix(t *testing.T) {
+	_, err := NewClient(WithTrackingURI("https://mlflow.example.com"), WithUserAgentSuffix("bad\r\nX-Injected: 1"))
+	if err == nil {
+		t.Error("expected error for suffix with line breaks")
+	}
+}
@@ -71,8 +71,7 @@ func runPromptDemo(ctx context.Context, client *mlflow.Client) {
 	if err != nil {
 		log.Fatalf("Failed to load latest prompt: %v", err)
 	}
-	fmt.Printf("  Loaded version %d (latest)\n", latestPrompt.Version)
-	printPromptVersion(latestPrompt)
+	fmt.Printf("  Loaded %s\n", latestPrompt)
 
 	// === 3. Load specific version ===
 	fmt.Println("\n=== 3. LoadPrompt with WithVersion: Loading version 1 ===")
@@ -288,18 +287,12 @@ func deleteDemo(ctx context.Context, client *mlflow.Client, promptName, chatProm
 	}
 
 	// Final cleanup
-	fmt.Println("\n=== 8f. Cleanup: Deleting remaining versions and prompt ===")
-	err = client.PromptRegistry().DeletePromptVersion(ctx, promptName, 2)
-	if err != nil {
-		log.Fatalf("Failed to delete version 2: %v", err)
-	}
-	fmt.Printf("  Deleted version 2 of %s\n", promptName)
-
-	err = client.PromptRegistry().DeletePrompt(ctx, promptName)
+	fmt.Println("\n=== 8f. DeletePromptCompletely: Deleting remaining aliases, versions, and prompt ===")
+	err = promptregistry.DeletePromptCompletely(ctx, client.PromptRegistry(), promptName)
 	if err != nil {
 		log.Fatalf("Failed to delete prompt: %v", err)
 	}
-	fmt.Printf("  Deleted prompt %s\n", promptName)
+	fmt.Printf("  Deleted prompt %s and all of its versions\n", promptName)
 
 	_, err = client.PromptRegistry().LoadPrompt(ctx, promptName)
 	if !mlflow.IsNotFound(err) {
@@ -400,7 +393,7 @@ func runTrackingDemo(ctx context.Context, client *mlflow.Client) {
 	if err != nil {
 		log.Fatalf("Failed to get experiment: %v", err)
 	}
-	fmt.Printf("  Name: %s\n  Lifecycle: %s\n", exp.Name, exp.LifecycleStage)
+	fmt.Printf("  %s\n", exp)
 
 	// === Path 11b: Get experiment by name ===
 	fmt.Println("\n=== 11b. GetExperimentByName ===")
@@ -495,8 +488,7 @@ func runTrackingDemo(ctx context.Context, client *mlflow.Client) {
 	if err != nil {
 		log.Fatalf("Failed to get run: %v", err)
 	}
-	fmt.Printf("  Run: %s\n", loadedRun.Info.RunID)
-	fmt.Printf("  Status: %s\n", loadedRun.Info.Status)
+	fmt.Printf("  %s\n", loadedRun)
 	fmt.Printf("  Params (%d):\n", len(loadedRun.Data.Params))
 	for _, p := range loadedRun.Data.Params {
 		fmt.Printf("    %s = %s\n", p.Key, p.Value)
@@ -505,6 +497,12 @@ func runTrackingDemo(ctx context.Context, client *mlflow.Client) {
 	for _, m := range loadedRun.Data.Metrics {
 		fmt.Printf("    %s = %.4f (step %d)\n", m.Key, m.Value, m.Step)
 	}
+	if rmse, ok := loadedRun.LatestMetric("rmse"); ok {
+		fmt.Printf("  Final rmse: %.4f at step %d\n", rmse.Value, rmse.Step)
+	}
+	if depth, ok := loadedRun.Param("max_depth"); ok {
+		fmt.Printf("  max_depth: %s\n", depth)
+	}
 
 	// === Path 20: Update run ===
 	fmt.Println("\n=== 20. UpdateRun: Marking run as finished ===")
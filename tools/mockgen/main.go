@@ -0,0 +1,395 @@
+// Command mockgen generates func-field mocks for the SDK's API interfaces.
+//
+// It reads a single interface declaration from a source file and writes a
+// struct with one XxxFunc field per method, in the style of moq, without
+// needing the type checker or any third-party tooling:
+//
+//	go run ./tools/mockgen -src mlflow/tracking/api.go \
+//		-import github.com/opendatahub-io/mlflow-go/mlflow/tracking \
+//		-mock Tracking -out mlflow/mlflowmock/tracking.go
+//
+// The generated mock embeds the hand-written recorder type from the output
+// package, which must provide record(method string, args ...any).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	var (
+		src        = flag.String("src", "", "Go file declaring the interface")
+		iface      = flag.String("iface", "API", "name of the interface to mock")
+		importPath = flag.String("import", "", "import path of the package declaring the interface")
+		mock       = flag.String("mock", "", "name of the generated mock type")
+		pkg        = flag.String("pkg", "mlflowmock", "package name of the generated file")
+		out        = flag.String("out", "", "output file (default stdout)")
+	)
+	flag.Parse()
+
+	if *src == "" || *importPath == "" || *mock == "" {
+		fmt.Fprintln(os.Stderr, "mockgen: -src, -import and -mock are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	code, err := generate(*src, *iface, *importPath, *mock, *pkg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mockgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		_, _ = os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil { //nolint:gosec // generated source is meant to be readable
+		fmt.Fprintf(os.Stderr, "mockgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// method is an interface method ready to be rendered.
+type method struct {
+	name     string
+	params   []param
+	results  []string
+	variadic bool
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+func generate(src, iface, importPath, mock, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, src, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := findInterface(file, iface)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &qualifier{
+		pkgName: file.Name.Name,
+		imports: fileImports(file),
+		used:    map[string]string{file.Name.Name: importPath},
+	}
+
+	var methods []method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, fmt.Errorf("%s: embedded interfaces are not supported", iface)
+		}
+		m, err := q.method(fset, field.Names[0].Name, ft)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, m)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by tools/mockgen from %s. DO NOT EDIT.\n\n", path.Join(path.Base(importPath), path.Base(src)))
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+
+	b.WriteString("import (\n")
+	names := make([]string, 0, len(q.used))
+	for name := range q.used {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		pa, pb := q.used[a], q.used[b]
+		if isStdlib(pa) != isStdlib(pb) {
+			if isStdlib(pa) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(pa, pb)
+	})
+	for i, name := range names {
+		p := q.used[name]
+		// Standard library imports first, as goimports groups them
+		if i > 0 && isStdlib(q.used[names[i-1]]) && !isStdlib(p) {
+			b.WriteString("\n")
+		}
+		if path.Base(p) == name {
+			fmt.Fprintf(&b, "\t%q\n", p)
+		} else {
+			fmt.Fprintf(&b, "\t%s %q\n", name, p)
+		}
+	}
+	b.WriteString(")\n\n")
+
+	ifaceRef := q.pkgName + "." + iface
+	fmt.Fprintf(&b, "// %s is a mock implementation of %s.\n", mock, ifaceRef)
+	fmt.Fprintf(&b, "// Set the Func field of each method the code under test calls;\n")
+	fmt.Fprintf(&b, "// calling a method whose Func field is nil panics.\n")
+	fmt.Fprintf(&b, "type %s struct {\n", mock)
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc func(%s) %s\n", m.name, m.signatureParams(), m.signatureResults())
+	}
+	b.WriteString("\n\trecorder\n}\n\n")
+	fmt.Fprintf(&b, "var _ %s = (*%s)(nil)\n", ifaceRef, mock)
+
+	for _, m := range methods {
+		args := make([]string, len(m.params))
+		for i, p := range m.params {
+			args[i] = p.name
+		}
+		callArgs := strings.Join(args, ", ")
+		if m.variadic {
+			callArgs += "..."
+		}
+		recordArgs := append([]string{strconv.Quote(m.name)}, args...)
+
+		fmt.Fprintf(&b, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (mock *%s) %s(%s) %s {\n", mock, m.name, m.signatureParams(), m.signatureResults())
+		fmt.Fprintf(&b, "\tmock.record(%s)\n", strings.Join(recordArgs, ", "))
+		fmt.Fprintf(&b, "\tif mock.%sFunc == nil {\n", m.name)
+		fmt.Fprintf(&b, "\t\tpanic(%q)\n", fmt.Sprintf("mlflowmock: %s.%s called but %sFunc is not set", mock, m.name, m.name))
+		b.WriteString("\t}\n\t")
+		if len(m.results) > 0 {
+			b.WriteString("return ")
+		}
+		fmt.Fprintf(&b, "mock.%sFunc(%s)\n}\n", m.name, callArgs)
+	}
+
+	code, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.Bytes())
+	}
+	return code, nil
+}
+
+func findInterface(file *ast.File, name string) (*ast.InterfaceType, error) {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || ts.Name.Name != name {
+				continue
+			}
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok {
+				return nil, fmt.Errorf("%s is not an interface", name)
+			}
+			return it, nil
+		}
+	}
+	return nil, fmt.Errorf("interface %s not found", name)
+}
+
+// fileImports maps the names by which file refers to its imports to their
+// import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string, len(file.Imports))
+	for _, spec := range file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+// qualifier rewrites types from the interface's package so they can be used
+// from another package, recording the imports they need.
+type qualifier struct {
+	pkgName string
+	imports map[string]string
+	used    map[string]string
+}
+
+func (q *qualifier) method(fset *token.FileSet, name string, ft *ast.FuncType) (method, error) {
+	m := method{name: name}
+
+	if ft.Params != nil {
+		for _, field := range ft.Params.List {
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				m.variadic = true
+			}
+			typ, err := q.render(fset, field.Type)
+			if err != nil {
+				return method{}, err
+			}
+			if len(field.Names) == 0 {
+				m.params = append(m.params, param{name: fmt.Sprintf("p%d", len(m.params)), typ: typ})
+				continue
+			}
+			for _, n := range field.Names {
+				pname := n.Name
+				if pname == "_" || pname == "mock" {
+					pname = fmt.Sprintf("p%d", len(m.params))
+				}
+				m.params = append(m.params, param{name: pname, typ: typ})
+			}
+		}
+	}
+
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			typ, err := q.render(fset, field.Type)
+			if err != nil {
+				return method{}, err
+			}
+			for range max(len(field.Names), 1) {
+				m.results = append(m.results, typ)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (q *qualifier) render(fset *token.FileSet, expr ast.Expr) (string, error) {
+	qualified, err := q.qualify(expr)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := format.Node(&b, fset, qualified); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (q *qualifier) qualify(expr ast.Expr) (ast.Expr, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if !token.IsExported(e.Name) {
+			return e, nil
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(q.pkgName), Sel: ast.NewIdent(e.Name)}, nil
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type expression %T", e.X)
+		}
+		p, ok := q.imports[x.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown package %s", x.Name)
+		}
+		q.used[x.Name] = p
+		return e, nil
+	case *ast.StarExpr:
+		x, err := q.qualify(e.X)
+		return &ast.StarExpr{X: x}, err
+	case *ast.Ellipsis:
+		elt, err := q.qualify(e.Elt)
+		return &ast.Ellipsis{Elt: elt}, err
+	case *ast.ArrayType:
+		elt, err := q.qualify(e.Elt)
+		return &ast.ArrayType{Len: e.Len, Elt: elt}, err
+	case *ast.MapType:
+		key, err := q.qualify(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := q.qualify(e.Value)
+		return &ast.MapType{Key: key, Value: value}, err
+	case *ast.ChanType:
+		value, err := q.qualify(e.Value)
+		return &ast.ChanType{Dir: e.Dir, Value: value}, err
+	case *ast.IndexExpr:
+		x, err := q.qualify(e.X)
+		if err != nil {
+			return nil, err
+		}
+		index, err := q.qualify(e.Index)
+		return &ast.IndexExpr{X: x, Index: index}, err
+	case *ast.IndexListExpr:
+		x, err := q.qualify(e.X)
+		if err != nil {
+			return nil, err
+		}
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, index := range e.Indices {
+			if indices[i], err = q.qualify(index); err != nil {
+				return nil, err
+			}
+		}
+		return &ast.IndexListExpr{X: x, Indices: indices}, nil
+	case *ast.FuncType:
+		params, err := q.fieldList(e.Params)
+		if err != nil {
+			return nil, err
+		}
+		results, err := q.fieldList(e.Results)
+		return &ast.FuncType{Params: params, Results: results}, err
+	case *ast.InterfaceType:
+		if len(e.Methods.List) > 0 {
+			return nil, fmt.Errorf("non-empty interface literals are not supported")
+		}
+		return e, nil
+	default:
+		return nil, fmt.Errorf("unsupported type expression %T", expr)
+	}
+}
+
+func (q *qualifier) fieldList(fl *ast.FieldList) (*ast.FieldList, error) {
+	if fl == nil {
+		return nil, nil
+	}
+	out := &ast.FieldList{List: make([]*ast.Field, len(fl.List))}
+	for i, field := range fl.List {
+		typ, err := q.qualify(field.Type)
+		if err != nil {
+			return nil, err
+		}
+		out.List[i] = &ast.Field{Names: field.Names, Type: typ}
+	}
+	return out, nil
+}
+
+// isStdlib reports whether importPath is a standard library package, which by
+// convention have no dot in their first path element.
+func isStdlib(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+// signatureParams renders the parameter list of m.
+func (m method) signatureParams() string {
+	parts := make([]string, len(m.params))
+	for i, p := range m.params {
+		parts[i] = p.name + " " + p.typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+// signatureResults renders the result list of m.
+func (m method) signatureResults() string {
+	switch len(m.results) {
+	case 0:
+		return ""
+	case 1:
+		return m.results[0]
+	default:
+		return "(" + strings.Join(m.results, ", ") + ")"
+	}
+}